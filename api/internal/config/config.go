@@ -13,7 +13,12 @@ type Config struct {
 	RedisConfig            []*cache.RedisConfigs `json:"RedisConfig"`
 	SnowFlake              SnowFlake             `json:"SnowFlake,optional"` //雪花算法参数
 	Alarm                  Alarm                 //自定义告警
-	BaseAppConfigServerUrl string                `json:"BaseAppConfigServerUrl"` // baseAppConfigServer地址
+	BaseAppConfigServerUrl string                `json:"BaseAppConfigServerUrl"`   // baseAppConfigServer地址
+	SubscribeDeduct        SubscribeDeduct       `json:"SubscribeDeduct,optional"` // 续费扣款并发控制
+	Jobs                   []JobConf             `json:"Jobs,optional"`            // 定时任务配置，未配置的任务使用默认执行时间
+	Supplementary          Supplementary         `json:"Supplementary,optional"`   // 补单并发控制
+	DyPeriodDeduct         DyPeriodDeduct        `json:"DyPeriodDeduct,optional"`  // 抖音周期代扣
+	SecretMasterKey        string                `json:"SecretMasterKey,optional"` // 商户密钥加密主密钥，base64编码的32字节，为空时读取环境变量PAY_GATEWAY_MASTER_KEY
 }
 
 // nacos配置
@@ -36,6 +41,43 @@ type SnowFlake struct {
 	WorkerNo  int64 //数据中心ID
 }
 
+// 续费扣款并发控制，按支付宝appid分别限制
+type SubscribeDeduct struct {
+	Concurrency int `json:",default=5"`  // 每个appid同时扣款的协程数
+	Qps         int `json:",default=10"` // 每个appid每秒最多扣款请求数
+}
+
+// 补单并发控制，按渠道分别限制
+type Supplementary struct {
+	Concurrency int                    `json:",default=5"`   // 每个渠道同时查单的协程数
+	Qps         int                    `json:",default=20"`  // 每个渠道每秒最多查单请求数
+	BatchSize   int                    `json:",default=500"` // 每批从数据库读取的订单数
+	Channels    []SupplementaryChannel `json:",optional"`    // 单独配置并发的渠道，未配置的渠道使用上面的默认值
+}
+
+// 单个渠道的补单并发配置
+type SupplementaryChannel struct {
+	Channel     string // 渠道：wxUni|wxH5|douyin|ks|alipay|dyPeriod
+	Concurrency int    `json:",optional"`
+	Qps         int    `json:",optional"`
+}
+
+// 抖音周期代扣，由网关按签约单的下次扣款时间发起扣款
+type DyPeriodDeduct struct {
+	AppPkgs     []string `json:",optional"`    // 由网关扣款的包名，为空时不扣款；这些包名的业务方不再通过DyPeriodActionGetPayList自行扣款
+	Concurrency int      `json:",default=5"`   // 同时扣款的协程数
+	Qps         int      `json:",default=10"`  // 每秒最多扣款请求数
+	BatchSize   int      `json:",default=200"` // 每批从数据库读取的签约单数
+}
+
+// 定时任务配置
+type JobConf struct {
+	Name     string // 任务名
+	Spec     string `json:",optional"`     // cron表达式(秒 分 时 日 月 周)，为空时只能手动触发
+	Timeout  int    `json:",default=3600"` // 超时时间，秒
+	Disabled bool   `json:",optional"`     // 不定时执行
+}
+
 type Alarm struct {
 	Redis       cache.PublishRedisConfig
 	DingDingUrl string
@@ -0,0 +1,258 @@
+package crontab
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	dbmodel "gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	redisDeductCheckpointKey = "payGateway:subscribeDeduct:checkpoint:%s:%s" // %s:任务名 %s:日期，记录已扣完的最大订单id
+	redisDeductRunningKey    = "payGateway:subscribeDeduct:running:%s"       // %s:任务名，防止同一任务同时执行
+
+	deductCheckpointTtl = 86400       // 断点保留1天，第二天重新开始
+	deductRunningTtl    = 3 * 3600000 // 执行锁最长3小时，毫秒
+)
+
+// 续费扣款执行汇总
+type DeductSummary struct {
+	Job        string    `json:"job"`         // 任务名
+	ResumeFrom int       `json:"resume_from"` // 从哪个订单id之后开始，断点续扣时大于起始id
+	LastId     int       `json:"last_id"`     // 最后处理的订单id
+	Total      int64     `json:"total"`       // 处理订单数
+	Success    int64     `json:"success"`     // 扣款成功数
+	Fail       int64     `json:"fail"`        // 扣款失败数
+	Aborted    bool      `json:"aborted"`     // 是否因失去leader或超时中止
+	StartAt    time.Time `json:"start_at"`    // 开始时间
+	EndAt      time.Time `json:"end_at"`      // 结束时间
+}
+
+// 续费扣款执行器：按id游标分批读取订单，分发到各支付宝appid的协程池，每个appid限制并发数和qps
+// 协程池在整个任务期间复用，读取下一批不用等上一批扣完；断点记录为已分发订单中未扣完的最小id之前，从断点继续时不会漏扣
+type deductRunner struct {
+	c           *CrontabOrder
+	job         string
+	fence       *dbmodel.LeaderFence // 执行任务时的leader fencing token，更新订单时校验
+	concurrency int
+	qps         int
+	rdb         *cache.RedisInstance
+	pools       map[string]*deductPool // 每个appid一个协程池
+	workerWg    sync.WaitGroup
+	stopped     int32 // 中止后队列中的订单不再扣款
+
+	mu         sync.Mutex
+	pending    map[int]bool // 已分发未扣完的订单id
+	dispatched int          // 已分发的最大订单id
+
+	summary *DeductSummary
+}
+
+// 一个支付宝appid的扣款协程池
+type deductPool struct {
+	tasks   chan *dbmodel.OrderTable
+	limiter *time.Ticker
+}
+
+func (c *CrontabOrder) newDeductRunner(job string, token int64) *deductRunner {
+	r := &deductRunner{
+		c:           c,
+		job:         job,
+		fence:       &dbmodel.LeaderFence{Name: CronLeaderName, Token: token},
+		concurrency: c.Conf.SubscribeDeduct.Concurrency,
+		qps:         c.Conf.SubscribeDeduct.Qps,
+		rdb:         db.WithRedisDBContext(define.DbPayGateway),
+		pools:       make(map[string]*deductPool),
+		pending:     make(map[int]bool),
+	}
+	if r.concurrency <= 0 {
+		r.concurrency = 1
+	}
+	if r.qps <= 0 {
+		r.qps = 20 // 与原来每单间隔50ms一致
+	}
+	return r
+}
+
+// 从lastId之后开始扣款，当天有断点时从断点继续
+func (r *deductRunner) run(ctx context.Context, lastId int, getRangeData func(id int) ([]*dbmodel.OrderTable, error)) *DeductSummary {
+	runningKey, value := fmt.Sprintf(redisDeductRunningKey, r.job), uuid.New().String()
+	isLock, err := r.rdb.TryLockWithTimeout(context.Background(), runningKey, value, deductRunningTtl)
+	if err != nil || !isLock {
+		logx.Errorf("续费扣款任务正在执行中 job: %s, err: %v", r.job, err)
+		return nil
+	}
+	defer r.rdb.Unlock(context.Background(), runningKey, value)
+
+	checkpointKey := fmt.Sprintf(redisDeductCheckpointKey, r.job, time.Now().Format("20060102"))
+	if checkpoint, err := r.rdb.GetString(context.Background(), checkpointKey); err == nil {
+		if id, _ := strconv.Atoi(checkpoint); id > lastId {
+			logx.Errorf("续费扣款从断点继续 job: %s, checkpoint: %d", r.job, id)
+			lastId = id
+		}
+	}
+
+	r.dispatched = lastId
+	r.summary = &DeductSummary{
+		Job:        r.job,
+		ResumeFrom: lastId,
+		LastId:     lastId,
+		StartAt:    time.Now(),
+	}
+
+	for !r.summary.Aborted {
+		// 每批读取前确认仍是leader，租约丢失或超时后由下次执行从断点继续
+		if !r.c.Leader.CheckToken(r.fence.Token) {
+			logx.Errorf("续费扣款中止，当前节点已不是leader job: %s, token: %d", r.job, r.fence.Token)
+			r.summary.Aborted = true
+			break
+		}
+		if ctx.Err() != nil {
+			logx.Errorf("续费扣款中止，执行超时 job: %s, lastId: %d", r.job, lastId)
+			r.summary.Aborted = true
+			break
+		}
+
+		models, err := getRangeData(lastId)
+		if err != nil {
+			logx.Errorf("orderModel::GetRangeData error: %v", err)
+			break
+		}
+		if len(models) == 0 {
+			break
+		}
+
+		for _, tb := range models {
+			if !r.dispatch(ctx, tb) {
+				logx.Errorf("续费扣款中止，执行超时 job: %s, lastId: %d", r.job, lastId)
+				r.summary.Aborted = true
+				break
+			}
+			lastId = tb.ID
+		}
+		r.summary.LastId = lastId
+		r.rdb.Set(context.Background(), checkpointKey, strconv.Itoa(r.checkpoint()), deductCheckpointTtl)
+	}
+
+	// 中止时队列中的订单不再扣款，留给下次执行
+	if r.summary.Aborted {
+		atomic.StoreInt32(&r.stopped, 1)
+	}
+	r.stop()
+
+	if r.summary.Aborted {
+		r.rdb.Set(context.Background(), checkpointKey, strconv.Itoa(r.checkpoint()), deductCheckpointTtl)
+	} else {
+		// 全部扣完后清除断点，当天再次执行时重新扫描
+		r.rdb.Set(context.Background(), checkpointKey, "0", deductCheckpointTtl)
+	}
+
+	r.summary.EndAt = time.Now()
+	logx.Errorf("续费扣款执行完成 job: %s, resumeFrom: %d, lastId: %d, total: %d, success: %d, fail: %d, cost: %s",
+		r.job, r.summary.ResumeFrom, r.summary.LastId, r.summary.Total, r.summary.Success, r.summary.Fail, r.summary.EndAt.Sub(r.summary.StartAt))
+	return r.summary
+}
+
+// 把订单分发到appid的协程池，协程池队列满时等待，超时返回false
+func (r *deductRunner) dispatch(ctx context.Context, tb *dbmodel.OrderTable) bool {
+	r.mu.Lock()
+	r.pending[tb.ID] = true
+	r.mu.Unlock()
+
+	select {
+	case r.pool(tb.PayAppID).tasks <- tb:
+	case <-ctx.Done():
+		r.mu.Lock()
+		delete(r.pending, tb.ID)
+		r.mu.Unlock()
+		return false
+	}
+
+	r.mu.Lock()
+	r.dispatched = tb.ID
+	r.mu.Unlock()
+	return true
+}
+
+// appid的协程池，第一次用到时启动
+func (r *deductRunner) pool(payAppId string) *deductPool {
+	pool, ok := r.pools[payAppId]
+	if ok {
+		return pool
+	}
+	pool = &deductPool{
+		tasks:   make(chan *dbmodel.OrderTable, r.concurrency*2),
+		limiter: time.NewTicker(time.Second / time.Duration(r.qps)),
+	}
+	r.pools[payAppId] = pool
+
+	for i := 0; i < r.concurrency; i++ {
+		r.workerWg.Add(1)
+		go func() {
+			defer r.workerWg.Done()
+			for tb := range pool.tasks {
+				if atomic.LoadInt32(&r.stopped) == 1 {
+					continue
+				}
+				<-pool.limiter.C
+				r.deduct(tb)
+
+				r.mu.Lock()
+				delete(r.pending, tb.ID)
+				r.mu.Unlock()
+			}
+		}()
+	}
+	return pool
+}
+
+// 关闭协程池，等待队列中的订单处理完
+func (r *deductRunner) stop() {
+	for _, pool := range r.pools {
+		close(pool.tasks)
+	}
+	r.workerWg.Wait()
+	for _, pool := range r.pools {
+		pool.limiter.Stop()
+	}
+}
+
+// 可以记录的断点：未扣完的最小订单id之前的订单都已扣完，没有未扣完的订单时为已分发的最大id
+func (r *deductRunner) checkpoint() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	checkpoint := r.dispatched
+	for id := range r.pending {
+		if id-1 < checkpoint {
+			checkpoint = id - 1
+		}
+	}
+	return checkpoint
+}
+
+func (r *deductRunner) deduct(tb *dbmodel.OrderTable) {
+	defer exception.Recover()
+	atomic.AddInt64(&r.summary.Total, 1)
+
+	logx.Errorf("开始扣款订单号：%s", tb.OutTradeNo)
+	err := r.c.PaySubscribeFee(tb, r.fence)
+	if err != nil {
+		logx.Errorf("扣款失败 %v", err)
+		PaySubscribeFeeErrNum.CounterInc()
+		atomic.AddInt64(&r.summary.Fail, 1)
+	} else {
+		logx.Errorf("扣款成功：%s", tb.OutTradeNo)
+		atomic.AddInt64(&r.summary.Success, 1)
+	}
+}
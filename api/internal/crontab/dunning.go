@@ -0,0 +1,199 @@
+package crontab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	alipay2 "gitlab.muchcloud.com/consumer-project/alipay"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/utils"
+	dbmodel "gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/alarm"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	SubscribeLapsedNum             = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "SubscribeLapsedNum", nil, "续费最终失败订阅失效", nil})}
+	SubscribeFirstDeductExpiredNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "SubscribeFirstDeductExpiredNum", nil, "续费订单超过30天未首次扣款被关闭", nil})}
+)
+
+// 支付宝扣款失败子码中不可重试的错误，协议已失效或用户状态异常，重试也不会成功
+var alipayDeductTerminalSubCodes = map[string]bool{
+	"ACQ.AGREEMENT_NOT_EXIST":         true, // 协议不存在
+	"ACQ.AGREEMENT_INVALID":           true, // 协议已失效
+	"ACQ.AGREEMENT_STATUS_NOT_NORMAL": true, // 协议状态非正常
+	"ACQ.AGREEMENT_ERROR":             true, // 协议信息异常
+	"ACQ.BUYER_ENABLE_STATUS_FORBID":  true, // 买家状态非法
+	"ACQ.BUYER_SELLER_EQUAL":          true, // 买卖家不能相同
+	"ACQ.TRADE_HAS_CLOSE":             true, // 交易已关闭
+	"ACQ.TRADE_BUYER_NOT_MATCH":       true, // 交易买家不匹配
+}
+
+// 交易已支付成功，并发扣款时另一次扣款已成功，按扣款成功处理
+const alipayTradeHasSuccessSubCode = "ACQ.TRADE_HAS_SUCCESS"
+
+// 续费订单创建超过30天仍未首次扣款，关闭订单时记录的错误码
+const firstDeductExpiredSubCode = "FIRST_DEDUCT_EXPIRED"
+
+// 判断扣款失败是否可以重试，余额不足、系统繁忙、限额等都可以重试，未知错误默认重试
+func isAlipayDeductRetryable(subCode string) bool {
+	return !alipayDeductTerminalSubCodes[subCode]
+}
+
+// 续费扣款失败后的处理结果
+type deductRetryResult struct {
+	Attempts       int       // 已尝试次数
+	Lapsed         bool      // 是否已最终失败
+	NextDeductTime time.Time // 下次重试时间
+	SubCode        string    // 失败子码
+}
+
+// 扣款失败：未到最后一次且错误可重试时按包名的重试计划安排下次扣款，否则关闭订单，订阅失效
+// fence为执行扣款时的leader fencing token，已不是leader时不更新订单
+func (c *CrontabOrder) handleDeductFail(tb *dbmodel.OrderTable, subCode string, fence *dbmodel.LeaderFence) *deductRetryResult {
+	result := &deductRetryResult{
+		Attempts: tb.DeductAttempts + 1,
+		SubCode:  subCode,
+	}
+	orderModel := dbmodel.NewOrderModel(define.DbPayGateway)
+
+	steps := deductRetrySteps(tb.AppPkg)
+	if !isAlipayDeductRetryable(subCode) || tb.DeductAttempts >= len(steps) {
+		result.Lapsed = true
+		orderModel.LapseSubscribeFee(tb.ID, result.Attempts, subCode, fence)
+		return result
+	}
+
+	result.NextDeductTime = deductRetryTime(steps, tb.DeductAttempts)
+	orderModel.UpdateDeductRetry(tb.ID, result.Attempts, subCode, result.NextDeductTime, fence)
+	return result
+}
+
+// 扣款返回交易已成功时查询支付宝交易，确认已支付后把订单置为已支付并回调业务方
+// 支付宝异步通知可能已先处理了订单，这时不再回调
+func (c *CrontabOrder) confirmDeductPaid(payClient *alipay2.Client, tb *dbmodel.OrderTable) error {
+	res, err := payClient.TradeQuery(alipay2.TradeQuery{
+		OutTradeNo: tb.OutTradeNo,
+	})
+	if err != nil {
+		return fmt.Errorf("续费扣款: 查询支付宝交易失败 outTradeNo=%s, err=%v", tb.OutTradeNo, err)
+	}
+	tradeStatus := string(res.Content.TradeStatus)
+	if !res.IsSuccess() || (tradeStatus != "TRADE_SUCCESS" && tradeStatus != "TRADE_FINISHED") {
+		return fmt.Errorf("续费扣款: 支付宝交易未支付成功 outTradeNo=%s, tradeStatus=%s, subMsg=%s", tb.OutTradeNo, tradeStatus, res.Content.SubMsg)
+	}
+
+	updated, err := dbmodel.NewOrderModel(define.DbPayGateway).QueryAfterUpdate(tb.OutTradeNo, res.Content.TradeNo, dbmodel.PmPayOrderTablePayTypeAlipay)
+	if err != nil {
+		return err
+	}
+	logx.Infof("续费扣款: 交易已支付成功 outTradeNo=%s, tradeNo=%s, updated=%v", tb.OutTradeNo, res.Content.TradeNo, updated)
+	if !updated || tb.AppNotifyUrl == "" {
+		return nil
+	}
+
+	go func() {
+		defer exception.Recover()
+		//字段和支付宝交易状态同步回调保持一致
+		dataMap := map[string]interface{}{
+			"notify_type":           code.APP_NOTIFY_TYPE_PAY,
+			"app_id":                tb.PayAppID,
+			"out_trade_no":          tb.OutTradeNo,
+			"trade_no":              res.Content.TradeNo,
+			"trade_status":          tradeStatus,
+			"total_amount":          res.Content.TotalAmount,
+			"external_agreement_no": tb.ExternalAgreementNo,
+		}
+		headerMap := map[string]string{
+			"App-Origin": tb.AppPkg,
+		}
+		err := utils.CallbackWithRetry(tb.AppNotifyUrl, headerMap, dataMap, 5*time.Second)
+		if err != nil {
+			desc := fmt.Sprintf("回调通知用户付款成功 异常, app_pkg=%s, user_id=%d, out_trade_no=%s, 报错信息：%v", tb.AppPkg, tb.UserID, tb.OutTradeNo, err)
+			alarm.ImmediateAlarm("notifyUserPayErr", desc, alarm.ALARM_LEVEL_FATAL)
+		}
+	}()
+	return nil
+}
+
+// 包名的续费重试计划
+func deductRetrySteps(pkgName string) []dbmodel.SubscribeRetryStep {
+	retryCfg, err := dbmodel.NewPmSubscribeRetryConfigModel(define.DbPayGateway).GetByPkgName(pkgName)
+	if err == nil {
+		return retryCfg.Steps()
+	}
+	// 配置读取失败时不关闭订单，按默认计划重试
+	return (&dbmodel.PmSubscribeRetryConfigTable{RetryDays: dbmodel.SubscribeRetryDefaultDays, RetryHours: dbmodel.SubscribeRetryDefaultHours}).Steps()
+}
+
+// 已失败attempts次后的下次重试时间，attempts需小于重试计划的步数
+func deductRetryTime(steps []dbmodel.SubscribeRetryStep, attempts int) time.Time {
+	// 重试天数是相对首次失败的，每次按与上次计划的间隔推算
+	prevDay := 0
+	if attempts > 0 {
+		prevDay = steps[attempts-1].Day
+	}
+	step := steps[attempts]
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	return today.AddDate(0, 0, step.Day-prevDay).Add(time.Duration(step.Hour) * time.Hour)
+}
+
+// 回调业务方订阅失效
+func (c *CrontabOrder) notifySubscribeLapsed(tb *dbmodel.OrderTable, retry *deductRetryResult) {
+	SubscribeLapsedNum.CounterInc()
+	logx.Errorf("续费最终失败订阅失效: out_trade_no=%s, attempts=%d, sub_code=%s", tb.OutTradeNo, retry.Attempts, retry.SubCode)
+
+	dataMap := make(map[string]interface{})
+	dataMap["notify_type"] = code.APP_NOTIFY_TYPE_SUBSCRIBE_LAPSED
+	dataMap["external_agreement_no"] = tb.ExternalAgreementNo
+	dataMap["out_trade_no"] = tb.OutTradeNo
+	dataMap["attempts"] = retry.Attempts
+	dataMap["sub_code"] = retry.SubCode
+	headerMap := map[string]string{
+		"App-Origin": tb.AppPkg,
+	}
+	err := utils.CallbackWithRetry(tb.AppNotifyUrl, headerMap, dataMap, 5*time.Second)
+	if err != nil {
+		desc := fmt.Sprintf("回调通知用户订阅失效 异常, app_pkg=%s, user_id=%d, out_trade_no=%s", tb.AppPkg, tb.UserID, tb.OutTradeNo)
+		alarm.ImmediateAlarm("notifyUserSubscribeLapsedErr", desc, alarm.ALARM_LEVEL_FATAL)
+	}
+}
+
+// 关闭创建超过30天仍未首次扣款的续费订单并回调业务方订阅失效，每日扣款前执行
+// 这些订单不在首次扣款范围内，也没有失败记录不会进入重试，不处理会一直停留在待扣款；有关闭的订单时告警
+func (c *CrontabOrder) lapseExpiredFirstDeduct(ctx context.Context, fence *dbmodel.LeaderFence) (num int64) {
+	orderModel := dbmodel.NewOrderModel(define.DbPayGateway)
+	lastId := 0
+	for ctx.Err() == nil {
+		list, err := orderModel.GetExpiredFirstDeductData(lastId)
+		if err != nil || len(list) == 0 {
+			break
+		}
+		for _, tb := range list {
+			lastId = tb.ID
+			err = orderModel.LapseSubscribeFee(tb.ID, tb.DeductAttempts, firstDeductExpiredSubCode, fence)
+			if errors.Is(err, dbmodel.ErrLeaderFenced) {
+				return
+			}
+			if err != nil {
+				continue
+			}
+			num++
+			SubscribeFirstDeductExpiredNum.CounterInc()
+			c.notifySubscribeLapsed(tb, &deductRetryResult{Attempts: tb.DeductAttempts, Lapsed: true, SubCode: firstDeductExpiredSubCode})
+		}
+	}
+
+	if num > 0 {
+		desc := fmt.Sprintf("续费订单创建超过%d天未首次扣款，已关闭并回调业务方订阅失效 num=%d, lastId=%d", dbmodel.SubscribeFirstDeductExpireDays, num, lastId)
+		alarm.ImmediateAlarm("subscribeFirstDeductExpired", desc, alarm.ALARM_LEVEL_FATAL)
+	}
+	return
+}
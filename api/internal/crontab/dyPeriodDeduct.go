@@ -0,0 +1,422 @@
+package crontab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	douyin "gitlab.muchcloud.com/consumer-project/pay-gateway/common/client/douyinGeneralTrade"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/utils"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	dbmodel "gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/alarm"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+	"gorm.io/gorm"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	redisDyPeriodDeductRunningKey = "payGateway:dyPeriodDeduct:running" // 防止抖音周期代扣同时执行
+
+	dySignOrderSuffix = "0" // 代扣单的内部签约单号后缀，与rpc创建代扣单时一致
+)
+
+var (
+	DyPeriodDeductErrNum  = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "DyPeriodDeductErrNum", nil, "抖音周期代扣扣款失败", nil})}
+	DyPeriodLapsedNum     = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "DyPeriodLapsedNum", nil, "抖音周期代扣最终失败停止代扣", nil})}
+	errDyDeductPending    = errors.New("代扣单等待抖音扣款结果")
+	errDyDeductNotCreated = errors.New("抖音侧代扣单不存在")
+)
+
+// 抖音周期代扣，由任务调度在leader节点执行：查找到期的签约单，创建下一期代扣单并发起扣款，扣款结果以抖音代扣结果回调为准。
+// 按签约单的扣款状态查询，不需要断点，中止后下次执行会重新查到未扣款的签约单；没有配置包名时返回nil
+func (c *CrontabOrder) DyPeriodDeduct(ctx context.Context, token int64) *DeductSummary {
+	conf := c.Conf.DyPeriodDeduct
+	if len(conf.AppPkgs) == 0 {
+		logx.Errorf("抖音周期代扣未配置包名，不执行")
+		return nil
+	}
+	if conf.Concurrency <= 0 {
+		conf.Concurrency = 1
+	}
+	if conf.Qps <= 0 {
+		conf.Qps = 10
+	}
+	if conf.BatchSize <= 0 {
+		conf.BatchSize = 200
+	}
+
+	rdb := db.WithRedisDBContext(define.DbPayGateway)
+	value := uuid.New().String()
+	isLock, err := rdb.TryLockWithTimeout(context.Background(), redisDyPeriodDeductRunningKey, value, deductRunningTtl)
+	if err != nil || !isLock {
+		logx.Errorf("抖音周期代扣正在执行中 err: %v", err)
+		return nil
+	}
+	defer rdb.Unlock(context.Background(), redisDyPeriodDeductRunningKey, value)
+
+	summary := &DeductSummary{
+		Job:     "dyPeriodDeduct",
+		StartAt: time.Now(),
+	}
+	limiter := time.NewTicker(time.Second / time.Duration(conf.Qps))
+	defer limiter.Stop()
+
+	fence := &dbmodel.LeaderFence{Name: CronLeaderName, Token: token}
+	periodModel := dbmodel.NewPmDyPeriodOrderModel(define.DbPayGateway)
+	for {
+		if !c.Leader.CheckToken(token) {
+			logx.Errorf("抖音周期代扣中止，当前节点已不是leader token: %d", token)
+			summary.Aborted = true
+			break
+		}
+		if ctx.Err() != nil {
+			logx.Errorf("抖音周期代扣中止，执行超时 lastId: %d", summary.LastId)
+			summary.Aborted = true
+			break
+		}
+
+		list, err := periodModel.GetDueSignedBatch(conf.AppPkgs, time.Now(), summary.LastId, conf.BatchSize)
+		if err != nil || len(list) == 0 {
+			break
+		}
+
+		ch := make(chan *dbmodel.PmDyPeriodOrderTable, len(list))
+		for _, tb := range list {
+			ch <- tb
+		}
+		close(ch)
+
+		var wg sync.WaitGroup
+		for i := 0; i < conf.Concurrency && i < len(list); i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for tb := range ch {
+					<-limiter.C
+					c.dyDeduct(summary, tb, fence)
+				}
+			}()
+		}
+		wg.Wait()
+		summary.LastId = list[len(list)-1].ID
+	}
+
+	summary.EndAt = time.Now()
+	logx.Errorf("抖音周期代扣执行完成 lastId: %d, total: %d, success: %d, fail: %d, aborted: %v, cost: %s",
+		summary.LastId, summary.Total, summary.Success, summary.Fail, summary.Aborted, summary.EndAt.Sub(summary.StartAt))
+	return summary
+}
+
+// 一个签约单发起扣款，等待上次扣款结果的不计入统计，成功数为成功发起扣款的签约单数
+func (c *CrontabOrder) dyDeduct(summary *DeductSummary, contract *dbmodel.PmDyPeriodOrderTable, fence *dbmodel.LeaderFence) {
+	defer exception.Recover()
+
+	err := c.deductSignOrder(contract, fence)
+	if errors.Is(err, errDyDeductPending) {
+		logx.Errorf("抖音周期代扣等待上次扣款结果 signOrderId: %d", contract.ID)
+		return
+	}
+	atomic.AddInt64(&summary.Total, 1)
+	if err != nil {
+		logx.Errorf("抖音周期代扣失败 signOrderId: %d, err: %v", contract.ID, err)
+		DyPeriodDeductErrNum.CounterInc()
+		atomic.AddInt64(&summary.Fail, 1)
+		return
+	}
+	atomic.AddInt64(&summary.Success, 1)
+}
+
+// 创建签约单的下一期代扣单并发起扣款，上次发起的代扣单还没有结果时先向抖音确认
+// 代扣单带着fencing token创建，已不是leader时不会发起扣款
+func (c *CrontabOrder) deductSignOrder(contract *dbmodel.PmDyPeriodOrderTable, fence *dbmodel.LeaderFence) error {
+	periodModel := dbmodel.NewPmDyPeriodOrderModel(define.DbPayGateway)
+	nthNum, err := nextDyNthNum(periodModel, contract)
+	if err != nil {
+		return err
+	}
+
+	payCfg, err := dbmodel.NewPmPayConfigTiktokModel(define.DbPayGateway).GetOneByAppID(contract.PayAppId)
+	if err != nil {
+		return fmt.Errorf("读取抖音支付配置失败 appId: %s, err: %v", contract.PayAppId, err)
+	}
+	payClient := douyin.NewDouyinPay(payCfg.GetGeneralTradeConfig())
+	clientToken, err := c.SvcCtx.BaseAppConfigServerApi.GetDyClientToken(context.Background(), contract.PayAppId)
+	if err != nil {
+		return fmt.Errorf("获取抖音client token失败 appId: %s, err: %v", contract.PayAppId, err)
+	}
+
+	last, err := periodModel.GetLastDeductOrder(contract.ID, nthNum)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return err
+	}
+	if last.ID > 0 {
+		switch last.PayStatus {
+		case dbmodel.Dy_Pay_Status_Paid:
+			// 扣款成功但签约单未更新
+			return DyPeriodDeductSuccess(last)
+		case dbmodel.Dy_Pay_Status_Wait:
+			return checkPendingDeduct(payClient, clientToken, last)
+		}
+	}
+
+	orderSn := utils.GenerateOrderCode(c.Conf.SnowFlake.MachineNo, c.Conf.SnowFlake.WorkerNo)
+	order := &dbmodel.PmDyPeriodOrderTable{
+		OrderSn:           orderSn,                     // 内部 订单唯一标识
+		SignNo:            orderSn + dySignOrderSuffix, // 内部 签约单号
+		UserId:            contract.UserId,
+		AppPkgName:        contract.AppPkgName,
+		Amount:            contract.Amount,
+		Subject:           "签约代扣单",
+		NotifyUrl:         contract.NotifyUrl,
+		PayAppId:          contract.PayAppId,
+		PayType:           contract.PayType,
+		PayStatus:         dbmodel.Dy_Pay_Status_Wait,
+		ThirdSignOrderNo:  contract.ThirdSignOrderNo,
+		Currency:          contract.Currency,
+		SignDate:          contract.SignDate,
+		UnsignDate:        contract.UnsignDate,
+		ExpireDate:        contract.ExpireDate,
+		NextDecuctionTime: contract.NextDecuctionTime,
+		DyProductId:       contract.DyProductId,
+		NthNum:            nthNum,
+		SignOrderId:       contract.ID,
+		PeriodUnit:        contract.PeriodUnit,
+		PeriodCount:       contract.PeriodCount,
+		TrialDays:         contract.TrialDays,
+	}
+	if err = periodModel.CreateDeductOrder(order, fence); err != nil {
+		return fmt.Errorf("创建代扣单失败 signOrderId: %d, err: %v", contract.ID, err)
+	}
+
+	resp, err := payClient.CreateSignPay(clientToken, contract.ThirdSignOrderNo, order.OrderSn, payCfg.SignPayMerchantUid, "", int64(order.Amount))
+	if err != nil {
+		// 请求结果未知，代扣单保持等待结果，下次执行时向抖音确认
+		return fmt.Errorf("发起扣款请求失败 orderSn: %s, err: %v", order.OrderSn, err)
+	}
+	if resp.ErrNo != douyin.DySuccess {
+		errDesc := fmt.Sprintf("抖音周期代扣: 发起扣款失败 orderSn=%s, err_no=%d, err_msg=%s", order.OrderSn, resp.ErrNo, resp.ErrMsg)
+		DyPeriodDeductFail(order, strconv.FormatInt(resp.ErrNo, 10), errDesc)
+		return errors.New(errDesc)
+	}
+
+	return periodModel.UpdateSomeData(order.ID, map[string]interface{}{
+		"third_order_no": resp.Data.PayOrderId, // 抖音平台返回的代扣单的单号
+	})
+}
+
+// 向抖音确认等待结果的代扣单，扣款失败或抖音侧没有创建时按失败处理，到重试时间后再发起新的代扣单
+func checkPendingDeduct(payClient *douyin.PayClient, clientToken string, order *dbmodel.PmDyPeriodOrderTable) error {
+	res, err := payClient.QuerySignPayOrder(clientToken, order.OrderSn)
+	if err != nil {
+		return errDyDeductPending
+	}
+
+	switch {
+	case res.ErrNo != douyin.DySuccess && order.ThirdOrderNo == "":
+		// 发起扣款请求失败且抖音侧查不到
+		DyPeriodDeductFail(order, strconv.FormatInt(res.ErrNo, 10), fmt.Sprintf("抖音周期代扣: %s orderSn=%s, err_msg=%s", errDyDeductNotCreated, order.OrderSn, res.ErrMsg))
+		return errDyDeductNotCreated
+	case res.SignPayData.Status == douyin.Dy_Sign_Pay_Status_FAIL || res.SignPayData.Status == douyin.Dy_Sign_Pay_Status_TIME_OUT:
+		// 失败回调丢失
+		DyPeriodDeductFail(order, res.SignPayData.Status, fmt.Sprintf("抖音周期代扣: 扣款失败 orderSn=%s, status=%s", order.OrderSn, res.SignPayData.Status))
+		return errors.New("扣款失败 status: " + res.SignPayData.Status)
+	case res.ErrNo == douyin.DySuccess && res.SignPayData.Status == douyin.Dy_Sign_Pay_Status_SUCCESS:
+		// 成功回调丢失，补单只扫描最近的订单，这里直接处理
+		return settleDyDeductPaid(order, &res.SignPayData)
+	}
+	// 处理中的由代扣结果回调处理
+	return errDyDeductPending
+}
+
+// 代扣单扣款成功但回调丢失：置为已支付，推进签约单，再按抖音代扣结果回调的格式回调业务方
+func settleDyDeductPaid(order *dbmodel.PmDyPeriodOrderTable, signPayData *douyin.SignPayDataObj) error {
+	eventTime := time.Now()
+	if signPayData.PayTime > 0 {
+		eventTime = time.UnixMilli(signPayData.PayTime)
+	}
+	periodModel := dbmodel.NewPmDyPeriodOrderModel(define.DbPayGateway)
+	isUpdate, err := periodModel.QueryAfterUpdate(order.ID, map[string]interface{}{
+		"pay_status":       dbmodel.Dy_Pay_Status_Paid,
+		"pay_channel":      signPayData.PayChannel,
+		"third_order_sn":   signPayData.ChannelPayId,
+		"third_order_no":   signPayData.PayOrderId,
+		"user_bill_pay_id": signPayData.UserBillPayId,
+		"notify_amount":    signPayData.TotalAmount,
+	})
+	if err != nil {
+		return err
+	}
+	if !isUpdate {
+		// 回调或补单已经处理
+		return errDyDeductPending
+	}
+	logx.Errorf("抖音周期代扣 扣款成功回调丢失，查单后置为已支付 orderSn: %s, signOrderId: %d", order.OrderSn, order.SignOrderId)
+	if err = DyPeriodDeductSuccess(order); err != nil {
+		logx.Errorf("DyPeriodDeductSuccess failed orderSn: %s, err: %v", order.OrderSn, err)
+	}
+
+	go func() {
+		defer exception.Recover()
+		msg, _ := json.Marshal(douyin.DySignPayCallbackNotify{
+			AppId:         signPayData.AppId,
+			Status:        signPayData.Status,
+			AuthOrderId:   signPayData.AuthOrderId,
+			PayOrderId:    signPayData.PayOrderId,
+			OutPayOrderNo: signPayData.OutPayOrderNo,
+			TotalAmount:   signPayData.TotalAmount,
+			PayChannel:    signPayData.PayChannel,
+			ChannelPayId:  signPayData.ChannelPayId,
+			MerchantUid:   signPayData.MerchantUid,
+			UserBillPayId: signPayData.UserBillPayId,
+			EventTime:     eventTime.UnixMilli(),
+		})
+		req := &douyin.GeneralTradeCallbackData{
+			Msg:  DyPeriodPayCallbackMsg(order, string(msg)),
+			Type: douyin.EventSignPayCallback,
+		}
+		headerMap := map[string]string{
+			"App-Origin": order.AppPkgName,
+		}
+		if _, err := util.HttpPostWithHeader(order.NotifyUrl, req, headerMap, 5*time.Second); err != nil {
+			desc := fmt.Sprintf("回调通知用户抖音代扣成功 异常, app_pkg=%s, user_id=%d, order_sn=%s", order.AppPkgName, order.UserId, order.OrderSn)
+			alarm.ImmediateAlarm("notifyUserDyDeductSuccessErr", desc, alarm.ALARM_LEVEL_FATAL)
+		}
+	}()
+	return err
+}
+
+// 签约单的下一期期数：签约时的首次扣款为第1期，从业务方扣款切换过来的签约单按已扣款成功的最大期数继续
+func nextDyNthNum(periodModel *dbmodel.PmDyPeriodOrderModel, contract *dbmodel.PmDyPeriodOrderTable) (int, error) {
+	if contract.NthNum > 0 {
+		return contract.NthNum + 1, nil
+	}
+	paid, err := periodModel.GetMaxPaidNthNum(contract.ThirdSignOrderNo, contract.PayAppId)
+	if err != nil {
+		return 0, err
+	}
+	if paid < 1 {
+		paid = 1
+	}
+	return paid + 1, nil
+}
+
+// DyPeriodDeductSuccess 网关发起的代扣单扣款成功，签约单记录已扣款期数，下次扣款时间顺延一期
+func DyPeriodDeductSuccess(order *dbmodel.PmDyPeriodOrderTable) error {
+	periodModel := dbmodel.NewPmDyPeriodOrderModel(define.DbPayGateway)
+	contract, err := periodModel.GetById(int64(order.SignOrderId))
+	if err != nil {
+		return fmt.Errorf("查询签约单失败 signOrderId: %d, err: %v", order.SignOrderId, err)
+	}
+
+	// 长期未扣款的签约单从现在开始算，避免连续补扣多期
+	nextDeductTime := contract.NextDeductionTime(contract.NextDecuctionTime)
+	if nextDeductTime.Before(time.Now()) {
+		nextDeductTime = contract.NextDeductionTime(time.Now())
+	}
+	_, err = periodModel.AdvanceSignedOrder(contract.ID, order.NthNum, nextDeductTime)
+	return err
+}
+
+// DyPeriodDeductFail 网关发起的代扣单扣款失败：按包名的重试计划安排签约单下次扣款，重试次数用完后停止代扣，并回调业务方
+func DyPeriodDeductFail(order *dbmodel.PmDyPeriodOrderTable, errCode, errDesc string) {
+	periodModel := dbmodel.NewPmDyPeriodOrderModel(define.DbPayGateway)
+	isUpdate, err := periodModel.MarkDeductFail(order.ID)
+	if err != nil || !isUpdate {
+		return
+	}
+	contract, err := periodModel.GetById(int64(order.SignOrderId))
+	if err != nil {
+		logx.Errorf("查询签约单失败 signOrderId: %d, err: %v", order.SignOrderId, err)
+		return
+	}
+
+	retry := &deductRetryResult{
+		Attempts: contract.DeductAttempts + 1,
+		SubCode:  errCode,
+	}
+	steps := deductRetrySteps(contract.AppPkgName)
+	if contract.DeductAttempts >= len(steps) {
+		retry.Lapsed = true
+		periodModel.UpdateDeductRetry(contract.ID, retry.Attempts, errCode, dbmodel.Default2000Date, true)
+	} else {
+		retry.NextDeductTime = deductRetryTime(steps, contract.DeductAttempts)
+		periodModel.UpdateDeductRetry(contract.ID, retry.Attempts, errCode, retry.NextDeductTime, false)
+	}
+
+	go func() {
+		defer exception.Recover()
+		dataMap := make(map[string]interface{})
+		dataMap["notify_type"] = code.APP_NOTIFY_TYPE_SIGN_FEE_FAILED
+		dataMap["out_trade_no"] = order.OrderSn
+		dataMap["user_id"] = order.UserId
+		dataMap["nth_num"] = order.NthNum
+		dataMap["err_info"] = errDesc
+		dataMap["sub_code"] = errCode
+		dataMap["attempts"] = retry.Attempts
+		dataMap["lapsed"] = retry.Lapsed
+		if !retry.Lapsed {
+			dataMap["next_deduct_time"] = retry.NextDeductTime.Format("2006-01-02 15:04:05")
+		}
+		headerMap := map[string]string{
+			"App-Origin": order.AppPkgName,
+		}
+		err := utils.CallbackWithRetry(order.NotifyUrl, headerMap, dataMap, 5*time.Second)
+		if err != nil {
+			desc := fmt.Sprintf("回调通知用户抖音代扣失败 异常, app_pkg=%s, user_id=%d, order_sn=%s", order.AppPkgName, order.UserId, order.OrderSn)
+			alarm.ImmediateAlarm("notifyUserDyDeductFailedErr", desc, alarm.ALARM_LEVEL_FATAL)
+		}
+		if retry.Lapsed {
+			notifyDyPeriodLapsed(order, retry)
+		}
+	}()
+}
+
+// 回调业务方抖音周期代扣失效，签约仍然有效，由业务方决定是否解约
+func notifyDyPeriodLapsed(order *dbmodel.PmDyPeriodOrderTable, retry *deductRetryResult) {
+	DyPeriodLapsedNum.CounterInc()
+	logx.Errorf("抖音周期代扣最终失败停止代扣: order_sn=%s, sign_order_id=%d, attempts=%d, sub_code=%s", order.OrderSn, order.SignOrderId, retry.Attempts, retry.SubCode)
+
+	dataMap := make(map[string]interface{})
+	dataMap["notify_type"] = code.APP_NOTIFY_TYPE_SUBSCRIBE_LAPSED
+	dataMap["out_trade_no"] = order.OrderSn
+	dataMap["user_id"] = order.UserId
+	dataMap["nth_num"] = order.NthNum
+	dataMap["attempts"] = retry.Attempts
+	dataMap["sub_code"] = retry.SubCode
+	headerMap := map[string]string{
+		"App-Origin": order.AppPkgName,
+	}
+	err := utils.CallbackWithRetry(order.NotifyUrl, headerMap, dataMap, 5*time.Second)
+	if err != nil {
+		desc := fmt.Sprintf("回调通知用户抖音代扣失效 异常, app_pkg=%s, user_id=%d, order_sn=%s", order.AppPkgName, order.UserId, order.OrderSn)
+		alarm.ImmediateAlarm("notifyUserDyDeductLapsedErr", desc, alarm.ALARM_LEVEL_FATAL)
+	}
+}
+
+// DyPeriodPayCallbackMsg 网关发起的代扣单扣款成功回调业务方时，在抖音回调内容上补充用户、包名和期数，业务方不需要自己建单
+func DyPeriodPayCallbackMsg(order *dbmodel.PmDyPeriodOrderTable, msg string) string {
+	if order.SignOrderId == 0 {
+		return msg
+	}
+	msgMap := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(msg), &msgMap); err != nil {
+		return msg
+	}
+	msgMap["app_pkg"] = order.AppPkgName
+	msgMap["userId"] = strconv.Itoa(order.UserId)
+	msgMap["nth_num"] = order.NthNum
+	msgByte, _ := json.Marshal(msgMap)
+	return string(msgByte)
+}
@@ -1,10 +1,10 @@
 package crontab
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strconv"
 	"time"
 
 	alipay2 "gitlab.muchcloud.com/consumer-project/alipay"
@@ -12,19 +12,17 @@ import (
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/leader"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/types"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/utils"
 	"gitlab.muchcloud.com/consumer-project/zhuyun-core/alarm"
 
-	"github.com/nacos-group/nacos-sdk-go/v2/model"
-	"github.com/robfig/cron"
 	"github.com/zeromicro/go-zero/core/logx"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/config"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
 	dbmodel "gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
 	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
 	"gitlab.muchcloud.com/consumer-project/zhuyun-core/nacos"
-	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
 	"gorm.io/gorm"
 )
 
@@ -38,11 +36,11 @@ type CrontabOrder struct {
 	SvcName string
 	Conf    *config.Config
 	SvcCtx  *svc.ServiceContext
+	Leader  *leader.Elector
 }
 
-const (
-	payOrderTime = "0 0 11 * * ?"
-)
+// 定时任务选主名称
+const CronLeaderName = "payment-cron"
 
 var crontabOrder *CrontabOrder
 
@@ -52,19 +50,10 @@ func InitCrontabOrder(namingClient *nacos.Instance, svcName string, c *config.Co
 		SvcName: svcName,
 		Conf:    c,
 		SvcCtx:  s,
+		Leader:  leader.NewElector(CronLeaderName),
 	}
+	crontabOrder.Leader.Start()
 
-	// 定时任务
-	cronTask := cron.New()
-
-	err := cronTask.AddFunc(payOrderTime, func() {
-		crontabOrder.PayOrder()
-	})
-	if err != nil {
-		logx.Errorf("创建支付订单任务定时任务失败，err= %v", err)
-	}
-
-	cronTask.Start()
 	logx.Info("InitCrontabOrder success")
 }
 
@@ -75,22 +64,14 @@ func GetCrontabOrder() *CrontabOrder {
 	return crontabOrder
 }
 
-func (c *CrontabOrder) PayOrder() {
-	instances, err := c.Nacos.SelectAllInstances(&c.SvcName)
-	if err != nil {
-		logx.Errorf("获取dsp服务 %s 的注册实例失败, err= %v", c.SvcName, err)
-		return
-	}
-
-	// 判断是否在此服务执行定时任务
-	localDo := c.CheckLocalMachineDo(&instances)
-	if !localDo {
-		return
-	}
+// 每日续费扣款，由任务调度在leader节点执行，token为执行时的leader fencing token，没有可扣款订单时返回nil
+func (c *CrontabOrder) PayOrder(ctx context.Context, token int64) *DeductSummary {
 	logx.Errorf("开始执行订阅扣款")
 
 	orderModel = dbmodel.NewOrderModel(define.DbPayGateway)
 
+	c.lapseExpiredFirstDeduct(ctx, &dbmodel.LeaderFence{Name: CronLeaderName, Token: token})
+
 	firstModel, err := orderModel.GetFirstUnpaidSubscribeFee()
 	if err != nil {
 		if err != gorm.ErrRecordNotFound {
@@ -98,44 +79,26 @@ func (c *CrontabOrder) PayOrder() {
 			GetFirstUnpaidSubscribeFeeErrNum.CounterInc()
 		}
 		logx.Errorf("没有可扣款单 CrontabOrder::CreateOrder error: %v", err)
-		return
+		return nil
 	}
 
 	if firstModel.ID == 0 {
 		logx.Info("暂时没有需要扣款的VIP订阅")
 		logx.Errorf("没有可扣款单")
-		return
+		return nil
 	}
 
-	lastId := firstModel.ID - 1
-	for {
-		logx.Errorf("进入循环扣款")
-		models, err := orderModel.GetRangeData(lastId)
-		if err != nil {
-			logx.Errorf("orderModel::GetRangeData error: %v", err)
-			break
-		}
+	return c.newDeductRunner("payOrder", token).run(ctx, firstModel.ID-1, orderModel.GetRangeData)
+}
 
-		if len(models) == 0 {
-			break
-		}
-		for _, tmpOrderModel := range models {
-			logx.Errorf("开始扣款订单号：%s", tmpOrderModel.OutTradeNo)
-			lastId = tmpOrderModel.ID
-			err = c.PaySubscribeFee(tmpOrderModel)
-			if err != nil {
-				logx.Errorf("扣款失败 %v", err)
-				PaySubscribeFeeErrNum.CounterInc()
-			} else {
-				logx.Errorf("扣款成功：%s", tmpOrderModel.OutTradeNo)
-			}
-			time.Sleep(50 * time.Millisecond)
-		}
-	}
+// 续费重试，只扣到达重试时间的失败订单
+func (c *CrontabOrder) PayRetryOrder(ctx context.Context, token int64) *DeductSummary {
+	orderModel = dbmodel.NewOrderModel(define.DbPayGateway)
+	return c.newDeductRunner("payRetryOrder", token).run(ctx, 0, orderModel.GetRetryRangeData)
 }
 
-// 创建续费订单
-func (c *CrontabOrder) PaySubscribeFee(tb *dbmodel.OrderTable) error {
+// 创建续费订单，fence为执行扣款时的leader fencing token
+func (c *CrontabOrder) PaySubscribeFee(tb *dbmodel.OrderTable, fence *dbmodel.LeaderFence) error {
 
 	agreementSignParams := &alipay2.AgreementParams{
 		AgreementNo: tb.AgreementNo,
@@ -173,12 +136,21 @@ func (c *CrontabOrder) PaySubscribeFee(tb *dbmodel.OrderTable) error {
 	if err != nil || result.Content.Code != alipay2.CodeSuccess {
 		errDesc := ""
 		if err != nil {
-			errDesc = fmt.Sprintf("订阅扣款: 扣款失败 outTradeNo=%v, err=%s", result, err.Error())
+			errDesc = fmt.Sprintf("订阅扣款: 扣款失败 outTradeNo=%v, err=%s", tb.OutTradeNo, err.Error())
 		} else {
 			errDesc = fmt.Sprintf("续费失败: out_trade_no = %v, msg = %v, subMsg = %v", tb.OutTradeNo, result.Content.Msg, result.Content.SubMsg)
 		}
 
 		logx.Errorf(errDesc)
+		subCode := ""
+		if err == nil {
+			subCode = result.Content.SubCode
+		}
+		if subCode == alipayTradeHasSuccessSubCode {
+			// 交易已支付成功，不安排重试，也不回调扣款失败
+			return c.confirmDeductPaid(client, tb)
+		}
+		retry := c.handleDeductFail(tb, subCode, fence)
 		go func() {
 			defer exception.Recover()
 			dataMap := make(map[string]interface{})
@@ -186,6 +158,12 @@ func (c *CrontabOrder) PaySubscribeFee(tb *dbmodel.OrderTable) error {
 			dataMap["external_agreement_no"] = tb.ExternalAgreementNo
 			dataMap["out_trade_no"] = tb.OutTradeNo
 			dataMap["err_info"] = errDesc
+			dataMap["sub_code"] = subCode
+			dataMap["attempts"] = retry.Attempts
+			dataMap["lapsed"] = retry.Lapsed
+			if !retry.Lapsed {
+				dataMap["next_deduct_time"] = retry.NextDeductTime.Format("2006-01-02 15:04:05")
+			}
 			headerMap := map[string]string{
 				"App-Origin": tb.AppPkg,
 			}
@@ -194,6 +172,9 @@ func (c *CrontabOrder) PaySubscribeFee(tb *dbmodel.OrderTable) error {
 				desc := fmt.Sprintf("回调通知用户续约失败 异常, app_pkg=%s, user_id=%d, out_trade_no=%s", tb.AppPkg, tb.UserID, tb.OutTradeNo)
 				alarm.ImmediateAlarm("notifyUserSignFeeFailedErr", desc, alarm.ALARM_LEVEL_FATAL)
 			}
+			if retry.Lapsed {
+				c.notifySubscribeLapsed(tb, retry)
+			}
 		}()
 		return errors.New(errDesc)
 	} else {
@@ -207,31 +188,3 @@ func (c *CrontabOrder) PaySubscribeFee(tb *dbmodel.OrderTable) error {
 	}
 
 }
-
-// 检查是否在本机执行
-func (c *CrontabOrder) CheckLocalMachineDo(instances *[]model.Instance) bool {
-	// 本机 ip
-	ip, err := util.ExternalIP()
-	if err != nil {
-		logx.Errorf("获取本机 ip 失败, err= %v", err)
-		return false
-	}
-	localIp := ip.String()
-	var minTime int64 = 0
-	var hitIp string
-	for _, instance := range *instances {
-		if metadate, ok := instance.Metadata["startTime"]; ok && instance.Enable {
-			startTime, err := strconv.ParseInt(metadate, 10, 64)
-			if err != nil {
-				logx.Errorf("获取生成续费订单定时任务 metadata 失败, err= %v, metadata= %v", err, metadate)
-				return false
-			}
-			if minTime == 0 || startTime < minTime {
-				minTime = startTime
-				hitIp = instance.Ip
-			}
-		}
-	}
-
-	return localIp == hitIp
-}
@@ -0,0 +1,35 @@
+package crontab
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/scheduler"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+)
+
+func DyPeriodSignCheckHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.DyPeriodSignCheckReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.Error(w, err)
+			return
+		}
+
+		// 参数校验后交给任务调度执行
+		runId, err := triggerJob(r, scheduler.JobDyPeriodSignCheck)
+		resp := &types.DyPeriodSignCheckResp{
+			ErrNo:   0,
+			ErrTips: fmt.Sprintf("已提交执行 runId: %d", runId),
+		}
+		if err != nil {
+			resp = &types.DyPeriodSignCheckResp{
+				ErrNo:   -1,
+				ErrTips: err.Error(),
+			}
+		}
+		httpx.OkJson(w, resp)
+	}
+}
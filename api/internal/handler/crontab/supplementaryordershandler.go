@@ -1,10 +1,11 @@
 package crontab
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/zeromicro/go-zero/rest/httpx"
-	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/logic/crontab"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/scheduler"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
 )
@@ -17,8 +18,12 @@ func SupplementaryOrdersHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 			return
 		}
 
-		l := crontab.NewSupplementaryOrdersLogic(r.Context(), svcCtx)
-		resp, err := l.SupplementaryOrders(&req)
+		// 参数校验后交给任务调度执行
+		runId, err := triggerJob(r, scheduler.JobSupplementaryOrders)
+		resp := &types.SupplementaryOrdersResp{
+			ErrNo:   0,
+			ErrTips: fmt.Sprintf("已提交执行 runId: %d", runId),
+		}
 		if err != nil {
 			resp = &types.SupplementaryOrdersResp{
 				ErrNo:   -1,
@@ -0,0 +1,27 @@
+package crontab
+
+import (
+	"errors"
+	"net/http"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/scheduler"
+)
+
+// 通过/crontab接口触发的执行记录的操作人
+const crontabOperator = "crontab"
+
+// 按接口参数写入待执行记录，由leader节点通过任务调度执行，和定时执行共用任务锁和执行记录，返回执行记录id
+func triggerJob(r *http.Request, name string) (int, error) {
+	s := scheduler.GetScheduler()
+	if s == nil {
+		return 0, errors.New("任务调度未启动")
+	}
+	if err := r.ParseForm(); err != nil {
+		return 0, err
+	}
+	params := make(map[string]string, len(r.Form))
+	for key := range r.Form {
+		params[key] = r.Form.Get(key)
+	}
+	return s.Trigger(name, params, crontabOperator)
+}
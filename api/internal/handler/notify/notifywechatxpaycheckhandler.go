@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/logic/notify"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+)
+
+func NotifyWechatXPayCheckHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.WechatXPayNotifyReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.Error(w, err)
+			return
+		}
+
+		l := notify.NewNotifyWechatXPayLogic(r.Context(), svcCtx)
+		echostr, err := l.NotifyWechatXPayCheck(&req)
+		if err != nil {
+			httpx.Error(w, err)
+		} else {
+			// 微信要求原样返回echostr
+			w.Write([]byte(echostr))
+		}
+	}
+}
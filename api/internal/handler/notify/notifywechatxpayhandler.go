@@ -0,0 +1,27 @@
+package notify
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/logic/notify"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+)
+
+func NotifyWechatXPayHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		//var req types.WechatXPayNotifyReq
+		//if err := httpx.Parse(r, &req); err != nil {
+		//	httpx.Error(w, err)
+		//	return
+		//}
+
+		l := notify.NewNotifyWechatXPayLogic(r.Context(), svcCtx)
+		resp, err := l.NotifyWechatXPay(r)
+		if err != nil {
+			httpx.Error(w, err)
+		} else {
+			httpx.OkJson(w, resp)
+		}
+	}
+}
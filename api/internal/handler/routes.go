@@ -77,6 +77,18 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/notify/h5/wechat/:AppID",
 				Handler: notify.NotifyWechatH5OrderHandler(serverCtx),
 			},
+			{
+				// 微信商家转账回调
+				Method:  http.MethodPost,
+				Path:    "/notify/transfer/wechat/:AppID",
+				Handler: notify.NotifyWechatTransferHandler(serverCtx),
+			},
+			{
+				// 微信消费者投诉通知
+				Method:  http.MethodPost,
+				Path:    "/notify/complain/wechat/:AppID",
+				Handler: notify.NotifyWechatComplainHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/notify/douyin",
@@ -87,6 +99,18 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/notify/huawei",
 				Handler: notify.NotifyHuaweiHandler(serverCtx),
 			},
+			{
+				// 微信虚拟支付-消息推送服务器地址校验
+				Method:  http.MethodGet,
+				Path:    "/notify/xpay/wechat/:AppID",
+				Handler: notify.NotifyWechatXPayCheckHandler(serverCtx),
+			},
+			{
+				// 微信虚拟支付-道具发货/代币支付推送
+				Method:  http.MethodPost,
+				Path:    "/notify/xpay/wechat/:AppID",
+				Handler: notify.NotifyWechatXPayHandler(serverCtx),
+			},
 		},
 	)
 
@@ -114,6 +138,91 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/internal/handleRefund",
 					Handler: inter.HandleRefundHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/dyRefundAudit/list",
+					Handler: inter.DyRefundAuditListHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/dyRefundAudit",
+					Handler: inter.DyRefundAuditHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/fundTransApproval/list",
+					Handler: inter.FundTransApprovalListHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/fundTransApproval",
+					Handler: inter.FundTransApprovalHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/alipayComplain/list",
+					Handler: inter.AlipayComplainListHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/alipayComplain/reply",
+					Handler: inter.AlipayComplainReplyHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/alipayComplain/finish",
+					Handler: inter.AlipayComplainFinishHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/alipayComplain/rate",
+					Handler: inter.AlipayComplainRateHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/wechatComplain/list",
+					Handler: inter.WechatComplainListHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/wechatComplain/reply",
+					Handler: inter.WechatComplainReplyHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/wechatComplain/complete",
+					Handler: inter.WechatComplainCompleteHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/wechatComplain/notifyUrl",
+					Handler: inter.WechatComplainNotifyUrlHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/job/list",
+					Handler: inter.JobListHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/job/trigger",
+					Handler: inter.JobTriggerHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/job/pause",
+					Handler: inter.JobPauseHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/job/resume",
+					Handler: inter.JobResumeHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/internal/job/runs",
+					Handler: inter.JobRunListHandler(serverCtx),
+				},
 			}...,
 		),
 	)
@@ -130,16 +239,64 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/internal/paySubscribeMoney",
 				Handler: inter.HandlePaySubscribeMoneyHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/internal/cronLeader",
+				Handler: inter.CronLeaderHandler(serverCtx),
+			},
 		},
 	)
 
 	server.AddRoutes(
-		[]rest.Route{
-			{
-				Method:  http.MethodPost,
-				Path:    "/crontab/supplementaryOrders",
-				Handler: crontab.SupplementaryOrdersHandler(serverCtx),
-			},
-		},
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Inter},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/crontab/supplementaryOrders",
+					Handler: crontab.SupplementaryOrdersHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/crontab/huaweiConfirmPurchase",
+					Handler: crontab.HuaweiConfirmPurchaseHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/crontab/huaweiCancelledPurchase",
+					Handler: crontab.HuaweiCancelledPurchaseHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/crontab/alipayFundTransSettle",
+					Handler: crontab.AlipayFundTransSettleHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/crontab/alipayComplainSync",
+					Handler: crontab.AlipayComplainSyncHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/crontab/wechatComplainSync",
+					Handler: crontab.WechatComplainSyncHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/crontab/alipayAgreementCheck",
+					Handler: crontab.AlipayAgreementCheckHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/crontab/dyPeriodSignCheck",
+					Handler: crontab.DyPeriodSignCheckHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/crontab/subscribeRemind",
+					Handler: crontab.SubscribeRemindHandler(serverCtx),
+				},
+			}...,
+		),
 	)
 }
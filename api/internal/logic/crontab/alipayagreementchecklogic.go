@@ -0,0 +1,146 @@
+package crontab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/utils"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/alarm"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	alipayAgreementUnsignNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "alipayAgreementUnsignNum", nil, "支付宝协议对账发现已解约的协议", nil})}
+)
+
+// 每批查询的签约订单数
+const alipayAgreementCheckBatch = 100
+
+type AlipayAgreementCheckLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	orderModel *model.OrderModel
+}
+
+func NewAlipayAgreementCheckLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AlipayAgreementCheckLogic {
+	return &AlipayAgreementCheckLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		orderModel: model.NewOrderModel(define.DbPayGateway),
+	}
+}
+
+// AlipayAgreementCheck 对账有待扣款续费订单的支付宝协议状态
+//
+// 解约通知丢失时续费扣款会一直失败，已解约的协议关闭待扣款续费订单并回调业务方解约；签约通知丢失的补全协议号
+func (l *AlipayAgreementCheckLogic) AlipayAgreementCheck(req *types.AlipayAgreementCheckReq) (resp *types.AlipayAgreementCheckResp, err error) {
+	var checkNum, unsignNum int
+	lastId := 0
+	for {
+		list, err := l.orderModel.GetSubscribeWithUnpaidFee(lastId, alipayAgreementCheckBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, orderInfo := range list {
+			lastId = orderInfo.ID
+			if req.PayAppId != "" && orderInfo.PayAppID != req.PayAppId {
+				continue
+			}
+			checkNum++
+			if l.checkAgreement(orderInfo) {
+				unsignNum++
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		if len(list) < alipayAgreementCheckBatch {
+			break
+		}
+	}
+	l.Sloww("AlipayAgreementCheck finish", logx.Field("checkNum", checkNum), logx.Field("unsignNum", unsignNum))
+
+	resp = &types.AlipayAgreementCheckResp{
+		ErrNo:   0,
+		ErrTips: "success",
+	}
+	return
+}
+
+// 查询单个协议状态并修正本地数据，返回协议是否已解约
+func (l *AlipayAgreementCheckLogic) checkAgreement(orderInfo *model.OrderTable) bool {
+	payClient, _, _, err := clientMgr.GetAlipayClientByAppIdWithCache(orderInfo.PayAppID)
+	if err != nil {
+		l.Errorf("协议对账获取支付宝客户端失败 payAppId: %s, err: %v", orderInfo.PayAppID, err)
+		return false
+	}
+
+	rsp, err := client.AlipayAgreementStatusQuery(payClient, orderInfo.AgreementNo, orderInfo.ExternalAgreementNo)
+	if err != nil {
+		l.Errorf("查询支付宝协议失败 externalAgreementNo: %s, err: %v", orderInfo.ExternalAgreementNo, err)
+		return false
+	}
+
+	switch rsp.Content.Status {
+	case client.AlipayAgreementStatusNormal:
+		// 签约通知丢失，补全协议号
+		if orderInfo.AgreementNo == "" && rsp.Content.AgreementNo != "" {
+			orderInfo.AgreementNo = rsp.Content.AgreementNo
+			if err = l.orderModel.UpdateNotify(orderInfo); err != nil {
+				l.Errorf("补全协议号失败 externalAgreementNo: %s, err: %v", orderInfo.ExternalAgreementNo, err)
+			}
+		}
+		return false
+	case client.AlipayAgreementStatusUnsign:
+		l.unsign(orderInfo, rsp)
+		return true
+	default:
+		l.Sloww("支付宝协议非正常状态", logx.Field("externalAgreementNo", orderInfo.ExternalAgreementNo), logx.Field("status", rsp.Content.Status))
+		return false
+	}
+}
+
+// 协议已解约：关闭待扣款续费订单，回调业务方解约
+func (l *AlipayAgreementCheckLogic) unsign(orderInfo *model.OrderTable, rsp *client.AlipayAgreementQueryRsp) {
+	alipayAgreementUnsignNum.CounterInc()
+	l.Errorf("协议对账发现已解约协议 externalAgreementNo: %s, agreementNo: %s, invalidTime: %s", orderInfo.ExternalAgreementNo, rsp.Content.AgreementNo, rsp.Content.InvalidTime)
+
+	if err := l.orderModel.UnsignAgreementByExternalAgreementNo(orderInfo.ExternalAgreementNo); err != nil {
+		return
+	}
+	if err := l.orderModel.CloseUnpaidSubscribeFeeOrderByExternalAgreementNo(orderInfo.ExternalAgreementNo); err != nil {
+		return
+	}
+
+	go func() {
+		defer exception.Recover()
+		dataMap := make(map[string]interface{})
+		dataMap["notify_type"] = code.APP_NOTIFY_TYPE_UNSIGN
+		dataMap["out_trade_no"] = orderInfo.OutTradeNo
+		dataMap["agreement_no"] = rsp.Content.AgreementNo
+		dataMap["external_agreement_no"] = orderInfo.ExternalAgreementNo
+		dataMap["status"] = rsp.Content.Status
+		dataMap["invalid_time"] = rsp.Content.InvalidTime
+		headerMap := map[string]string{
+			"App-Origin": orderInfo.AppPkg,
+		}
+		err := utils.CallbackWithRetry(orderInfo.AppNotifyUrl, headerMap, dataMap, 5*time.Second)
+		if err != nil {
+			desc := fmt.Sprintf("回调通知用户解约(协议对账) 异常, app_pkg=%s, out_trade_no=%s", orderInfo.AppPkg, orderInfo.OutTradeNo)
+			alarm.ImmediateAlarm("notifyUserUnsignErr", desc, alarm.ALARM_LEVEL_FATAL)
+		}
+	}()
+}
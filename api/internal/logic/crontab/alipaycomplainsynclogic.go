@@ -0,0 +1,142 @@
+package crontab
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 支付宝投诉列表每页最多20条
+const alipayComplainPageSize = 20
+
+type AlipayComplainSyncLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	payConfigAlipayModel *model.PmPayConfigAlipayModel
+	alipayComplainModel  *model.PmAlipayComplainModel
+	orderModel           *model.OrderModel
+}
+
+func NewAlipayComplainSyncLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AlipayComplainSyncLogic {
+	return &AlipayComplainSyncLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		payConfigAlipayModel: model.NewPmPayConfigAlipayModel(define.DbPayGateway),
+		alipayComplainModel:  model.NewPmAlipayComplainModel(define.DbPayGateway),
+		orderModel:           model.NewOrderModel(define.DbPayGateway),
+	}
+}
+
+// AlipayComplainSync 同步所有支付宝商户最近几天的交易投诉到本地，已存在的投诉单更新状态
+func (l *AlipayComplainSyncLogic) AlipayComplainSync(req *types.AlipayComplainSyncReq) (resp *types.AlipayComplainSyncResp, err error) {
+	cfgList, err := l.payConfigAlipayModel.GetAllList()
+	if err != nil {
+		return nil, err
+	}
+
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -req.Days)
+	for _, cfg := range cfgList {
+		syncNum, syncErr := l.syncMerchant(cfg, startTime, endTime)
+		if syncErr != nil {
+			l.Errorf("同步支付宝投诉失败 appId: %s, merchantNo: %s, err: %v", cfg.AppID, cfg.MerchantNo, syncErr)
+			continue
+		}
+		if syncNum > 0 {
+			l.Sloww("AlipayComplainSync merchant", logx.Field("appId", cfg.AppID), logx.Field("merchantNo", cfg.MerchantNo), logx.Field("syncNum", syncNum))
+		}
+	}
+
+	resp = &types.AlipayComplainSyncResp{
+		ErrNo:   0,
+		ErrTips: "success",
+	}
+	return
+}
+
+// 分页拉取单个商户的投诉单
+func (l *AlipayComplainSyncLogic) syncMerchant(cfg *model.PmPayConfigAlipayTable, startTime, endTime time.Time) (syncNum int, err error) {
+	payClient, _, _, err := clientMgr.GetAlipayClientByAppIdWithCache(cfg.AppID)
+	if err != nil {
+		return 0, err
+	}
+
+	param := client.AlipayComplainBatchQuery{
+		PageSize:  alipayComplainPageSize,
+		PageNum:   1,
+		BeginTime: startTime.Format("2006-01-02 15:04:05"),
+		EndTime:   endTime.Format("2006-01-02 15:04:05"),
+	}
+	for {
+		rsp, err := client.AlipayComplainList(payClient, param)
+		if err != nil {
+			return syncNum, err
+		}
+
+		for _, complain := range rsp.Content.ComplaintList {
+			if err = l.alipayComplainModel.Sync(l.toComplainTable(cfg, complain)); err != nil {
+				continue
+			}
+			syncNum++
+		}
+
+		if len(rsp.Content.ComplaintList) < alipayComplainPageSize || param.PageNum*alipayComplainPageSize >= rsp.Content.TotalSize {
+			return syncNum, nil
+		}
+		param.PageNum++
+	}
+}
+
+// 支付宝投诉单转换为本地记录，按商家订单号关联我方订单
+func (l *AlipayComplainSyncLogic) toComplainTable(cfg *model.PmPayConfigAlipayTable, complain *client.AlipayComplain) *model.PmAlipayComplainTable {
+	amount, _ := strconv.ParseFloat(complain.ComplainAmount, 64)
+	info := &model.PmAlipayComplainTable{
+		PayAppId:         cfg.AppID,
+		MerchantNo:       cfg.MerchantNo,
+		ComplainEventId:  complain.ComplainEventId,
+		Status:           complain.Status,
+		TradeNo:          complain.TradeNo,
+		OutTradeNo:       complain.MerchantOrderNo,
+		ComplainAmount:   int(math.Round(amount * 100)),
+		LeafCategoryName: complain.LeafCategoryName,
+		ComplainReason:   complain.ComplainReason,
+		Content:          complain.Content,
+		Images:           strings.Join(complain.Images, ","),
+		PhoneNo:          complain.PhoneNo,
+		GmtComplain:      parseAlipayTime(complain.GmtCreate),
+		GmtModified:      parseAlipayTime(complain.GmtModified),
+		GmtFinished:      parseAlipayTime(complain.GmtFinished),
+	}
+
+	if complain.MerchantOrderNo != "" {
+		orderInfo, err := l.orderModel.GetOneByOutTradeNo(complain.MerchantOrderNo)
+		if err == nil {
+			info.AppPkg = orderInfo.AppPkg
+		}
+	}
+	return info
+}
+
+// 支付宝返回的时间为东八区 yyyy-MM-dd HH:mm:ss，为空或格式不对时返回默认时间，零值写不进datetime字段
+func parseAlipayTime(value string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", value, time.Local)
+	if err != nil {
+		return model.Default2000Date
+	}
+	return t
+}
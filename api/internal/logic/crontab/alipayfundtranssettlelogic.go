@@ -0,0 +1,68 @@
+package crontab
+
+import (
+	"context"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AlipayFundTransSettleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	fundTransOrderModel *model.PmFundTransOrderModel
+}
+
+func NewAlipayFundTransSettleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AlipayFundTransSettleLogic {
+	return &AlipayFundTransSettleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		fundTransOrderModel: model.NewPmFundTransOrderModel(define.DbPayGateway),
+	}
+}
+
+// AlipayFundTransSettle 定时任务查询处理中、结果未知的转账（支付宝、微信），更新为最终状态
+func (l *AlipayFundTransSettleLogic) AlipayFundTransSettle(req *types.AlipayFundTransSettleReq) (resp *types.AlipayFundTransSettleResp, err error) {
+	now := time.Now()
+	startTime := now.AddDate(0, 0, -req.Days)
+	endTime := now.Add(-time.Duration(req.Minutes) * time.Minute)
+	list, err := l.fundTransOrderModel.GetUncertainList(startTime, endTime, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	successNum, failNum := 0, 0
+	for _, orderInfo := range list {
+		queryErr := clientMgr.FundTransQuery(orderInfo)
+		if queryErr != nil {
+			l.Errorf("AlipayFundTransSettle err: %v", queryErr)
+			continue
+		}
+
+		switch orderInfo.Status {
+		case model.PmFundTransOrderStatusSuccess:
+			successNum++
+		case model.PmFundTransOrderStatusFail:
+			failNum++
+			l.Errorf("转账失败 channel: %d, orderSn: %s, pkgName: %s, reason: %s", orderInfo.Channel, orderInfo.OrderSn, orderInfo.AppPkgName, orderInfo.FailReason)
+		}
+	}
+
+	l.Sloww("AlipayFundTransSettle finish", logx.Field("total", len(list)), logx.Field("successNum", successNum), logx.Field("failNum", failNum))
+
+	resp = &types.AlipayFundTransSettleResp{
+		ErrNo:   0,
+		ErrTips: "success",
+	}
+	return
+}
@@ -0,0 +1,198 @@
+package crontab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/logic/notify"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+	douyin "gitlab.muchcloud.com/consumer-project/pay-gateway/common/client/douyinGeneralTrade"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	dyPeriodSignUnsignNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "dyPeriodSignUnsignNum", nil, "抖音签约单对账发现已解约或到期的签约", nil})}
+)
+
+const (
+	dyPeriodSignCheckBatch    = 100                   // 每批查询的签约单数
+	dyPeriodSignCheckInterval = 50 * time.Millisecond // 查询抖音签约单的间隔
+)
+
+type DyPeriodSignCheckLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	payDyPeriodOrderModel *model.PmDyPeriodOrderModel
+	dyClient              *douyin.PayClient
+	clientTokens          map[string]string // 本次对账内缓存的client token
+}
+
+func NewDyPeriodSignCheckLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DyPeriodSignCheckLogic {
+	return &DyPeriodSignCheckLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		payDyPeriodOrderModel: model.NewPmDyPeriodOrderModel(define.DbPayGateway),
+		dyClient:              &douyin.PayClient{}, // 查询签约单用不到支付相关的配置
+		clientTokens:          make(map[string]string),
+	}
+}
+
+// DyPeriodSignCheck 对账已签约的抖音周期代扣签约单状态，返回检查数和已解约或到期数
+//
+// 签约回调丢失或用户在抖音侧解约时本地仍是已签约，已解约或到期的更新签约状态并回调业务方
+// 每批查询前确认任务未超时且checkLeader仍为true（节点仍是leader），否则中止并返回错误
+func (l *DyPeriodSignCheckLogic) DyPeriodSignCheck(req *types.DyPeriodSignCheckReq, checkLeader func() bool) (checkNum, unsignNum int64, err error) {
+	// 控制查询抖音签约单的频率
+	ticker := time.NewTicker(dyPeriodSignCheckInterval)
+	defer ticker.Stop()
+
+	lastId := 0
+	for {
+		if l.ctx.Err() != nil {
+			err = errors.New("签约对账中止，执行超时")
+			break
+		}
+		if !checkLeader() {
+			err = errors.New("签约对账中止，已不是leader")
+			break
+		}
+
+		list, listErr := l.payDyPeriodOrderModel.GetSignedBatch(req.PayAppId, lastId, dyPeriodSignCheckBatch)
+		if listErr != nil {
+			err = listErr
+			break
+		}
+		for _, orderInfo := range list {
+			select {
+			case <-l.ctx.Done():
+			case <-ticker.C:
+			}
+			if l.ctx.Err() != nil {
+				break
+			}
+			lastId = orderInfo.ID
+			checkNum++
+			if l.checkSign(orderInfo) {
+				unsignNum++
+			}
+		}
+		if len(list) < dyPeriodSignCheckBatch {
+			break
+		}
+	}
+	l.Sloww("DyPeriodSignCheck finish", logx.Field("checkNum", checkNum), logx.Field("unsignNum", unsignNum), logx.Field("lastId", lastId), logx.Field("err", err))
+	return
+}
+
+func (l *DyPeriodSignCheckLogic) getClientToken(appId string) (string, error) {
+	if token, ok := l.clientTokens[appId]; ok {
+		return token, nil
+	}
+	token, err := l.svcCtx.BaseAppConfigServerApi.GetDyClientToken(l.ctx, appId)
+	if err != nil {
+		return "", err
+	}
+	l.clientTokens[appId] = token
+	return token, nil
+}
+
+// 查询单个签约单状态并修正本地数据，返回签约是否已解约或到期
+func (l *DyPeriodSignCheckLogic) checkSign(orderInfo *model.PmDyPeriodOrderTable) bool {
+	clientToken, err := l.getClientToken(orderInfo.PayAppId)
+	if err != nil {
+		l.Errorf("签约对账获取抖音client token失败 appId: %s, err: %v", orderInfo.PayAppId, err)
+		return false
+	}
+
+	signResult, err := l.dyClient.QuerySignOrder(clientToken, orderInfo.SignNo)
+	if err != nil {
+		l.Errorf("查询抖音签约单失败 signNo: %s, err: %v", orderInfo.SignNo, err)
+		return false
+	}
+	if signResult.ErrNo != 0 {
+		l.Errorw("查询抖音签约单失败", logx.Field("signNo", orderInfo.SignNo), logx.Field("signResult", signResult))
+		return false
+	}
+
+	now := time.Now().Format("2006-01-02 15:04:05")
+	var updateData map[string]interface{}
+	var callbackStatus string
+	switch signResult.UserSignData.Status {
+	case douyin.Dy_Sign_Status_Query_SERVING:
+		// 签约回调丢失，补全抖音签约单号
+		if orderInfo.ThirdSignOrderNo == "" && signResult.UserSignData.AuthOrderId != "" {
+			err = l.payDyPeriodOrderModel.UpdateSomeData(orderInfo.ID, map[string]interface{}{
+				"third_sign_order_no": signResult.UserSignData.AuthOrderId,
+			})
+			if err != nil {
+				l.Errorf("补全抖音签约单号失败 signNo: %s, err: %v", orderInfo.SignNo, err)
+			}
+		}
+		return false
+	case douyin.Dy_Sign_Status_Query_CANCEL:
+		updateData = map[string]interface{}{
+			"sign_status": model.Sign_Status_Cancel,
+			"unsign_date": now,
+		}
+		callbackStatus = douyin.Dy_Sign_Status_CANCEL
+	case douyin.Dy_Sign_Status_Query_DONE:
+		updateData = map[string]interface{}{
+			"sign_status": model.Sign_Status_Done,
+			"expire_date": now,
+		}
+		callbackStatus = douyin.Dy_Sign_Status_DONE
+	default:
+		l.Sloww("抖音签约单非正常状态", logx.Field("signNo", orderInfo.SignNo), logx.Field("status", signResult.UserSignData.Status))
+		return false
+	}
+
+	// 对账期间签约回调已处理的不再回调
+	isUpdate, err := l.payDyPeriodOrderModel.UpdateSignedStatus(orderInfo.ID, updateData)
+	if err != nil || !isUpdate {
+		return false
+	}
+	dyPeriodSignUnsignNum.CounterInc()
+	l.Errorf("签约对账发现已解约或到期的签约单 signNo: %s, authOrderId: %s, status: %s, cancelSource: %d",
+		orderInfo.SignNo, signResult.UserSignData.AuthOrderId, signResult.UserSignData.Status, signResult.UserSignData.CancelSource)
+
+	go l.notifyUnsign(orderInfo, signResult.UserSignData.AppId, callbackStatus)
+	return true
+}
+
+// 按抖音签约回调的格式回调业务方解约或到期
+func (l *DyPeriodSignCheckLogic) notifyUnsign(orderInfo *model.PmDyPeriodOrderTable, appId, status string) {
+	defer exception.Recover()
+
+	msgByte, _ := json.Marshal(map[string]string{
+		"app_id":  appId,
+		"app_pkg": orderInfo.AppPkgName,
+		"status":  status,
+		"userId":  strconv.Itoa(orderInfo.UserId),
+	})
+	postData := map[string]interface{}{
+		"type": douyin.EventSignCallback,
+		"msg":  string(msgByte),
+	}
+	headMap := map[string]string{
+		"App-Origin": orderInfo.AppPkgName,
+	}
+	_, err := util.HttpPostWithHeader(orderInfo.NotifyUrl, postData, headMap, 5*time.Second)
+	if err != nil {
+		notify.CallbackBizFailNum.CounterInc()
+		l.Errorf("DyPeriodSignCheck:callback notify_url failed , req:%+v, err:%v", postData, err)
+	}
+}
@@ -0,0 +1,93 @@
+package crontab
+
+import (
+	"context"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/logic/notify"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/alarm"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	dyRefundAuditRecoverNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "dyRefundAuditRecoverNum", nil, "抖音退款自动审核中断后重新审核", nil})}
+)
+
+const (
+	dyRefundAuditStaleMinute = 5   // 创建超过该分钟数仍在自动审核中的视为中断，自动审核协程正常几十秒内结束
+	dyRefundAuditRecoverMax  = 200 // 单次最多处理数
+)
+
+type DyRefundAuditRecoverLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	refundAuditModel *model.PmDyRefundAuditModel
+}
+
+func NewDyRefundAuditRecoverLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DyRefundAuditRecoverLogic {
+	return &DyRefundAuditRecoverLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		refundAuditModel: model.NewPmDyRefundAuditModel(define.DbPayGateway),
+	}
+}
+
+// DyRefundAuditRecover 重新审核中断的抖音退款自动审核
+//
+// 进程重启或最终更新失败时审核单会停留在自动审核中，既不会出现在待人工审核列表也不能人工审核。
+// 未过审核截止时间的按保存的策略结果重新审核，失败的转人工审核；已过截止时间的置为超时未审核并告警
+func (l *DyRefundAuditRecoverLogic) DyRefundAuditRecover() (total, success int64, err error) {
+	list, err := l.refundAuditModel.GetStaleAutoList(time.Now().Add(-dyRefundAuditStaleMinute*time.Minute), dyRefundAuditRecoverMax)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	douyinLogic := notify.NewNotifyDouyinLogic(l.ctx, l.svcCtx)
+	for _, auditInfo := range list {
+		if l.ctx.Err() != nil {
+			l.Errorf("DyRefundAuditRecover 中止，执行超时 total: %d", total)
+			break
+		}
+		total++
+
+		if time.Now().After(auditInfo.RefundAuditDeadline) {
+			isUpdate, _ := l.refundAuditModel.UpdateByStatus(auditInfo.ID, model.DyRefundAuditStatusAuto, map[string]interface{}{
+				"audit_status": model.DyRefundAuditStatusExpire,
+			})
+			if isUpdate {
+				alarm.ImmediateAlarm("dyRefundAuditAutoExpire", "抖音退款自动审核中断且已过审核截止时间 refundId: "+auditInfo.RefundId, alarm.ALARM_LEVEL_FATAL)
+			}
+			continue
+		}
+
+		if auditInfo.AutoAuditStatus != model.DyRefundAuditStatusPass && auditInfo.AutoAuditStatus != model.DyRefundAuditStatusReject {
+			// 上线前创建的没有保存策略结果，转人工审核
+			isUpdate, _ := l.refundAuditModel.UpdateByStatus(auditInfo.ID, model.DyRefundAuditStatusAuto, map[string]interface{}{
+				"audit_status": model.DyRefundAuditStatusWait,
+				"audit_remark": auditInfo.AuditRemark + "，自动审核中断，转人工审核",
+			})
+			if isUpdate {
+				success++
+			}
+			continue
+		}
+
+		dyRefundAuditRecoverNum.CounterInc()
+		l.Errorf("DyRefundAuditRecover 自动审核中断，重新审核 refundId: %s, autoAuditStatus: %d", auditInfo.RefundId, auditInfo.AutoAuditStatus)
+		if douyinLogic.RedriveAutoAudit(auditInfo) {
+			success++
+		}
+	}
+
+	l.Sloww("DyRefundAuditRecover finish", logx.Field("total", total), logx.Field("success", success))
+	return total, success, nil
+}
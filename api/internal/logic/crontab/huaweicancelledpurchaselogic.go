@@ -0,0 +1,216 @@
+package crontab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/logic/notify"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/huawei"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/utils"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	huaweiCancelledPurchaseErrNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "huaweiCancelledPurchaseErrNum", nil, "华为退款对账处理失败", nil})}
+	huaweiCancelledPurchaseNum    = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "huaweiCancelledPurchaseNum", nil, "华为退款对账发现的退款订单", nil})}
+)
+
+type HuaweiCancelledPurchaseLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	huaweiOrderModel *model.HuaweiOrderModel
+	huaweiAppModel   *model.HuaweiAppModel
+}
+
+func NewHuaweiCancelledPurchaseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *HuaweiCancelledPurchaseLogic {
+	return &HuaweiCancelledPurchaseLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		huaweiOrderModel: model.NewHuaweiOrderModel(define.DbPayGateway),
+		huaweiAppModel:   model.NewHuaweiAppModel(define.DbPayGateway),
+	}
+}
+
+// HuaweiCancelledPurchase 每日对账，查询华为已取消或已退款的购买记录，标记订单已退款并回调业务方收回权益
+//
+// 华为客服退款等场景不一定会有回调通知，需要主动查询；先重试之前回调业务方失败的订单
+func (l *HuaweiCancelledPurchaseLogic) HuaweiCancelledPurchase(req *types.HuaweiCancelledPurchaseReq) (resp *types.HuaweiCancelledPurchaseResp, err error) {
+	l.retryRefundNotify()
+
+	appList, err := l.huaweiAppModel.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	endAt := time.Now()
+	startAt := endAt.AddDate(0, 0, -req.Days)
+
+	for _, hwApp := range appList {
+		if req.AppId != "" && hwApp.AppID != req.AppId {
+			continue
+		}
+
+		authHeaderString, authErr := huawei.NewClient(l.ctx, define.DbPayGateway, hwApp.ClientId, hwApp.ClientSecret, hwApp.AppSecret).BuildAuthorization()
+		if authErr != nil {
+			huaweiCancelledPurchaseErrNum.CounterInc()
+			l.Errorf("华为BuildAuthorization失败 error: %v, appId: %s", authErr, hwApp.AppID)
+			continue
+		}
+
+		// 一次性商品和订阅型商品分开查询
+		for _, productType := range []int{huawei.CANCELLED_LIST_TYPE_ONE_TIME, huawei.CANCELLED_LIST_TYPE_SUBSCRIPTION} {
+			l.reconcileApp(hwApp, authHeaderString, productType, startAt, endAt, req.MaxRows)
+		}
+	}
+
+	resp = &types.HuaweiCancelledPurchaseResp{
+		ErrNo:   0,
+		ErrTips: "success",
+	}
+	return
+}
+
+// 按continuationToken分页查询一个应用的退款记录
+func (l *HuaweiCancelledPurchaseLogic) reconcileApp(hwApp *model.HuaweiAppTable, authHeaderString string, productType int, startAt, endAt time.Time, maxRows int) {
+	continuationToken := ""
+	total, refundedNum := 0, 0
+	for {
+		hwResp, err := huawei.OrderDemo.CancelledListPurchase(authHeaderString, endAt.UnixMilli(), startAt.UnixMilli(), maxRows, productType, continuationToken)
+		if err != nil {
+			huaweiCancelledPurchaseErrNum.CounterInc()
+			l.Errorf("华为查询退款记录失败 error: %v, appId: %s, type: %d", err, hwApp.AppID, productType)
+			break
+		}
+
+		purchaseList, err := hwResp.GetCanceledPurchases()
+		if err != nil {
+			huaweiCancelledPurchaseErrNum.CounterInc()
+			l.Errorf("json.Unmarshal error: %v, raw string: %s", err, hwResp.CpList)
+			break
+		}
+
+		for _, purchase := range purchaseList {
+			total++
+			if l.handleCanceledPurchase(hwApp, purchase) {
+				refundedNum++
+			}
+		}
+
+		if hwResp.ContinuationToken == "" || len(purchaseList) == 0 {
+			break
+		}
+		continuationToken = hwResp.ContinuationToken
+	}
+
+	l.Sloww("华为退款对账完成", logx.Field("appId", hwApp.AppID), logx.Field("type", productType), logx.Field("total", total), logx.Field("refundedNum", refundedNum))
+}
+
+// 处理一条退款记录，返回是否新标记了退款
+func (l *HuaweiCancelledPurchaseLogic) handleCanceledPurchase(hwApp *model.HuaweiAppTable, purchase *huawei.CanceledPurchase) bool {
+	hworder, err := l.huaweiOrderModel.GetOneByTokenOrPayOrderId(hwApp.AppID, purchase.PurchaseToken, purchase.OrderId)
+	if err != nil || hworder.Id < 1 {
+		// 不是网关创建的订单
+		l.Sloww("华为退款记录未匹配到订单", logx.Field("purchase", purchase), logx.Field("err", err))
+		return false
+	}
+
+	if hworder.Status == model.HuaweiOrderStatusRefunded {
+		// 已经处理过
+		return false
+	}
+
+	cancellationTime := int(time.Now().Unix())
+	if purchase.CancelledTime > 1000 {
+		cancellationTime = int(purchase.CancelledTime / 1000)
+	}
+
+	// 回调业务方收回权益，回调数据和已退款状态一起保存，回调失败时下次对账重试
+	callbackData := map[string]interface{}{
+		"notify_type":       code.APP_NOTIFY_HUAWEI_REFUND,
+		"user_id":           hworder.UserId,
+		"out_trade_no":      hworder.OutTradeNo,
+		"pkg":               hworder.AppPkg,
+		"product_id":        hworder.ProductId,
+		"subscription_id":   hworder.SubscriptionId,
+		"platform_trade_no": purchase.OrderId,
+		"cancelled_reason":  purchase.CancelledReason,
+		"cancellation_time": cancellationTime,
+	}
+	callbackJson, _ := json.Marshal(callbackData)
+
+	updateData := map[string]interface{}{
+		"status":               model.HuaweiOrderStatusRefunded,
+		"cancellation_date":    cancellationTime,
+		"refund_notify_status": model.HuaweiOrderRefundNotifyPending,
+		"refund_notify_data":   string(callbackJson),
+	}
+	if purchase.RefundPayOrderId != "" {
+		updateData["refund_pay_order_id"] = purchase.RefundPayOrderId
+	}
+	isUpdate, err := l.huaweiOrderModel.MarkRefunded(hworder.Id, updateData)
+	if err != nil {
+		huaweiCancelledPurchaseErrNum.CounterInc()
+		return false
+	}
+	if !isUpdate {
+		return false
+	}
+	huaweiCancelledPurchaseNum.CounterInc()
+
+	l.notifyRefund(hworder, callbackData)
+	return true
+}
+
+// 重试回调业务方失败的退款订单
+func (l *HuaweiCancelledPurchaseLogic) retryRefundNotify() {
+	lastId := 0
+	for {
+		list, err := l.huaweiOrderModel.GetRefundNotifyPendingList(lastId, 100)
+		if err != nil || len(list) == 0 {
+			return
+		}
+		for _, hworder := range list {
+			lastId = hworder.Id
+			callbackData := make(map[string]interface{})
+			if err = json.Unmarshal([]byte(hworder.RefundNotifyData), &callbackData); err != nil {
+				l.Errorf("华为退款对账 回调数据解析失败 out_trade_no: %s, err: %v", hworder.OutTradeNo, err)
+				continue
+			}
+			l.notifyRefund(hworder, callbackData)
+		}
+	}
+}
+
+// 回调业务方退款，成功后置为已回调
+func (l *HuaweiCancelledPurchaseLogic) notifyRefund(hworder *model.HuaweiOrderTable, callbackData map[string]interface{}) {
+	notifyUrl := huawei.DefaultNotifyUrl
+	if hworder.AppNotifyUrl != "" {
+		notifyUrl = hworder.AppNotifyUrl
+	}
+	headerMap := map[string]string{
+		"App-Origin": hworder.AppPkg,
+	}
+	err := utils.CallbackWithRetry(notifyUrl, headerMap, callbackData, 5*time.Second)
+	if err != nil {
+		huaweiCancelledPurchaseErrNum.CounterInc()
+		desc := fmt.Sprintf("华为退款对账 回调业务方失败，下次对账重试 out_trade_no: %s, err: %v", hworder.OutTradeNo, err)
+		l.Error(desc)
+		notify.CallbackBizFailNum.CounterInc()
+		return
+	}
+
+	_ = l.huaweiOrderModel.UpdateData(hworder.Id, map[string]interface{}{"refund_notify_status": model.HuaweiOrderRefundNotifyDone})
+}
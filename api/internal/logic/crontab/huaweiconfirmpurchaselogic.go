@@ -0,0 +1,84 @@
+package crontab
+
+import (
+	"context"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/huawei"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	huaweiConfirmPurchaseErrNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "huaweiConfirmPurchaseErrNum", nil, "华为确认购买失败", nil})}
+)
+
+type HuaweiConfirmPurchaseLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	huaweiOrderModel *model.HuaweiOrderModel
+	huaweiAppModel   *model.HuaweiAppModel
+}
+
+func NewHuaweiConfirmPurchaseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *HuaweiConfirmPurchaseLogic {
+	return &HuaweiConfirmPurchaseLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		huaweiOrderModel: model.NewHuaweiOrderModel(define.DbPayGateway),
+		huaweiAppModel:   model.NewHuaweiAppModel(define.DbPayGateway),
+	}
+}
+
+// HuaweiConfirmPurchase 定时任务重试确认已发货但未确认购买的华为一次性商品订单
+func (l *HuaweiConfirmPurchaseLogic) HuaweiConfirmPurchase(req *types.HuaweiConfirmPurchaseReq) (resp *types.HuaweiConfirmPurchaseResp, err error) {
+	startTime := time.Now().AddDate(0, 0, -req.Days)
+	list, err := l.huaweiOrderModel.GetUnconfirmedList(startTime, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	successNum := 0
+	for _, hworder := range list {
+		hwApp, appErr := l.huaweiAppModel.GetInfo(hworder.AppId)
+		if appErr != nil || hwApp.ID < 1 {
+			l.Errorf("获取华为应用配置失败 error: %v, appId: %s", appErr, hworder.AppId)
+			continue
+		}
+
+		authHeaderString, authErr := huawei.NewClient(l.ctx, define.DbPayGateway, hwApp.ClientId, hwApp.ClientSecret, hwApp.AppSecret).BuildAuthorization()
+		if authErr != nil {
+			l.Errorf("华为BuildAuthorization失败 error: %v, appId: %s", authErr, hwApp.AppID)
+			continue
+		}
+
+		_, confirmErr := huawei.OrderDemo.ConfirmPurchaseWithRetry(authHeaderString, hworder.PurchaseToken, hworder.ProductId, 3)
+		if confirmErr != nil {
+			huaweiConfirmPurchaseErrNum.CounterInc()
+			l.Errorf("华为确认购买失败 error: %v, order id: %d, out_trade_no: %s", confirmErr, hworder.Id, hworder.OutTradeNo)
+			continue
+		}
+
+		updateErr := l.huaweiOrderModel.UpdateData(hworder.Id, map[string]interface{}{"confirm_status": model.HuaweiOrderConfirmStatusYes})
+		if updateErr != nil {
+			continue
+		}
+		successNum++
+	}
+
+	l.Sloww("HuaweiConfirmPurchase finish", logx.Field("total", len(list)), logx.Field("successNum", successNum))
+
+	resp = &types.HuaweiConfirmPurchaseResp{
+		ErrNo:   0,
+		ErrTips: "success",
+	}
+	return
+}
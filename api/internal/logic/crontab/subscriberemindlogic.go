@@ -0,0 +1,207 @@
+package crontab
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	productTypes "gitlab.muchcloud.com/consumer-project/pay-gateway/common/types"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/utils"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	subscribeRemindNum    = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "subscribeRemindNum", nil, "续费扣款前提醒回调数", nil})}
+	subscribeRemindErrNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "subscribeRemindErrNum", nil, "续费扣款前提醒回调失败", nil})}
+)
+
+// 每批查询的订单数
+const subscribeRemindBatch = 200
+
+type SubscribeRemindLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	orderModel            *model.OrderModel
+	payDyPeriodOrderModel *model.PmDyPeriodOrderModel
+	remindConfigModel     *model.PmSubscribeRemindConfigModel
+	remindDays            map[string]int // 本次执行内缓存的包名提醒天数
+}
+
+func NewSubscribeRemindLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubscribeRemindLogic {
+	return &SubscribeRemindLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		orderModel:            model.NewOrderModel(define.DbPayGateway),
+		payDyPeriodOrderModel: model.NewPmDyPeriodOrderModel(define.DbPayGateway),
+		remindConfigModel:     model.NewPmSubscribeRemindConfigModel(define.DbPayGateway),
+		remindDays:            make(map[string]int),
+	}
+}
+
+// SubscribeRemind 续费扣款前回调业务方，由业务方提醒用户即将自动续费
+//
+// 按包名配置的提前天数，支付宝按签约订单的首次续费时间和续费订单的扣款时间，抖音按签约单的下次扣款时间，同一扣款日只提醒一次
+func (l *SubscribeRemindLogic) SubscribeRemind(req *types.SubscribeRemindReq) (resp *types.SubscribeRemindResp, err error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	// 按最大提前天数查询，再按包名的配置过滤
+	endTime := today.AddDate(0, 0, model.SubscribeRemindMaxDays+1)
+
+	var alipayNum, dyNum int
+	lastId := 0
+	for {
+		list, err := l.orderModel.GetUpcomingDeductBatch(now, endTime, lastId, subscribeRemindBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, orderInfo := range list {
+			lastId = orderInfo.ID
+			if req.AppPkg != "" && orderInfo.AppPkg != req.AppPkg {
+				continue
+			}
+			if l.remindAlipay(orderInfo, today) {
+				alipayNum++
+			}
+		}
+		if len(list) < subscribeRemindBatch {
+			break
+		}
+	}
+
+	lastId = 0
+	for {
+		list, err := l.payDyPeriodOrderModel.GetUpcomingSignedBatch(now, endTime, lastId, subscribeRemindBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, contract := range list {
+			lastId = contract.ID
+			if req.AppPkg != "" && contract.AppPkgName != req.AppPkg {
+				continue
+			}
+			if l.remindDouyin(contract, today) {
+				dyNum++
+			}
+		}
+		if len(list) < subscribeRemindBatch {
+			break
+		}
+	}
+	l.Sloww("SubscribeRemind finish", logx.Field("alipayNum", alipayNum), logx.Field("dyNum", dyNum))
+
+	resp = &types.SubscribeRemindResp{
+		ErrNo:   0,
+		ErrTips: "success",
+	}
+	return
+}
+
+// 扣款时间是否已进入包名配置的提醒天数内，配置读取失败的本次不提醒
+func (l *SubscribeRemindLogic) inRemindDays(pkgName string, deductTime, today time.Time) bool {
+	days, ok := l.remindDays[pkgName]
+	if !ok {
+		cfg, err := l.remindConfigModel.GetByPkgName(pkgName)
+		if err != nil {
+			return false
+		}
+		days = cfg.Days()
+		l.remindDays[pkgName] = days
+	}
+	return days > 0 && deductTime.Before(today.AddDate(0, 0, days+1))
+}
+
+// 支付宝签约订单或续费订单的扣款提醒，提醒记录在签约订单上
+func (l *SubscribeRemindLogic) remindAlipay(orderInfo *model.OrderTable, today time.Time) bool {
+	if orderInfo.ExternalAgreementNo == "" || !l.inRemindDays(orderInfo.AppPkg, orderInfo.DeductTime, today) {
+		return false
+	}
+
+	amount := orderInfo.Amount
+	if orderInfo.ProductType == code.PRODUCT_TYPE_SUBSCRIBE {
+		// 签约订单的金额是首期金额，续费按商品的每期金额
+		product := productTypes.Product{}
+		if err := json.Unmarshal([]byte(orderInfo.ProductDesc), &product); err != nil {
+			l.Errorf("扣款提醒解析商品详情失败 outTradeNo: %s, err: %v", orderInfo.OutTradeNo, err)
+			return false
+		}
+		amount = int(math.Round(product.Amount * 100))
+	}
+
+	isUpdate, err := l.orderModel.MarkDeductRemind(orderInfo.ExternalAgreementNo, deductDate(orderInfo.DeductTime))
+	if err != nil || !isUpdate {
+		return false
+	}
+
+	dataMap := make(map[string]interface{})
+	dataMap["notify_type"] = code.APP_NOTIFY_TYPE_SUBSCRIBE_REMIND
+	dataMap["channel"] = model.Subscription_Channel_Alipay
+	dataMap["external_agreement_no"] = orderInfo.ExternalAgreementNo
+	dataMap["out_trade_no"] = orderInfo.OutTradeNo
+	dataMap["user_id"] = orderInfo.UserID
+	dataMap["amount"] = amount
+	dataMap["deduct_time"] = orderInfo.DeductTime.Format("2006-01-02 15:04:05")
+	if !l.notifyRemind(orderInfo.AppNotifyUrl, orderInfo.AppPkg, dataMap) {
+		// 先标记再回调，避免并发重复提醒；回调失败时撤销标记，下次执行重试
+		l.orderModel.ResetDeductRemind(orderInfo.ExternalAgreementNo, deductDate(orderInfo.DeductTime))
+		return false
+	}
+	return true
+}
+
+// 抖音签约单的扣款提醒
+func (l *SubscribeRemindLogic) remindDouyin(contract *model.PmDyPeriodOrderTable, today time.Time) bool {
+	if !l.inRemindDays(contract.AppPkgName, contract.NextDecuctionTime, today) {
+		return false
+	}
+
+	isUpdate, err := l.payDyPeriodOrderModel.MarkDeductRemind(contract.ID, deductDate(contract.NextDecuctionTime))
+	if err != nil || !isUpdate {
+		return false
+	}
+
+	dataMap := make(map[string]interface{})
+	dataMap["notify_type"] = code.APP_NOTIFY_TYPE_SUBSCRIBE_REMIND
+	dataMap["channel"] = model.Subscription_Channel_Douyin
+	dataMap["out_trade_no"] = contract.OrderSn
+	dataMap["sign_no"] = contract.SignNo
+	dataMap["user_id"] = contract.UserId
+	dataMap["amount"] = contract.Amount
+	dataMap["deduct_time"] = contract.NextDecuctionTime.Format("2006-01-02 15:04:05")
+	if !l.notifyRemind(contract.NotifyUrl, contract.AppPkgName, dataMap) {
+		l.payDyPeriodOrderModel.ResetDeductRemind(contract.ID, deductDate(contract.NextDecuctionTime))
+		return false
+	}
+	return true
+}
+
+// 回调业务方，返回是否成功
+func (l *SubscribeRemindLogic) notifyRemind(notifyUrl, pkgName string, dataMap map[string]interface{}) bool {
+	headerMap := map[string]string{
+		"App-Origin": pkgName,
+	}
+	err := utils.CallbackWithRetry(notifyUrl, headerMap, dataMap, 5*time.Second)
+	if err != nil {
+		subscribeRemindErrNum.CounterInc()
+		l.Errorf("SubscribeRemind:callback notify_url failed, req:%+v, err:%v", dataMap, err)
+		return false
+	}
+	subscribeRemindNum.CounterInc()
+	return true
+}
+
+// 扣款时间所在的日期，用于同一扣款日去重
+func deductDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
@@ -2,13 +2,14 @@ package crontab
 
 import (
 	"context"
-	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/trace"
+	"gitlab.muchcloud.com/consumer-project/alipay"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/logic/notify"
 	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
 	"go.opentelemetry.io/otel"
@@ -17,11 +18,15 @@ import (
 	"github.com/bytedance/sonic"
 	"github.com/zeromicro/go-zero/core/logx"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/notice"
+	cron "gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/crontab"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
 	douyin "gitlab.muchcloud.com/consumer-project/pay-gateway/common/client/douyinGeneralTrade"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/utils"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
 	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
 )
@@ -33,18 +38,51 @@ var (
 	orderSupplementarySuccessNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "notifyOrderSupplementarySuccessNum", nil, "订单补偿成功", nil})}
 )
 
+// 补单渠道
+const (
+	supplementaryChannelWxUni    = "wxUni"
+	supplementaryChannelWxH5     = "wxH5"
+	supplementaryChannelDouyin   = "douyin"
+	supplementaryChannelKs       = "ks"
+	supplementaryChannelAlipay   = "alipay"
+	supplementaryChannelDyPeriod = "dyPeriod"
+)
+
+// 补单汇总信息中渠道的展示顺序
+var supplementaryChannels = []string{
+	supplementaryChannelWxUni,
+	supplementaryChannelWxH5,
+	supplementaryChannelDouyin,
+	supplementaryChannelKs,
+	supplementaryChannelAlipay,
+	supplementaryChannelDyPeriod,
+}
+
+// 补单渠道展示名
+var supplementaryChannelNames = map[string]string{
+	supplementaryChannelWxUni:    "微信小程序",
+	supplementaryChannelWxH5:     "微信H5",
+	supplementaryChannelDouyin:   "抖音",
+	supplementaryChannelKs:       "快手",
+	supplementaryChannelAlipay:   "支付宝",
+	supplementaryChannelDyPeriod: "抖音周期代扣",
+}
+
 type SupplementaryOrdersLogic struct {
 	logx.Logger
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
 
-	payOrderModel  *model.PmPayOrderModel
-	appConfigModel *model.PmAppConfigModel
+	payOrderModel         *model.PmPayOrderModel
+	orderModel            *model.OrderModel
+	payDyPeriodOrderModel *model.PmDyPeriodOrderModel
+	appConfigModel        *model.PmAppConfigModel
 
-	//payConfigAlipayModel *model.PmPayConfigAlipayModel
 	payConfigTiktokModel *model.PmPayConfigTiktokModel
 	payConfigWechatModel *model.PmPayConfigWechatModel
-	//payConfigKsModel     *model.PmPayConfigKsModel
+	payConfigKsModel     *model.PmPayConfigKsModel
+
+	cfgCache *supplementaryCfgCache // 本次补单的配置缓存
 }
 
 func NewSupplementaryOrdersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SupplementaryOrdersLogic {
@@ -53,36 +91,23 @@ func NewSupplementaryOrdersLogic(ctx context.Context, svcCtx *svc.ServiceContext
 		ctx:    ctx,
 		svcCtx: svcCtx,
 
-		payOrderModel:  model.NewPmPayOrderModel(define.DbPayGateway),
-		appConfigModel: model.NewPmAppConfigModel(define.DbPayGateway),
-		//payConfigAlipayModel: model.NewPmPayConfigAlipayModel(define.DbPayGateway),
-		payConfigTiktokModel: model.NewPmPayConfigTiktokModel(define.DbPayGateway),
-		payConfigWechatModel: model.NewPmPayConfigWechatModel(define.DbPayGateway),
-		//payConfigKsModel:     model.NewPmPayConfigKsModel(define.DbPayGateway),
+		payOrderModel:         model.NewPmPayOrderModel(define.DbPayGateway),
+		orderModel:            model.NewOrderModel(define.DbPayGateway),
+		payDyPeriodOrderModel: model.NewPmDyPeriodOrderModel(define.DbPayGateway),
+		appConfigModel:        model.NewPmAppConfigModel(define.DbPayGateway),
+		payConfigTiktokModel:  model.NewPmPayConfigTiktokModel(define.DbPayGateway),
+		payConfigWechatModel:  model.NewPmPayConfigWechatModel(define.DbPayGateway),
+		payConfigKsModel:      model.NewPmPayConfigKsModel(define.DbPayGateway),
+		cfgCache:              newSupplementaryCfgCache(),
 	}
 }
 
-// SupplementaryOrders 定时任务补单逻辑
+// SupplementaryOrders 定时任务补单逻辑，异步执行
 func (l *SupplementaryOrdersLogic) SupplementaryOrders(req *types.SupplementaryOrdersReq) (resp *types.SupplementaryOrdersResp, err error) {
-	//请求参数处理，获取时间区间
+	//请求参数处理
 	if req.Type != "lastDay" && req.Type != "lastTenMinute" {
 		return nil, errors.New("invalid type")
 	}
-	startTime, endTime := l.getRequestParams(req)
-
-	//获取待处理订单
-	payList, err := l.payOrderModel.GetListByCreateTimeRange(startTime, endTime)
-	if err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			l.Logger.Error("get list by create time range fail,err=%v", err)
-		}
-		return nil, errors.New("get list by create time range fail")
-	}
-
-	if len(payList) == 0 {
-		l.Logger.Info("SupplementaryOrders: no order need supplementary")
-		return nil, errors.New("no order need supplementary")
-	}
 
 	go util.SafeRun(func() {
 		//重写ctx,防止超时
@@ -93,67 +118,7 @@ func (l *SupplementaryOrdersLogic) SupplementaryOrders(req *types.SupplementaryO
 		l.ctx = ctx
 		l.Logger = logx.WithContext(ctx)
 
-		totalNeedSupplementCount := len(payList)
-		wxNeedSupplementCount := 0
-		wxActualSupplementCount := 0
-		douyinNeedSupplementCount := 0
-		douyinActualSupplementCount := 0
-		// 对需要补单的订单进行处理
-		for _, payItem := range payList {
-			//调用三方，触发回调
-			pkgCfg, err := l.appConfigModel.GetOneByPkgName(payItem.AppPkgName)
-			if err != nil {
-				l.Logger.Errorf("读取应用配置失败 pkgName= %s, err:=%v", payItem.AppPkgName, err)
-				continue
-			}
-
-			//小程序目前调过来的支付类型只有1，2，5，8 这4种，其中5为快手已弃用，2为抖音担保交易，已废弃，故实现1，，8即可
-			switch payItem.PayType {
-			case model.PmPayOrderTablePayTypeWechatPayUni: //model.PmPayOrderTablePayTypeWechatPayH5 ,暂时没用
-				wxNeedSupplementCount++
-				if err = l.handleWxOrder(payItem, pkgCfg.WechatPayAppID); err != nil {
-					if !errors.Is(err, model.NoNeedSupplementaryError) {
-						orderSupplementaryErrNum.CounterInc()
-						l.Logger.Error(err.Error())
-					}
-				} else {
-					orderSupplementarySuccessNum.CounterInc()
-					wxActualSupplementCount++
-
-				}
-			case model.PmPayOrderTablePayTypeDouyinGeneralTrade: //抖音，通用交易
-				douyinNeedSupplementCount++
-				if err = l.handleDouyinOrder(payItem, pkgCfg.TiktokPayAppID); err != nil {
-					if !errors.Is(err, model.NoNeedSupplementaryError) {
-						orderSupplementaryErrNum.CounterInc()
-						l.Logger.Error(err.Error())
-					}
-				} else {
-					orderSupplementarySuccessNum.CounterInc()
-					douyinActualSupplementCount++
-				}
-			}
-			//case model.PmPayOrderTablePayTypeTiktokPayEc: //字节,担保交易，已废弃
-			//	if err = l.handleBytedanceOrder(payItem, pkgCfg.TiktokPayAppID); err != nil {
-			//		l.Logger.Error(err.Error())
-			//	}
-		}
-
-		msg := fmt.Sprintf("订单补偿信息 :\n需要补单总数:%d;\n微信需要补单总数：%d,实际补单数目%d;\n抖音需要补单总数：%d,实际补单数目%d", totalNeedSupplementCount, wxNeedSupplementCount, wxActualSupplementCount, douyinNeedSupplementCount, douyinActualSupplementCount)
-		l.Logger.Info(msg)
-		if req.IsNotice == "1" {
-			req := &notice.RobotSendReq{
-				Msgtype: "text",
-				Text: &notice.Text{
-					Content: msg,
-				},
-			}
-			_, err = notice.SendWebhookMsg(l.ctx, req, DingdingRobot)
-			if err != nil {
-				l.Errorf("dingDing notify fail, err:%v", err)
-				return
-			}
-		}
+		_, _ = l.Run(ctx, req)
 	})
 
 	return &types.SupplementaryOrdersResp{
@@ -162,9 +127,113 @@ func (l *SupplementaryOrdersLogic) SupplementaryOrders(req *types.SupplementaryO
 	}, nil
 }
 
+// Run 同步执行补单，按id游标分批读取待补单订单，各渠道并发查单，支持从断点继续；同类补单正在执行时返回nil
+func (l *SupplementaryOrdersLogic) Run(ctx context.Context, req *types.SupplementaryOrdersReq) (*SupplementarySummary, error) {
+	if req.Type != "lastDay" && req.Type != "lastTenMinute" {
+		return nil, errors.New("invalid type")
+	}
+	startTime, endTime := l.getRequestParams(req)
+
+	summary := newSupplementaryRunner(l, req.Type, startTime, endTime).run(ctx)
+	if summary == nil {
+		return nil, nil
+	}
+
+	msg := fmt.Sprintf("订单补偿信息 :\n需要补单总数:%d;", summary.Total)
+	for _, channel := range supplementaryChannels {
+		stat := summary.Channels[channel]
+		msg += fmt.Sprintf("\n%s需要补单总数：%d,实际补单数目%d,失败数目%d;", supplementaryChannelNames[channel], stat.Need, stat.Actual, stat.Fail)
+	}
+	if summary.Aborted {
+		msg += "\n补单未完成，下次执行从断点继续"
+	}
+	l.Logger.Info(msg)
+	if req.IsNotice == "1" && summary.Total > 0 {
+		noticeReq := &notice.RobotSendReq{
+			Msgtype: "text",
+			Text: &notice.Text{
+				Content: msg,
+			},
+		}
+		if _, err := notice.SendWebhookMsg(l.ctx, noticeReq, DingdingRobot); err != nil {
+			l.Errorf("dingDing notify fail, err:%v", err)
+		}
+	}
+
+	if summary.Aborted {
+		return summary, errors.New("补单中止，下次执行从断点继续")
+	}
+	return summary, nil
+}
+
+// dispatchPayOrders 读取一批小程序订单分发到各渠道，返回本批最大id，没有订单时返回lastId
+func (l *SupplementaryOrdersLogic) dispatchPayOrders(r *supplementaryRunner, lastId int) (int, error) {
+	payList, err := l.payOrderModel.GetUnpaidBatchByCreateTimeRange(r.summary.StartTime, r.summary.EndTime, uint(lastId), r.conf.BatchSize)
+	if err != nil {
+		return lastId, err
+	}
+
+	for _, payItem := range payList {
+		lastId = int(payItem.ID)
+
+		pkgCfg, err := l.cfgCache.getPkgCfg(l.appConfigModel, payItem.AppPkgName)
+		if err != nil {
+			l.Logger.Errorf("读取应用配置失败 pkgName= %s, err:=%v", payItem.AppPkgName, err)
+			continue
+		}
+
+		//2为抖音担保交易，已废弃
+		switch payItem.PayType {
+		case model.PmPayOrderTablePayTypeWechatPayUni:
+			r.dispatch(supplementaryChannelWxUni, func() error { return l.handleWxOrder(payItem, pkgCfg.WechatPayAppID) })
+		case model.PmPayOrderTablePayWxV3H5:
+			r.dispatch(supplementaryChannelWxH5, func() error { return l.handleWxOrder(payItem, pkgCfg.WechatPayAppID) })
+		case model.PmPayOrderTablePayTypeDouyinGeneralTrade: //抖音，通用交易
+			r.dispatch(supplementaryChannelDouyin, func() error { return l.handleDouyinOrder(payItem, pkgCfg.TiktokPayAppID) })
+		case model.PmPayOrderTablePayTypeKsUniApp:
+			r.dispatch(supplementaryChannelKs, func() error { return l.handleKsOrder(payItem, pkgCfg.KsPayAppID) })
+		}
+	}
+	return lastId, nil
+}
+
+// dispatchOrders 读取一批流量订单分发到各渠道，下单时已记录支付appid
+func (l *SupplementaryOrdersLogic) dispatchOrders(r *supplementaryRunner, lastId int) (int, error) {
+	orderList, err := l.orderModel.GetUnpaidBatchByCreateTimeRange(r.summary.StartTime, r.summary.EndTime, lastId, r.conf.BatchSize)
+	if err != nil {
+		return lastId, err
+	}
+
+	for _, orderItem := range orderList {
+		lastId = orderItem.ID
+
+		switch orderItem.PayType {
+		case model.PmPayOrderTablePayTypeAlipay:
+			r.dispatch(supplementaryChannelAlipay, func() error { return l.handleAlipayOrder(orderItem) })
+		case model.PmPayOrderTablePayTypeWechatPayUni, model.PmPayOrderTablePayTypeWechatPayH5:
+			r.dispatch(supplementaryChannelWxH5, func() error { return l.handleWxH5Order(orderItem) })
+		}
+	}
+	return lastId, nil
+}
+
+// dispatchPeriodOrders 读取一批抖音周期代扣订单分发到协程池
+func (l *SupplementaryOrdersLogic) dispatchPeriodOrders(r *supplementaryRunner, lastId int) (int, error) {
+	periodList, err := l.payDyPeriodOrderModel.GetUnpaidBatchByCreateTimeRange(r.summary.StartTime, r.summary.EndTime, lastId, r.conf.BatchSize)
+	if err != nil {
+		return lastId, err
+	}
+
+	for _, periodItem := range periodList {
+		lastId = periodItem.ID
+		r.dispatch(supplementaryChannelDyPeriod, func() error { return l.handleDyPeriodOrder(periodItem) })
+	}
+	return lastId, nil
+}
+
 // handleWxOrder 微信订单处理
 func (l *SupplementaryOrdersLogic) handleWxOrder(orderInfo *model.PmPayOrderTable, appId string) error {
-	payCfg, cfgErr := l.payConfigWechatModel.GetOneByAppID(appId)
+	payCfg, cfgErr := l.cfgCache.getWechatCfg(l.payConfigWechatModel, appId)
 	if cfgErr != nil {
 		return fmt.Errorf("handleWxOrder: 读取微信支付配置失败 pkgName= %s, err:=%v", orderInfo.AppPkgName, cfgErr)
 	}
@@ -202,7 +271,7 @@ func (l *SupplementaryOrdersLogic) handleWxOrder(orderInfo *model.PmPayOrderTabl
 
 // handleDouyinOrder 抖音订单回调处理
 func (l *SupplementaryOrdersLogic) handleDouyinOrder(orderInfo *model.PmPayOrderTable, appId string) error {
-	payCfg, cfgErr := l.payConfigTiktokModel.GetOneByAppID(appId)
+	payCfg, cfgErr := l.cfgCache.getTiktokCfg(l.payConfigTiktokModel, appId)
 	if cfgErr != nil {
 		return fmt.Errorf("handleDouyinOrder: pkgName= %s, 读取抖音支付配置失败，err:=%v", orderInfo.AppPkgName, cfgErr)
 	}
@@ -256,6 +325,283 @@ func (l *SupplementaryOrdersLogic) handleDouyinOrder(orderInfo *model.PmPayOrder
 	return model.NoNeedSupplementaryError
 }
 
+// handleKsOrder 快手订单处理
+func (l *SupplementaryOrdersLogic) handleKsOrder(orderInfo *model.PmPayOrderTable, appId string) error {
+	payCfg, cfgErr := l.cfgCache.getKsCfg(l.payConfigKsModel, appId)
+	if cfgErr != nil {
+		return fmt.Errorf("handleKsOrder: 读取快手支付配置失败 pkgName= %s, err:=%v", orderInfo.AppPkgName, cfgErr)
+	}
+
+	ksAccessToken, err := l.svcCtx.BaseAppConfigServerApi.GetKsAppidToken(l.ctx, appId)
+	if err != nil {
+		return fmt.Errorf("handleKsOrder: 获取快手access token失败 appId=%s, err=%v", appId, err)
+	}
+
+	payClient := client.NewKsPay(*payCfg.TransClientConfig())
+	ksOrder, err := payClient.QueryOrder(orderInfo.OrderSn, ksAccessToken)
+	if err != nil {
+		return fmt.Errorf("handleKsOrder:查询快手订单失败, orderSn=%s, err=%v", orderInfo.OrderSn, err)
+	}
+
+	if ksOrder.PayStatus == "SUCCESS" {
+		isSupplementary, err := l.payOrderModel.QueryAfterUpdate(orderInfo.OrderSn, appId, ksOrder.KsOrderNo, ksOrder.TotalAmount)
+		if err != nil {
+			return err
+		}
+
+		if isSupplementary { //成功补单
+			//按快手支付回调的格式回调业务方接口
+			notifyData := new(notify.KsOrderNotifyData)
+			notifyData.Data.Channel = ksOrder.PayChannel
+			notifyData.Data.OutOrderNo = orderInfo.OrderSn
+			notifyData.Data.Status = ksOrder.PayStatus
+			notifyData.Data.KsOrderNo = ksOrder.KsOrderNo
+			notifyData.Data.OrderAmount = ksOrder.TotalAmount
+			notifyData.Data.ExtraInfo = ksOrder.ExtraInfo
+			notifyData.Data.EnablePromotion = ksOrder.EnablePromotion
+			notifyData.Data.PromotionAmount = ksOrder.PromotionAmount
+			notifyData.BizType = client.Payment
+			notifyData.AppId = appId
+			notifyData.Timestamp = time.Now().UnixMilli()
+			headerMap := map[string]string{
+				"App-Origin": orderInfo.AppPkgName,
+				"From-App":   orderInfo.AppPkgName,
+			}
+			_, err = util.HttpPostWithHeader(orderInfo.NotifyUrl, notifyData, headerMap, 5*time.Second)
+			if err != nil {
+				notify.CallbackBizFailNum.CounterInc()
+				return fmt.Errorf("handleKsOrder:callback notify_url failed , notifyData:%+v, err:%v", notifyData, err)
+			}
+			//正常处理
+			return nil
+		}
+	}
+
+	return model.NoNeedSupplementaryError
+}
+
+// handleWxH5Order 微信H5流量订单处理
+func (l *SupplementaryOrdersLogic) handleWxH5Order(orderInfo *model.OrderTable) error {
+	payCfg, cfgErr := l.cfgCache.getWechatCfg(l.payConfigWechatModel, orderInfo.PayAppID)
+	if cfgErr != nil {
+		return fmt.Errorf("handleWxH5Order: 读取微信支付配置失败 appId= %s, err:=%v", orderInfo.PayAppID, cfgErr)
+	}
+
+	payClient := client.NewWeChatCommPay(*payCfg.TransClientConfig())
+	transaction, err := payClient.GetOrderStatus(orderInfo.OutTradeNo)
+	if err != nil {
+		return fmt.Errorf("handleWxH5Order:查询微信订单失败, outTradeNo=%s, err=%v", orderInfo.OutTradeNo, err)
+	}
+
+	if *transaction.TradeState == "SUCCESS" {
+		isSupplementary, err := l.orderModel.QueryAfterUpdate(orderInfo.OutTradeNo, *transaction.TransactionId, orderInfo.PayType)
+		if err != nil {
+			return err
+		}
+
+		if isSupplementary { //成功补单
+			dataMap := map[string]interface{}{
+				"notify_type":  code.APP_NOTIFY_TYPE_PAY,
+				"out_trade_no": orderInfo.OutTradeNo,
+			}
+			return l.callbackOrder(orderInfo, dataMap)
+		}
+	}
+
+	return model.NoNeedSupplementaryError
+}
+
+// handleAlipayOrder 支付宝流量订单处理
+func (l *SupplementaryOrdersLogic) handleAlipayOrder(orderInfo *model.OrderTable) error {
+	payClient, _, _, err := clientMgr.GetAlipayClientByAppIdWithCache(orderInfo.PayAppID)
+	if err != nil {
+		return fmt.Errorf("handleAlipayOrder: 获取支付宝客户端失败 appId=%s, err=%v", orderInfo.PayAppID, err)
+	}
+
+	res, err := payClient.TradeQuery(alipay.TradeQuery{
+		OutTradeNo: orderInfo.OutTradeNo,
+	})
+	if err != nil {
+		return fmt.Errorf("handleAlipayOrder:查询支付宝订单失败, outTradeNo=%s, err=%v", orderInfo.OutTradeNo, err)
+	}
+
+	tradeStatus := string(res.Content.TradeStatus)
+	if res.IsSuccess() && (tradeStatus == "TRADE_SUCCESS" || tradeStatus == "TRADE_FINISHED") {
+		isSupplementary, err := l.orderModel.QueryAfterUpdate(orderInfo.OutTradeNo, res.Content.TradeNo, model.PmPayOrderTablePayTypeAlipay)
+		if err != nil {
+			return err
+		}
+
+		if isSupplementary { //成功补单
+			//字段和支付宝交易状态同步回调保持一致
+			dataMap := map[string]interface{}{
+				"notify_type":  code.APP_NOTIFY_TYPE_PAY,
+				"app_id":       orderInfo.PayAppID,
+				"out_trade_no": orderInfo.OutTradeNo,
+				"trade_no":     res.Content.TradeNo,
+				"trade_status": tradeStatus,
+				"total_amount": res.Content.TotalAmount,
+			}
+			return l.callbackOrder(orderInfo, dataMap)
+		}
+	}
+
+	return model.NoNeedSupplementaryError
+}
+
+// callbackOrder 流量订单补单成功后回调业务方
+func (l *SupplementaryOrdersLogic) callbackOrder(orderInfo *model.OrderTable, dataMap map[string]interface{}) error {
+	if orderInfo.AppNotifyUrl == "" {
+		l.Logger.Errorf("order id:%d, out_trade_no:%s, app notify url is empty", orderInfo.ID, orderInfo.OutTradeNo)
+		return nil
+	}
+
+	headerMap := map[string]string{
+		"App-Origin": orderInfo.AppPkg,
+	}
+	err := utils.CallbackWithRetry(orderInfo.AppNotifyUrl, headerMap, dataMap, 5*time.Second)
+	if err != nil {
+		notify.CallbackBizFailNum.CounterInc()
+		return fmt.Errorf("callbackOrder:callback notify_url failed , out_trade_no:%s, err:%v", orderInfo.OutTradeNo, err)
+	}
+	return nil
+}
+
+// handleDyPeriodOrder 抖音周期代扣订单处理，先补签约状态，再补代扣结果
+func (l *SupplementaryOrdersLogic) handleDyPeriodOrder(orderInfo *model.PmDyPeriodOrderTable) error {
+	payCfg, cfgErr := l.cfgCache.getTiktokCfg(l.payConfigTiktokModel, orderInfo.PayAppId)
+	if cfgErr != nil {
+		return fmt.Errorf("handleDyPeriodOrder: pkgName= %s, 读取抖音支付配置失败，err:=%v", orderInfo.AppPkgName, cfgErr)
+	}
+
+	douyinPayConfig := payCfg.GetGeneralTradeConfig()
+	payClient := douyin.NewDouyinPay(douyinPayConfig)
+
+	clientToken, err := l.svcCtx.BaseAppConfigServerApi.GetDyClientToken(l.ctx, douyinPayConfig.AppId)
+	if err != nil {
+		l.Errorw("get douyin client token fail", logx.Field("err", err), logx.Field("appId", douyinPayConfig.AppId))
+		return err
+	}
+
+	// 网关发起的代扣单没有签约流程
+	if orderInfo.SignStatus == model.Sign_Status_Wait && orderInfo.SignOrderId == 0 {
+		if err = l.supplementDySign(orderInfo, payClient, clientToken); err != nil {
+			return err
+		}
+	}
+
+	signPayOrder, err := payClient.QuerySignPayOrder(clientToken, orderInfo.OrderSn)
+	if err != nil {
+		return fmt.Errorf("handleDyPeriodOrder:查询抖音代扣单失败, orderSn=%s, err=%v", orderInfo.OrderSn, err)
+	}
+	signPayData := signPayOrder.SignPayData
+	if signPayOrder.ErrNo != 0 || signPayData.Status != douyin.Dy_Sign_Pay_Status_SUCCESS {
+		return model.NoNeedSupplementaryError
+	}
+
+	eventTime := time.Now()
+	if signPayData.PayTime > 0 {
+		eventTime = time.UnixMilli(signPayData.PayTime)
+	}
+	updateData := map[string]interface{}{
+		"pay_status":          model.PmPayOrderTablePayStatusPaid,
+		"pay_channel":         signPayData.PayChannel,
+		"third_order_sn":      signPayData.ChannelPayId,
+		"third_order_no":      signPayData.PayOrderId,
+		"third_sign_order_no": signPayData.AuthOrderId,
+		"next_decuction_time": orderInfo.FirstDeductionTime(eventTime).Format("2006-01-02 15:04:05"),
+		"user_bill_pay_id":    signPayData.UserBillPayId,
+		"notify_amount":       signPayData.TotalAmount,
+	}
+	isSupplementary, err := l.payDyPeriodOrderModel.QueryAfterUpdate(orderInfo.ID, updateData)
+	if err != nil {
+		return err
+	}
+	if !isSupplementary {
+		return model.NoNeedSupplementaryError
+	}
+	if orderInfo.SignOrderId > 0 {
+		// 网关发起的代扣单，推进签约单的期数和下次扣款时间
+		if err = cron.DyPeriodDeductSuccess(orderInfo); err != nil {
+			l.Errorf("handleDyPeriodOrder:DyPeriodDeductSuccess failed, orderSn=%s, err=%v", orderInfo.OrderSn, err)
+		}
+	}
+
+	//按抖音代扣结果回调的格式回调业务方接口
+	msg, _ := sonic.MarshalString(douyin.DySignPayCallbackNotify{
+		AppId:         signPayData.AppId,
+		Status:        signPayData.Status,
+		AuthOrderId:   signPayData.AuthOrderId,
+		PayOrderId:    signPayData.PayOrderId,
+		OutPayOrderNo: signPayData.OutPayOrderNo,
+		TotalAmount:   signPayData.TotalAmount,
+		PayChannel:    signPayData.PayChannel,
+		ChannelPayId:  signPayData.ChannelPayId,
+		MerchantUid:   signPayData.MerchantUid,
+		UserBillPayId: signPayData.UserBillPayId,
+		EventTime:     eventTime.UnixMilli(),
+	})
+	req := &douyin.GeneralTradeCallbackData{
+		Msg:  cron.DyPeriodPayCallbackMsg(orderInfo, msg),
+		Type: douyin.EventSignPayCallback,
+	}
+	headMap := map[string]string{
+		"App-Origin": orderInfo.AppPkgName,
+	}
+	_, err = util.HttpPostWithHeader(orderInfo.NotifyUrl, req, headMap, 5*time.Second)
+	if err != nil {
+		notify.CallbackBizFailNum.CounterInc()
+		return fmt.Errorf("handleDyPeriodOrder:callback notify_url failed , req:%+v, err:%v", req, err)
+	}
+	return nil
+}
+
+// supplementDySign 补抖音签约结果，签约成功时更新签约状态并回调业务方
+func (l *SupplementaryOrdersLogic) supplementDySign(orderInfo *model.PmDyPeriodOrderTable, payClient *douyin.PayClient, clientToken string) error {
+	signOrder, err := payClient.QuerySignOrder(clientToken, orderInfo.SignNo)
+	if err != nil {
+		return fmt.Errorf("supplementDySign:查询抖音签约单失败, signNo=%s, err=%v", orderInfo.SignNo, err)
+	}
+	signData := signOrder.UserSignData
+	if signOrder.ErrNo != 0 || signData.Status != douyin.Dy_Sign_Status_Query_SERVING {
+		return nil
+	}
+
+	signTime := time.Now()
+	if signData.SignTime > 0 {
+		signTime = time.UnixMilli(signData.SignTime)
+	}
+	updateData := map[string]interface{}{
+		"sign_status":         model.Sign_Status_Success,
+		"sign_date":           signTime.Format("2006-01-02 15:04:05"),
+		"third_sign_order_no": signData.AuthOrderId,
+	}
+	if err = l.payDyPeriodOrderModel.UpdateSomeData(orderInfo.ID, updateData); err != nil {
+		return err
+	}
+
+	//按抖音签约回调的格式回调业务方接口
+	msgByte, _ := json.Marshal(map[string]string{
+		"app_id":  signData.AppId,
+		"app_pkg": orderInfo.AppPkgName,
+		"status":  douyin.Dy_Sign_Status_SUCCESS,
+		"userId":  strconv.Itoa(orderInfo.UserId),
+	})
+	postData := map[string]interface{}{
+		"type": douyin.EventSignCallback,
+		"msg":  string(msgByte),
+	}
+	headMap := map[string]string{
+		"App-Origin": orderInfo.AppPkgName,
+	}
+	_, err = util.HttpPostWithHeader(orderInfo.NotifyUrl, postData, headMap, 5*time.Second)
+	if err != nil {
+		notify.CallbackBizFailNum.CounterInc()
+		l.Logger.Errorf("supplementDySign:callback notify_url failed , req:%+v, err:%v", postData, err)
+	}
+	return nil
+}
+
 // getRequestParams 参数处理
 func (l *SupplementaryOrdersLogic) getRequestParams(req *types.SupplementaryOrdersReq) (startTime, endTime time.Time) {
 	now := time.Now()
@@ -0,0 +1,327 @@
+package crontab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/config"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+)
+
+const (
+	redisSupplementaryCheckpointKey = "payGateway:supplementary:checkpoint:%s:%s" // %s:订单表 %s:补单时间窗口，记录已补完的最大订单id
+	redisSupplementaryRunningKey    = "payGateway:supplementary:running:%s"       // %s:补单类型，防止同类补单同时执行
+
+	supplementaryCheckpointTtl = 86400       // 断点保留1天
+	supplementaryRunningTtl    = 3 * 3600000 // 执行锁最长3小时，毫秒
+)
+
+// 补单执行汇总
+type SupplementarySummary struct {
+	Type      string                        `json:"type"`       // 补单类型 lastDay|lastTenMinute
+	StartTime time.Time                     `json:"start_time"` // 补单时间窗口开始
+	EndTime   time.Time                     `json:"end_time"`   // 补单时间窗口结束
+	Total     int64                         `json:"total"`      // 检查订单数
+	Success   int64                         `json:"success"`    // 实际补单数
+	Fail      int64                         `json:"fail"`       // 查单或回调失败数
+	Aborted   bool                          `json:"aborted"`    // 是否因超时或读取订单失败中止
+	Channels  map[string]*SupplementaryStat `json:"channels"`   // 各渠道统计
+	StartAt   time.Time                     `json:"start_at"`   // 开始时间
+	EndAt     time.Time                     `json:"end_at"`     // 结束时间
+}
+
+// 单个渠道的补单统计
+type SupplementaryStat struct {
+	Need   int64 `json:"need"`   // 需要补单数
+	Actual int64 `json:"actual"` // 实际补单数
+	Fail   int64 `json:"fail"`   // 失败数
+}
+
+// 单个渠道的查单协程池，每个渠道单独限制并发数和qps
+type supplementaryPool struct {
+	tasks   chan func() error
+	limiter *time.Ticker
+	stat    *SupplementaryStat
+}
+
+// 补单执行器：按id游标分批读取待补单订单，分发到各渠道的协程池，每批完成后记录断点
+type supplementaryRunner struct {
+	l        *SupplementaryOrdersLogic
+	conf     config.Supplementary
+	rdb      *cache.RedisInstance
+	window   string // 补单时间窗口，用于区分断点
+	pools    map[string]*supplementaryPool
+	batchWg  sync.WaitGroup // 当前批次未完成的订单
+	workerWg sync.WaitGroup
+	summary  *SupplementarySummary
+}
+
+func newSupplementaryRunner(l *SupplementaryOrdersLogic, reqType string, startTime, endTime time.Time) *supplementaryRunner {
+	r := &supplementaryRunner{
+		l:      l,
+		conf:   l.svcCtx.Config.Supplementary,
+		rdb:    db.WithRedisDBContext(define.DbPayGateway),
+		window: startTime.Format("200601021504") + "-" + endTime.Format("200601021504"),
+		pools:  make(map[string]*supplementaryPool, len(supplementaryChannels)),
+		summary: &SupplementarySummary{
+			Type:      reqType,
+			StartTime: startTime,
+			EndTime:   endTime,
+			Channels:  make(map[string]*SupplementaryStat, len(supplementaryChannels)),
+		},
+	}
+	if r.conf.BatchSize <= 0 {
+		r.conf.BatchSize = 500
+	}
+	return r
+}
+
+// 渠道的并发数和qps，单独配置的优先
+func (r *supplementaryRunner) channelLimit(channel string) (concurrency, qps int) {
+	concurrency, qps = r.conf.Concurrency, r.conf.Qps
+	for _, v := range r.conf.Channels {
+		if v.Channel != channel {
+			continue
+		}
+		if v.Concurrency > 0 {
+			concurrency = v.Concurrency
+		}
+		if v.Qps > 0 {
+			qps = v.Qps
+		}
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	if qps <= 0 {
+		qps = 20
+	}
+	return
+}
+
+// 启动各渠道的协程池
+func (r *supplementaryRunner) start() {
+	for _, channel := range supplementaryChannels {
+		concurrency, qps := r.channelLimit(channel)
+		pool := &supplementaryPool{
+			tasks:   make(chan func() error, concurrency*2),
+			limiter: time.NewTicker(time.Second / time.Duration(qps)),
+			stat:    new(SupplementaryStat),
+		}
+		r.pools[channel] = pool
+		r.summary.Channels[channel] = pool.stat
+
+		for i := 0; i < concurrency; i++ {
+			r.workerWg.Add(1)
+			go func() {
+				defer r.workerWg.Done()
+				for task := range pool.tasks {
+					<-pool.limiter.C
+					r.execute(pool.stat, task)
+				}
+			}()
+		}
+	}
+}
+
+// 关闭协程池，等待全部订单处理完
+func (r *supplementaryRunner) stop() {
+	for _, pool := range r.pools {
+		close(pool.tasks)
+	}
+	r.workerWg.Wait()
+	for _, pool := range r.pools {
+		pool.limiter.Stop()
+	}
+}
+
+// 把一个订单的补单任务分发到渠道的协程池
+func (r *supplementaryRunner) dispatch(channel string, task func() error) {
+	r.batchWg.Add(1)
+	r.pools[channel].tasks <- task
+}
+
+func (r *supplementaryRunner) execute(stat *SupplementaryStat, task func() error) {
+	defer r.batchWg.Done()
+	defer exception.Recover()
+
+	atomic.AddInt64(&stat.Need, 1)
+	atomic.AddInt64(&r.summary.Total, 1)
+	err := task()
+	if err == nil {
+		orderSupplementarySuccessNum.CounterInc()
+		atomic.AddInt64(&stat.Actual, 1)
+		atomic.AddInt64(&r.summary.Success, 1)
+		return
+	}
+	if !errors.Is(err, model.NoNeedSupplementaryError) {
+		orderSupplementaryErrNum.CounterInc()
+		atomic.AddInt64(&stat.Fail, 1)
+		atomic.AddInt64(&r.summary.Fail, 1)
+		r.l.Logger.Error(err.Error())
+	}
+}
+
+func (r *supplementaryRunner) run(ctx context.Context) *SupplementarySummary {
+	runningKey, value := fmt.Sprintf(redisSupplementaryRunningKey, r.summary.Type), uuid.New().String()
+	isLock, err := r.rdb.TryLockWithTimeout(context.Background(), runningKey, value, supplementaryRunningTtl)
+	if err != nil || !isLock {
+		logx.Errorf("补单任务正在执行中 type: %s, err: %v", r.summary.Type, err)
+		return nil
+	}
+	defer r.rdb.Unlock(context.Background(), runningKey, value)
+
+	r.summary.StartAt = time.Now()
+	r.start()
+
+	// 依次扫描小程序订单pm_pay_order、流量订单order、抖音周期代扣订单pm_dy_period_order
+	if !r.scan(ctx, (&model.PmPayOrderTable{}).TableName(), r.l.dispatchPayOrders) ||
+		!r.scan(ctx, (&model.OrderTable{}).TableName(), r.l.dispatchOrders) ||
+		!r.scan(ctx, model.PmDyPeriodOrderTableName, r.l.dispatchPeriodOrders) {
+		r.summary.Aborted = true
+	}
+
+	r.stop()
+	r.summary.EndAt = time.Now()
+	logx.Errorf("补单执行完成 type: %s, window: %s, total: %d, success: %d, fail: %d, aborted: %v, cost: %s",
+		r.summary.Type, r.window, r.summary.Total, r.summary.Success, r.summary.Fail, r.summary.Aborted, r.summary.EndAt.Sub(r.summary.StartAt))
+	return r.summary
+}
+
+// 按id游标扫描一张订单表，从断点继续，每批订单全部处理完后记录断点；中止时返回false
+func (r *supplementaryRunner) scan(ctx context.Context, table string, dispatchBatch func(r *supplementaryRunner, lastId int) (int, error)) bool {
+	checkpointKey := fmt.Sprintf(redisSupplementaryCheckpointKey, table, r.window)
+	lastId := 0
+	if checkpoint, err := r.rdb.GetString(context.Background(), checkpointKey); err == nil {
+		if id, _ := strconv.Atoi(checkpoint); id > 0 {
+			logx.Errorf("补单从断点继续 table: %s, window: %s, checkpoint: %d", table, r.window, id)
+			lastId = id
+		}
+	}
+
+	for {
+		if ctx.Err() != nil {
+			logx.Errorf("补单中止，执行超时 table: %s, lastId: %d", table, lastId)
+			return false
+		}
+
+		nextId, err := dispatchBatch(r, lastId)
+		if err != nil {
+			logx.Errorf("补单中止，读取订单失败 table: %s, lastId: %d, err: %v", table, lastId, err)
+			r.batchWg.Wait()
+			return false
+		}
+		if nextId == lastId {
+			break
+		}
+
+		r.batchWg.Wait()
+		lastId = nextId
+		r.rdb.Set(context.Background(), checkpointKey, strconv.Itoa(lastId), supplementaryCheckpointTtl)
+	}
+
+	// 扫描完成后清除断点，同一窗口再次执行时重新扫描
+	r.rdb.Set(context.Background(), checkpointKey, "0", supplementaryCheckpointTtl)
+	return true
+}
+
+// 补单配置缓存，只在一次补单内有效，避免每个订单都查一次库
+type supplementaryCfgCache struct {
+	mu     sync.Mutex
+	pkg    map[string]*model.PmAppConfigTable
+	wechat map[string]*model.PmPayConfigWechatTable
+	tiktok map[string]*model.PmPayConfigTiktokTable
+	ks     map[string]*model.PmPayConfigKsTable
+}
+
+func newSupplementaryCfgCache() *supplementaryCfgCache {
+	return &supplementaryCfgCache{
+		pkg:    make(map[string]*model.PmAppConfigTable),
+		wechat: make(map[string]*model.PmPayConfigWechatTable),
+		tiktok: make(map[string]*model.PmPayConfigTiktokTable),
+		ks:     make(map[string]*model.PmPayConfigKsTable),
+	}
+}
+
+func (c *supplementaryCfgCache) getPkgCfg(m *model.PmAppConfigModel, pkgName string) (*model.PmAppConfigTable, error) {
+	c.mu.Lock()
+	cfg, ok := c.pkg[pkgName]
+	c.mu.Unlock()
+	if ok {
+		return cfg, nil
+	}
+
+	cfg, err := m.GetOneByPkgName(pkgName)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.pkg[pkgName] = cfg
+	c.mu.Unlock()
+	return cfg, nil
+}
+
+func (c *supplementaryCfgCache) getWechatCfg(m *model.PmPayConfigWechatModel, appId string) (*model.PmPayConfigWechatTable, error) {
+	c.mu.Lock()
+	cfg, ok := c.wechat[appId]
+	c.mu.Unlock()
+	if ok {
+		return cfg, nil
+	}
+
+	cfg, err := m.GetOneByAppID(appId)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.wechat[appId] = cfg
+	c.mu.Unlock()
+	return cfg, nil
+}
+
+func (c *supplementaryCfgCache) getTiktokCfg(m *model.PmPayConfigTiktokModel, appId string) (*model.PmPayConfigTiktokTable, error) {
+	c.mu.Lock()
+	cfg, ok := c.tiktok[appId]
+	c.mu.Unlock()
+	if ok {
+		return cfg, nil
+	}
+
+	cfg, err := m.GetOneByAppID(appId)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.tiktok[appId] = cfg
+	c.mu.Unlock()
+	return cfg, nil
+}
+
+func (c *supplementaryCfgCache) getKsCfg(m *model.PmPayConfigKsModel, appId string) (*model.PmPayConfigKsTable, error) {
+	c.mu.Lock()
+	cfg, ok := c.ks[appId]
+	c.mu.Unlock()
+	if ok {
+		return cfg, nil
+	}
+
+	cfg, err := m.GetOneByAppID(appId)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.ks[appId] = cfg
+	c.mu.Unlock()
+	return cfg, nil
+}
@@ -0,0 +1,97 @@
+package crontab
+
+import (
+	"context"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 微信投诉列表每页最多50条
+const wechatComplainPageSize = 50
+
+type WechatComplainSyncLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	payConfigWechatModel *model.PmPayConfigWechatModel
+}
+
+func NewWechatComplainSyncLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WechatComplainSyncLogic {
+	return &WechatComplainSyncLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		payConfigWechatModel: model.NewPmPayConfigWechatModel(define.DbPayGateway),
+	}
+}
+
+// WechatComplainSync 同步所有微信商户最近几天的消费者投诉，同一商户号只拉取一次
+func (l *WechatComplainSyncLogic) WechatComplainSync(req *types.WechatComplainSyncReq) (resp *types.WechatComplainSyncResp, err error) {
+	if req.Days < 1 || req.Days > 30 {
+		req.Days = 7
+	}
+
+	cfgList, err := l.payConfigWechatModel.GetAllList()
+	if err != nil {
+		return nil, err
+	}
+
+	endDate := time.Now()
+	beginDate := endDate.AddDate(0, 0, -req.Days+1)
+	syncedMch := make(map[string]bool)
+	for _, cfg := range cfgList {
+		if cfg.MchID == "" || syncedMch[cfg.MchID] {
+			continue
+		}
+		syncedMch[cfg.MchID] = true
+
+		syncNum, syncErr := l.syncMerchant(cfg, beginDate.Format("2006-01-02"), endDate.Format("2006-01-02"))
+		if syncErr != nil {
+			l.Errorf("同步微信投诉失败 appId: %s, mchId: %s, err: %v", cfg.AppID, cfg.MchID, syncErr)
+			continue
+		}
+		if syncNum > 0 {
+			l.Sloww("WechatComplainSync merchant", logx.Field("appId", cfg.AppID), logx.Field("mchId", cfg.MchID), logx.Field("syncNum", syncNum))
+		}
+	}
+
+	resp = &types.WechatComplainSyncResp{
+		ErrNo:   0,
+		ErrTips: "success",
+	}
+	return
+}
+
+// 分页拉取单个商户的投诉单
+func (l *WechatComplainSyncLogic) syncMerchant(cfg *model.PmPayConfigWechatTable, beginDate, endDate string) (syncNum int, err error) {
+	payClient := client.NewWeChatCommPay(*cfg.TransClientConfig())
+	offset := 0
+	for {
+		rsp, err := payClient.ComplaintList(beginDate, endDate, offset, wechatComplainPageSize)
+		if err != nil {
+			return syncNum, err
+		}
+
+		for _, complaint := range rsp.Data {
+			if err = clientMgr.SaveWechatComplaint(cfg.AppID, complaint); err != nil {
+				continue
+			}
+			syncNum++
+		}
+
+		offset += len(rsp.Data)
+		if len(rsp.Data) < wechatComplainPageSize || offset >= rsp.TotalCount {
+			return syncNum, nil
+		}
+	}
+}
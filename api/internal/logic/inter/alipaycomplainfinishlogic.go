@@ -0,0 +1,228 @@
+package inter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	alipay2 "gitlab.muchcloud.com/consumer-project/alipay"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/utils"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/alarm"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AlipayComplainFinishLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	alipayComplainModel *model.PmAlipayComplainModel
+	orderModel          *model.OrderModel
+	refundModel         *model.RefundModel
+	Rdb                 *cache.RedisInstance
+}
+
+const (
+	redisComplainRefundLockKey = "payGateway:alipayComplainRefund:%s" // %s:支付宝投诉单号
+	complainRefundLockMs       = 30000                                // 退款锁30秒，覆盖退款接口的超时时间
+)
+
+func NewAlipayComplainFinishLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AlipayComplainFinishLogic {
+	return &AlipayComplainFinishLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		alipayComplainModel: model.NewPmAlipayComplainModel(define.DbPayGateway),
+		orderModel:          model.NewOrderModel(define.DbPayGateway),
+		refundModel:         model.NewRefundModel(define.DbPayGateway),
+		Rdb:                 db.WithRedisDBContext(define.DbPayGateway),
+	}
+}
+
+// 提交支付宝交易投诉处理结果，需要退款时先退款，退款成功后才提交处理结果
+func (l *AlipayComplainFinishLogic) AlipayComplainFinish(req *types.AlipayComplainFinishReq) (resp *types.ResultResp, err error) {
+	if req.Operator == "" || req.FeedbackCode == "" || req.FeedbackContent == "" {
+		res := response.MakeResult(code.CODE_ERROR, "处理结果码、处理描述和操作人必填", nil)
+		return &res, nil
+	}
+
+	complain, err := l.alipayComplainModel.GetOneByComplainEventId(req.ComplainEventId)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "投诉单不存在", nil)
+		return &res, nil
+	}
+	if complain.Status != client.AlipayComplainStatusMerchantProcessing {
+		res := response.MakeResult(code.CODE_ERROR, "投诉单不是待商家处理状态", nil)
+		return &res, nil
+	}
+
+	payClient, _, _, err := clientMgr.GetAlipayClientByAppIdWithCache(complain.PayAppId)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, err.Error(), nil)
+		return &res, nil
+	}
+
+	if req.Refund == 1 && complain.RefundStatus != model.AlipayComplainRefundStatusSuccess {
+		if err = l.refund(payClient, complain, req); err != nil {
+			l.Errorf("投诉退款失败 complainEventId: %s, err: %v", complain.ComplainEventId, err)
+			res := response.MakeResult(code.CODE_ERROR, "退款失败："+err.Error(), nil)
+			return &res, nil
+		}
+	}
+
+	err = client.AlipayComplainFeedbackSubmit(payClient, client.AlipayComplainFeedback{
+		ComplainEventId: complain.ComplainEventId,
+		FeedbackCode:    req.FeedbackCode,
+		FeedbackContent: req.FeedbackContent,
+		FeedbackImages:  req.FeedbackImages,
+		Operator:        req.Operator,
+	})
+	if err != nil {
+		l.Errorf("提交支付宝投诉处理结果失败 complainEventId: %s, err: %v", complain.ComplainEventId, err)
+		res := response.MakeResult(code.CODE_ERROR, "提交处理结果失败："+err.Error(), nil)
+		return &res, nil
+	}
+
+	l.alipayComplainModel.UpdateSomeData(complain.ID, map[string]interface{}{
+		"status":           client.AlipayComplainStatusMerchantFeedbacked,
+		"feedback_code":    req.FeedbackCode,
+		"feedback_content": req.FeedbackContent,
+		"operator":         req.Operator,
+	})
+
+	res := response.MakeResult(code.CODE_OK, "操作成功", nil)
+	return &res, nil
+}
+
+// 投诉订单退款，退款金额默认为投诉金额，退款单号先落库，重试时使用同一退款单号不会重复退款
+func (l *AlipayComplainFinishLogic) refund(payClient *alipay2.Client, complain *model.PmAlipayComplainTable, req *types.AlipayComplainFinishReq) error {
+	if complain.OutTradeNo == "" {
+		return errors.New("投诉单未关联订单")
+	}
+	orderInfo, err := l.orderModel.GetOneByOutTradeNo(complain.OutTradeNo)
+	if err != nil {
+		return errors.New("订单不存在")
+	}
+
+	refundAmount := req.RefundAmount
+	if refundAmount <= 0 {
+		refundAmount = complain.ComplainAmount
+	}
+	if refundAmount <= 0 || refundAmount > orderInfo.Amount {
+		refundAmount = orderInfo.Amount
+	}
+
+	// 同一投诉单的退款串行执行，避免并发时生成不同的退款单号重复退款
+	lockKey, value := fmt.Sprintf(redisComplainRefundLockKey, complain.ComplainEventId), uuid.New().String()
+	isLock, err := l.Rdb.TryLockWithTimeout(context.Background(), lockKey, value, complainRefundLockMs)
+	if err != nil || !isLock {
+		return errors.New("投诉单正在退款中，请稍后重试")
+	}
+	defer func() {
+		unlockErr := l.Rdb.Unlock(context.Background(), lockKey, value)
+		if unlockErr != nil {
+			l.Slowf("redis unlock fail, key:%s, value:%s", lockKey, value)
+		}
+	}()
+
+	// 加锁后重新读取，以库中已落的退款单号和金额为准
+	complain, err = l.alipayComplainModel.GetOneByComplainEventId(complain.ComplainEventId)
+	if err != nil {
+		return errors.New("投诉单不存在")
+	}
+	if complain.RefundStatus == model.AlipayComplainRefundStatusSuccess {
+		return nil
+	}
+	if complain.OutTradeRefundNo == "" {
+		refundNo := utils.GenerateOrderCode(l.svcCtx.Config.SnowFlake.MachineNo, l.svcCtx.Config.SnowFlake.WorkerNo)
+		isSet, err := l.alipayComplainModel.SetRefundNo(complain.ID, refundNo, refundAmount)
+		if err != nil {
+			return err
+		}
+		if !isSet {
+			complain, err = l.alipayComplainModel.GetOneByComplainEventId(complain.ComplainEventId)
+			if err != nil || complain.OutTradeRefundNo == "" {
+				return errors.New("读取退款单号失败")
+			}
+		} else {
+			complain.OutTradeRefundNo, complain.RefundAmount = refundNo, refundAmount
+		}
+	}
+	// 重试时退款单号和金额都沿用第一次的，支付宝按退款单号幂等
+	refundAmount = complain.RefundAmount
+
+	refundReason := "交易投诉退款：" + complain.ComplainReason
+	result, err := payClient.TradeRefund(alipay2.TradeRefund{
+		OutTradeNo:   complain.OutTradeNo,
+		RefundAmount: strconv.FormatFloat(float64(refundAmount)/100, 'f', 2, 64),
+		RefundReason: refundReason,
+		OutRequestNo: complain.OutTradeRefundNo,
+	})
+	if err == nil && result.Content.Code != alipay2.CodeSuccess {
+		err = fmt.Errorf("%s %s", result.Content.SubCode, result.Content.SubMsg)
+	}
+	if err != nil {
+		l.alipayComplainModel.UpdateSomeData(complain.ID, map[string]interface{}{"refund_status": model.AlipayComplainRefundStatusFail})
+		return err
+	}
+
+	l.alipayComplainModel.UpdateSomeData(complain.ID, map[string]interface{}{"refund_status": model.AlipayComplainRefundStatusSuccess})
+	refundInfo := &model.RefundTable{
+		PayType:          orderInfo.PayType,
+		OutTradeNo:       orderInfo.OutTradeNo,
+		OutTradeRefundNo: complain.OutTradeRefundNo,
+		Reason:           refundReason,
+		RefundAmount:     refundAmount,
+		RefundStatus:     model.REFUND_STATUS_SUCCESS,
+		RefundNo:         complain.TradeNo, // 支付宝退款没有退款单号
+		Operator:         req.Operator,
+		AppPkg:           orderInfo.AppPkg,
+		ReviewerComment:  "交易投诉退款",
+		RefundedAt:       time.Now(),
+	}
+	if err = l.refundModel.Create(refundInfo); err != nil {
+		l.Errorf("投诉退款成功但创建退款记录失败 outTradeNo: %s, err: %v", orderInfo.OutTradeNo, err)
+	}
+	// 部分退款时订单状态不变
+	if refundAmount == orderInfo.Amount {
+		l.orderModel.UpdateStatusByOutTradeNo(orderInfo.OutTradeNo, code.ORDER_REFUNDED)
+	}
+
+	// 回调通知业务方退款成功
+	go func() {
+		defer exception.Recover()
+		dataMap := make(map[string]interface{})
+		dataMap["notify_type"] = code.APP_NOTIFY_TYPE_REFUND
+		dataMap["out_trade_refund_no"] = refundInfo.OutTradeRefundNo
+		dataMap["out_trade_no"] = orderInfo.OutTradeNo
+		dataMap["refund_out_side_app"] = false
+		dataMap["refund_status"] = model.REFUND_STATUS_SUCCESS
+		headerMap := map[string]string{
+			"App-Origin": orderInfo.AppPkg,
+		}
+
+		notifyErr := utils.CallbackWithRetry(orderInfo.AppNotifyUrl, headerMap, dataMap, 5*time.Second)
+		if notifyErr != nil {
+			desc := fmt.Sprintf("回调通知用户投诉退款成功 异常, app_pkg=%s, out_trade_no=%s", orderInfo.AppPkg, orderInfo.OutTradeNo)
+			alarm.ImmediateAlarm("notifyUserRefundErr", desc, alarm.ALARM_LEVEL_FATAL)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,70 @@
+package inter
+
+import (
+	"context"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AlipayComplainListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	alipayComplainModel *model.PmAlipayComplainModel
+}
+
+func NewAlipayComplainListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AlipayComplainListLogic {
+	return &AlipayComplainListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		alipayComplainModel: model.NewPmAlipayComplainModel(define.DbPayGateway),
+	}
+}
+
+// 本地同步的支付宝交易投诉列表，按投诉时间倒序
+func (l *AlipayComplainListLogic) AlipayComplainList(req *types.AlipayComplainListReq) (resp *types.ResultResp, err error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+
+	filter := &model.AlipayComplainFilter{
+		PayAppId:   req.PayAppId,
+		AppPkg:     req.PkgName,
+		Status:     req.Status,
+		OutTradeNo: req.OutTradeNo,
+	}
+	if req.StartTime != "" {
+		filter.StartTime, _ = time.ParseInLocation("2006-01-02 15:04:05", req.StartTime, time.Local)
+	}
+	if req.EndTime != "" {
+		filter.EndTime, _ = time.ParseInLocation("2006-01-02 15:04:05", req.EndTime, time.Local)
+	}
+
+	list, total, err := l.alipayComplainModel.GetList(filter, req.Page, req.PageSize)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "查询投诉列表失败", nil)
+		return &res, nil
+	}
+
+	data := map[string]interface{}{
+		"total": total,
+		"list":  list,
+	}
+	res := response.MakeResult(code.CODE_OK, "操作成功", data)
+	return &res, nil
+}
@@ -0,0 +1,98 @@
+package inter
+
+import (
+	"context"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AlipayComplainRateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	payConfigAlipayModel *model.PmPayConfigAlipayModel
+	alipayComplainModel  *model.PmAlipayComplainModel
+	orderModel           *model.OrderModel
+}
+
+func NewAlipayComplainRateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AlipayComplainRateLogic {
+	return &AlipayComplainRateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		payConfigAlipayModel: model.NewPmPayConfigAlipayModel(define.DbPayGateway),
+		alipayComplainModel:  model.NewPmAlipayComplainModel(define.DbPayGateway),
+		orderModel:           model.NewOrderModel(define.DbPayGateway),
+	}
+}
+
+// 按商户统计时间段内的投诉率：投诉数 / 支付成功订单数
+func (l *AlipayComplainRateLogic) AlipayComplainRate(req *types.AlipayComplainRateReq) (resp *types.ResultResp, err error) {
+	endTime := time.Now()
+	if req.EndTime != "" {
+		endTime, _ = time.ParseInLocation("2006-01-02 15:04:05", req.EndTime, time.Local)
+	}
+	startTime := endTime.AddDate(0, 0, -30)
+	if req.StartTime != "" {
+		startTime, _ = time.ParseInLocation("2006-01-02 15:04:05", req.StartTime, time.Local)
+	}
+
+	cfgList, err := l.payConfigAlipayModel.GetAllList()
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "查询支付宝配置失败", nil)
+		return &res, nil
+	}
+	complainList, err := l.alipayComplainModel.CountByPayAppId(startTime, endTime)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "统计投诉数失败", nil)
+		return &res, nil
+	}
+	orderList, err := l.orderModel.CountPaidByPayAppID(startTime, endTime)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "统计订单数失败", nil)
+		return &res, nil
+	}
+
+	complainNumMap := make(map[string]int64, len(complainList))
+	for _, v := range complainList {
+		complainNumMap[v.PayAppId] = v.ComplainNum
+	}
+	orderNumMap := make(map[string]int64, len(orderList))
+	for _, v := range orderList {
+		orderNumMap[v.PayAppID] = v.OrderNum
+	}
+
+	list := make([]*types.AlipayComplainRateItem, 0, len(cfgList))
+	for _, cfg := range cfgList {
+		item := &types.AlipayComplainRateItem{
+			PayAppId:     cfg.AppID,
+			MerchantNo:   cfg.MerchantNo,
+			MerchantName: cfg.MerchantName,
+			ComplainNum:  complainNumMap[cfg.AppID],
+			OrderNum:     orderNumMap[cfg.AppID],
+		}
+		if item.OrderNum > 0 {
+			item.ComplainRate = float64(item.ComplainNum) / float64(item.OrderNum)
+		}
+		list = append(list, item)
+	}
+
+	data := map[string]interface{}{
+		"start_time": startTime.Format("2006-01-02 15:04:05"),
+		"end_time":   endTime.Format("2006-01-02 15:04:05"),
+		"list":       list,
+	}
+	res := response.MakeResult(code.CODE_OK, "操作成功", data)
+	return &res, nil
+}
@@ -0,0 +1,74 @@
+package inter
+
+import (
+	"context"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AlipayComplainReplyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	alipayComplainModel *model.PmAlipayComplainModel
+}
+
+func NewAlipayComplainReplyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AlipayComplainReplyLogic {
+	return &AlipayComplainReplyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		alipayComplainModel: model.NewPmAlipayComplainModel(define.DbPayGateway),
+	}
+}
+
+// 回复支付宝交易投诉，回复内容用户可见，不改变投诉单状态
+func (l *AlipayComplainReplyLogic) AlipayComplainReply(req *types.AlipayComplainReplyReq) (resp *types.ResultResp, err error) {
+	if req.Operator == "" || req.ReplyContent == "" {
+		res := response.MakeResult(code.CODE_ERROR, "回复内容和操作人必填", nil)
+		return &res, nil
+	}
+
+	complain, err := l.alipayComplainModel.GetOneByComplainEventId(req.ComplainEventId)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "投诉单不存在", nil)
+		return &res, nil
+	}
+
+	payClient, _, _, err := clientMgr.GetAlipayClientByAppIdWithCache(complain.PayAppId)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, err.Error(), nil)
+		return &res, nil
+	}
+
+	err = client.AlipayComplainReplySubmit(payClient, client.AlipayComplainReply{
+		ComplainEventId: complain.ComplainEventId,
+		ReplyContent:    req.ReplyContent,
+		ReplyImages:     req.ReplyImages,
+	})
+	if err != nil {
+		l.Errorf("回复支付宝投诉失败 complainEventId: %s, err: %v", complain.ComplainEventId, err)
+		res := response.MakeResult(code.CODE_ERROR, "回复投诉失败："+err.Error(), nil)
+		return &res, nil
+	}
+
+	l.alipayComplainModel.UpdateSomeData(complain.ID, map[string]interface{}{
+		"reply_content": req.ReplyContent,
+		"operator":      req.Operator,
+	})
+
+	res := response.MakeResult(code.CODE_OK, "操作成功", nil)
+	return &res, nil
+}
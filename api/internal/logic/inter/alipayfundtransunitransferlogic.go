@@ -3,11 +3,13 @@ package inter
 import (
 	"context"
 	"fmt"
+	"math"
 	"strconv"
 
 	alipay2 "gitlab.muchcloud.com/consumer-project/alipay"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
@@ -30,7 +32,6 @@ type AlipayFundTransUniTransferLogic struct {
 	svcCtx *svc.ServiceContext
 
 	appConfigModel       *model.PmAppConfigModel
-	fundTransOrderModel  *model.PmFundTransOrderModel
 	payConfigAlipayModel *model.PmPayConfigAlipayModel
 }
 
@@ -41,13 +42,12 @@ func NewAlipayFundTransUniTransferLogic(ctx context.Context, svcCtx *svc.Service
 		svcCtx: svcCtx,
 
 		appConfigModel:       model.NewPmAppConfigModel(define.DbPayGateway),
-		fundTransOrderModel:  model.NewPmFundTransOrderModel(define.DbPayGateway),
 		payConfigAlipayModel: model.NewPmPayConfigAlipayModel(define.DbPayGateway),
 	}
 }
 
 func (l *AlipayFundTransUniTransferLogic) AlipayFundTransUniTransfer(req *types.AlipayFundTransUniTransferReq) (resp *types.ResultResp, err error) {
-	//检查金额 最大100
+	//金额限制按包名配置，转账时统一做风控检查
 	amountFloat, err := strconv.ParseFloat(req.TransAmount, 64)
 	if err != nil {
 		err = fmt.Errorf("transAmount转化错误:%v", err)
@@ -55,11 +55,6 @@ func (l *AlipayFundTransUniTransferLogic) AlipayFundTransUniTransfer(req *types.
 		res := response.MakeResult(code.CODE_ERROR, err.Error(), nil)
 		return &res, nil
 	}
-	if amountFloat < 0.1 || amountFloat > 100 {
-		err = fmt.Errorf("转账金额必须在0.1~100之间，当前金额:%.2f", amountFloat)
-		res := response.MakeResult(code.CODE_ERROR, err.Error(), nil)
-		return &res, nil
-	}
 
 	//读取应用配置
 	pkgCfg, err := l.appConfigModel.GetOneByPkgName(req.PkgName)
@@ -103,7 +98,17 @@ func (l *AlipayFundTransUniTransferLogic) AlipayFundTransUniTransfer(req *types.
 	if l.svcCtx.Config.Mode != "pro" {
 		fundTransUniTransfer.TransAmount = "0.1"
 	}
-	rest, err := payClient.FundTransUniTransfer(fundTransUniTransfer)
+	//先记录再转账，同一订单号重复提交不会重复打款
+	orderInfo := &model.PmFundTransOrderTable{
+		OrderSn:      req.OrderNo,
+		AppPkgName:   req.PkgName,
+		Amount:       int(math.Round(amountFloat * 100)),
+		AliName:      req.PayName,
+		AliAccount:   req.PayAccount,
+		PayeeAccount: req.PayAccount,
+		PayAppId:     payCfg.AppID,
+	}
+	orderInfo, err = clientMgr.AlipayFundTransfer(payClient, orderInfo, fundTransUniTransfer)
 	if err != nil {
 		err = fmt.Errorf("pkgName= %s, 支付宝转账失败，err:=%v", req.PkgName, err)
 		logx.Errorf(err.Error())
@@ -112,30 +117,10 @@ func (l *AlipayFundTransUniTransferLogic) AlipayFundTransUniTransfer(req *types.
 		return &res, nil
 	}
 
-	if !rest.IsSuccess() {
-		err = fmt.Errorf("调用转账失败 err: %s %s", rest.Content.SubCode, rest.Content.SubMsg)
-		logx.Errorf(err.Error())
-		alipayFundTransUniTransferFailNum.CounterInc()
-		res := response.MakeResult(code.CODE_ERROR, err.Error(), nil)
-		return &res, nil
-	}
-
-	//提现记录入库
-	amount, _ := strconv.ParseFloat(req.TransAmount, 64)
-	orderInfo := &model.PmFundTransOrderTable{
-		OrderSn:    req.OrderNo,
-		AppPkgName: req.PkgName,
-		Amount:     int(amount * 100),
-		AliName:    req.PayName,
-		AliAccount: req.PayAccount,
-		PayAppId:   payCfg.AppID,
-	}
-	err = l.fundTransOrderModel.Create(orderInfo)
-	if err != nil {
-		err = fmt.Errorf("fundTransOrderModel Create err: %v", err)
-		util.CheckError(err.Error())
-	}
-
-	res := response.MakeResult(code.CODE_OK, "", nil)
+	res := response.MakeResult(code.CODE_OK, "", map[string]interface{}{
+		"status":          orderInfo.Status,
+		"ali_order_id":    orderInfo.AliOrderId,
+		"channel_bill_no": orderInfo.ChannelBillNo,
+	})
 	return &res, nil
 }
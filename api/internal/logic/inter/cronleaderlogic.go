@@ -0,0 +1,47 @@
+package inter
+
+import (
+	"context"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/crontab"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/leader"
+)
+
+type CronLeaderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCronLeaderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CronLeaderLogic {
+	return &CronLeaderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 查看当前定时任务leader，以及处理请求的节点是否为leader
+func (l *CronLeaderLogic) CronLeader(req *types.EmptyReq) (resp *types.ResultResp, err error) {
+	info, err := leader.GetLeader(crontab.CronLeaderName)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "获取leader失败", nil)
+		return &res, nil
+	}
+
+	data := map[string]interface{}{
+		"leader": info,
+	}
+	if crontabOrder := crontab.GetCrontabOrder(); crontabOrder != nil {
+		_, isLeader := crontabOrder.Leader.IsLeader()
+		data["node_id"] = crontabOrder.Leader.NodeId()
+		data["is_leader"] = isLeader
+	}
+	res := response.MakeResult(code.CODE_OK, "操作成功", data)
+	return &res, nil
+}
@@ -0,0 +1,56 @@
+package inter
+
+import (
+	"context"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DyRefundAuditListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	refundAuditModel *model.PmDyRefundAuditModel
+}
+
+func NewDyRefundAuditListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DyRefundAuditListLogic {
+	return &DyRefundAuditListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		refundAuditModel: model.NewPmDyRefundAuditModel(define.DbPayGateway),
+	}
+}
+
+// 待人工审核且未过审核截止时间的抖音退款申请，按截止时间升序
+func (l *DyRefundAuditListLogic) DyRefundAuditList(req *types.DyRefundAuditListReq) (resp *types.ResultResp, err error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+
+	list, total, err := l.refundAuditModel.GetWaitList(req.PkgName, req.Page, req.PageSize)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "查询待审核退款申请失败", nil)
+		return &res, nil
+	}
+
+	data := map[string]interface{}{
+		"total": total,
+		"list":  list,
+	}
+	res := response.MakeResult(code.CODE_OK, "操作成功", data)
+	return &res, nil
+}
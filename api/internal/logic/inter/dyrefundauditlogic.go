@@ -0,0 +1,147 @@
+package inter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	douyin "gitlab.muchcloud.com/consumer-project/pay-gateway/common/client/douyinGeneralTrade"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DyRefundAuditLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	refundAuditModel     *model.PmDyRefundAuditModel
+	payConfigTiktokModel *model.PmPayConfigTiktokModel
+
+	Rdb *cache.RedisInstance
+}
+
+func NewDyRefundAuditLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DyRefundAuditLogic {
+	return &DyRefundAuditLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		refundAuditModel:     model.NewPmDyRefundAuditModel(define.DbPayGateway),
+		payConfigTiktokModel: model.NewPmPayConfigTiktokModel(define.DbPayGateway),
+		Rdb:                  db.WithRedisDBContext(define.DbPayGateway),
+	}
+}
+
+// 人工审核抖音退款申请，需要在抖音的审核截止时间前调用审核接口
+// https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/trade-system/general/refund/refund_audit
+func (l *DyRefundAuditLogic) DyRefundAudit(req *types.DyRefundAuditReq) (resp *types.ResultResp, err error) {
+	if req.Reviewer == "" {
+		res := response.MakeResult(code.CODE_ERROR, "审核人员必填", nil)
+		return &res, nil
+	}
+	if req.Status != model.DyRefundAuditStatusPass && req.Status != model.DyRefundAuditStatusReject {
+		res := response.MakeResult(code.CODE_ERROR, "审核操作不正确", nil)
+		return &res, nil
+	}
+	if req.Status == model.DyRefundAuditStatusReject && req.DenyMessage == "" {
+		res := response.MakeResult(code.CODE_ERROR, "拒绝原因必填", nil)
+		return &res, nil
+	}
+
+	// 和退款申请回调共用并发控制
+	concurrentKey, value := fmt.Sprintf("payGateway:preCreateRefundNotify:douyin:%s", req.RefundId), uuid.New().String()
+	isLock, err := l.Rdb.TryLockWithTimeout(context.Background(), concurrentKey, value, 3000)
+	if err != nil || !isLock {
+		res := response.MakeResult(code.CODE_ERROR, "退款申请正在处理中，请稍后重试", nil)
+		return &res, nil
+	}
+	defer func() {
+		unlockErr := l.Rdb.Unlock(context.Background(), concurrentKey, value)
+		if unlockErr != nil {
+			l.Slowf("redis unlock fail, key:%s, value:%s", concurrentKey, value)
+		}
+	}()
+
+	auditInfo, err := l.refundAuditModel.GetOneByRefundId(req.RefundId)
+	if err != nil || auditInfo.ID == 0 {
+		res := response.MakeResult(code.CODE_ERROR, "退款申请不存在", nil)
+		return &res, nil
+	}
+	if auditInfo.AuditStatus == model.DyRefundAuditStatusAuto {
+		res := response.MakeResult(code.CODE_ERROR, "退款申请正在自动审核中，请稍后重试", nil)
+		return &res, nil
+	}
+	if auditInfo.AuditStatus != model.DyRefundAuditStatusWait {
+		res := response.MakeResult(code.CODE_ERROR, "退款申请已处理", nil)
+		return &res, nil
+	}
+	if time.Now().After(auditInfo.RefundAuditDeadline) {
+		_, _ = l.refundAuditModel.UpdateByStatus(auditInfo.ID, model.DyRefundAuditStatusWait, map[string]interface{}{
+			"audit_status": model.DyRefundAuditStatusExpire,
+		})
+		res := response.MakeResult(code.CODE_ERROR, "已超过审核截止时间", nil)
+		return &res, nil
+	}
+
+	payCfg, err := l.payConfigTiktokModel.GetOneByAppID(auditInfo.AppId)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "读取抖音支付配置失败", nil)
+		return &res, nil
+	}
+	clientToken, err := l.svcCtx.BaseAppConfigServerApi.GetDyClientToken(l.ctx, auditInfo.AppId)
+	if err != nil {
+		l.Errorf("DyRefundAudit 获取抖音clientToken失败 appId: %s, err: %v", auditInfo.AppId, err)
+		res := response.MakeResult(code.CODE_ERROR, "获取抖音clientToken失败", nil)
+		return &res, nil
+	}
+
+	auditReq := &douyin.AuditRefundReq{
+		RefundId:          auditInfo.RefundId,
+		RefundAuditStatus: douyin.RefundAuditStatusPass,
+	}
+	if req.Status == model.DyRefundAuditStatusReject {
+		auditReq.RefundAuditStatus = douyin.RefundAuditStatusReject
+		auditReq.DenyMessage = req.DenyMessage
+	}
+
+	payClient := douyin.NewDouyinPay(payCfg.GetGeneralTradeConfig())
+	auditResp, err := payClient.AuditRefund(auditReq, clientToken)
+	if err != nil {
+		l.Errorf("DyRefundAudit 审核退款失败 refundId: %s, err: %v", auditInfo.RefundId, err)
+		res := response.MakeResult(code.CODE_ERROR, "审核退款异常", nil)
+		return &res, nil
+	}
+	if auditResp.ErrNo != 0 {
+		l.Errorf("DyRefundAudit 审核退款失败 refundId: %s, resp: %+v", auditInfo.RefundId, auditResp)
+		res := response.MakeResult(code.CODE_ERROR, auditResp.ErrMsg, nil)
+		return &res, nil
+	}
+
+	updateData := map[string]interface{}{
+		"audit_status": req.Status,
+		"audit_source": model.DyRefundAuditSourceManual,
+		"deny_message": auditReq.DenyMessage,
+		"reviewer":     req.Reviewer,
+		"audit_at":     time.Now(),
+	}
+	isUpdate, err := l.refundAuditModel.UpdateByStatus(auditInfo.ID, model.DyRefundAuditStatusWait, updateData)
+	l.Sloww("DyRefundAudit refundAuditModel.UpdateByStatus", logx.Field("id", auditInfo.ID), logx.Field("updateData", updateData), logx.Field("isUpdate", isUpdate), logx.Field("err", err))
+	if err != nil || !isUpdate {
+		res := response.MakeResult(code.CODE_ERROR, "更新审核状态异常", nil)
+		return &res, nil
+	}
+
+	res := response.MakeResult(code.CODE_OK, "操作成功", nil)
+	return &res, nil
+}
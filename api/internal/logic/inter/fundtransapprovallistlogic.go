@@ -0,0 +1,56 @@
+package inter
+
+import (
+	"context"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FundTransApprovalListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	fundTransRiskModel *model.PmFundTransRiskModel
+}
+
+func NewFundTransApprovalListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FundTransApprovalListLogic {
+	return &FundTransApprovalListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		fundTransRiskModel: model.NewPmFundTransRiskModel(define.DbPayGateway),
+	}
+}
+
+// 待审核的转账，按提交时间升序
+func (l *FundTransApprovalListLogic) FundTransApprovalList(req *types.FundTransApprovalListReq) (resp *types.ResultResp, err error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+
+	list, total, err := l.fundTransRiskModel.GetWaitApprovalList(req.PkgName, req.Page, req.PageSize)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "查询待审核转账失败", nil)
+		return &res, nil
+	}
+
+	data := map[string]interface{}{
+		"total": total,
+		"list":  list,
+	}
+	res := response.MakeResult(code.CODE_OK, "操作成功", data)
+	return &res, nil
+}
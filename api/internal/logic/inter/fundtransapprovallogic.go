@@ -0,0 +1,65 @@
+package inter
+
+import (
+	"context"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FundTransApprovalLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewFundTransApprovalLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FundTransApprovalLogic {
+	return &FundTransApprovalLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 人工审核进入审核队列的转账，通过后立即发起转账，审核操作会记录审计日志
+func (l *FundTransApprovalLogic) FundTransApproval(req *types.FundTransApprovalReq) (resp *types.ResultResp, err error) {
+	if req.Reviewer == "" {
+		res := response.MakeResult(code.CODE_ERROR, "审核人员必填", nil)
+		return &res, nil
+	}
+	if req.Status != model.FundTransApprovalStatusPass && req.Status != model.FundTransApprovalStatusReject {
+		res := response.MakeResult(code.CODE_ERROR, "审核操作不正确", nil)
+		return &res, nil
+	}
+	if req.Status == model.FundTransApprovalStatusReject && req.Remark == "" {
+		res := response.MakeResult(code.CODE_ERROR, "拒绝原因必填", nil)
+		return &res, nil
+	}
+
+	orderInfo, err := clientMgr.FundTransApprove(req.OrderSn, req.Status == model.FundTransApprovalStatusPass, req.Reviewer, req.Remark)
+	l.Sloww("FundTransApproval", logx.Field("orderSn", req.OrderSn), logx.Field("status", req.Status), logx.Field("reviewer", req.Reviewer), logx.Field("err", err))
+	if err != nil {
+		// 审核已通过但转账请求异常时，转账结果由定时任务确认
+		if orderInfo != nil {
+			res := response.MakeResult(code.CODE_OK, err.Error(), map[string]interface{}{"status": orderInfo.Status})
+			return &res, nil
+		}
+		res := response.MakeResult(code.CODE_ERROR, err.Error(), nil)
+		return &res, nil
+	}
+
+	res := response.MakeResult(code.CODE_OK, "操作成功", map[string]interface{}{
+		"status":          orderInfo.Status,
+		"ali_order_id":    orderInfo.AliOrderId,
+		"channel_bill_no": orderInfo.ChannelBillNo,
+		"package_info":    orderInfo.PackageInfo,
+	})
+	return &res, nil
+}
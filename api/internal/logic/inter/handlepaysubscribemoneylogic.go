@@ -5,7 +5,7 @@ import (
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
-	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/crontab"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/scheduler"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
@@ -26,8 +26,17 @@ func NewHandlePaySubscribeMoneyLogic(ctx context.Context, svcCtx *svc.ServiceCon
 }
 
 func (l *HandlePaySubscribeMoneyLogic) HandlePaySubscribeMoney(req *types.EmptyReq) (resp *types.ResultResp, err error) {
-	crontabOrder := crontab.GetCrontabOrder()
-	crontabOrder.PayOrder()
-	res := response.MakeResult(code.CODE_OK, "操作成功", nil)
+	// 通过任务调度执行，避免重复触发时并发扣款
+	s := scheduler.GetScheduler()
+	if s == nil {
+		res := response.MakeResult(code.CODE_ERROR, "任务调度未启动", nil)
+		return &res, nil
+	}
+	runId, err := s.Trigger(scheduler.JobPayOrder, nil, "paySubscribeMoney")
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, err.Error(), nil)
+		return &res, nil
+	}
+	res := response.MakeResult(code.CODE_OK, "操作成功", map[string]interface{}{"run_id": runId})
 	return &res, nil
 }
@@ -0,0 +1,70 @@
+package inter
+
+import (
+	"context"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/scheduler"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type JobListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewJobListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *JobListLogic {
+	return &JobListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 定时任务列表，包含执行时间、暂停状态、是否执行中和最近一次执行记录
+func (l *JobListLogic) JobList(req *types.EmptyReq) (resp *types.ResultResp, err error) {
+	s := scheduler.GetScheduler()
+	if s == nil {
+		res := response.MakeResult(code.CODE_ERROR, "任务调度未启动", nil)
+		return &res, nil
+	}
+
+	jobModel := model.NewPmJobModel(define.DbPayGateway)
+	stateList, err := jobModel.GetAllJob()
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "查询任务状态失败", nil)
+		return &res, nil
+	}
+	pausedMap := make(map[string]bool, len(stateList))
+	for _, v := range stateList {
+		pausedMap[v.JobName] = v.Paused == 1
+	}
+
+	list := make([]map[string]interface{}, 0)
+	for _, job := range s.Jobs() {
+		item := map[string]interface{}{
+			"name":    job.Name,
+			"desc":    job.Desc,
+			"params":  job.Params,
+			"spec":    job.Spec(),
+			"timeout": int(job.Timeout().Seconds()),
+			"paused":  pausedMap[job.Name],
+			"running": s.IsRunning(job.Name),
+		}
+		if lastRun, err := jobModel.GetLastRun(job.Name); err == nil {
+			item["last_run"] = lastRun
+		}
+		list = append(list, item)
+	}
+
+	res := response.MakeResult(code.CODE_OK, "操作成功", list)
+	return &res, nil
+}
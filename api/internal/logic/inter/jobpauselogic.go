@@ -0,0 +1,49 @@
+package inter
+
+import (
+	"context"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/scheduler"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type JobPauseLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewJobPauseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *JobPauseLogic {
+	return &JobPauseLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 暂停任务，只停止定时执行，仍可手动触发
+func (l *JobPauseLogic) JobPause(req *types.JobPauseReq) (resp *types.ResultResp, err error) {
+	if req.Operator == "" {
+		res := response.MakeResult(code.CODE_ERROR, "操作人必填", nil)
+		return &res, nil
+	}
+	s := scheduler.GetScheduler()
+	if s == nil {
+		res := response.MakeResult(code.CODE_ERROR, "任务调度未启动", nil)
+		return &res, nil
+	}
+
+	if err = s.SetPaused(req.Name, true, req.Operator); err != nil {
+		res := response.MakeResult(code.CODE_ERROR, err.Error(), nil)
+		return &res, nil
+	}
+
+	res := response.MakeResult(code.CODE_OK, "操作成功", nil)
+	return &res, nil
+}
@@ -0,0 +1,56 @@
+package inter
+
+import (
+	"context"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type JobRunListLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	jobModel *model.PmJobModel
+}
+
+func NewJobRunListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *JobRunListLogic {
+	return &JobRunListLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		jobModel: model.NewPmJobModel(define.DbPayGateway),
+	}
+}
+
+// 任务执行记录，按id倒序
+func (l *JobRunListLogic) JobRunList(req *types.JobRunListReq) (resp *types.ResultResp, err error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+
+	list, total, err := l.jobModel.GetRunList(req.Name, req.Page, req.PageSize)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "查询执行记录失败", nil)
+		return &res, nil
+	}
+
+	data := map[string]interface{}{
+		"total": total,
+		"list":  list,
+	}
+	res := response.MakeResult(code.CODE_OK, "操作成功", data)
+	return &res, nil
+}
@@ -0,0 +1,50 @@
+package inter
+
+import (
+	"context"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/scheduler"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type JobTriggerLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewJobTriggerLogic(ctx context.Context, svcCtx *svc.ServiceContext) *JobTriggerLogic {
+	return &JobTriggerLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 手动触发任务，返回执行记录id，由leader节点异步执行
+func (l *JobTriggerLogic) JobTrigger(req *types.JobTriggerReq) (resp *types.ResultResp, err error) {
+	if req.Operator == "" {
+		res := response.MakeResult(code.CODE_ERROR, "操作人必填", nil)
+		return &res, nil
+	}
+	s := scheduler.GetScheduler()
+	if s == nil {
+		res := response.MakeResult(code.CODE_ERROR, "任务调度未启动", nil)
+		return &res, nil
+	}
+
+	runId, err := s.Trigger(req.Name, req.Params, req.Operator)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, err.Error(), nil)
+		return &res, nil
+	}
+
+	res := response.MakeResult(code.CODE_OK, "操作成功", map[string]interface{}{"run_id": runId})
+	return &res, nil
+}
@@ -0,0 +1,66 @@
+package inter
+
+import (
+	"context"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WechatComplainCompleteLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	wechatComplainModel *model.PmWechatComplainModel
+}
+
+func NewWechatComplainCompleteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WechatComplainCompleteLogic {
+	return &WechatComplainCompleteLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		wechatComplainModel: model.NewPmWechatComplainModel(define.DbPayGateway),
+	}
+}
+
+// 反馈微信消费者投诉处理完成，需要先回复过用户
+func (l *WechatComplainCompleteLogic) WechatComplainComplete(req *types.WechatComplainCompleteReq) (resp *types.ResultResp, err error) {
+	if req.Operator == "" {
+		res := response.MakeResult(code.CODE_ERROR, "操作人必填", nil)
+		return &res, nil
+	}
+
+	complain, payClient, err := getWechatComplainClient(l.wechatComplainModel, req.ComplaintId)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, err.Error(), nil)
+		return &res, nil
+	}
+	if complain.ComplaintState == client.WechatComplaintStateProcessed {
+		res := response.MakeResult(code.CODE_ERROR, "投诉单已处理完成", nil)
+		return &res, nil
+	}
+
+	err = payClient.ComplaintComplete(complain.ComplaintId)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "反馈处理完成失败："+err.Error(), nil)
+		return &res, nil
+	}
+
+	l.wechatComplainModel.UpdateSomeData(complain.ID, map[string]interface{}{
+		"complaint_state": client.WechatComplaintStateProcessed,
+		"operator":        req.Operator,
+	})
+
+	res := response.MakeResult(code.CODE_OK, "操作成功", nil)
+	return &res, nil
+}
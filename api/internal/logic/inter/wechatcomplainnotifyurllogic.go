@@ -0,0 +1,61 @@
+package inter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WechatComplainNotifyUrlLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	payConfigWechatModel *model.PmPayConfigWechatModel
+}
+
+func NewWechatComplainNotifyUrlLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WechatComplainNotifyUrlLogic {
+	return &WechatComplainNotifyUrlLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		payConfigWechatModel: model.NewPmPayConfigWechatModel(define.DbPayGateway),
+	}
+}
+
+// 为商户设置投诉通知回调地址，每个商户号只需设置一次
+func (l *WechatComplainNotifyUrlLogic) WechatComplainNotifyUrl(req *types.WechatComplainNotifyUrlReq) (resp *types.ResultResp, err error) {
+	payCfg, err := l.payConfigWechatModel.GetOneByAppID(req.AppId)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "读取微信支付配置失败", nil)
+		return &res, nil
+	}
+
+	params, err := url.Parse(payCfg.NotifyUrl)
+	if err != nil || params.Host == "" {
+		res := response.MakeResult(code.CODE_ERROR, "微信支付配置的回调地址不正确", nil)
+		return &res, nil
+	}
+	notifyUrl := fmt.Sprintf("%s://%s/notify/complain/wechat/%s", params.Scheme, params.Host, payCfg.AppID)
+
+	err = client.NewWeChatCommPay(*payCfg.TransClientConfig()).SetComplaintNotifyUrl(notifyUrl)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "设置投诉通知地址失败："+err.Error(), nil)
+		return &res, nil
+	}
+
+	res := response.MakeResult(code.CODE_OK, "操作成功", map[string]interface{}{"notify_url": notifyUrl})
+	return &res, nil
+}
@@ -0,0 +1,88 @@
+package inter
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/common/response"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WechatComplainReplyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	wechatComplainModel *model.PmWechatComplainModel
+}
+
+func NewWechatComplainReplyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WechatComplainReplyLogic {
+	return &WechatComplainReplyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		wechatComplainModel: model.NewPmWechatComplainModel(define.DbPayGateway),
+	}
+}
+
+// 回复微信消费者投诉，回复后投诉单变为处理中
+func (l *WechatComplainReplyLogic) WechatComplainReply(req *types.WechatComplainReplyReq) (resp *types.ResultResp, err error) {
+	if req.Operator == "" || req.ResponseContent == "" {
+		res := response.MakeResult(code.CODE_ERROR, "回复内容和操作人必填", nil)
+		return &res, nil
+	}
+
+	complain, payClient, err := getWechatComplainClient(l.wechatComplainModel, req.ComplaintId)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, err.Error(), nil)
+		return &res, nil
+	}
+
+	err = payClient.ComplaintResponse(complain.ComplaintId, req.ResponseContent, req.ResponseImages)
+	if err != nil {
+		res := response.MakeResult(code.CODE_ERROR, "回复投诉失败："+err.Error(), nil)
+		return &res, nil
+	}
+
+	l.wechatComplainModel.UpdateSomeData(complain.ID, map[string]interface{}{
+		"response_content": req.ResponseContent,
+		"operator":         req.Operator,
+	})
+	refreshWechatComplaint(payClient, complain)
+
+	res := response.MakeResult(code.CODE_OK, "操作成功", nil)
+	return &res, nil
+}
+
+// 获取投诉单及其商户对应的微信client
+func getWechatComplainClient(wechatComplainModel *model.PmWechatComplainModel, complaintId string) (*model.PmWechatComplainTable, *client.WeChatCommPay, error) {
+	complain, err := wechatComplainModel.GetOneByComplaintId(complaintId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("投诉单不存在")
+	}
+
+	payCfg, err := model.NewPmPayConfigWechatModel(define.DbPayGateway).GetOneByAppID(complain.PayAppId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("读取微信支付配置失败 appId: %s", complain.PayAppId)
+	}
+	return complain, client.NewWeChatCommPay(*payCfg.TransClientConfig()), nil
+}
+
+// 处理后重新查询投诉详情，更新本地投诉单状态
+func refreshWechatComplaint(payClient *client.WeChatCommPay, complain *model.PmWechatComplainTable) {
+	complaint, err := payClient.ComplaintDetail(complain.ComplaintId)
+	if err != nil {
+		return
+	}
+	clientMgr.SaveWechatComplaint(complain.PayAppId, complaint)
+}
@@ -336,6 +336,7 @@ func (l *NotifyAlipayNewLogic) NotifyAlipayNew(r *http.Request, w http.ResponseW
 			return
 		}
 		go func() {
+			l.orderModel.UnsignAgreementByExternalAgreementNo(externalAgreement)
 			l.orderModel.CloseUnpaidSubscribeFeeOrderByExternalAgreementNo(externalAgreement)
 		}()
 		go func() {
@@ -7,10 +7,12 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bytedance/sonic"
 	"github.com/google/uuid"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/crontab"
 	douyin "gitlab.muchcloud.com/consumer-project/pay-gateway/common/client/douyinGeneralTrade"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
@@ -38,6 +40,7 @@ type NotifyDouyinLogic struct {
 	payConfigTiktokModel  *model.PmPayConfigTiktokModel
 	refundOrderModel      *model.PmRefundOrderModel
 	payDyPeriodOrderModel *model.PmDyPeriodOrderModel
+	refundAuditModel      *model.PmDyRefundAuditModel
 
 	Rdb *cache.RedisInstance
 }
@@ -52,6 +55,7 @@ func NewNotifyDouyinLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Noti
 		payConfigTiktokModel:  model.NewPmPayConfigTiktokModel(define.DbPayGateway),
 		refundOrderModel:      model.NewPmRefundOrderModel(define.DbPayGateway),
 		payDyPeriodOrderModel: model.NewPmDyPeriodOrderModel(define.DbPayGateway),
+		refundAuditModel:      model.NewPmDyRefundAuditModel(define.DbPayGateway),
 		Rdb:                   db.WithRedisDBContext(define.DbPayGateway),
 	}
 }
@@ -371,6 +375,8 @@ func (l *NotifyDouyinLogic) notifyRefund(req *http.Request, body []byte, msgJson
 	return resp, nil
 }
 
+// 抖音退款申请回调，按包名配置的审核策略自动审核，未命中规则的进入人工审核
+// https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/trade-system/general/refund/refund_callback
 func (l *NotifyDouyinLogic) notifyPreCreateRefund(req *http.Request, body []byte, msgJson string, originData interface{}) (*types.DouyinResp, error) {
 	_ = originData
 	msg := new(douyin.PreCreateRefundMsg)
@@ -401,17 +407,84 @@ func (l *NotifyDouyinLogic) notifyPreCreateRefund(req *http.Request, body []byte
 		}, nil
 	}
 
-	/*
-		并发控制
-	*/
+	// redis 并发控制
+	concurrentKey, value := fmt.Sprintf("payGateway:preCreateRefundNotify:douyin:%s", msg.RefundId), uuid.New().String()
+	isLock, err := l.Rdb.TryLockWithTimeout(context.Background(), concurrentKey, value, 1000)
+	if err != nil || !isLock {
+		l.Slowf("notifyPreCreateRefund redis lock fail, err:%v, isLock:%v, key:%v", err, isLock, concurrentKey)
+		return nil, fmt.Errorf("redis lock fail, err:%v, isLock:%v, key:%v", err, isLock, concurrentKey)
+	}
+	defer func() {
+		unlockErr := l.Rdb.Unlock(context.Background(), concurrentKey, value)
+		if unlockErr != nil {
+			l.Slowf("redis unlock fail, key:%s, value:%s", concurrentKey, value)
+		}
+	}()
 
-	/*
-		业务逻辑
-	*/
+	// 重复通知直接返回成功
+	auditInfo, err := l.refundAuditModel.GetOneByRefundId(msg.RefundId)
+	if err == nil && auditInfo.ID > 0 {
+		l.Slowf("notifyPreCreateRefund 退款申请已处理过 refundId: %s, auditStatus: %d", msg.RefundId, auditInfo.AuditStatus)
+		return &types.DouyinResp{
+			ErrNo:   0,
+			ErrTips: "success",
+		}, nil
+	}
 
-	/*
-		回调业务方
-	*/
+	orderInfo, err := l.payOrderModel.GetOneByOrderSnAndAppId(msg.OutOrderNo, msg.AppId)
+	if err != nil || orderInfo == nil || orderInfo.ID < 1 {
+		err = fmt.Errorf("notifyPreCreateRefund 获取订单失败 err=%v, order_code:%s, appId:%s", err, msg.OutOrderNo, msg.AppId)
+		util.CheckError(err.Error())
+		return nil, err
+	}
+
+	auditInfo = &model.PmDyRefundAuditTable{
+		AppId:               msg.AppId,
+		AppPkgName:          orderInfo.AppPkgName,
+		RefundId:            msg.RefundId,
+		OrderId:             msg.OrderId,
+		OutOrderNo:          msg.OutOrderNo,
+		RefundAmount:        int(msg.RefundTotalAmount),
+		RefundReason:        strings.Join(msg.RefundReason, ","),
+		RefundAuditDeadline: time.UnixMilli(msg.RefundAuditDeadline),
+		AuditStatus:         model.DyRefundAuditStatusWait,
+		NotifyData:          msgJson,
+		AuditAt:             model.Default2000Date,
+	}
+
+	// 抖音侧无需审核的只做记录
+	if msg.NeedRefundAudit != douyin.NeedRefundAuditYes {
+		auditInfo.AuditStatus = model.DyRefundAuditStatusNoNeed
+		err = l.refundAuditModel.Create(auditInfo)
+		if err != nil {
+			return nil, err
+		}
+		return &types.DouyinResp{
+			ErrNo:   0,
+			ErrTips: "success",
+		}, nil
+	}
+
+	auditStatus, remark, denyMessage := l.matchRefundAuditPolicy(msg, orderInfo)
+	auditInfo.AuditRemark = remark
+	if auditStatus != model.DyRefundAuditStatusWait {
+		// 自动审核完成前不能人工审核，保存审核结果，自动审核中断时由定时任务重新审核
+		auditInfo.AuditStatus = model.DyRefundAuditStatusAuto
+		auditInfo.AutoAuditStatus = auditStatus
+		auditInfo.DenyMessage = denyMessage
+	}
+	err = l.refundAuditModel.Create(auditInfo)
+	if err != nil {
+		return nil, err
+	}
+	l.Sloww("notifyPreCreateRefund 退款审核策略", logx.Field("refundId", msg.RefundId), logx.Field("pkg", orderInfo.AppPkgName), logx.Field("auditStatus", auditStatus), logx.Field("remark", remark))
+
+	if auditStatus != model.DyRefundAuditStatusWait {
+		// 回调成功后抖音侧才会生成退款单，异步调用审核接口
+		go util.SafeRun(func() {
+			l.autoAuditRefund(auditInfo, auditStatus, denyMessage)
+		})
+	}
 
 	resp := &types.DouyinResp{
 		ErrNo:   0,
@@ -421,6 +494,129 @@ func (l *NotifyDouyinLogic) notifyPreCreateRefund(req *http.Request, body []byte
 	return resp, nil
 }
 
+// 按包名的审核策略给出审核结果，返回审核状态、命中规则说明和拒绝文案
+func (l *NotifyDouyinLogic) matchRefundAuditPolicy(msg *douyin.PreCreateRefundMsg, orderInfo *model.PmPayOrderTable) (int, string, string) {
+	policy, err := l.refundAuditModel.GetPolicyByPkgName(orderInfo.AppPkgName)
+	if err != nil || policy == nil {
+		return model.DyRefundAuditStatusWait, "未配置审核策略", ""
+	}
+
+	// 内容已消费优先拒绝
+	if policy.RejectConsumed == 1 && policy.ConsumeQueryUrl != "" {
+		consumed, queryErr := l.queryContentConsumed(policy.ConsumeQueryUrl, orderInfo)
+		if queryErr != nil {
+			l.Errorf("matchRefundAuditPolicy 查询内容消费情况失败, pkg: %s, orderSn: %s, err: %v", orderInfo.AppPkgName, orderInfo.OrderSn, queryErr)
+			return model.DyRefundAuditStatusWait, "查询内容消费情况失败，转人工审核", ""
+		}
+		if consumed {
+			return model.DyRefundAuditStatusReject, "内容已消费", policy.DenyMessage
+		}
+	}
+
+	if policy.AutoPassAmount > 0 && int(msg.RefundTotalAmount) <= policy.AutoPassAmount {
+		return model.DyRefundAuditStatusPass, fmt.Sprintf("退款金额不超过%d分", policy.AutoPassAmount), ""
+	}
+
+	// 订单表没有支付时间，以下单时间近似
+	if policy.AutoPassHours > 0 && time.UnixMilli(msg.CreateRefundTime).Sub(orderInfo.CreatedAt) <= time.Duration(policy.AutoPassHours)*time.Hour {
+		return model.DyRefundAuditStatusPass, fmt.Sprintf("支付后%d小时内申请", policy.AutoPassHours), ""
+	}
+
+	return model.DyRefundAuditStatusWait, "未命中自动审核规则", ""
+}
+
+// 向业务方查询订单内容是否已消费，业务方返回 {"consumed": true}
+func (l *NotifyDouyinLogic) queryContentConsumed(url string, orderInfo *model.PmPayOrderTable) (bool, error) {
+	headMap := map[string]string{
+		"App-Origin": orderInfo.AppPkgName,
+	}
+	postData := map[string]interface{}{
+		"out_order_no": orderInfo.OrderSn,
+		"order_id":     orderInfo.ThirdOrderNo,
+	}
+	respData, err := util.HttpPostWithHeader(url, postData, headMap, 3*time.Second)
+	if err != nil {
+		return false, err
+	}
+
+	var result struct {
+		Consumed bool `json:"consumed"`
+	}
+	err = sonic.UnmarshalString(respData, &result)
+	if err != nil {
+		return false, fmt.Errorf("unmarshal consume query resp fail, resp: %s, err: %v", respData, err)
+	}
+	return result.Consumed, nil
+}
+
+// RedriveAutoAudit 按保存的策略审核结果重新调用抖音审核接口，返回是否审核完成
+func (l *NotifyDouyinLogic) RedriveAutoAudit(auditInfo *model.PmDyRefundAuditTable) bool {
+	return l.autoAuditRefund(auditInfo, auditInfo.AutoAuditStatus, auditInfo.DenyMessage)
+}
+
+// 调用抖音审核接口，失败时重试，最终失败的转人工审核，返回是否审核完成
+func (l *NotifyDouyinLogic) autoAuditRefund(auditInfo *model.PmDyRefundAuditTable, auditStatus int, denyMessage string) bool {
+	auditReq := &douyin.AuditRefundReq{
+		RefundId:          auditInfo.RefundId,
+		RefundAuditStatus: douyin.RefundAuditStatusPass,
+	}
+	if auditStatus == model.DyRefundAuditStatusReject {
+		auditReq.RefundAuditStatus = douyin.RefundAuditStatusReject
+		auditReq.DenyMessage = denyMessage
+	}
+
+	payCfg, err := l.payConfigTiktokModel.GetOneByAppID(auditInfo.AppId)
+	if err != nil {
+		l.Errorf("autoAuditRefund 读取抖音支付配置失败 appId: %s, err: %v", auditInfo.AppId, err)
+		return false
+	}
+	payClient := douyin.NewDouyinPay(payCfg.GetGeneralTradeConfig())
+
+	var auditErr error
+	for i := 0; i < 3; i++ {
+		time.Sleep(time.Duration(i+1) * time.Second)
+		if time.Now().After(auditInfo.RefundAuditDeadline) {
+			auditErr = fmt.Errorf("已超过审核截止时间")
+			break
+		}
+
+		clientToken, tokenErr := l.svcCtx.BaseAppConfigServerApi.GetDyClientToken(context.Background(), auditInfo.AppId)
+		if tokenErr != nil {
+			auditErr = tokenErr
+			continue
+		}
+
+		var resp *douyin.ApiCommonResp
+		resp, auditErr = payClient.AuditRefund(auditReq, clientToken)
+		if auditErr == nil && resp.ErrNo != 0 {
+			auditErr = fmt.Errorf("errNo: %d, errMsg: %s, logId: %s", resp.ErrNo, resp.ErrMsg, resp.LogId)
+		}
+		if auditErr == nil {
+			break
+		}
+	}
+
+	if auditErr != nil {
+		l.Errorf("autoAuditRefund 自动审核失败，转人工审核 refundId: %s, err: %v", auditInfo.RefundId, auditErr)
+		CallbackRefundFailNum.CounterInc()
+		_, _ = l.refundAuditModel.UpdateByStatus(auditInfo.ID, model.DyRefundAuditStatusAuto, map[string]interface{}{
+			"audit_status": model.DyRefundAuditStatusWait,
+			"audit_remark": fmt.Sprintf("%s，自动审核失败: %v", auditInfo.AuditRemark, auditErr),
+		})
+		return false
+	}
+
+	updateData := map[string]interface{}{
+		"audit_status": auditStatus,
+		"audit_source": model.DyRefundAuditSourceAuto,
+		"deny_message": auditReq.DenyMessage,
+		"audit_at":     time.Now(),
+	}
+	isUpdate, err := l.refundAuditModel.UpdateByStatus(auditInfo.ID, model.DyRefundAuditStatusAuto, updateData)
+	l.Sloww("autoAuditRefund refundAuditModel.UpdateByStatus", logx.Field("id", auditInfo.ID), logx.Field("updateData", updateData), logx.Field("isUpdate", isUpdate), logx.Field("err", err))
+	return err == nil && isUpdate
+}
+
 // 抖音周期代扣结果回调通知
 // https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/payment/management-capacity/periodic-deduction/pay/sign-pay-callback
 func (l *NotifyDouyinLogic) handleSignPayCallback(msg string, originData *douyin.GeneralTradeCallbackData) error {
@@ -473,6 +669,7 @@ func (l *NotifyDouyinLogic) handleSignPayCallback(msg string, originData *douyin
 
 	if signResult.Status != douyin.Dy_Sign_Pay_Status_SUCCESS {
 		l.Slowf("扣款非成功 raw msg: %s ", msg)
+		l.handleSignPayFail(&signResult)
 		return nil
 	}
 
@@ -483,19 +680,20 @@ func (l *NotifyDouyinLogic) handleSignPayCallback(msg string, originData *douyin
 		return fmt.Errorf("扣款成功 查询记录出错 error: %s", err.Error())
 	}
 
-	if orderInfo.PayStatus > 0 {
+	// 网关发起的代扣单可能因为查单超时已按失败处理，以扣款成功回调为准
+	if orderInfo.PayStatus == model.Dy_Pay_Status_Paid {
 		l.Slowf("扣款成功 订单已经处理过了 不需要再次数据 raw msg: %s , orderInfo id: %d , payStatus : %d", msg, orderInfo.ID, orderInfo.PayStatus)
 		return nil
 	}
 
 	updateData := map[string]interface{}{
 		"pay_status":          1,
-		"pay_channel":         signResult.PayChannel,                                                                  // 支付渠道 扣款成功时才有
-		"third_order_sn":      signResult.ChannelPayId,                                                                // 抖音平台返回的渠道支付单号
-		"third_order_no":      signResult.PayOrderId,                                                                  // 抖音平台返回的代扣单的单号
-		"next_decuction_time": time.Unix(signResult.EventTime/1000, 0).AddDate(0, 1, 0).Format("2006-01-02 15:04:05"), // 下次扣款时间
-		"user_bill_pay_id":    signResult.UserBillPayId,                                                               // 用户抖音交易单号（账单号）
-		"notify_amount":       signResult.TotalAmount,                                                                 // 回调扣款金额（分）
+		"pay_channel":         signResult.PayChannel,                                                                               // 支付渠道 扣款成功时才有
+		"third_order_sn":      signResult.ChannelPayId,                                                                             // 抖音平台返回的渠道支付单号
+		"third_order_no":      signResult.PayOrderId,                                                                               // 抖音平台返回的代扣单的单号
+		"next_decuction_time": orderInfo.FirstDeductionTime(time.Unix(signResult.EventTime/1000, 0)).Format("2006-01-02 15:04:05"), // 下次扣款时间
+		"user_bill_pay_id":    signResult.UserBillPayId,                                                                            // 用户抖音交易单号（账单号）
+		"notify_amount":       signResult.TotalAmount,                                                                              // 回调扣款金额（分）
 	}
 
 	if orderInfo.ThirdSignOrderNo == "" {
@@ -515,6 +713,14 @@ func (l *NotifyDouyinLogic) handleSignPayCallback(msg string, originData *douyin
 		return err
 	}
 
+	if orderInfo.SignOrderId > 0 {
+		// 网关发起的代扣单，推进签约单的期数和下次扣款时间
+		if err = crontab.DyPeriodDeductSuccess(orderInfo); err != nil {
+			l.Errorf("DyPeriodDeductSuccess failed orderSn: %s, err: %v", orderInfo.OrderSn, err)
+		}
+		originData.Msg = crontab.DyPeriodPayCallbackMsg(orderInfo, originData.Msg)
+	}
+
 	// 回调
 	go util.SafeRun(func() {
 		headMap := map[string]string{
@@ -531,6 +737,16 @@ func (l *NotifyDouyinLogic) handleSignPayCallback(msg string, originData *douyin
 	return nil
 }
 
+// 网关发起的代扣单扣款失败或超时，按重试计划安排下次扣款并回调业务方；业务方自己发起的代扣单不处理
+func (l *NotifyDouyinLogic) handleSignPayFail(signResult *douyin.DySignPayCallbackNotify) {
+	orderInfo, err := l.payDyPeriodOrderModel.GetOneByOrderSnAndAppId(signResult.OutPayOrderNo, signResult.AppId)
+	if err != nil || orderInfo.SignOrderId == 0 {
+		return
+	}
+	errDesc := fmt.Sprintf("抖音周期代扣: 扣款失败 orderSn=%s, status=%s", orderInfo.OrderSn, signResult.Status)
+	crontab.DyPeriodDeductFail(orderInfo, signResult.Status, errDesc)
+}
+
 // 抖音周期代扣签约回调处理
 // https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/payment/management-capacity/periodic-deduction/sign/sign-callback
 func (l *NotifyDouyinLogic) handleSignCallback(msg string, originData *douyin.GeneralTradeCallbackData) error {
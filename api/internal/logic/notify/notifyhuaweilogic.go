@@ -4,16 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/huawei"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/utils"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
 	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -26,8 +31,14 @@ type NotifyHuaweiLogic struct {
 	notifyHuaweiLogModel *model.NotifyHuaweiLogModel
 	huaweiOrderModel     *model.HuaweiOrderModel
 	huaweiAppModel       *model.HuaweiAppModel
+
+	Rdb *cache.RedisInstance
 }
 
+// 同一购买token的订单通知串行处理，锁的时间覆盖同步发货回调的重试
+const redisHuaweiOrderLockKey = "payGateway:paymentNotify:huaweiOrder:%s"
+const huaweiOrderLockMs = 60000
+
 func NewNotifyHuaweiLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NotifyHuaweiLogic {
 	return &NotifyHuaweiLogic{
 		Logger:               logx.WithContext(ctx),
@@ -36,6 +47,7 @@ func NewNotifyHuaweiLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Noti
 		notifyHuaweiLogModel: model.NewNotifyHuaweiLogModel(define.DbPayGateway),
 		huaweiOrderModel:     model.NewHuaweiOrderModel(define.DbPayGateway),
 		huaweiAppModel:       model.NewHuaweiAppModel(define.DbPayGateway),
+		Rdb:                  db.WithRedisDBContext(define.DbPayGateway),
 	}
 }
 
@@ -87,7 +99,7 @@ func (l *NotifyHuaweiLogic) NotifyHuawei(req *types.HuaweiReq) *huawei.Notificat
 		}
 	} else if req.EventType == huawei.HUAWEI_EVENT_TYPE_ORDER {
 		// 处理订单
-		err = l.handleHuaweiOrder(req, logModel.Id)
+		err = l.handleHuaweiOrder(req, hwApp, logModel.Id)
 		if err != nil {
 			l.Errorf("handleHuaweiOrder error: %v", err)
 		}
@@ -187,7 +199,7 @@ func (l *NotifyHuaweiLogic) handleHuaweiSub(req *types.HuaweiReq, hwApp *model.H
 	notificationType := info.NotificationType
 
 	// 这里直接填线上地址
-	tmpNotifyUrl := "http://quick4-go-pre.muchcloud.com/ver/user/notifyUser"
+	tmpNotifyUrl := huawei.DefaultNotifyUrl
 
 	// 根据内部订单号查询订单信息
 	hworder, err := l.huaweiOrderModel.GetOneByOutTradeNo(info.DeveloperPayload)
@@ -348,7 +360,9 @@ func (l *NotifyHuaweiLogic) handleHuaweiSub(req *types.HuaweiReq, hwApp *model.H
 // 5.验证结果成功，处理发货，并记录购买商品的Token。请根据Order服务验证购买Token接口响应中InAppPurchaseData的purchaseState字段决定是否发货。若purchaseState为0，则执行发货操作。
 // 6.调用华为IAP服务器提供的Order服务确认购买接口确认购买（即消耗）。
 // 7.IAP服务器返回确认购买结果。
-func (l *NotifyHuaweiLogic) handleHuaweiOrder(req *types.HuaweiReq, logId int) error {
+//
+// 发货成功但未确认购买时，华为会在一段时间后自动退款，所以确认失败时返回错误让华为重推，定时任务也会重试确认
+func (l *NotifyHuaweiLogic) handleHuaweiOrder(req *types.HuaweiReq, hwApp *model.HuaweiAppTable, logId int) error {
 	// 订单 notificationType 通知事件的类型，取值如下：1：支付成功 2：退款成功
 	if req.OrderNotification.NotificationType != 1 {
 		// 非支付成功直接返回
@@ -363,6 +377,20 @@ func (l *NotifyHuaweiLogic) handleHuaweiOrder(req *types.HuaweiReq, logId int) e
 		return err
 	}
 
+	// redis 并发控制，华为重推同一订单时串行处理，避免重复发货
+	concurrentKey, value := fmt.Sprintf(redisHuaweiOrderLockKey, purchaseToken), uuid.New().String()
+	isLock, err := l.Rdb.TryLockWithTimeout(context.Background(), concurrentKey, value, huaweiOrderLockMs)
+	if err != nil || !isLock {
+		err = fmt.Errorf("redis lock fail, err:%v, isLock:%v, key:%v", err, isLock, concurrentKey)
+		l.Slowf(err.Error())
+		return err
+	}
+	defer func() {
+		if unlockErr := l.Rdb.Unlock(context.Background(), concurrentKey, value); unlockErr != nil {
+			l.Slowf("redis unlock fail, key:%s, value:%s", concurrentKey, value)
+		}
+	}()
+
 	// 根据购买token查找订单数据
 	hworder, err := l.huaweiOrderModel.GetOneByTokenAndSubId(purchaseToken, "", "", "")
 	if err != nil {
@@ -376,11 +404,18 @@ func (l *NotifyHuaweiLogic) handleHuaweiOrder(req *types.HuaweiReq, logId int) e
 		return err
 	}
 
-	// 购买型只处理一次
-	if hworder.Status != 0 {
-		// 订单已处理
-		l.Slowf("订单已处理 purchase_token: %s, order id: %d", purchaseToken, hworder.Id)
-		return nil
+	// 购买型只处理一次：已支付待发货的重试发货，已发货未确认购买的重试确认
+	if hworder.Status != model.HuaweiOrderStatusNoPay {
+		if hworder.Status == model.HuaweiOrderStatusPaid && hworder.DeliverStatus == model.HuaweiOrderDeliverStatusDelivered &&
+			hworder.ConfirmStatus == model.HuaweiOrderConfirmStatusNo {
+			return l.confirmHuaweiPurchase(hwApp, hworder)
+		}
+
+		if hworder.Status != model.HuaweiOrderStatusPaid || hworder.DeliverStatus != model.HuaweiOrderDeliverStatusPending {
+			// 订单已处理
+			l.Slowf("订单已处理 purchase_token: %s, order id: %d", purchaseToken, hworder.Id)
+			return nil
+		}
 	}
 
 	// 验证数据
@@ -396,53 +431,130 @@ func (l *NotifyHuaweiLogic) handleHuaweiOrder(req *types.HuaweiReq, logId int) e
 		return err
 	}
 
-	// 更新数据
-	var purchaseTime string
-	if req.NotifyTime > 1000 {
-		purchaseTime = time.Unix(int64(req.NotifyTime/1000), 0).Format("2006-01-02 15:04:05")
+	// 验证购买token
+	authHeaderString, err := huawei.NewClient(l.ctx, define.DbPayGateway, hwApp.ClientId, hwApp.ClientSecret, hwApp.AppSecret).BuildAuthorization()
+	if err != nil {
+		l.Errorf("华为BuildAuthorization失败 error: %v, appId: %s", err, hwApp.AppID)
+		return err
 	}
-	updateData := map[string]interface{}{
-		"log_id":            logId,
-		"version":           req.Version,
-		"event_type":        req.EventType,
-		"notify_time":       int(req.NotifyTime / 1000), // 毫秒转成秒级时间戳
-		"notification_type": req.OrderNotification.NotificationType,
-		"environment":       "",
-		"status":            1,
-		"pay_time":          purchaseTime,
+
+	verifyResp, err := huawei.OrderDemo.VerifyToken(authHeaderString, purchaseToken, hworder.ProductId)
+	if err != nil {
+		l.Errorf("华为验证购买token失败 error: %v, token: %s", err, purchaseToken)
+		return err
 	}
-	err = l.huaweiOrderModel.UpdateData(hworder.Id, updateData)
+
+	purchaseData, err := verifyResp.GetPurchaseData()
 	if err != nil {
+		l.Errorf("json.Unmarshal error: %v, raw string: %s", err, verifyResp.PurchaseTokenData)
 		return err
 	}
+	l.Sloww("华为验证购买token结果", logx.Field("purchaseData", purchaseData))
 
-	// 这里直接填线上地址
-	tmpNotifyUrl := "http://quick4-go-pre.muchcloud.com/ver/user/notifyUser"
+	// purchaseState 订单交易状态。-1：初始化 0：已购买 1：已取消 2：已退款 3：待处理
+	if purchaseData.PurchaseState != 0 {
+		l.Errorw("订单未完成购买，不发货", logx.Field("purchaseData", purchaseData), logx.Field("order id", hworder.Id))
+		return nil
+	}
 
-	// 回调业务方接口 使用回调来 提供服务 回调才是处理实际逻辑的地方
-	// 成功操作时 异步回调 把订单号、通知类型、用户id、包名返回
-	if hworder.AppNotifyUrl != "" {
-		tmpNotifyUrl = hworder.AppNotifyUrl
+	if purchaseData.ProductId != hworder.ProductId {
+		err = errors.New("验证结果商品id不一致")
+		l.Errorf(err.Error()+" 数据库商品id: %s, 验证结果商品id: %s", hworder.ProductId, purchaseData.ProductId)
+		return err
 	}
 
-	go util.SafeRun(func() {
-		// 回调数据
-		callbackData := map[string]interface{}{
-			"notify_type":  code.APP_NOTIFY_HUAWEI_PRODUCT_BUY,
-			"user_id":      hworder.UserId,
-			"out_trade_no": hworder.OutTradeNo,
-			"pkg":          hworder.AppPkg,
+	if hworder.Amount > 0 && purchaseData.Price != int64(hworder.Amount) {
+		err = errors.New("验证结果金额不一致")
+		l.Errorf(err.Error()+" 数据库金额: %d, 验证结果金额: %d", hworder.Amount, purchaseData.Price)
+		return err
+	}
+
+	// 先置为已支付待发货，再同步回调业务方发货，发货成功之后才能确认购买
+	if hworder.Status == model.HuaweiOrderStatusNoPay {
+		var purchaseTime string
+		if purchaseData.PurchaseTime > 1000 {
+			purchaseTime = time.Unix(purchaseData.PurchaseTime/1000, 0).Format("2006-01-02 15:04:05")
+		} else if req.NotifyTime > 1000 {
+			purchaseTime = time.Unix(int64(req.NotifyTime/1000), 0).Format("2006-01-02 15:04:05")
 		}
 
-		headerMap := map[string]string{
-			"App-Origin": hworder.AppPkg,
+		environment := "prod"
+		if verifyResp.IsSandbox() {
+			environment = "sandbox"
 		}
-		l.Sloww("华为回调app数据", logx.Field("call back data", callbackData))
-		tmpErr := utils.CallbackWithRetry(tmpNotifyUrl, headerMap, callbackData, 5*time.Second)
-		if tmpErr != nil {
-			l.Errorf("callback error: %v, call back data: %v", tmpErr, callbackData)
+
+		updateData := map[string]interface{}{
+			"log_id":            logId,
+			"version":           req.Version,
+			"event_type":        req.EventType,
+			"notify_time":       int(req.NotifyTime / 1000), // 毫秒转成秒级时间戳
+			"notification_type": req.OrderNotification.NotificationType,
+			"environment":       environment,
+			"pay_order_id":      purchaseData.OrderId,
+			"platform_trade_no": purchaseData.PayOrderId,
+			"status":            model.HuaweiOrderStatusPaid,
+			"deliver_status":    model.HuaweiOrderDeliverStatusPending,
+			"pay_time":          purchaseTime,
 		}
-	})
+		isUpdate, err := l.huaweiOrderModel.PayOrder(hworder.Id, updateData)
+		if err != nil {
+			return err
+		}
+		if !isUpdate {
+			l.Slowf("订单已处理 purchase_token: %s, order id: %d", purchaseToken, hworder.Id)
+			return nil
+		}
+	}
 
-	return nil
+	tmpNotifyUrl := huawei.DefaultNotifyUrl
+	if hworder.AppNotifyUrl != "" {
+		tmpNotifyUrl = hworder.AppNotifyUrl
+	}
+
+	callbackData := map[string]interface{}{
+		"notify_type":       code.APP_NOTIFY_HUAWEI_PRODUCT_BUY,
+		"user_id":           hworder.UserId,
+		"out_trade_no":      hworder.OutTradeNo,
+		"pkg":               hworder.AppPkg,
+		"product_id":        hworder.ProductId,
+		"platform_trade_no": purchaseData.PayOrderId, // 华为返回的交易单号
+	}
+	headerMap := map[string]string{
+		"App-Origin": hworder.AppPkg,
+	}
+	l.Sloww("华为回调app数据", logx.Field("call back data", callbackData))
+	err = utils.CallbackWithRetry(tmpNotifyUrl, headerMap, callbackData, 5*time.Second)
+	if err != nil {
+		// 发货失败 订单保持待发货，返回错误让华为重推
+		l.Errorf("callback error: %v, call back data: %v", err, callbackData)
+		return err
+	}
+
+	if err = l.huaweiOrderModel.MarkDelivered(hworder.Id); err != nil {
+		return err
+	}
+
+	if purchaseData.ConsumptionState == 1 {
+		// 已经消耗过 无需再确认
+		return l.huaweiOrderModel.UpdateData(hworder.Id, map[string]interface{}{"confirm_status": model.HuaweiOrderConfirmStatusYes})
+	}
+
+	return l.confirmHuaweiPurchase(hwApp, hworder)
+}
+
+// 确认购买（即消耗），成功后更新确认状态
+func (l *NotifyHuaweiLogic) confirmHuaweiPurchase(hwApp *model.HuaweiAppTable, hworder *model.HuaweiOrderTable) error {
+	authHeaderString, err := huawei.NewClient(l.ctx, define.DbPayGateway, hwApp.ClientId, hwApp.ClientSecret, hwApp.AppSecret).BuildAuthorization()
+	if err != nil {
+		l.Errorf("华为BuildAuthorization失败 error: %v, appId: %s", err, hwApp.AppID)
+		return err
+	}
+
+	_, err = huawei.OrderDemo.ConfirmPurchaseWithRetry(authHeaderString, hworder.PurchaseToken, hworder.ProductId, 3)
+	if err != nil {
+		l.Errorf("华为确认购买失败 error: %v, order id: %d", err, hworder.Id)
+		return err
+	}
+
+	return l.huaweiOrderModel.UpdateData(hworder.Id, map[string]interface{}{"confirm_status": model.HuaweiOrderConfirmStatusYes})
 }
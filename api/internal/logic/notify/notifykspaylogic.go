@@ -41,7 +41,7 @@ var (
 )
 
 // 快手订单支付回调 https://open.kuaishou.com/docs/develop/server/epay/open-api-new/prePay-new.html
-type ksOrderNotifyData struct {
+type KsOrderNotifyData struct {
 	Data struct {
 		Channel         string `json:"channel"`          //支付渠道。取值：UNKNOWN - 未知｜WECHAT-微信｜ALIPAY-支付宝
 		OutOrderNo      string `json:"out_order_no"`     //商户系统内部订单号
@@ -143,7 +143,7 @@ func (l *NotifyKspayLogic) NotifyKspay(r *http.Request, w http.ResponseWriter) (
 
 // OrderNotify 快手支付回调
 func (l *NotifyKspayLogic) OrderNotify(bodyData string, w http.ResponseWriter) (resp *types.EmptyReq, err error) {
-	notifyData := new(ksOrderNotifyData)
+	notifyData := new(KsOrderNotifyData)
 	err = jsoniter.UnmarshalFromString(bodyData, notifyData)
 	if err != nil {
 		logx.Errorf("NotifyKspay 快手支付回调失败 err: %v", err)
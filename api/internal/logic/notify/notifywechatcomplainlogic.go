@@ -0,0 +1,81 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type NotifyWechatComplainLogic struct {
+	logx.Logger
+	ctx                  context.Context
+	svcCtx               *svc.ServiceContext
+	payConfigWechatModel *model.PmPayConfigWechatModel
+}
+
+func NewNotifyWechatComplainLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NotifyWechatComplainLogic {
+	return &NotifyWechatComplainLogic{
+		Logger:               logx.WithContext(ctx),
+		ctx:                  ctx,
+		svcCtx:               svcCtx,
+		payConfigWechatModel: model.NewPmPayConfigWechatModel(define.DbPayGateway),
+	}
+}
+
+// 微信消费者投诉通知，通知只有投诉单号，查询投诉详情后保存，处理失败返回错误，微信会重试通知
+func (l *NotifyWechatComplainLogic) NotifyWechatComplain(request *http.Request) (resp *types.WeChatResp, err error) {
+	var req types.WechatComplainNotifyReq
+	err = httpx.ParsePath(request, &req)
+	if err != nil {
+		err = fmt.Errorf("解析path失败！err=%v ", err)
+		l.Errorf(err.Error())
+		return
+	}
+
+	payCfg, err := l.payConfigWechatModel.GetOneByAppID(req.AppID)
+	if err != nil {
+		err = fmt.Errorf("appid= %s, 读取微信支付配置失败，err:=%v", req.AppID, err)
+		util.CheckError(err.Error())
+		return
+	}
+
+	wxCli := client.NewWeChatCommPay(*payCfg.TransClientConfig())
+	complaintNotify, err := wxCli.ComplaintNotify(request)
+	if err != nil {
+		err = fmt.Errorf("解析及验证内容失败！err=%v ", err)
+		l.Errorf(err.Error())
+		return
+	}
+
+	complaint, err := wxCli.ComplaintDetail(complaintNotify.ComplaintId)
+	if err != nil {
+		err = fmt.Errorf("查询微信投诉详情失败 complaintId: %s, err: %v", complaintNotify.ComplaintId, err)
+		l.Errorf(err.Error())
+		return
+	}
+
+	err = clientMgr.SaveWechatComplaint(payCfg.AppID, complaint)
+	if err != nil {
+		err = fmt.Errorf("保存微信投诉失败 complaintId: %s, err: %v", complaint.ComplaintId, err)
+		util.CheckError(err.Error())
+		return
+	}
+
+	resp = &types.WeChatResp{
+		Code:    "SUCCESS",
+		Message: "",
+	}
+	return
+}
@@ -0,0 +1,77 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type NotifyWechatTransferLogic struct {
+	logx.Logger
+	ctx                  context.Context
+	svcCtx               *svc.ServiceContext
+	payConfigWechatModel *model.PmPayConfigWechatModel
+}
+
+func NewNotifyWechatTransferLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NotifyWechatTransferLogic {
+	return &NotifyWechatTransferLogic{
+		Logger:               logx.WithContext(ctx),
+		ctx:                  ctx,
+		svcCtx:               svcCtx,
+		payConfigWechatModel: model.NewPmPayConfigWechatModel(define.DbPayGateway),
+	}
+}
+
+// 微信商家转账结果回调，处理失败返回错误，微信会重试通知
+func (l *NotifyWechatTransferLogic) NotifyWechatTransfer(request *http.Request) (resp *types.WeChatResp, err error) {
+	var req types.WechatTransferNotifyReq
+	err = httpx.ParsePath(request, &req)
+	if err != nil {
+		err = fmt.Errorf("解析path失败！err=%v ", err)
+		l.Errorf(err.Error())
+		return
+	}
+
+	payCfg, err := l.payConfigWechatModel.GetOneByAppID(req.AppID)
+	if err != nil {
+		err = fmt.Errorf("appid= %s, 读取微信支付配置失败，err:=%v", req.AppID, err)
+		util.CheckError(err.Error())
+		return
+	}
+
+	wxCli := client.NewWeChatCommPay(*payCfg.TransClientConfig())
+	bill, err := wxCli.TransferBillNotify(request)
+	if err != nil {
+		err = fmt.Errorf("解析及验证内容失败！err=%v ", err)
+		l.Errorf(err.Error())
+		return
+	}
+
+	orderInfo, err := clientMgr.WechatFundTransNotify(bill)
+	if err != nil {
+		err = fmt.Errorf("微信转账回调处理失败 outBillNo: %s, err: %v", bill.OutBillNo, err)
+		util.CheckError(err.Error())
+		return
+	}
+	if orderInfo.Status == model.PmFundTransOrderStatusFail {
+		l.Errorf("微信转账失败 orderSn: %s, pkgName: %s, reason: %s", orderInfo.OrderSn, orderInfo.AppPkgName, orderInfo.FailReason)
+	}
+
+	resp = &types.WeChatResp{
+		Code:    "SUCCESS",
+		Message: "",
+	}
+	return
+}
@@ -0,0 +1,241 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/thirdApis"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 推送时间与当前时间相差超过该值的视为重放，不处理
+const wechatXPayNotifyMaxDelay = 5 * time.Minute
+
+// 查单结果中视为已支付的状态 2-已支付待发货 3-发货中 4-已发货
+var wechatXPayPaidStatus = map[int]bool{2: true, 3: true, 4: true}
+
+type NotifyWechatXPayLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+
+	payOrderModel        *model.PmPayOrderModel
+	payConfigWechatModel *model.PmPayConfigWechatModel
+
+	Rdb *cache.RedisInstance
+}
+
+func NewNotifyWechatXPayLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NotifyWechatXPayLogic {
+	return &NotifyWechatXPayLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+
+		payOrderModel:        model.NewPmPayOrderModel(define.DbPayGateway),
+		payConfigWechatModel: model.NewPmPayConfigWechatModel(define.DbPayGateway),
+		Rdb:                  db.WithRedisDBContext(define.DbPayGateway),
+	}
+}
+
+// 消息推送配置时的服务器地址校验，校验通过后原样返回echostr
+func (l *NotifyWechatXPayLogic) NotifyWechatXPayCheck(req *types.WechatXPayNotifyReq) (echostr string, err error) {
+	payCfg, err := l.payConfigWechatModel.GetOneByAppID(req.AppID)
+	if err != nil || payCfg.XPayMsgToken == "" {
+		err = fmt.Errorf("微信虚拟支付消息校验 读取微信支付配置失败 appId: %s, err: %v", req.AppID, err)
+		util.CheckError(err.Error())
+		return
+	}
+
+	if !thirdApis.WechatXPayApi.CheckPushSignature(payCfg.XPayMsgToken, req.Signature, req.Timestamp, req.Nonce) {
+		err = fmt.Errorf("微信虚拟支付消息校验 签名错误 appId: %s", req.AppID)
+		l.Errorf(err.Error())
+		return
+	}
+
+	return req.Echostr, nil
+}
+
+// 微信虚拟支付 道具发货/代币支付推送
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/virtual-payment.html
+func (l *NotifyWechatXPayLogic) NotifyWechatXPay(request *http.Request) (resp *types.WechatXPayResp, err error) {
+	var req types.WechatXPayNotifyReq
+	if err = httpx.ParsePath(request, &req); err != nil {
+		err = fmt.Errorf("解析path失败！err=%v ", err)
+		l.Errorf(err.Error())
+		return
+	}
+	if err = httpx.ParseForm(request, &req); err != nil {
+		err = fmt.Errorf("解析query失败！err=%v ", err)
+		l.Errorf(err.Error())
+		return
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		l.Errorf("NotifyWechatXPay, read from body fail, err:%v", err)
+		return
+	}
+
+	l.Slowf("NotifyWechatXPay appId:%s, body:%s", req.AppID, string(body))
+
+	payCfg, err := l.payConfigWechatModel.GetOneByAppID(req.AppID)
+	if err != nil || payCfg.XPayMsgToken == "" {
+		err = fmt.Errorf("微信虚拟支付回调 读取微信支付配置失败 appId: %s, err: %v", req.AppID, err)
+		util.CheckError(err.Error())
+		DingdingNotify(l.ctx, err.Error())
+		return
+	}
+
+	if !thirdApis.WechatXPayApi.CheckPushSignature(payCfg.XPayMsgToken, req.Signature, req.Timestamp, req.Nonce) {
+		err = fmt.Errorf("微信虚拟支付回调 签名错误 appId: %s", req.AppID)
+		l.Errorf(err.Error())
+		return
+	}
+
+	// 签名只覆盖token、timestamp、nonce，拒绝过期的推送防止重放
+	pushTime, _ := strconv.ParseInt(req.Timestamp, 10, 64)
+	if delay := time.Since(time.Unix(pushTime, 0)); delay > wechatXPayNotifyMaxDelay || delay < -wechatXPayNotifyMaxDelay {
+		err = fmt.Errorf("微信虚拟支付回调 推送已过期 appId: %s, timestamp: %s", req.AppID, req.Timestamp)
+		l.Errorf(err.Error())
+		return
+	}
+
+	var msg thirdApis.XPayNotifyMsg
+	if err = json.Unmarshal(body, &msg); err != nil {
+		err = fmt.Errorf("微信虚拟支付回调 解析消息失败 appId: %s, err: %v", req.AppID, err)
+		l.Errorf(err.Error())
+		return
+	}
+
+	var actualPrice int64
+	switch msg.Event {
+	case thirdApis.XPayEventGoodsDeliver:
+		actualPrice = msg.GoodsInfo.ActualPrice
+	case thirdApis.XPayEventCoinPay:
+		actualPrice = msg.CoinInfo.ActualPrice
+	default:
+		// 其他事件不处理，直接返回成功
+		l.Slowf("NotifyWechatXPay 忽略事件 event:%s, appId:%s", msg.Event, req.AppID)
+		return &types.WechatXPayResp{ErrCode: 0, ErrMsg: "success"}, nil
+	}
+
+	// redis 并发控制
+	concurrentKey, value := fmt.Sprintf("payGateway:paymentNotify:wechatXPay:%s", msg.OutTradeNo), uuid.New().String()
+	isLock, err := l.Rdb.TryLockWithTimeout(context.Background(), concurrentKey, value, 1000)
+	if err != nil || !isLock {
+		err = fmt.Errorf("redis lock fail, err:%v, isLock:%v, key:%v", err, isLock, concurrentKey)
+		l.Slowf(err.Error())
+		return
+	}
+
+	defer func() {
+		unlockErr := l.Rdb.Unlock(context.Background(), concurrentKey, value)
+		if unlockErr != nil {
+			l.Slowf("redis unlock fail, key:%s, value:%s", concurrentKey, value)
+		}
+	}()
+
+	orderInfo, err := l.payOrderModel.GetOneByOrderSnAndAppId(msg.OutTradeNo, req.AppID)
+	if err != nil || orderInfo == nil || orderInfo.ID < 1 {
+		err = fmt.Errorf("微信虚拟支付回调 获取订单失败 err:%v, order_code:%s, appId:%s", err, msg.OutTradeNo, req.AppID)
+		util.CheckError(err.Error())
+		DingdingNotify(l.ctx, err.Error())
+		return
+	}
+
+	if orderInfo.PayStatus != model.PmPayOrderTablePayStatusNo {
+		// 重复推送直接返回成功，避免微信侧重试
+		notifyOrderHasDispose.CounterInc()
+		return &types.WechatXPayResp{ErrCode: 0, ErrMsg: "success"}, nil
+	}
+
+	// 实付金额与订单金额不一致的不置为已支付，返回失败由微信重试，同时告警人工核对
+	if actualPrice != int64(orderInfo.Amount) {
+		err = fmt.Errorf("微信虚拟支付回调 金额不一致 orderSn: %s, amount: %d, actualPrice: %d", orderInfo.OrderSn, orderInfo.Amount, actualPrice)
+		util.CheckError(err.Error())
+		DingdingNotify(l.ctx, err.Error())
+		return
+	}
+
+	// 签名不覆盖消息体，以微信查单结果为准
+	wechatOrder, err := l.queryPaidOrder(req.AppID, payCfg.XPayAppKey, &msg, orderInfo)
+	if err != nil {
+		util.CheckError(err.Error())
+		DingdingNotify(l.ctx, err.Error())
+		return
+	}
+
+	//修改数据库
+	orderInfo.NotifyAmount = wechatOrder.PaidFee
+	orderInfo.PayStatus = model.PmPayOrderTablePayStatusPaid
+	orderInfo.ThirdOrderNo = wechatOrder.WxPayOrderId
+	err = l.payOrderModel.UpdateNotify(orderInfo)
+	if err != nil {
+		err = fmt.Errorf("微信虚拟支付回调 orderSn: %s UpdateNotify err: %v", orderInfo.OrderSn, err)
+		util.CheckError(err.Error())
+		DingdingNotify(l.ctx, err.Error())
+		return
+	}
+
+	//回调业务方接口
+	go func() {
+		defer exception.Recover()
+		headerMap := map[string]string{
+			"App-Origin": orderInfo.AppPkgName,
+		}
+		_, err := util.HttpPostWithHeader(orderInfo.NotifyUrl, msg, headerMap, 5*time.Second)
+		if err != nil {
+			util.CheckError("NotifyWechatXPay call business failed orderSn = %s, err:=%v", orderInfo.OrderSn, err)
+			CallbackBizFailNum.CounterInc()
+		}
+	}()
+
+	resp = &types.WechatXPayResp{
+		ErrCode: 0,
+		ErrMsg:  "success",
+	}
+	return
+}
+
+// 向微信查单，确认订单已支付且实付金额与订单金额一致
+func (l *NotifyWechatXPayLogic) queryPaidOrder(appId, appKey string, msg *thirdApis.XPayNotifyMsg, orderInfo *model.PmPayOrderTable) (*thirdApis.XPayOrderItem, error) {
+	token, err := l.svcCtx.BaseAppConfigServerApi.GetWxAccessToken(l.ctx, appId)
+	if err != nil {
+		return nil, fmt.Errorf("微信虚拟支付回调 获取accessToken失败 orderSn: %s, appId: %s, err: %v", orderInfo.OrderSn, appId, err)
+	}
+
+	param := &thirdApis.XPayQueryOrderParam{
+		OpenId:  msg.OpenId,
+		OrderId: orderInfo.OrderSn,
+	}
+	res, err := thirdApis.WechatXPayApi.QueryOrder(param, appKey, token)
+	if err != nil {
+		return nil, fmt.Errorf("微信虚拟支付回调 查单失败 orderSn: %s, appId: %s, err: %v", orderInfo.OrderSn, appId, err)
+	}
+	if res.ErrCode != 0 {
+		return nil, fmt.Errorf("微信虚拟支付回调 查单失败 orderSn: %s, appId: %s, errcode[%d], errmsg[%s]", orderInfo.OrderSn, appId, res.ErrCode, res.ErrMsg)
+	}
+
+	if res.Order.OrderId != orderInfo.OrderSn || !wechatXPayPaidStatus[res.Order.Status] || res.Order.PaidFee != orderInfo.Amount {
+		return nil, fmt.Errorf("微信虚拟支付回调 查单结果与订单不符 orderSn: %s, amount: %d, wechatOrderId: %s, status: %d, paidFee: %d",
+			orderInfo.OrderSn, orderInfo.Amount, res.Order.OrderId, res.Order.Status, res.Order.PaidFee)
+	}
+	return &res.Order, nil
+}
@@ -0,0 +1,259 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/crontab"
+	crontabLogic "gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/logic/crontab"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/types"
+)
+
+// 任务名
+const (
+	JobPayOrder                = "payOrder"
+	JobPayRetryOrder           = "payRetryOrder"
+	JobSupplementaryOrders     = "supplementaryOrders"
+	JobSupplementaryDayOrders  = "supplementaryDayOrders"
+	JobHuaweiConfirmPurchase   = "huaweiConfirmPurchase"
+	JobHuaweiCancelledPurchase = "huaweiCancelledPurchase"
+	JobAlipayFundTransSettle   = "alipayFundTransSettle"
+	JobAlipayComplainSync      = "alipayComplainSync"
+	JobWechatComplainSync      = "wechatComplainSync"
+	JobAlipayAgreementCheck    = "alipayAgreementCheck"
+	JobDyPeriodDeduct          = "dyPeriodDeduct"
+	JobDyPeriodSignCheck       = "dyPeriodSignCheck"
+	JobSubscribeRemind         = "subscribeRemind"
+	JobDyRefundAuditRecover    = "dyRefundAuditRecover"
+)
+
+// crontab接口返回错误码时转为错误
+func crontabErr(errNo int, errTips string) error {
+	if errNo != 0 {
+		return errors.New(errTips)
+	}
+	return nil
+}
+
+// NewJobs 所有定时任务，手动触发的参数和对应/crontab接口的参数一致
+func NewJobs(c *crontab.CrontabOrder, svcCtx *svc.ServiceContext) []*Job {
+	return []*Job{
+		{
+			Name:        JobPayOrder,
+			Desc:        "支付宝订阅续费扣款",
+			DefaultSpec: "0 0 11 * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				return deductResult(c.PayOrder(ctx, token))
+			},
+		},
+		{
+			Name:        JobPayRetryOrder,
+			Desc:        "支付宝订阅续费失败重试，11点由payOrder统一扣款",
+			DefaultSpec: "0 0 0-10,12-23 * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				return deductResult(c.PayRetryOrder(ctx, token))
+			},
+		},
+		{
+			Name:        JobDyPeriodDeduct,
+			Desc:        "抖音周期代扣扣款及失败重试，只扣DyPeriodDeduct.AppPkgs配置的包名",
+			DefaultSpec: "0 0 * * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				return deductResult(c.DyPeriodDeduct(ctx, token))
+			},
+		},
+		{
+			Name:          JobSupplementaryOrders,
+			Desc:          "微信、抖音、快手、支付宝订单及抖音周期代扣补单，定时执行补最近10分钟的订单",
+			Params:        "type: lastDay|lastTenMinute, startMinute, endMinute, isNotice",
+			DefaultSpec:   "0 */5 * * * ?",
+			DefaultParams: map[string]string{"type": "lastTenMinute"},
+			Fn:            supplementaryOrdersFn(svcCtx),
+		},
+		{
+			Name:          JobSupplementaryDayOrders,
+			Desc:          "补前一自然日的订单，有补单时告警",
+			Params:        "type: lastDay|lastTenMinute, startMinute, endMinute, isNotice",
+			DefaultSpec:   "0 30 0 * * ?",
+			DefaultParams: map[string]string{"type": "lastDay", "isNotice": "1"},
+			Fn:            supplementaryOrdersFn(svcCtx),
+		},
+		{
+			Name:        JobHuaweiConfirmPurchase,
+			Desc:        "华为一次性商品确认发货重试",
+			Params:      "days: 默认3, limit: 默认200",
+			DefaultSpec: "0 */10 * * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				var req types.HuaweiConfirmPurchaseReq
+				if err := ParseParams(params, &req); err != nil {
+					return nil, err
+				}
+				resp, err := crontabLogic.NewHuaweiConfirmPurchaseLogic(ctx, svcCtx).HuaweiConfirmPurchase(&req)
+				if err != nil {
+					return nil, err
+				}
+				return nil, crontabErr(resp.ErrNo, resp.ErrTips)
+			},
+		},
+		{
+			Name:        JobHuaweiCancelledPurchase,
+			Desc:        "华为退款对账，先重试回调业务方失败的订单",
+			Params:      "days: 默认2, maxRows: 默认100, appId: 为空处理全部",
+			DefaultSpec: "0 0 4 * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				var req types.HuaweiCancelledPurchaseReq
+				if err := ParseParams(params, &req); err != nil {
+					return nil, err
+				}
+				resp, err := crontabLogic.NewHuaweiCancelledPurchaseLogic(ctx, svcCtx).HuaweiCancelledPurchase(&req)
+				if err != nil {
+					return nil, err
+				}
+				return nil, crontabErr(resp.ErrNo, resp.ErrTips)
+			},
+		},
+		{
+			Name:        JobAlipayFundTransSettle,
+			Desc:        "支付宝转账状态补偿",
+			Params:      "days: 默认3, minutes: 默认5, limit: 默认500",
+			DefaultSpec: "0 */5 * * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				var req types.AlipayFundTransSettleReq
+				if err := ParseParams(params, &req); err != nil {
+					return nil, err
+				}
+				resp, err := crontabLogic.NewAlipayFundTransSettleLogic(ctx, svcCtx).AlipayFundTransSettle(&req)
+				if err != nil {
+					return nil, err
+				}
+				return nil, crontabErr(resp.ErrNo, resp.ErrTips)
+			},
+		},
+		{
+			Name:        JobAlipayComplainSync,
+			Desc:        "同步支付宝交易投诉",
+			Params:      "days: 默认30",
+			DefaultSpec: "0 15 * * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				var req types.AlipayComplainSyncReq
+				if err := ParseParams(params, &req); err != nil {
+					return nil, err
+				}
+				resp, err := crontabLogic.NewAlipayComplainSyncLogic(ctx, svcCtx).AlipayComplainSync(&req)
+				if err != nil {
+					return nil, err
+				}
+				return nil, crontabErr(resp.ErrNo, resp.ErrTips)
+			},
+		},
+		{
+			Name:        JobWechatComplainSync,
+			Desc:        "同步微信消费者投诉",
+			Params:      "days: 默认7",
+			DefaultSpec: "0 45 * * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				var req types.WechatComplainSyncReq
+				if err := ParseParams(params, &req); err != nil {
+					return nil, err
+				}
+				resp, err := crontabLogic.NewWechatComplainSyncLogic(ctx, svcCtx).WechatComplainSync(&req)
+				if err != nil {
+					return nil, err
+				}
+				return nil, crontabErr(resp.ErrNo, resp.ErrTips)
+			},
+		},
+		{
+			Name:        JobAlipayAgreementCheck,
+			Desc:        "支付宝周期扣款协议状态对账",
+			Params:      "pay_app_id: 为空对账全部",
+			DefaultSpec: "0 0 3 * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				var req types.AlipayAgreementCheckReq
+				if err := ParseParams(params, &req); err != nil {
+					return nil, err
+				}
+				resp, err := crontabLogic.NewAlipayAgreementCheckLogic(ctx, svcCtx).AlipayAgreementCheck(&req)
+				if err != nil {
+					return nil, err
+				}
+				return nil, crontabErr(resp.ErrNo, resp.ErrTips)
+			},
+		},
+		{
+			Name:        JobDyPeriodSignCheck,
+			Desc:        "抖音周期代扣签约单状态对账",
+			Params:      "pay_app_id: 为空对账全部",
+			DefaultSpec: "0 30 3 * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				var req types.DyPeriodSignCheckReq
+				if err := ParseParams(params, &req); err != nil {
+					return nil, err
+				}
+				checkNum, unsignNum, err := crontabLogic.NewDyPeriodSignCheckLogic(ctx, svcCtx).DyPeriodSignCheck(&req, func() bool {
+					return c.Leader.CheckToken(token)
+				})
+				// 成功数为发现的已解约或到期数
+				return &Result{Total: checkNum, Success: unsignNum}, err
+			},
+		},
+		{
+			Name:        JobSubscribeRemind,
+			Desc:        "支付宝、抖音续费扣款前提醒，提前天数按包名配置",
+			Params:      "app_pkg: 为空处理全部",
+			DefaultSpec: "0 0 10 * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				var req types.SubscribeRemindReq
+				if err := ParseParams(params, &req); err != nil {
+					return nil, err
+				}
+				resp, err := crontabLogic.NewSubscribeRemindLogic(ctx, svcCtx).SubscribeRemind(&req)
+				if err != nil {
+					return nil, err
+				}
+				return nil, crontabErr(resp.ErrNo, resp.ErrTips)
+			},
+		},
+		{
+			Name:        JobDyRefundAuditRecover,
+			Desc:        "抖音退款自动审核中断后重新审核，已过审核截止时间的置为超时",
+			DefaultSpec: "0 */5 * * * ?",
+			Fn: func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+				total, success, err := crontabLogic.NewDyRefundAuditRecoverLogic(ctx, svcCtx).DyRefundAuditRecover()
+				return &Result{Total: total, Success: success, Fail: total - success}, err
+			},
+		},
+	}
+}
+
+// 补单任务，最近10分钟和前一自然日的补单共用，按type区分
+func supplementaryOrdersFn(svcCtx *svc.ServiceContext) JobFunc {
+	return func(ctx context.Context, token int64, params map[string]string) (*Result, error) {
+		var req types.SupplementaryOrdersReq
+		if err := ParseParams(params, &req); err != nil {
+			return nil, err
+		}
+		summary, err := crontabLogic.NewSupplementaryOrdersLogic(ctx, svcCtx).Run(ctx, &req)
+		if summary == nil {
+			return nil, err
+		}
+		return &Result{Total: summary.Total, Success: summary.Success, Fail: summary.Fail}, err
+	}
+}
+
+// 续费扣款汇总转为任务结果，没有可扣款订单时为空
+func deductResult(summary *crontab.DeductSummary) (*Result, error) {
+	if summary == nil {
+		return nil, nil
+	}
+	result := &Result{
+		Total:   summary.Total,
+		Success: summary.Success,
+		Fail:    summary.Fail,
+	}
+	if summary.Aborted {
+		return result, errors.New("续费扣款中止，下次执行从断点继续")
+	}
+	return result, nil
+}
@@ -0,0 +1,347 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/mapping"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/config"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/leader"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+)
+
+// 定时任务调度
+//
+// 所有节点都加载同样的任务，只有leader节点执行；定时触发和手动触发都会写pm_job_run执行记录
+// 手动触发先写一条待执行记录，由leader轮询领取执行，所以请求落到任意节点都可以
+// 同一任务同时只执行一次（redis锁），超时后执行记录标记为超时，任务本身需要通过ctx感知超时
+// 任务锁在执行期间持续续期，直到任务真正返回才释放，超时后仍在执行的任务不会被再次启动
+
+var (
+	jobRunFailNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "jobRunFailNum", nil, "定时任务执行失败", nil})}
+)
+
+const (
+	redisJobRunningKey = "payGateway:job:running:%s" // %s:任务名
+
+	pendingPollInterval = 5 * time.Second // 手动触发任务轮询间隔
+	pendingPollLimit    = 20
+	defaultJobTimeout   = 3600  // 秒
+	jobLockTtlMs        = 60000 // 任务锁有效期，执行期间每20秒续期一次，节点宕机后1分钟释放
+)
+
+var (
+	ErrJobNotFound = errors.New("任务不存在")
+	ErrJobRunning  = errors.New("任务正在执行中")
+)
+
+// 任务执行结果
+type Result struct {
+	Total   int64
+	Success int64
+	Fail    int64
+}
+
+// 任务执行函数，params为手动触发时传入的参数，定时触发时为任务的DefaultParams；token为leader fencing token
+type JobFunc func(ctx context.Context, token int64, params map[string]string) (*Result, error)
+
+type Job struct {
+	Name          string            // 任务名
+	Desc          string            // 任务描述
+	Params        string            // 参数说明
+	DefaultSpec   string            // 默认cron表达式，为空时只能手动触发
+	DefaultParams map[string]string // 定时触发时的参数
+	Fn            JobFunc           // 执行函数
+
+	spec    string
+	timeout time.Duration
+}
+
+func (j *Job) Spec() string {
+	return j.spec
+}
+
+func (j *Job) Timeout() time.Duration {
+	return j.timeout
+}
+
+type Scheduler struct {
+	elector  *leader.Elector
+	jobModel *model.PmJobModel
+
+	mu      sync.Mutex
+	jobs    map[string]*Job
+	running map[string]bool // 本节点正在执行的任务
+}
+
+var defaultScheduler *Scheduler
+
+// InitScheduler 按配置加载任务并启动调度
+func InitScheduler(c *config.Config, elector *leader.Elector, jobs []*Job) {
+	s := &Scheduler{
+		elector:  elector,
+		jobModel: model.NewPmJobModel(define.DbPayGateway),
+		jobs:     make(map[string]*Job, len(jobs)),
+		running:  make(map[string]bool),
+	}
+
+	jobConfMap := make(map[string]config.JobConf, len(c.Jobs))
+	for _, conf := range c.Jobs {
+		jobConfMap[conf.Name] = conf
+	}
+
+	cronTask := cron.New()
+	for _, job := range jobs {
+		job.spec = job.DefaultSpec
+		job.timeout = defaultJobTimeout * time.Second
+		if conf, ok := jobConfMap[job.Name]; ok {
+			if conf.Spec != "" {
+				job.spec = conf.Spec
+			}
+			if conf.Timeout > 0 {
+				job.timeout = time.Duration(conf.Timeout) * time.Second
+			}
+			if conf.Disabled {
+				job.spec = ""
+			}
+		}
+		s.jobs[job.Name] = job
+
+		if job.spec == "" {
+			continue
+		}
+		name := job.Name
+		err := cronTask.AddFunc(job.spec, func() {
+			s.runCron(name)
+		})
+		if err != nil {
+			logx.Errorf("创建定时任务失败 job: %s, spec: %s, err: %v", job.Name, job.spec, err)
+		}
+	}
+	cronTask.Start()
+
+	go s.pollPending()
+	defaultScheduler = s
+	logx.Info("InitScheduler success")
+}
+
+func GetScheduler() *Scheduler {
+	return defaultScheduler
+}
+
+// Jobs 所有任务，按名称排序
+func (s *Scheduler) Jobs() []*Job {
+	list := make([]*Job, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		list = append(list, job)
+	}
+	sort.Slice(list, func(i, k int) bool {
+		return list[i].Name < list[k].Name
+	})
+	return list
+}
+
+func (s *Scheduler) IsRunning(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running[name]
+}
+
+// Trigger 手动触发任务，写入待执行记录后由leader执行，返回执行记录id
+func (s *Scheduler) Trigger(name string, params map[string]string, operator string) (int, error) {
+	if _, ok := s.jobs[name]; !ok {
+		return 0, ErrJobNotFound
+	}
+	paramsJson, _ := json.Marshal(params)
+	run := &model.PmJobRunTable{
+		JobName:  name,
+		Trigger:  model.JobTriggerManual,
+		Params:   string(paramsJson),
+		Operator: operator,
+		Status:   model.JobRunStatusPending,
+	}
+	if err := s.jobModel.CreateRun(run); err != nil {
+		return 0, err
+	}
+	return run.ID, nil
+}
+
+// SetPaused 暂停或恢复任务，暂停只影响定时执行
+func (s *Scheduler) SetPaused(name string, paused bool, operator string) error {
+	if _, ok := s.jobs[name]; !ok {
+		return ErrJobNotFound
+	}
+	value := 0
+	if paused {
+		value = 1
+	}
+	return s.jobModel.SetPaused(name, value, operator)
+}
+
+// 定时触发
+func (s *Scheduler) runCron(name string) {
+	defer exception.Recover()
+	token, ok := s.elector.IsLeader()
+	if !ok {
+		return
+	}
+	if paused, err := s.jobModel.IsPaused(name); err != nil || paused {
+		return
+	}
+
+	job := s.jobs[name]
+	params := job.DefaultParams
+	if params == nil {
+		params = make(map[string]string)
+	}
+	paramsJson, _ := json.Marshal(params)
+	run := &model.PmJobRunTable{
+		JobName: name,
+		Trigger: model.JobTriggerCron,
+		Params:  string(paramsJson),
+		Status:  model.JobRunStatusRunning,
+		NodeId:  s.elector.NodeId(),
+		Token:   token,
+		StartAt: time.Now(),
+	}
+	if err := s.jobModel.CreateRun(run); err != nil {
+		return
+	}
+	s.execute(job, run, params)
+}
+
+// 轮询手动触发的任务
+func (s *Scheduler) pollPending() {
+	defer exception.Recover()
+	ticker := time.NewTicker(pendingPollInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		token, ok := s.elector.IsLeader()
+		if !ok {
+			continue
+		}
+		list, err := s.jobModel.GetPendingRuns(pendingPollLimit)
+		if err != nil {
+			continue
+		}
+		for _, run := range list {
+			job, ok := s.jobs[run.JobName]
+			if !ok {
+				s.jobModel.UpdateRun(run.ID, map[string]interface{}{"status": model.JobRunStatusFail, "err_msg": ErrJobNotFound.Error(), "end_at": time.Now()})
+				continue
+			}
+			claimed, err := s.jobModel.ClaimPendingRun(run.ID, s.elector.NodeId(), token)
+			if err != nil || !claimed {
+				continue
+			}
+			run.Token = token
+			params := make(map[string]string)
+			json.Unmarshal([]byte(run.Params), &params)
+			go func(job *Job, run *model.PmJobRunTable) {
+				defer exception.Recover()
+				s.execute(job, run, params)
+			}(job, run)
+		}
+	}
+}
+
+// 执行任务：同一任务同时只执行一次，超时后标记执行记录，任务结束后才释放锁
+func (s *Scheduler) execute(job *Job, run *model.PmJobRunTable, params map[string]string) {
+	rdb := db.WithRedisDBContext(define.DbPayGateway)
+	lockKey, value := fmt.Sprintf(redisJobRunningKey, job.Name), uuid.New().String()
+	isLock, err := rdb.TryLockWithTimeout(context.Background(), lockKey, value, jobLockTtlMs)
+	if err != nil || !isLock {
+		logx.Errorf("任务正在执行中，跳过 job: %s, runId: %d", job.Name, run.ID)
+		s.jobModel.UpdateRun(run.ID, map[string]interface{}{"status": model.JobRunStatusSkip, "err_msg": ErrJobRunning.Error(), "end_at": time.Now()})
+		return
+	}
+
+	s.mu.Lock()
+	s.running[job.Name] = true
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), job.timeout)
+	done := make(chan struct{})
+	var result *Result
+	var runErr error
+	go func() {
+		defer close(done)
+		defer func() {
+			if msg := recover(); msg != nil {
+				runErr = fmt.Errorf("panic: %v", msg)
+			}
+			s.mu.Lock()
+			delete(s.running, job.Name)
+			s.mu.Unlock()
+			rdb.Unlock(context.Background(), lockKey, value)
+		}()
+		result, runErr = job.Fn(ctx, run.Token, params)
+	}()
+	go s.keepLock(rdb, lockKey, value, done)
+
+	updateData := map[string]interface{}{}
+	select {
+	case <-done:
+		updateData["status"] = model.JobRunStatusSuccess
+		if runErr != nil {
+			updateData["status"] = model.JobRunStatusFail
+			updateData["err_msg"] = runErr.Error()
+		}
+		if result != nil {
+			updateData["total"] = result.Total
+			updateData["success"] = result.Success
+			updateData["fail"] = result.Fail
+		}
+	case <-ctx.Done():
+		updateData["status"] = model.JobRunStatusTimeout
+		updateData["err_msg"] = fmt.Sprintf("执行超过%s", job.timeout)
+	}
+	cancel()
+	updateData["end_at"] = time.Now()
+	s.jobModel.UpdateRun(run.ID, updateData)
+
+	if updateData["status"] != model.JobRunStatusSuccess {
+		jobRunFailNum.CounterInc()
+		logx.Errorf("任务执行失败 job: %s, runId: %d, status: %v, err: %v", job.Name, run.ID, updateData["status"], updateData["err_msg"])
+	}
+}
+
+// 任务返回前持续续期任务锁
+func (s *Scheduler) keepLock(rdb *cache.RedisInstance, lockKey, value string, done <-chan struct{}) {
+	defer exception.Recover()
+	ticker := time.NewTicker(jobLockTtlMs * time.Millisecond / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			isLock, err := leader.ExtendLock(rdb, lockKey, value, jobLockTtlMs)
+			if err != nil || !isLock {
+				logx.Errorf("任务锁续期失败 key: %s, isLock: %v, err: %v", lockKey, isLock, err)
+			}
+		}
+	}
+}
+
+// ParseParams 把手动触发的参数按form标签解析到请求结构体，和http接口的参数定义保持一致
+func ParseParams(params map[string]string, v interface{}) error {
+	m := make(map[string]interface{}, len(params))
+	for key, value := range params {
+		m[key] = value
+	}
+	return mapping.NewUnmarshaler("form", mapping.WithStringValues()).Unmarshal(m, v)
+}
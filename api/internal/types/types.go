@@ -97,6 +97,27 @@ type WechatNotifyH5Req struct {
 	Summary      string   `json:"summary"`        // 回调摘要
 }
 
+type WechatTransferNotifyReq struct {
+	AppID string `path:"AppID,optional"` // 微信支付appid
+}
+
+type WechatComplainNotifyReq struct {
+	AppID string `path:"AppID,optional"` // 微信支付appid
+}
+
+type WechatXPayNotifyReq struct {
+	AppID     string `path:"AppID,optional"`     // 小程序appid
+	Signature string `form:"signature,optional"` // 消息推送签名
+	Timestamp string `form:"timestamp,optional"` // 时间戳
+	Nonce     string `form:"nonce,optional"`     // 随机数
+	Echostr   string `form:"echostr,optional"`   // 随机字符串，服务器地址校验时原样返回
+}
+
+type WechatXPayResp struct {
+	ErrCode int    `json:"ErrCode"` // 0表示成功，其他值微信侧会重试推送
+	ErrMsg  string `json:"ErrMsg"`
+}
+
 type DouyinReq struct {
 	Version       string `json:"version,optional"`
 	Type          string `json:"type,optional"`
@@ -173,6 +194,118 @@ type RefundReq struct {
 	ReviewerComment  string `json:"reviewer_comment"` // 操作者备注
 }
 
+type DyRefundAuditListReq struct {
+	PkgName  string `json:"pkg_name,optional"`    // 包名，为空查询全部
+	Page     int    `json:"page,default=1"`       // 页码
+	PageSize int    `json:"page_size,default=20"` // 每页条数
+}
+
+type DyRefundAuditReq struct {
+	RefundId    string `json:"refund_id"`             // 抖音退款单号
+	Status      int    `json:"status"`                // 操作，1同意，2拒绝
+	Reviewer    string `json:"reviewer"`              // 操作者
+	DenyMessage string `json:"deny_message,optional"` // 拒绝原因，拒绝时必填
+}
+
+type FundTransApprovalListReq struct {
+	PkgName  string `json:"pkg_name,optional"`    // 包名，为空查询全部
+	Page     int    `json:"page,default=1"`       // 页码
+	PageSize int    `json:"page_size,default=20"` // 每页条数
+}
+
+type FundTransApprovalReq struct {
+	OrderSn  string `json:"order_sn"`        // 商户转账订单号
+	Status   int    `json:"status"`          // 操作，1通过，2拒绝
+	Reviewer string `json:"reviewer"`        // 操作者
+	Remark   string `json:"remark,optional"` // 审核备注，拒绝时必填
+}
+
+type AlipayComplainListReq struct {
+	PayAppId   string `json:"pay_app_id,optional"`   // 支付宝appid，为空查询全部
+	PkgName    string `json:"pkg_name,optional"`     // 包名，为空查询全部
+	Status     string `json:"status,optional"`       // 投诉单状态，如MERCHANT_PROCESSING待商家处理
+	OutTradeNo string `json:"out_trade_no,optional"` // 内部订单号
+	StartTime  string `json:"start_time,optional"`   // 投诉时间开始 yyyy-MM-dd HH:mm:ss
+	EndTime    string `json:"end_time,optional"`     // 投诉时间结束 yyyy-MM-dd HH:mm:ss
+	Page       int    `json:"page,default=1"`        // 页码
+	PageSize   int    `json:"page_size,default=20"`  // 每页条数
+}
+
+type AlipayComplainReplyReq struct {
+	ComplainEventId string   `json:"complain_event_id"`     // 支付宝投诉单号
+	ReplyContent    string   `json:"reply_content"`         // 回复内容，用户可见
+	ReplyImages     []string `json:"reply_images,optional"` // 回复图片
+	Operator        string   `json:"operator"`              // 操作者
+}
+
+type AlipayComplainFinishReq struct {
+	ComplainEventId string   `json:"complain_event_id"`        // 支付宝投诉单号
+	FeedbackCode    string   `json:"feedback_code"`            // 处理结果码 00:使用体验保障金退款 02:通过其他方式退款 03:已发货 04:其他 05:已完成售后服务 06:非我方责任范围
+	FeedbackContent string   `json:"feedback_content"`         // 处理描述
+	FeedbackImages  []string `json:"feedback_images,optional"` // 处理凭证图片
+	Operator        string   `json:"operator"`                 // 操作者
+	Refund          int      `json:"refund,optional"`          // 是否退款 1是
+	RefundAmount    int      `json:"refund_amount,optional"`   // 退款金额（分），默认为投诉金额
+}
+
+type AlipayComplainRateReq struct {
+	StartTime string `json:"start_time,optional"` // 统计开始时间 yyyy-MM-dd HH:mm:ss，默认结束时间前30天
+	EndTime   string `json:"end_time,optional"`   // 统计结束时间 yyyy-MM-dd HH:mm:ss，默认当前时间
+}
+
+type AlipayComplainRateItem struct {
+	PayAppId     string  `json:"pay_app_id"`    // 支付宝appid
+	MerchantNo   string  `json:"merchant_no"`   // 商户号
+	MerchantName string  `json:"merchant_name"` // 商户名称
+	ComplainNum  int64   `json:"complain_num"`  // 投诉数
+	OrderNum     int64   `json:"order_num"`     // 支付成功订单数
+	ComplainRate float64 `json:"complain_rate"` // 投诉率
+}
+
+type WechatComplainListReq struct {
+	MchId          string `json:"mch_id,optional"`          // 微信商户号，为空查询全部
+	PkgName        string `json:"pkg_name,optional"`        // 包名，为空查询全部
+	ComplaintState string `json:"complaint_state,optional"` // 投诉单状态 PENDING待处理 PROCESSING处理中 PROCESSED已处理完成
+	OrderSn        string `json:"order_sn,optional"`        // 订单号
+	StartTime      string `json:"start_time,optional"`      // 投诉时间开始 yyyy-MM-dd HH:mm:ss
+	EndTime        string `json:"end_time,optional"`        // 投诉时间结束 yyyy-MM-dd HH:mm:ss
+	Page           int    `json:"page,default=1"`           // 页码
+	PageSize       int    `json:"page_size,default=20"`     // 每页条数
+}
+
+type WechatComplainReplyReq struct {
+	ComplaintId     string   `json:"complaint_id"`             // 微信投诉单号
+	ResponseContent string   `json:"response_content"`         // 回复内容，用户可见
+	ResponseImages  []string `json:"response_images,optional"` // 回复图片media_id
+	Operator        string   `json:"operator"`                 // 操作者
+}
+
+type WechatComplainCompleteReq struct {
+	ComplaintId string `json:"complaint_id"` // 微信投诉单号
+	Operator    string `json:"operator"`     // 操作者
+}
+
+type WechatComplainNotifyUrlReq struct {
+	AppId string `json:"app_id"` // 微信支付appid
+}
+
+type JobTriggerReq struct {
+	Name     string            `json:"name"`            // 任务名
+	Params   map[string]string `json:"params,optional"` // 执行参数，与对应/crontab接口的参数一致
+	Operator string            `json:"operator"`        // 操作者
+}
+
+type JobPauseReq struct {
+	Name     string `json:"name"`     // 任务名
+	Operator string `json:"operator"` // 操作者
+}
+
+type JobRunListReq struct {
+	Name     string `json:"name,optional"`        // 任务名，为空查询全部
+	Page     int    `json:"page,default=1"`       // 页码
+	PageSize int    `json:"page_size,default=20"` // 每页条数
+}
+
 type ComplainReq struct {
 	AppId     string `json:"app_id"`
 	StartTime string `json:"start_time"`
@@ -190,3 +323,80 @@ type SupplementaryOrdersResp struct {
 	ErrNo   int    `json:"err_no"`
 	ErrTips string `json:"err_tips"`
 }
+
+type HuaweiConfirmPurchaseReq struct {
+	Days  int `form:"days,default=3"`    // 重试最近几天创建的订单
+	Limit int `form:"limit,default=200"` // 单次最多处理的订单数
+}
+
+type HuaweiConfirmPurchaseResp struct {
+	ErrNo   int    `json:"err_no"`
+	ErrTips string `json:"err_tips"`
+}
+
+type HuaweiCancelledPurchaseReq struct {
+	Days    int    `form:"days,default=2"`      // 查询最近几天的退款记录
+	MaxRows int    `form:"maxRows,default=100"` // 每页条数
+	AppId   string `form:"appId,optional"`      // 只处理指定的华为应用，为空时处理全部
+}
+
+type HuaweiCancelledPurchaseResp struct {
+	ErrNo   int    `json:"err_no"`
+	ErrTips string `json:"err_tips"`
+}
+
+type AlipayFundTransSettleReq struct {
+	Days    int `form:"days,default=3"`    // 查询最近几天发起的转账
+	Minutes int `form:"minutes,default=5"` // 跳过最近几分钟发起的转账，避免和正在请求的转账冲突
+	Limit   int `form:"limit,default=500"` // 每次最多处理条数
+}
+
+type AlipayFundTransSettleResp struct {
+	ErrNo   int    `json:"err_no"`
+	ErrTips string `json:"err_tips"`
+}
+
+type AlipayComplainSyncReq struct {
+	Days int `form:"days,default=30"` // 同步最近几天的投诉
+}
+
+type AlipayComplainSyncResp struct {
+	ErrNo   int    `json:"err_no"`
+	ErrTips string `json:"err_tips"`
+}
+
+type WechatComplainSyncReq struct {
+	Days int `form:"days,default=7"` // 同步最近几天的投诉，最多30天
+}
+
+type WechatComplainSyncResp struct {
+	ErrNo   int    `json:"err_no"`
+	ErrTips string `json:"err_tips"`
+}
+
+type AlipayAgreementCheckReq struct {
+	PayAppId string `form:"pay_app_id,optional"` // 支付宝appid，为空对账全部
+}
+
+type AlipayAgreementCheckResp struct {
+	ErrNo   int    `json:"err_no"`
+	ErrTips string `json:"err_tips"`
+}
+
+type DyPeriodSignCheckReq struct {
+	PayAppId string `form:"pay_app_id,optional"` // 抖音appid，为空对账全部
+}
+
+type DyPeriodSignCheckResp struct {
+	ErrNo   int    `json:"err_no"`
+	ErrTips string `json:"err_tips"`
+}
+
+type SubscribeRemindReq struct {
+	AppPkg string `form:"app_pkg,optional"` // 包名，为空处理全部
+}
+
+type SubscribeRemindResp struct {
+	ErrNo   int    `json:"err_no"`
+	ErrTips string `json:"err_tips"`
+}
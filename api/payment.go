@@ -9,7 +9,11 @@ import (
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/crontab"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/api/internal/scheduler"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/envelope"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
 	"gitlab.muchcloud.com/consumer-project/zhuyun-core/alarm"
 	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
 	"gitlab.muchcloud.com/consumer-project/zhuyun-core/nacos"
@@ -50,6 +54,16 @@ func main() {
 	// 初始化数据库
 	db.DBInit(c.Mysql, c.RedisConfig)
 
+	// 初始化商户密钥加密主密钥
+	if err = envelope.Init(c.SecretMasterKey); err != nil {
+		logx.Error("初始化商户密钥加密主密钥失败：" + err.Error())
+		return
+	}
+	if err = model.NewConfigAdminModel(define.DbPayGateway).CheckMasterKey(); err != nil {
+		logx.Error("检查商户密钥加密主密钥失败：" + err.Error())
+		return
+	}
+
 	//初使化钉钉通知服务
 	alarm.InitAlarmClient(c.Name, c.Alarm.Redis, c.Alarm.DingDingUrl)
 
@@ -66,6 +80,8 @@ func main() {
 		if nacosInstanc != nil {
 			namingClient, _ := nacos.InitNamingClient(nacosConfig)
 			crontab.InitCrontabOrder(namingClient, nacosServerName, &c, ctx)
+			crontabOrder := crontab.GetCrontabOrder()
+			scheduler.InitScheduler(&c, crontabOrder.Leader, scheduler.NewJobs(crontabOrder, ctx))
 		}
 	})
 
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"fmt"
+
+	alipay2 "gitlab.muchcloud.com/consumer-project/alipay"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+)
+
+var (
+	alipayAgreementQueryErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "alipayAgreementQueryErr", nil, "支付宝协议查询失败", nil})}
+)
+
+// 支付宝周期扣款协议状态
+const (
+	AlipayAgreementStatusTemp   = "TEMP"   // 暂存，协议未生效
+	AlipayAgreementStatusNormal = "NORMAL" // 正常
+	AlipayAgreementStatusStop   = "STOP"   // 暂停
+	AlipayAgreementStatusUnsign = "UNSIGN" // 已解约
+)
+
+// 查询支付宝用户协议，有协议号时按协议号查询，否则按商户协议号查询
+// https://opendocs.alipay.com/open/02fkao
+type AlipayAgreementQuery struct {
+	AgreementNo         string `json:"agreement_no,omitempty"`          // 支付宝协议号
+	ExternalAgreementNo string `json:"external_agreement_no,omitempty"` // 商户协议号
+	PersonalProductCode string `json:"personal_product_code,omitempty"` // 个人签约产品码，按商户协议号查询时必填
+	SignScene           string `json:"sign_scene,omitempty"`            // 签约场景码，按商户协议号查询时必填
+}
+
+func (q AlipayAgreementQuery) APIName() string {
+	return "alipay.user.agreement.query"
+}
+
+func (q AlipayAgreementQuery) Params() map[string]string {
+	return map[string]string{}
+}
+
+type AlipayAgreementQueryRsp struct {
+	Content struct {
+		Code                alipay2.Code `json:"code"`
+		Msg                 string       `json:"msg"`
+		SubCode             string       `json:"sub_code"`
+		SubMsg              string       `json:"sub_msg"`
+		AgreementNo         string       `json:"agreement_no"`
+		ExternalAgreementNo string       `json:"external_agreement_no"`
+		Status              string       `json:"status"`
+		SignTime            string       `json:"sign_time"`
+		ValidTime           string       `json:"valid_time"`
+		InvalidTime         string       `json:"invalid_time"`
+	} `json:"alipay_user_agreement_query_response"`
+}
+
+// 查询周期扣款协议状态
+func AlipayAgreementStatusQuery(payClient *alipay2.Client, agreementNo, externalAgreementNo string) (*AlipayAgreementQueryRsp, error) {
+	param := AlipayAgreementQuery{AgreementNo: agreementNo}
+	if agreementNo == "" {
+		param.ExternalAgreementNo = externalAgreementNo
+		param.PersonalProductCode = "CYCLE_PAY_AUTH_P" // 固定参数
+		param.SignScene = "INDUSTRY|DEFAULT_SCENE"     // 固定参数
+	}
+
+	rsp := new(AlipayAgreementQueryRsp)
+	if err := payClient.DoRequest("POST", param, rsp); err != nil {
+		alipayAgreementQueryErr.CounterInc()
+		return nil, err
+	}
+	if rsp.Content.Code != alipay2.CodeSuccess {
+		alipayAgreementQueryErr.CounterInc()
+		return nil, fmt.Errorf("%s %s %s", rsp.Content.Code, rsp.Content.SubCode, rsp.Content.SubMsg)
+	}
+	return rsp, nil
+}
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"fmt"
+
+	alipay2 "gitlab.muchcloud.com/consumer-project/alipay"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+)
+
+// 支付宝交易投诉处理文档
+//
+// https://opendocs.alipay.com/open/02c07e
+//
+
+var (
+	alipayComplainErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "alipayComplainErr", nil, "支付宝交易投诉接口请求失败", nil})}
+)
+
+// 交易投诉状态
+const (
+	AlipayComplainStatusMerchantProcessing = "MERCHANT_PROCESSING" // 待商家处理
+	AlipayComplainStatusMerchantFeedbacked = "MERCHANT_FEEDBACKED" // 商家已反馈
+	AlipayComplainStatusFinished           = "FINISHED"            // 投诉已完结
+	AlipayComplainStatusCancelled          = "CANCELLED"           // 用户已撤销
+	AlipayComplainStatusPlatformProcessing = "PLATFORM_PROCESSING" // 平台处理中
+	AlipayComplainStatusPlatformFinish     = "PLATFORM_FINISH"     // 平台处理完结
+	AlipayComplainStatusClosed             = "CLOSED"              // 系统关闭
+)
+
+// 交易投诉记录
+type AlipayComplain struct {
+	ComplainEventId  string   `json:"complain_event_id"`  // 支付宝侧投诉单号
+	Status           string   `json:"status"`             // 投诉单状态
+	TradeNo          string   `json:"trade_no"`           // 支付宝交易号
+	MerchantOrderNo  string   `json:"merchant_order_no"`  // 商家订单号，即我方订单号
+	GmtCreate        string   `json:"gmt_create"`         // 投诉时间
+	GmtModified      string   `json:"gmt_modified"`       // 投诉单修改时间
+	GmtFinished      string   `json:"gmt_finished"`       // 投诉完结时间
+	LeafCategoryName string   `json:"leaf_category_name"` // 投诉原因分类
+	ComplainReason   string   `json:"complain_reason"`    // 投诉原因
+	Content          string   `json:"content"`            // 投诉内容
+	Images           []string `json:"images"`             // 投诉图片
+	PhoneNo          string   `json:"phone_no"`           // 投诉人电话
+	ComplainAmount   string   `json:"complain_amount"`    // 投诉金额（元）
+	TargetId         string   `json:"target_id"`          // 被投诉人pid
+}
+
+// 查询交易投诉列表
+// https://opendocs.alipay.com/open/02c07f
+type AlipayComplainBatchQuery struct {
+	BizState  string `json:"biz_state,omitempty"`  // 投诉单状态，不传查询全部
+	PageSize  int    `json:"page_size"`            // 每页条数，最大20
+	PageNum   int    `json:"page_num"`             // 页码，从1开始
+	BeginTime string `json:"begin_time,omitempty"` // 投诉时间开始，yyyy-MM-dd HH:mm:ss
+	EndTime   string `json:"end_time,omitempty"`   // 投诉时间结束，yyyy-MM-dd HH:mm:ss
+}
+
+func (q AlipayComplainBatchQuery) APIName() string {
+	return "alipay.merchant.tradecomplain.batchquery"
+}
+
+func (q AlipayComplainBatchQuery) Params() map[string]string {
+	return map[string]string{}
+}
+
+type AlipayComplainBatchQueryRsp struct {
+	Content struct {
+		Code          alipay2.Code      `json:"code"`
+		Msg           string            `json:"msg"`
+		SubCode       string            `json:"sub_code"`
+		SubMsg        string            `json:"sub_msg"`
+		TotalSize     int               `json:"total_size"`
+		PageSize      int               `json:"page_size"`
+		PageNum       int               `json:"page_num"`
+		ComplaintList []*AlipayComplain `json:"complaint_list"`
+	} `json:"alipay_merchant_tradecomplain_batchquery_response"`
+}
+
+// 商家回复交易投诉，回复内容用户可见
+// https://opendocs.alipay.com/open/02c081
+type AlipayComplainReply struct {
+	ComplainEventId string   `json:"complain_event_id"`      // 支付宝侧投诉单号
+	ReplyContent    string   `json:"reply_content"`          // 回复内容，最多200字
+	ReplyImages     []string `json:"reply_images,omitempty"` // 回复图片，通过图片上传接口获取的图片地址
+}
+
+func (r AlipayComplainReply) APIName() string {
+	return "alipay.merchant.tradecomplain.reply.submit"
+}
+
+func (r AlipayComplainReply) Params() map[string]string {
+	return map[string]string{}
+}
+
+// 商家处理完成交易投诉，提交处理结果后投诉单状态变为商家已反馈
+// https://opendocs.alipay.com/open/02c082
+type AlipayComplainFeedback struct {
+	ComplainEventId string   `json:"complain_event_id"`         // 支付宝侧投诉单号
+	FeedbackCode    string   `json:"feedback_code"`             // 处理结果码 00:使用体验保障金退款 02:通过其他方式退款 03:已发货 04:其他 05:已完成售后服务 06:非我方责任范围
+	FeedbackContent string   `json:"feedback_content"`          // 处理描述，最多200字
+	FeedbackImages  []string `json:"feedback_images,omitempty"` // 处理凭证图片
+	Operator        string   `json:"operator,omitempty"`        // 处理人
+}
+
+func (f AlipayComplainFeedback) APIName() string {
+	return "alipay.merchant.tradecomplain.feedback.submit"
+}
+
+func (f AlipayComplainFeedback) Params() map[string]string {
+	return map[string]string{}
+}
+
+// 交易投诉回复、处理结果提交返回
+type AlipayComplainSubmitContent struct {
+	Code    alipay2.Code `json:"code"`
+	Msg     string       `json:"msg"`
+	SubCode string       `json:"sub_code"`
+	SubMsg  string       `json:"sub_msg"`
+}
+
+type AlipayComplainReplyRsp struct {
+	Content AlipayComplainSubmitContent `json:"alipay_merchant_tradecomplain_reply_submit_response"`
+}
+
+type AlipayComplainFeedbackRsp struct {
+	Content AlipayComplainSubmitContent `json:"alipay_merchant_tradecomplain_feedback_submit_response"`
+}
+
+// 查询交易投诉列表
+func AlipayComplainList(payClient *alipay2.Client, param AlipayComplainBatchQuery) (*AlipayComplainBatchQueryRsp, error) {
+	rsp := new(AlipayComplainBatchQueryRsp)
+	if err := payClient.DoRequest("POST", param, rsp); err != nil {
+		alipayComplainErr.CounterInc()
+		return nil, err
+	}
+	if rsp.Content.Code != alipay2.CodeSuccess {
+		alipayComplainErr.CounterInc()
+		return nil, fmt.Errorf("%s %s %s", rsp.Content.Code, rsp.Content.SubCode, rsp.Content.SubMsg)
+	}
+	return rsp, nil
+}
+
+// 回复交易投诉
+func AlipayComplainReplySubmit(payClient *alipay2.Client, param AlipayComplainReply) error {
+	rsp := new(AlipayComplainReplyRsp)
+	if err := payClient.DoRequest("POST", param, rsp); err != nil {
+		alipayComplainErr.CounterInc()
+		return err
+	}
+	return rsp.Content.checkSuccess()
+}
+
+// 提交交易投诉处理结果
+func AlipayComplainFeedbackSubmit(payClient *alipay2.Client, param AlipayComplainFeedback) error {
+	rsp := new(AlipayComplainFeedbackRsp)
+	if err := payClient.DoRequest("POST", param, rsp); err != nil {
+		alipayComplainErr.CounterInc()
+		return err
+	}
+	return rsp.Content.checkSuccess()
+}
+
+func (c AlipayComplainSubmitContent) checkSuccess() error {
+	if c.Code != alipay2.CodeSuccess {
+		alipayComplainErr.CounterInc()
+		return fmt.Errorf("%s %s %s", c.Code, c.SubCode, c.SubMsg)
+	}
+	return nil
+}
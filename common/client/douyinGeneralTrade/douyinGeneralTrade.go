@@ -29,6 +29,12 @@ const trade_terminate_sign_url = "https://open.douyin.com/api/trade_auth/v1/deve
 // 查询抖音周期代扣签约单的状态
 const query_sign_order_url = "https://open.douyin.com/api/trade_auth/v1/developer/query_sign_order/"
 
+// 查询抖音周期代扣单的状态
+const query_sign_pay_order_url = "https://open.douyin.com/api/trade_auth/v1/developer/query_sign_pay_order/"
+
+// 发起抖音周期代扣单
+const create_sign_pay_url = "https://open.douyin.com/api/trade_auth/v1/developer/create_sign_pay/"
+
 const refund_sign_order_url = "https://open.douyin.com/api/trade_auth/v1/developer/create_sign_refund/"
 
 type PayConfig struct {
@@ -548,6 +554,105 @@ func (c *PayClient) QuerySignOrder(clientToken, outAuthOrderId string) (*UserSig
 	return resp, nil
 }
 
+// 代扣单查询返回数据结构体
+type SignPayResp struct {
+	ApiCommonResp
+	SignPayData SignPayDataObj `json:"data,optional"`
+}
+
+type SignPayDataObj struct {
+	AppId         string `json:"app_id,optional"`           // 小程序 app_id
+	Status        string `json:"status,optional"`           // 代扣单状态 PROCESSING: 扣款中 SUCCESS: 扣款成功 FAIL: 扣款失败 TIMEOUT: 超时未扣款成功
+	AuthOrderId   string `json:"auth_order_id,optional"`    // 平台侧签约单的单号
+	PayOrderId    string `json:"pay_order_id,optional"`     // 平台侧代扣单的单号
+	OutPayOrderNo string `json:"out_pay_order_no,optional"` // 开发者侧代扣单的单号
+	TotalAmount   int64  `json:"total_amount,optional"`     // 扣款金额，单位[分]
+	PayChannel    int32  `json:"pay_channel,optional"`      // 支付渠道枚举（扣款成功时才有）10：抖音支付
+	ChannelPayId  string `json:"channel_pay_id,optional"`   // 渠道支付单
+	MerchantUid   string `json:"merchant_uid,optional"`     // 该笔交易卖家商户号
+	UserBillPayId string `json:"user_bill_pay_id,optional"` // 用户抖音交易单号（账单号）
+	PayTime       int64  `json:"pay_time,optional"`         // 扣款成功时间，时间毫秒
+}
+
+// 查询抖音周期代扣单的状态，用于补偿丢失的代扣结果回调
+// https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/payment/management-capacity/periodic-deduction/pay/query-sign-pay-order
+//
+// clientToken appid的access token
+//
+// outPayOrderNo 开发者侧代扣单的单号
+func (c *PayClient) QuerySignPayOrder(clientToken, outPayOrderNo string) (*SignPayResp, error) {
+	header := map[string]string{
+		"access-token": clientToken,
+	}
+
+	// pay_order_id 与 out_pay_order_no 二选一
+	params := map[string]string{
+		"out_pay_order_no": outPayOrderNo,
+	}
+	result, err := util.HttpPostWithHeader(query_sign_pay_order_url, params, header, time.Second*5)
+
+	// 记录返回日志
+	logx.Sloww("QuerySignPayOrder", logx.Field("result", result), logx.Field("outPayOrderNo", outPayOrderNo), logx.Field("err", err))
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SignPayResp)
+	err = json.Unmarshal([]byte(result), resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type CreateSignPayResp struct {
+	ApiCommonResp
+	Data struct {
+		PayOrderId string `json:"pay_order_id,omitempty"` // 平台侧代扣单的单号
+	} `json:"data,omitempty"`
+}
+
+// CreateSignPay 发起代扣，扣款结果以代扣结果回调通知为准
+// https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/payment/management-capacity/periodic-deduction/pay/create-sign-pay
+// 一个用户在每个扣款周期内只能发起一笔代扣单，同一周期内已有处理中或成功的代扣单时无法再发起
+//
+// authOrderId 平台侧签约单的单号
+//
+// notifyUrl 为空时使用开发者后台配置的回调地址
+func (c *PayClient) CreateSignPay(clientToken, authOrderId, outPayOrderNo, merchantUid, notifyUrl string, totalAmount int64) (*CreateSignPayResp, error) {
+	header := map[string]string{
+		"access-token": clientToken,
+	}
+
+	params := map[string]interface{}{
+		"auth_order_id":    authOrderId,   //平台侧签约单的单号
+		"out_pay_order_no": outPayOrderNo, //开发者侧代扣单的单号
+		"merchant_uid":     merchantUid,   //收款商户号
+		"total_amount":     totalAmount,   //扣款金额，单位[分]
+	}
+	if notifyUrl != "" {
+		params["notify_url"] = notifyUrl
+	}
+	result, err := util.HttpPostWithHeader(create_sign_pay_url, params, header, time.Second*5)
+
+	// 记录返回日志
+	logx.Sloww("CreateSignPay", logx.Field("result", result), logx.Field("authOrderId", authOrderId), logx.Field("outPayOrderNo", outPayOrderNo), logx.Field("err", err))
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(CreateSignPayResp)
+	err = json.Unmarshal([]byte(result), resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 // 发起解约抖音周期代扣
 // 签约单状态只有在 服务中（SERVING）才允许解约
 // https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/payment/management-capacity/periodic-deduction/sign/terminate-sign
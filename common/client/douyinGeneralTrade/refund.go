@@ -123,6 +123,18 @@ type SignRefundMsg struct {
 	EventTime         int64  `json:"event_time"`          //用户退款成功/退款失败时间戳，单位为毫秒
 }
 
+// 退款申请是否需要开发者审核 need_refund_audit
+const (
+	NeedRefundAuditYes int8 = 1 // 需要审核
+	NeedRefundAuditNo  int8 = 2 // 不需要审核
+)
+
+// 退款审核结果 refund_audit_status
+const (
+	RefundAuditStatusPass   int8 = 1 // 同意退款
+	RefundAuditStatusReject int8 = 2 // 不同意退款
+)
+
 type AuditRefundReq struct {
 	RefundId          string `json:"refund_id"`
 	RefundAuditStatus int8   `json:"refund_audit_status"`
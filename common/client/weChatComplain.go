@@ -0,0 +1,208 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/zeromicro/go-zero/core/logx"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+)
+
+// 微信支付消费者投诉2.0文档
+//
+// https://pay.weixin.qq.com/doc/v3/merchant/4012691700
+//
+
+var (
+	weChatComplainErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "weChatComplainErr", nil, "微信消费者投诉接口请求失败", nil})}
+)
+
+// 投诉单状态
+const (
+	WechatComplaintStatePending    = "PENDING"    // 待处理
+	WechatComplaintStateProcessing = "PROCESSING" // 处理中
+	WechatComplaintStateProcessed  = "PROCESSED"  // 已处理完成
+)
+
+// 投诉单关联的订单
+type WechatComplaintOrderInfo struct {
+	TransactionId string `json:"transaction_id"` // 微信支付订单号
+	OutTradeNo    string `json:"out_trade_no"`   // 商户订单号
+	Amount        int64  `json:"amount"`         // 订单金额（分）
+}
+
+// 投诉单详情
+type WechatComplaint struct {
+	ComplaintId           string                      `json:"complaint_id"`            // 投诉单号
+	ComplaintTime         string                      `json:"complaint_time"`          // 投诉时间，rfc3339格式
+	ComplaintDetail       string                      `json:"complaint_detail"`        // 投诉详情
+	ComplaintState        string                      `json:"complaint_state"`         // 投诉单状态
+	ComplaintedMchid      string                      `json:"complainted_mchid"`       // 被诉商户号
+	ComplaintOrderInfo    []*WechatComplaintOrderInfo `json:"complaint_order_info"`    // 投诉单关联订单
+	ComplaintFullRefunded bool                        `json:"complaint_full_refunded"` // 投诉单是否已全额退款
+	IncomingUserResponse  bool                        `json:"incoming_user_response"`  // 是否有待回复的用户留言
+	UserComplaintTimes    int                         `json:"user_complaint_times"`    // 用户投诉次数
+	ProblemDescription    string                      `json:"problem_description"`     // 问题描述
+	ProblemType           string                      `json:"problem_type"`            // 问题类型 REFUND申请退款 SERVICE_NOT_WORK服务权益未生效 OTHERS其他
+	ApplyRefundAmount     int64                       `json:"apply_refund_amount"`     // 申请退款金额（分）
+}
+
+// 查询投诉单列表返回
+type WechatComplaintListResp struct {
+	Data       []*WechatComplaint `json:"data"`
+	Limit      int                `json:"limit"`
+	Offset     int                `json:"offset"`
+	TotalCount int                `json:"total_count"`
+}
+
+// 回复用户
+type WechatComplaintResponseReq struct {
+	ComplaintedMchid string   `json:"complainted_mchid"`         // 被诉商户号
+	ResponseContent  string   `json:"response_content"`          // 回复内容，最多200字
+	ResponseImages   []string `json:"response_images,omitempty"` // 回复图片，通过图片上传接口获取的media_id
+}
+
+// 投诉通知解密后的内容
+type WechatComplaintNotify struct {
+	ComplaintId string `json:"complaint_id"` // 投诉单号
+	ActionType  string `json:"action_type"`  // 动作类型，如CREATE_COMPLAINT、CONTINUE_COMPLAINT、USER_RESPONSE、MERCHANT_CONFIRM_COMPLETE
+}
+
+// 查询投诉单列表，投诉日期区间最长30天，日期格式yyyy-MM-dd
+// https://pay.weixin.qq.com/doc/v3/merchant/4012691718
+func (l *WeChatCommPay) ComplaintList(beginDate, endDate string, offset, limit int) (*WechatComplaintListResp, error) {
+	client, err := l.getClient()
+	if err != nil {
+		logx.Errorf("ComplaintList 初始化微信client失败,err =%v", err)
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("begin_date", beginDate)
+	query.Set("end_date", endDate)
+	query.Set("offset", fmt.Sprint(offset))
+	query.Set("limit", fmt.Sprint(limit))
+	query.Set("complainted_mchid", l.Config.MchId)
+	result, err := client.Get(l.Ctx, "https://api.mch.weixin.qq.com/v3/merchant-service/complaints-v2?"+query.Encode())
+	if err != nil {
+		weChatComplainErr.CounterInc()
+		logx.Errorf("ComplaintList 请求失败 mchId: %s, err: %v", l.Config.MchId, err)
+		return nil, err
+	}
+
+	resp := new(WechatComplaintListResp)
+	if err = core.UnMarshalResponse(result.Response, resp); err != nil {
+		logx.Errorf("ComplaintList 解析返回失败 mchId: %s, err: %v", l.Config.MchId, err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// 查询投诉单详情
+// https://pay.weixin.qq.com/doc/v3/merchant/4012691722
+func (l *WeChatCommPay) ComplaintDetail(complaintId string) (*WechatComplaint, error) {
+	client, err := l.getClient()
+	if err != nil {
+		logx.Errorf("ComplaintDetail 初始化微信client失败,err =%v", err)
+		return nil, err
+	}
+
+	result, err := client.Get(l.Ctx, "https://api.mch.weixin.qq.com/v3/merchant-service/complaints-v2/"+url.PathEscape(complaintId))
+	if err != nil {
+		weChatComplainErr.CounterInc()
+		logx.Errorf("ComplaintDetail 请求失败 complaintId: %s, err: %v", complaintId, err)
+		return nil, err
+	}
+
+	complaint := new(WechatComplaint)
+	if err = core.UnMarshalResponse(result.Response, complaint); err != nil {
+		logx.Errorf("ComplaintDetail 解析返回失败 complaintId: %s, err: %v", complaintId, err)
+		return nil, err
+	}
+	return complaint, nil
+}
+
+// 回复用户，回复后投诉单状态变为处理中
+// https://pay.weixin.qq.com/doc/v3/merchant/4012691731
+func (l *WeChatCommPay) ComplaintResponse(complaintId, content string, images []string) error {
+	client, err := l.getClient()
+	if err != nil {
+		logx.Errorf("ComplaintResponse 初始化微信client失败,err =%v", err)
+		return err
+	}
+
+	req := &WechatComplaintResponseReq{
+		ComplaintedMchid: l.Config.MchId,
+		ResponseContent:  content,
+		ResponseImages:   images,
+	}
+	_, err = client.Post(l.Ctx, fmt.Sprintf("https://api.mch.weixin.qq.com/v3/merchant-service/complaints-v2/%s/response", url.PathEscape(complaintId)), req)
+	if err != nil {
+		weChatComplainErr.CounterInc()
+		logx.Errorf("ComplaintResponse 请求失败 complaintId: %s, err: %v", complaintId, err)
+	}
+	return err
+}
+
+// 反馈处理完成，投诉单状态变为已处理完成
+// https://pay.weixin.qq.com/doc/v3/merchant/4012691735
+func (l *WeChatCommPay) ComplaintComplete(complaintId string) error {
+	client, err := l.getClient()
+	if err != nil {
+		logx.Errorf("ComplaintComplete 初始化微信client失败,err =%v", err)
+		return err
+	}
+
+	req := map[string]string{"complainted_mchid": l.Config.MchId}
+	_, err = client.Post(l.Ctx, fmt.Sprintf("https://api.mch.weixin.qq.com/v3/merchant-service/complaints-v2/%s/complete", url.PathEscape(complaintId)), req)
+	if err != nil {
+		weChatComplainErr.CounterInc()
+		logx.Errorf("ComplaintComplete 请求失败 complaintId: %s, err: %v", complaintId, err)
+	}
+	return err
+}
+
+// 设置投诉通知回调地址，已设置过时更新
+// https://pay.weixin.qq.com/doc/v3/merchant/4012691741
+func (l *WeChatCommPay) SetComplaintNotifyUrl(notifyUrl string) error {
+	client, err := l.getClient()
+	if err != nil {
+		logx.Errorf("SetComplaintNotifyUrl 初始化微信client失败,err =%v", err)
+		return err
+	}
+
+	req := map[string]string{"url": notifyUrl}
+	uri := "https://api.mch.weixin.qq.com/v3/merchant-service/complaint-notifications"
+	_, err = client.Post(l.Ctx, uri, req)
+	if err != nil {
+		_, err = client.Put(l.Ctx, uri, req)
+	}
+	if err != nil {
+		weChatComplainErr.CounterInc()
+		logx.Errorf("SetComplaintNotifyUrl 请求失败 mchId: %s, err: %v", l.Config.MchId, err)
+	}
+	return err
+}
+
+// 投诉通知回调，验签并解密，通知只包含投诉单号，详情需要再查询
+// https://pay.weixin.qq.com/doc/v3/merchant/4012691745
+func (l *WeChatCommPay) ComplaintNotify(r *http.Request) (*WechatComplaintNotify, error) {
+	handler, err := l.newNotifyHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	complaintNotify := new(WechatComplaintNotify)
+	notifyReq, err := handler.ParseNotifyRequest(l.Ctx, r, complaintNotify)
+	if err != nil {
+		weChatNotifyErr.CounterInc()
+		err = fmt.Errorf("验签未通过，或者解密失败！err=%w", err)
+		logx.Error(err.Error())
+		return nil, err
+	}
+
+	logx.Slowf("ComplaintNotify eventType=%s, notify=%+v", notifyReq.EventType, complaintNotify)
+	return complaintNotify, nil
+}
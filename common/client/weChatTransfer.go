@@ -0,0 +1,188 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/verifiers"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/downloader"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+	"github.com/zeromicro/go-zero/core/logx"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+)
+
+// 微信商家转账文档
+//
+// https://pay.weixin.qq.com/doc/v3/merchant/4012711988
+//
+
+var (
+	weChatTransferErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "weChatTransferErr", nil, "微信商家转账请求失败", nil})}
+)
+
+// 商家转账单状态
+const (
+	WechatTransferStateAccepted        = "ACCEPTED"          // 转账已受理
+	WechatTransferStateProcessing      = "PROCESSING"        // 转账锁定资金中
+	WechatTransferStateWaitUserConfirm = "WAIT_USER_CONFIRM" // 待收款用户确认，需要用户在小程序内确认收款
+	WechatTransferStateTransfering     = "TRANSFERING"       // 转账中
+	WechatTransferStateSuccess         = "SUCCESS"           // 转账成功
+	WechatTransferStateFail            = "FAIL"              // 转账失败
+	WechatTransferStateCanceling       = "CANCELING"         // 商户撤销请求受理成功，该笔转账正在撤销中
+	WechatTransferStateCancelled       = "CANCELLED"         // 转账撤销完成
+)
+
+// 转账场景报备信息
+type TransferSceneReportInfo struct {
+	InfoType    string `json:"info_type"`    // 信息类型，如：活动名称、奖励说明
+	InfoContent string `json:"info_content"` // 信息内容
+}
+
+// 发起转账请求
+// https://pay.weixin.qq.com/doc/v3/merchant/4012716434
+type TransferBillReq struct {
+	Appid                    string                     `json:"appid"`                                 // 商户AppID
+	OutBillNo                string                     `json:"out_bill_no"`                           // 商户单号
+	TransferSceneId          string                     `json:"transfer_scene_id"`                     // 转账场景ID，如1000现金营销
+	Openid                   string                     `json:"openid"`                                // 收款用户OpenID
+	TransferAmount           int64                      `json:"transfer_amount"`                       // 转账金额（分），不传用户姓名，单笔需小于2000元
+	TransferRemark           string                     `json:"transfer_remark"`                       // 转账备注，用户收款时可见
+	NotifyUrl                string                     `json:"notify_url,omitempty"`                  // 通知地址
+	UserRecvPerception       string                     `json:"user_recv_perception,omitempty"`        // 用户收款感知，如：活动奖励、现金奖励
+	TransferSceneReportInfos []*TransferSceneReportInfo `json:"transfer_scene_report_infos,omitempty"` // 转账场景报备信息
+}
+
+// 发起转账返回
+type TransferBillResp struct {
+	OutBillNo      string `json:"out_bill_no"`      // 商户单号
+	TransferBillNo string `json:"transfer_bill_no"` // 微信转账单号
+	CreateTime     string `json:"create_time"`      // 单据创建时间
+	State          string `json:"state"`            // 单据状态
+	FailReason     string `json:"fail_reason"`      // 失败原因
+	PackageInfo    string `json:"package_info"`     // 跳转领取页面的package信息，小程序调起用户确认收款时使用
+}
+
+// 转账单详情，查询及回调通知解密后的内容
+// https://pay.weixin.qq.com/doc/v3/merchant/4012716437
+type TransferBill struct {
+	MchId          string `json:"mch_id"`           // 商户号
+	OutBillNo      string `json:"out_bill_no"`      // 商户单号
+	TransferBillNo string `json:"transfer_bill_no"` // 微信转账单号
+	Appid          string `json:"appid"`            // 商户AppID
+	State          string `json:"state"`            // 单据状态
+	TransferAmount int64  `json:"transfer_amount"`  // 转账金额（分）
+	TransferRemark string `json:"transfer_remark"`  // 转账备注
+	FailReason     string `json:"fail_reason"`      // 失败原因
+	Openid         string `json:"openid"`           // 收款用户OpenID
+	CreateTime     string `json:"create_time"`      // 单据创建时间
+	UpdateTime     string `json:"update_time"`      // 最后一次状态变更时间
+}
+
+// 发起商家转账，同一商户单号重复请求微信不会重复转账
+func (l *WeChatCommPay) TransferBill(req *TransferBillReq) (*TransferBillResp, error) {
+	client, err := l.getClient()
+	if err != nil {
+		logx.Errorf("TransferBill 初始化微信client失败,err =%v", err)
+		return nil, err
+	}
+
+	if req.NotifyUrl == "" {
+		params, _ := url.Parse(l.Config.NotifyUrl)
+		req.NotifyUrl = fmt.Sprintf("%s://%s/notify/transfer/wechat/%s", params.Scheme, params.Host, l.Config.AppId)
+	}
+	if req.Appid == "" {
+		req.Appid = l.Config.AppId
+	}
+
+	result, err := client.Post(l.Ctx, "https://api.mch.weixin.qq.com/v3/fund-app/mch-transfer/transfer-bills", req)
+	if err != nil {
+		weChatTransferErr.CounterInc()
+		logx.Errorf("TransferBill 请求失败 outBillNo: %s, err: %v", req.OutBillNo, err)
+		return nil, err
+	}
+
+	resp := new(TransferBillResp)
+	if err = core.UnMarshalResponse(result.Response, resp); err != nil {
+		logx.Errorf("TransferBill 解析返回失败 outBillNo: %s, err: %v", req.OutBillNo, err)
+		return nil, err
+	}
+	logx.Slowf("TransferBill outBillNo: %s, resp: %+v", req.OutBillNo, resp)
+	return resp, nil
+}
+
+// 商户单号查询转账单
+func (l *WeChatCommPay) QueryTransferBill(outBillNo string) (*TransferBill, error) {
+	client, err := l.getClient()
+	if err != nil {
+		logx.Errorf("QueryTransferBill 初始化微信client失败,err =%v", err)
+		return nil, err
+	}
+
+	uri := fmt.Sprintf("https://api.mch.weixin.qq.com/v3/fund-app/mch-transfer/transfer-bills/out-bill-no/%s", url.PathEscape(outBillNo))
+	result, err := client.Get(l.Ctx, uri)
+	if err != nil {
+		weChatTransferErr.CounterInc()
+		logx.Errorf("QueryTransferBill 请求失败 outBillNo: %s, err: %v", outBillNo, err)
+		return nil, err
+	}
+
+	bill := new(TransferBill)
+	if err = core.UnMarshalResponse(result.Response, bill); err != nil {
+		logx.Errorf("QueryTransferBill 解析返回失败 outBillNo: %s, err: %v", outBillNo, err)
+		return nil, err
+	}
+	return bill, nil
+}
+
+// 商家转账回调通知，验签并解密
+// https://pay.weixin.qq.com/doc/v3/merchant/4012712115
+func (l *WeChatCommPay) TransferBillNotify(r *http.Request) (*TransferBill, error) {
+	handler, err := l.newNotifyHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	bill := new(TransferBill)
+	notifyReq, err := handler.ParseNotifyRequest(l.Ctx, r, bill)
+	if err != nil {
+		weChatNotifyErr.CounterInc()
+		err = fmt.Errorf("验签未通过，或者解密失败！err=%w", err)
+		logx.Error(err.Error())
+		return nil, err
+	}
+
+	logx.Slowf("TransferBillNotify eventType=%s, bill=%+v", notifyReq.EventType, bill)
+	return bill, nil
+}
+
+// 回调通知验签解密使用的handler，配置了微信支付公钥时使用公钥验签，否则使用平台证书验签
+func (l *WeChatCommPay) newNotifyHandler() (*notify.Handler, error) {
+	tmpPublicKeyPemFile := l.getPublickKeyPemFile(l.Config.PrivateKeyPath)
+	if tmpPublicKeyPemFile != "" {
+		wechatpayPublicKey, err := utils.LoadPublicKeyWithPath(tmpPublicKeyPemFile)
+		if err != nil {
+			logx.Errorf("load wechatpay public key tmpPublicKeyPemFile:%s err:%s", tmpPublicKeyPemFile, err.Error())
+			return nil, err
+		}
+		return notify.NewNotifyHandler(l.Config.ApiKey, verifiers.NewSHA256WithRSAPubkeyVerifier(l.Config.PublicKeyId, *wechatpayPublicKey)), nil
+	}
+
+	mchPrivateKey, err := utils.LoadPrivateKeyWithPath(l.Config.PrivateKeyPath)
+	if err != nil {
+		weChatNotifyErr.CounterInc()
+		logx.Errorf("newNotifyHandler 获取私钥失败 err=%v", err)
+		return nil, err
+	}
+	err = downloader.MgrInstance().RegisterDownloaderWithPrivateKey(l.Ctx, mchPrivateKey, l.Config.SerialNumber, l.Config.MchId, l.Config.ApiKey)
+	if err != nil {
+		weChatNotifyErr.CounterInc()
+		logx.Errorf("newNotifyHandler 注册下载器失败 err=%v", err)
+		return nil, errors.New("注册下载器失败")
+	}
+	certificateVisitor := downloader.MgrInstance().GetCertificateVisitor(l.Config.MchId)
+	return notify.NewNotifyHandler(l.Config.ApiKey, verifiers.NewSHA256WithRSAVerifier(certificateVisitor)), nil
+}
@@ -9,6 +9,7 @@ import (
 	alipay2 "gitlab.muchcloud.com/consumer-project/alipay"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
 	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
 )
@@ -124,7 +125,8 @@ func getAlipayClientWithCache(pkgName string, aliAppId string, cacheTtl int) (pa
 		appConfigModel.RDB.Set(context.TODO(), rKeyAppCfg, *pkgCfg, cacheTtl)
 
 		rKeyPayCfg = payConfigAlipayModel.RDB.GetRedisKey(RedisAliPayConfigKey, pkgCfg.AlipayAppID)
-		payConfigAlipayModel.RDB.Set(context.TODO(), rKeyPayCfg, *payCfg, cacheTtl)
+		// 客户端缓存在内存中，Redis只缓存不含密钥的配置
+		payConfigAlipayModel.RDB.Set(context.TODO(), rKeyPayCfg, payCfg.WithoutSecrets(), cacheTtl)
 
 		payClient, err = client.GetAlipayClient(config)
 		if err == nil && payClient != nil {
@@ -140,3 +142,15 @@ func getAlipayClientWithCache(pkgName string, aliAppId string, cacheTtl int) (pa
 
 	return payClient, pkgCfg.AlipayAppID, payCfg.NotifyUrl, err
 }
+
+// ExpireAlipayClientCache 应用或支付宝商户配置变更后使缓存失效，pkgName、aliAppId为空的不处理
+func ExpireAlipayClientCache(pkgName string, aliAppId string) {
+	rdb := db.WithRedisDBContext(define.DbPayGateway)
+	if pkgName != "" {
+		model.ExpireCache(rdb, rdb.GetRedisKey(RedisAppConfigKey, pkgName))
+	}
+	if aliAppId != "" {
+		model.ExpireCache(rdb, rdb.GetRedisKey(RedisAliPayConfigKey, aliAppId))
+		cliCache.Delete(aliAppId)
+	}
+}
@@ -0,0 +1,141 @@
+package clientMgr
+
+import (
+	"fmt"
+	"time"
+
+	alipay2 "gitlab.muchcloud.com/consumer-project/alipay"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+)
+
+var (
+	alipayFundTransUnknownNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "alipayFundTransUnknownNum", nil, "支付宝转账结果未知", nil})}
+	alipayFundTransQueryErr   = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "alipayFundTransQueryErr", nil, "支付宝转账查询失败", nil})}
+)
+
+// 转账订单不存在时，超过该时间才认为转账失败，避免支付宝侧还未落单
+const fundTransNotExistFailAfter = 10 * time.Minute
+
+// 支付宝返回的转账状态转换为本地状态
+// https://opendocs.alipay.com/open/58a29899_alipay.fund.trans.common.query
+func alipayFundTransStatus(aliStatus string) int {
+	switch aliStatus {
+	case "SUCCESS":
+		return model.PmFundTransOrderStatusSuccess
+	case "FAIL", "CLOSED", "REFUND": // REFUND：转账到银行卡退票
+		return model.PmFundTransOrderStatusFail
+	case "INIT", "WAIT_PAY", "DEALING":
+		return model.PmFundTransOrderStatusDealing
+	}
+	return model.PmFundTransOrderStatusUnknown
+}
+
+// 转账接口返回的系统类错误不能确定是否打款成功，需要查询确认
+func isFundTransUncertainCode(code, subCode string) bool {
+	return code == "20000" || subCode == "SYSTEM_ERROR" || subCode == "aop.SYSTEM_ERROR"
+}
+
+// AlipayFundTransfer 发起支付宝单笔转账，以商户订单号（OutBizNo）做幂等，并经过风控检查
+func AlipayFundTransfer(payClient *alipay2.Client, orderInfo *model.PmFundTransOrderTable, param alipay2.FundTransUniTransfer) (*model.PmFundTransOrderTable, error) {
+	orderInfo.Channel = model.PmFundTransOrderChannelAlipay
+	orderInfo.ProductCode = param.ProductCode
+	orderInfo.BizScene = param.BizScene
+	return fundTransfer(orderInfo, param, func(info *model.PmFundTransOrderTable) (*model.PmFundTransOrderTable, error) {
+		return doAlipayFundTransfer(payClient, info, param)
+	})
+}
+
+// 请求支付宝转账并更新转账订单
+func doAlipayFundTransfer(payClient *alipay2.Client, orderInfo *model.PmFundTransOrderTable, param alipay2.FundTransUniTransfer) (*model.PmFundTransOrderTable, error) {
+	fundTransOrderModel := model.NewPmFundTransOrderModel(define.DbPayGateway)
+
+	rest, err := payClient.FundTransUniTransfer(param)
+	if err != nil {
+		alipayFundTransUnknownNum.CounterInc()
+		orderInfo.Status = model.PmFundTransOrderStatusUnknown
+		orderInfo.FailReason = fmt.Sprintf("请求转账失败：%v", err)
+		fundTransOrderModel.UpdateSomeData(orderInfo.Id, map[string]interface{}{
+			"status":      orderInfo.Status,
+			"fail_reason": orderInfo.FailReason,
+		})
+		return orderInfo, fmt.Errorf("支付宝转账失败 orderSn: %s, err: %v", param.OutBizNo, err)
+	}
+
+	if !rest.IsSuccess() {
+		orderInfo.Status = model.PmFundTransOrderStatusFail
+		if isFundTransUncertainCode(string(rest.Content.Code), rest.Content.SubCode) {
+			alipayFundTransUnknownNum.CounterInc()
+			orderInfo.Status = model.PmFundTransOrderStatusUnknown
+		}
+		orderInfo.FailReason = fmt.Sprintf("%s %s", rest.Content.SubCode, rest.Content.SubMsg)
+		fundTransOrderModel.UpdateSomeData(orderInfo.Id, map[string]interface{}{
+			"status":      orderInfo.Status,
+			"fail_reason": orderInfo.FailReason,
+		})
+		return orderInfo, fmt.Errorf("调用转账失败 orderSn: %s, err: %s", param.OutBizNo, orderInfo.FailReason)
+	}
+
+	orderInfo.Status = alipayFundTransStatus(rest.Content.Status)
+	orderInfo.AliOrderId = rest.Content.OrderId
+	orderInfo.ChannelBillNo = rest.Content.OrderId
+	orderInfo.PayFundOrderId = rest.Content.PayFundOrderId
+	orderInfo.TransDate = rest.Content.TransDate
+	orderInfo.FailReason = ""
+	fundTransOrderModel.UpdateSomeData(orderInfo.Id, map[string]interface{}{
+		"status":            orderInfo.Status,
+		"ali_order_id":      orderInfo.AliOrderId,
+		"channel_bill_no":   orderInfo.ChannelBillNo,
+		"pay_fund_order_id": orderInfo.PayFundOrderId,
+		"trans_date":        orderInfo.TransDate,
+		"fail_reason":       orderInfo.FailReason,
+	})
+	return orderInfo, nil
+}
+
+// AlipayFundTransQuery 查询支付宝转账结果，并更新处理中、结果未知的转账订单
+func AlipayFundTransQuery(payClient *alipay2.Client, orderInfo *model.PmFundTransOrderTable) error {
+	if !orderInfo.NeedQuery() {
+		return nil
+	}
+
+	param := alipay2.FundTransCommonQuery{
+		ProductCode: orderInfo.ProductCode,
+		BizScene:    orderInfo.BizScene,
+		OutBizNo:    orderInfo.OrderSn,
+	}
+	rest, err := payClient.FundTransCommonQuery(param)
+	if err != nil {
+		alipayFundTransQueryErr.CounterInc()
+		return fmt.Errorf("查询支付宝转账失败 orderSn: %s, err: %v", orderInfo.OrderSn, err)
+	}
+
+	updateData := make(map[string]interface{})
+	if !rest.IsSuccess() {
+		if rest.Content.SubCode != "ORDER_NOT_EXIST" || time.Since(orderInfo.CreatedAt) < fundTransNotExistFailAfter {
+			alipayFundTransQueryErr.CounterInc()
+			return fmt.Errorf("查询支付宝转账失败 orderSn: %s, err: %s %s", orderInfo.OrderSn, rest.Content.SubCode, rest.Content.SubMsg)
+		}
+		// 支付宝没有转账记录，说明转账请求未被受理，可以使用新订单号重新发起
+		orderInfo.Status = model.PmFundTransOrderStatusFail
+		orderInfo.FailReason = "支付宝转账订单不存在"
+		updateData["status"] = orderInfo.Status
+		updateData["fail_reason"] = orderInfo.FailReason
+	} else {
+		orderInfo.Status = alipayFundTransStatus(rest.Content.Status)
+		orderInfo.AliOrderId = rest.Content.OrderId
+		orderInfo.ChannelBillNo = rest.Content.OrderId
+		orderInfo.PayFundOrderId = rest.Content.PayFundOrderId
+		orderInfo.TransDate = rest.Content.PayDate
+		orderInfo.FailReason = rest.Content.FailReason
+		updateData["status"] = orderInfo.Status
+		updateData["ali_order_id"] = orderInfo.AliOrderId
+		updateData["channel_bill_no"] = orderInfo.ChannelBillNo
+		updateData["pay_fund_order_id"] = orderInfo.PayFundOrderId
+		updateData["trans_date"] = orderInfo.TransDate
+		updateData["fail_reason"] = orderInfo.FailReason
+	}
+
+	return model.NewPmFundTransOrderModel(define.DbPayGateway).UpdateSomeData(orderInfo.Id, updateData)
+}
@@ -0,0 +1,345 @@
+package clientMgr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	alipay2 "gitlab.muchcloud.com/consumer-project/alipay"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gorm.io/gorm"
+)
+
+var (
+	fundTransRiskBlockNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "fundTransRiskBlockNum", nil, "转账被风控拦截", nil})}
+)
+
+const (
+	redisFundTransLockKey     = "payGateway:fundTrans:%s"        // %s:商户订单号
+	redisFundTransRiskLockKey = "payGateway:fundTransRisk:%s:%s" // %s:统计维度 %s:收款账号或包名
+	fundTransRiskLockWait     = 3 * time.Second                  // 限额检查排队的最长等待时间
+)
+
+// 转账公共流程，以商户订单号做幂等：
+// 已成功、已失败、待审核的订单直接返回记录；处理中、结果未知的订单使用相同订单号重新请求，支付宝、微信都不会重复打款
+// 新订单先经过风控检查，超过限额直接拒绝，超过审核金额进入审核队列，审核通过后才发起转账
+// params为渠道的转账请求参数，进入审核队列时保存；doTransfer为实际请求渠道转账
+func fundTransfer(orderInfo *model.PmFundTransOrderTable, params interface{}, doTransfer func(*model.PmFundTransOrderTable) (*model.PmFundTransOrderTable, error)) (*model.PmFundTransOrderTable, error) {
+	fundTransOrderModel := model.NewPmFundTransOrderModel(define.DbPayGateway)
+
+	unlock, err := lockFundTrans(orderInfo.OrderSn)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	existInfo, err := fundTransOrderModel.GetOneByOrderSn(orderInfo.OrderSn)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	if existInfo.Id > 0 {
+		if existInfo.Amount != orderInfo.Amount || existInfo.PayeeAccount != orderInfo.PayeeAccount || existInfo.Channel != orderInfo.Channel {
+			return nil, fmt.Errorf("订单号已存在且转账信息不一致 orderSn: %s", orderInfo.OrderSn)
+		}
+		if existInfo.Status == model.PmFundTransOrderStatusFail {
+			return existInfo, fmt.Errorf("转账失败 orderSn: %s, reason: %s", orderInfo.OrderSn, existInfo.FailReason)
+		}
+		if !existInfo.NeedQuery() {
+			return existInfo, nil
+		}
+		return doTransfer(existInfo)
+	}
+
+	orderInfo, err = createFundTransOrder(orderInfo, params)
+	if err != nil || orderInfo.Status != model.PmFundTransOrderStatusDealing {
+		return orderInfo, err
+	}
+	return doTransfer(orderInfo)
+}
+
+// 风控检查通过后创建转账订单，超过审核金额的进入审核队列
+// 同一收款账号、同一应用的限额检查和入库串行执行，避免并发转账时都通过检查而超过当天限额
+func createFundTransOrder(orderInfo *model.PmFundTransOrderTable, params interface{}) (*model.PmFundTransOrderTable, error) {
+	unlockPkg, err := lockFundTransRisk("app_pkg_name", orderInfo.AppPkgName)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockPkg()
+	unlockAccount, err := lockFundTransRisk("payee_account", orderInfo.PayeeAccount)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockAccount()
+
+	blockReason, approvalReason, err := checkFundTransRisk(orderInfo)
+	if err != nil {
+		return nil, err
+	}
+	if blockReason != "" {
+		fundTransRiskBlockNum.CounterInc()
+		addFundTransAuditLog(orderInfo, model.FundTransAuditActionBlock, model.FundTransAuditOperatorSystem, blockReason)
+		return nil, fmt.Errorf("转账被风控拦截 orderSn: %s, reason: %s", orderInfo.OrderSn, blockReason)
+	}
+	if approvalReason != "" {
+		return submitFundTransApproval(orderInfo, params, approvalReason)
+	}
+
+	// 先入库再请求渠道，请求超时时能根据记录查询确认
+	orderInfo.Status = model.PmFundTransOrderStatusDealing
+	if err = model.NewPmFundTransOrderModel(define.DbPayGateway).Create(orderInfo); err != nil {
+		return nil, err
+	}
+	return orderInfo, nil
+}
+
+// FundTransQuery 按渠道查询转账结果，并更新处理中、结果未知的转账订单
+func FundTransQuery(orderInfo *model.PmFundTransOrderTable) error {
+	if !orderInfo.NeedQuery() {
+		return nil
+	}
+
+	if orderInfo.Channel == model.PmFundTransOrderChannelWechat {
+		payClient, err := getWechatTransClient(orderInfo.PayAppId)
+		if err != nil {
+			return err
+		}
+		return WechatFundTransQuery(payClient, orderInfo)
+	}
+
+	payClient, _, _, err := GetAlipayClientByAppIdWithCache(orderInfo.PayAppId)
+	if err != nil {
+		return err
+	}
+	return AlipayFundTransQuery(payClient, orderInfo)
+}
+
+// FundTransApprove 人工审核待审核的转账，通过后按原参数、原渠道发起转账，拒绝时转账订单置为失败
+func FundTransApprove(orderSn string, pass bool, reviewer, remark string) (*model.PmFundTransOrderTable, error) {
+	fundTransOrderModel := model.NewPmFundTransOrderModel(define.DbPayGateway)
+	fundTransRiskModel := model.NewPmFundTransRiskModel(define.DbPayGateway)
+
+	unlock, err := lockFundTrans(orderSn)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	approval, err := fundTransRiskModel.GetApprovalByOrderSn(orderSn)
+	if err != nil || approval.ID == 0 {
+		return nil, errors.New("转账审核单不存在")
+	}
+	if approval.Status != model.FundTransApprovalStatusWait {
+		return nil, errors.New("转账审核单已处理")
+	}
+	orderInfo, err := fundTransOrderModel.GetOneByOrderSn(orderSn)
+	if err != nil || orderInfo.Status != model.PmFundTransOrderStatusApproval {
+		return nil, fmt.Errorf("转账订单状态不正确 orderSn: %s, err: %v", orderSn, err)
+	}
+
+	// 审核通过时先准备好渠道的转账请求，避免审核单已通过但无法发起转账
+	var doTransfer func(*model.PmFundTransOrderTable) (*model.PmFundTransOrderTable, error)
+	if pass {
+		doTransfer, err = approvedFundTransfer(orderInfo, approval.TransParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status, action := model.FundTransApprovalStatusPass, model.FundTransAuditActionPass
+	if !pass {
+		status, action = model.FundTransApprovalStatusReject, model.FundTransAuditActionReject
+	}
+	err = fundTransRiskModel.UpdateApproval(approval.ID, map[string]interface{}{
+		"status":        status,
+		"reviewer":      reviewer,
+		"review_remark": remark,
+		"review_at":     time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	addFundTransAuditLog(orderInfo, action, reviewer, remark)
+
+	if !pass {
+		orderInfo.Status = model.PmFundTransOrderStatusFail
+		orderInfo.FailReason = "审核拒绝：" + remark
+		err = fundTransOrderModel.UpdateSomeData(orderInfo.Id, map[string]interface{}{
+			"status":      orderInfo.Status,
+			"fail_reason": orderInfo.FailReason,
+		})
+		return orderInfo, err
+	}
+
+	// 先置为处理中再请求，请求异常时由定时任务查询确认
+	orderInfo.Status = model.PmFundTransOrderStatusDealing
+	if err = fundTransOrderModel.UpdateSomeData(orderInfo.Id, map[string]interface{}{"status": orderInfo.Status}); err != nil {
+		return nil, err
+	}
+	return doTransfer(orderInfo)
+}
+
+// 按转账渠道解析审核单保存的原转账参数，返回发起转账的方法
+func approvedFundTransfer(orderInfo *model.PmFundTransOrderTable, transParams string) (func(*model.PmFundTransOrderTable) (*model.PmFundTransOrderTable, error), error) {
+	if orderInfo.Channel == model.PmFundTransOrderChannelWechat {
+		param := new(client.TransferBillReq)
+		if err := json.Unmarshal([]byte(transParams), param); err != nil {
+			return nil, fmt.Errorf("解析转账参数失败 orderSn: %s, err: %v", orderInfo.OrderSn, err)
+		}
+		payClient, err := getWechatTransClient(orderInfo.PayAppId)
+		if err != nil {
+			return nil, err
+		}
+		return func(info *model.PmFundTransOrderTable) (*model.PmFundTransOrderTable, error) {
+			return doWechatFundTransfer(payClient, info, param)
+		}, nil
+	}
+
+	var param alipay2.FundTransUniTransfer
+	if err := json.Unmarshal([]byte(transParams), &param); err != nil {
+		return nil, fmt.Errorf("解析转账参数失败 orderSn: %s, err: %v", orderInfo.OrderSn, err)
+	}
+	payClient, _, _, err := GetAlipayClientByAppIdWithCache(orderInfo.PayAppId)
+	if err != nil {
+		return nil, err
+	}
+	return func(info *model.PmFundTransOrderTable) (*model.PmFundTransOrderTable, error) {
+		return doAlipayFundTransfer(payClient, info, param)
+	}, nil
+}
+
+// redis 并发控制，同一订单号同时只能有一个请求在处理
+func lockFundTrans(orderSn string) (unlock func(), err error) {
+	unlock, err = lockFundTransKey(fmt.Sprintf(redisFundTransLockKey, orderSn), 0)
+	if err != nil {
+		return nil, errors.New("转账处理中，请勿重复提交")
+	}
+	return unlock, nil
+}
+
+// 限额统计维度加锁，同一维度的转账排队等待，超过等待时间返回失败
+func lockFundTransRisk(field, value string) (unlock func(), err error) {
+	unlock, err = lockFundTransKey(fmt.Sprintf(redisFundTransRiskLockKey, field, value), fundTransRiskLockWait)
+	if err != nil {
+		return nil, errors.New("转账繁忙，请稍后重试")
+	}
+	return unlock, nil
+}
+
+// 加锁失败时每50毫秒重试一次，直到超过wait
+func lockFundTransKey(lockKey string, wait time.Duration) (unlock func(), err error) {
+	rdb := db.WithRedisDBContext(define.DbPayGateway)
+	value := uuid.New().String()
+	deadline := time.Now().Add(wait)
+	for {
+		isLock, err := rdb.TryLockWithTimeout(context.Background(), lockKey, value, 15000)
+		if err == nil && isLock {
+			break
+		}
+		if err != nil || time.Now().After(deadline) {
+			logx.Errorf("lockFundTransKey redis lock fail, err:%v, isLock:%v, key:%s", err, isLock, lockKey)
+			return nil, errors.New("redis lock fail")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return func() {
+		if unlockErr := rdb.Unlock(context.Background(), lockKey, value); unlockErr != nil {
+			logx.Slowf("redis unlock fail, key:%s, value:%s", lockKey, value)
+		}
+	}, nil
+}
+
+// 检查转账限额，blockReason不为空时拒绝转账，approvalReason不为空时需要人工审核
+func checkFundTransRisk(orderInfo *model.PmFundTransOrderTable) (blockReason, approvalReason string, err error) {
+	limit, err := model.NewPmFundTransRiskModel(define.DbPayGateway).GetLimitByPkgName(orderInfo.AppPkgName)
+	if err != nil {
+		return "", "", err
+	}
+
+	if limit.SingleMinAmount > 0 && orderInfo.Amount < limit.SingleMinAmount {
+		return fmt.Sprintf("单笔转账金额不能小于%.2f元", float64(limit.SingleMinAmount)/100), "", nil
+	}
+	if limit.SingleMaxAmount > 0 && orderInfo.Amount > limit.SingleMaxAmount {
+		return fmt.Sprintf("单笔转账金额不能大于%.2f元", float64(limit.SingleMaxAmount)/100), "", nil
+	}
+
+	fundTransOrderModel := model.NewPmFundTransOrderModel(define.DbPayGateway)
+	if limit.PayeeDailyAmount > 0 || limit.IdentityDailyCount > 0 {
+		amount, count, statErr := fundTransOrderModel.GetDailyStat("payee_account", orderInfo.PayeeAccount)
+		if statErr != nil {
+			return "", "", statErr
+		}
+		if limit.IdentityDailyCount > 0 && count >= int64(limit.IdentityDailyCount) {
+			return fmt.Sprintf("收款账号当天转账次数已达上限%d次", limit.IdentityDailyCount), "", nil
+		}
+		if limit.PayeeDailyAmount > 0 && amount+int64(orderInfo.Amount) > int64(limit.PayeeDailyAmount) {
+			return fmt.Sprintf("收款账号当天累计转账金额超过%.2f元", float64(limit.PayeeDailyAmount)/100), "", nil
+		}
+	}
+	if limit.PkgDailyAmount > 0 {
+		amount, _, statErr := fundTransOrderModel.GetDailyStat("app_pkg_name", orderInfo.AppPkgName)
+		if statErr != nil {
+			return "", "", statErr
+		}
+		if amount+int64(orderInfo.Amount) > int64(limit.PkgDailyAmount) {
+			return fmt.Sprintf("应用当天累计转账金额超过%.2f元", float64(limit.PkgDailyAmount)/100), "", nil
+		}
+	}
+
+	if limit.ApprovalAmount > 0 && orderInfo.Amount > limit.ApprovalAmount {
+		return "", fmt.Sprintf("单笔转账金额超过%.2f元需要审核", float64(limit.ApprovalAmount)/100), nil
+	}
+	return "", "", nil
+}
+
+// 转账进入审核队列，保存原始转账参数，审核通过后按原参数转账
+func submitFundTransApproval(orderInfo *model.PmFundTransOrderTable, param interface{}, reason string) (*model.PmFundTransOrderTable, error) {
+	transParams, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+
+	orderInfo.Status = model.PmFundTransOrderStatusApproval
+	if err = model.NewPmFundTransOrderModel(define.DbPayGateway).Create(orderInfo); err != nil {
+		return nil, err
+	}
+
+	approval := &model.PmFundTransApprovalTable{
+		OrderSn:      orderInfo.OrderSn,
+		AppPkgName:   orderInfo.AppPkgName,
+		Amount:       orderInfo.Amount,
+		PayeeName:    orderInfo.AliName,
+		PayeeAccount: orderInfo.PayeeAccount,
+		Channel:      orderInfo.Channel,
+		PayAppId:     orderInfo.PayAppId,
+		TransParams:  string(transParams),
+		RiskReason:   reason,
+		Status:       model.FundTransApprovalStatusWait,
+		ReviewAt:     time.Date(2000, 1, 1, 0, 0, 1, 0, time.Local),
+	}
+	if err = model.NewPmFundTransRiskModel(define.DbPayGateway).CreateApproval(approval); err != nil {
+		return nil, err
+	}
+	addFundTransAuditLog(orderInfo, model.FundTransAuditActionSubmit, model.FundTransAuditOperatorSystem, reason)
+	return orderInfo, nil
+}
+
+func addFundTransAuditLog(orderInfo *model.PmFundTransOrderTable, action int, operator, remark string) {
+	model.NewPmFundTransRiskModel(define.DbPayGateway).AddAuditLog(&model.PmFundTransAuditLogTable{
+		OrderSn:      orderInfo.OrderSn,
+		AppPkgName:   orderInfo.AppPkgName,
+		Amount:       orderInfo.Amount,
+		PayeeAccount: orderInfo.PayeeAccount,
+		Action:       action,
+		Operator:     operator,
+		Remark:       remark,
+	})
+}
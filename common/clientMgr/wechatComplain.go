@@ -0,0 +1,88 @@
+package clientMgr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/alarm"
+)
+
+// 投诉激增告警：最近1小时投诉数不少于wechatComplainSpikeMinNum，且超过前7天小时均值的wechatComplainSpikeTimes倍
+const (
+	wechatComplainSpikeMinNum = 5
+	wechatComplainSpikeTimes  = 3
+)
+
+const redisWechatComplainSpikeKey = "payGateway:wechatComplainSpike:%s" // %s:商户号，同一商户1小时内只告警一次
+
+// SaveWechatComplaint 保存微信投诉单，按微信支付订单号关联pm_pay_order，新投诉会检查商户投诉是否激增
+func SaveWechatComplaint(payAppId string, complaint *client.WechatComplaint) error {
+	info := &model.PmWechatComplainTable{
+		MchId:              complaint.ComplaintedMchid,
+		PayAppId:           payAppId,
+		ComplaintId:        complaint.ComplaintId,
+		ComplaintState:     complaint.ComplaintState,
+		ComplaintDetail:    complaint.ComplaintDetail,
+		ProblemType:        complaint.ProblemType,
+		ProblemDescription: complaint.ProblemDescription,
+		ApplyRefundAmount:  int(complaint.ApplyRefundAmount),
+		UserComplaintTimes: complaint.UserComplaintTimes,
+	}
+	info.ComplaintTime, _ = time.ParseInLocation(time.RFC3339, complaint.ComplaintTime, time.Local)
+	if complaint.ComplaintFullRefunded {
+		info.ComplaintFullRefunded = 1
+	}
+
+	if len(complaint.ComplaintOrderInfo) > 0 {
+		orderInfo := complaint.ComplaintOrderInfo[0]
+		info.TransactionId = orderInfo.TransactionId
+		info.OrderAmount = int(orderInfo.Amount)
+		payOrder, err := model.NewPmPayOrderModel(define.DbPayGateway).GetOneByThirdOrderNo(orderInfo.TransactionId)
+		if err == nil {
+			info.OrderSn = payOrder.OrderSn
+			info.AppPkgName = payOrder.AppPkgName
+		}
+	}
+
+	isNew, err := model.NewPmWechatComplainModel(define.DbPayGateway).Sync(info)
+	if err != nil {
+		return err
+	}
+	if isNew {
+		checkWechatComplainSpike(info.MchId)
+	}
+	return nil
+}
+
+// 检查商户投诉是否激增，激增时告警
+func checkWechatComplainSpike(mchId string) {
+	complainModel := model.NewPmWechatComplainModel(define.DbPayGateway)
+	now := time.Now()
+	recentNum, err := complainModel.CountByMchId(mchId, now.Add(-time.Hour), now)
+	if err != nil || recentNum < wechatComplainSpikeMinNum {
+		return
+	}
+	baseNum, err := complainModel.CountByMchId(mchId, now.Add(-time.Hour).AddDate(0, 0, -7), now.Add(-time.Hour))
+	if err != nil {
+		return
+	}
+	hourlyAvg := float64(baseNum) / (7 * 24)
+	if float64(recentNum) < hourlyAvg*wechatComplainSpikeTimes {
+		return
+	}
+
+	rdb := db.WithRedisDBContext(define.DbPayGateway)
+	isLock, err := rdb.TryLockWithTimeout(context.Background(), fmt.Sprintf(redisWechatComplainSpikeKey, mchId), "1", 3600000)
+	if err != nil || !isLock {
+		return
+	}
+	desc := fmt.Sprintf("微信商户投诉激增, mch_id=%s, 最近1小时投诉数=%d, 前7天小时均值=%.2f", mchId, recentNum, hourlyAvg)
+	logx.Errorf(desc)
+	alarm.ImmediateAlarm("wechatComplainSpike", desc, alarm.ALARM_LEVEL_FATAL)
+}
@@ -0,0 +1,156 @@
+package clientMgr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+)
+
+var (
+	wechatFundTransUnknownNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "wechatFundTransUnknownNum", nil, "微信商家转账结果未知", nil})}
+	wechatFundTransQueryErr   = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "wechatFundTransQueryErr", nil, "微信商家转账查询失败", nil})}
+)
+
+// 微信返回的转账单状态转换为本地状态，待用户确认收款也按处理中查询，用户超时未确认微信会撤销
+func wechatFundTransStatus(state string) int {
+	switch state {
+	case client.WechatTransferStateSuccess:
+		return model.PmFundTransOrderStatusSuccess
+	case client.WechatTransferStateFail, client.WechatTransferStateCancelled:
+		return model.PmFundTransOrderStatusFail
+	case client.WechatTransferStateAccepted, client.WechatTransferStateProcessing, client.WechatTransferStateWaitUserConfirm,
+		client.WechatTransferStateTransfering, client.WechatTransferStateCanceling:
+		return model.PmFundTransOrderStatusDealing
+	}
+	return model.PmFundTransOrderStatusUnknown
+}
+
+// 微信返回的错误是否能确定转账未受理，5xx和SYSTEM_ERROR不能确定，需要查询确认
+func isWechatTransferDefiniteFail(err error) bool {
+	var apiErr *core.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode < http.StatusInternalServerError && apiErr.Code != "SYSTEM_ERROR"
+}
+
+// 根据微信appid获取转账使用的client
+func getWechatTransClient(appId string) (*client.WeChatCommPay, error) {
+	payCfg, err := model.NewPmPayConfigWechatModel(define.DbPayGateway).GetOneByAppID(appId)
+	if err != nil {
+		return nil, fmt.Errorf("读取微信支付配置失败 appId: %s, err: %v", appId, err)
+	}
+	return client.NewWeChatCommPay(*payCfg.TransClientConfig()), nil
+}
+
+// WechatFundTransfer 发起微信商家转账到零钱，以商户单号（OutBillNo）做幂等，并经过风控检查
+func WechatFundTransfer(payClient *client.WeChatCommPay, orderInfo *model.PmFundTransOrderTable, param *client.TransferBillReq) (*model.PmFundTransOrderTable, error) {
+	orderInfo.Channel = model.PmFundTransOrderChannelWechat
+	orderInfo.BizScene = param.TransferSceneId
+	return fundTransfer(orderInfo, param, func(info *model.PmFundTransOrderTable) (*model.PmFundTransOrderTable, error) {
+		return doWechatFundTransfer(payClient, info, param)
+	})
+}
+
+// 请求微信转账并更新转账订单
+func doWechatFundTransfer(payClient *client.WeChatCommPay, orderInfo *model.PmFundTransOrderTable, param *client.TransferBillReq) (*model.PmFundTransOrderTable, error) {
+	fundTransOrderModel := model.NewPmFundTransOrderModel(define.DbPayGateway)
+
+	resp, err := payClient.TransferBill(param)
+	if err != nil {
+		orderInfo.Status = model.PmFundTransOrderStatusFail
+		if !isWechatTransferDefiniteFail(err) {
+			wechatFundTransUnknownNum.CounterInc()
+			orderInfo.Status = model.PmFundTransOrderStatusUnknown
+		}
+		orderInfo.FailReason = fmt.Sprintf("请求转账失败：%v", err)
+		fundTransOrderModel.UpdateSomeData(orderInfo.Id, map[string]interface{}{
+			"status":      orderInfo.Status,
+			"fail_reason": orderInfo.FailReason,
+		})
+		return orderInfo, fmt.Errorf("微信转账失败 orderSn: %s, err: %v", param.OutBillNo, err)
+	}
+
+	orderInfo.Status = wechatFundTransStatus(resp.State)
+	orderInfo.ChannelBillNo = resp.TransferBillNo
+	orderInfo.PackageInfo = resp.PackageInfo
+	orderInfo.FailReason = resp.FailReason
+	fundTransOrderModel.UpdateSomeData(orderInfo.Id, map[string]interface{}{
+		"status":          orderInfo.Status,
+		"channel_bill_no": orderInfo.ChannelBillNo,
+		"package_info":    orderInfo.PackageInfo,
+		"fail_reason":     orderInfo.FailReason,
+	})
+	return orderInfo, nil
+}
+
+// WechatFundTransQuery 查询微信转账单，并更新处理中、结果未知的转账订单
+func WechatFundTransQuery(payClient *client.WeChatCommPay, orderInfo *model.PmFundTransOrderTable) error {
+	if !orderInfo.NeedQuery() {
+		return nil
+	}
+
+	bill, err := payClient.QueryTransferBill(orderInfo.OrderSn)
+	if err != nil {
+		var apiErr *core.APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != "NOT_FOUND" || time.Since(orderInfo.CreatedAt) < fundTransNotExistFailAfter {
+			wechatFundTransQueryErr.CounterInc()
+			return fmt.Errorf("查询微信转账失败 orderSn: %s, err: %v", orderInfo.OrderSn, err)
+		}
+		// 微信没有转账单，说明转账请求未被受理，可以使用新订单号重新发起
+		orderInfo.Status = model.PmFundTransOrderStatusFail
+		orderInfo.FailReason = "微信转账单不存在"
+		return model.NewPmFundTransOrderModel(define.DbPayGateway).UpdateSomeData(orderInfo.Id, map[string]interface{}{
+			"status":      orderInfo.Status,
+			"fail_reason": orderInfo.FailReason,
+		})
+	}
+
+	return updateWechatFundTrans(orderInfo, bill)
+}
+
+// WechatFundTransNotify 处理微信转账回调通知，返回对应的转账订单
+func WechatFundTransNotify(bill *client.TransferBill) (*model.PmFundTransOrderTable, error) {
+	unlock, err := lockFundTrans(bill.OutBillNo)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	orderInfo, err := model.NewPmFundTransOrderModel(define.DbPayGateway).GetOneByOrderSn(bill.OutBillNo)
+	if err != nil {
+		return nil, fmt.Errorf("获取转账订单失败 orderSn: %s, err: %v", bill.OutBillNo, err)
+	}
+	if orderInfo.Channel != model.PmFundTransOrderChannelWechat || int64(orderInfo.Amount) != bill.TransferAmount {
+		return nil, fmt.Errorf("转账订单与微信通知不一致 orderSn: %s, amount: %d, notifyAmount: %d", bill.OutBillNo, orderInfo.Amount, bill.TransferAmount)
+	}
+	if !orderInfo.NeedQuery() {
+		return orderInfo, nil
+	}
+
+	return orderInfo, updateWechatFundTrans(orderInfo, bill)
+}
+
+// 按微信转账单更新转账订单
+func updateWechatFundTrans(orderInfo *model.PmFundTransOrderTable, bill *client.TransferBill) error {
+	orderInfo.Status = wechatFundTransStatus(bill.State)
+	orderInfo.ChannelBillNo = bill.TransferBillNo
+	orderInfo.FailReason = bill.FailReason
+	updateData := map[string]interface{}{
+		"status":          orderInfo.Status,
+		"channel_bill_no": orderInfo.ChannelBillNo,
+		"fail_reason":     orderInfo.FailReason,
+	}
+	if orderInfo.Status == model.PmFundTransOrderStatusSuccess {
+		orderInfo.TransDate = bill.UpdateTime
+		updateData["trans_date"] = orderInfo.TransDate
+	}
+	return model.NewPmFundTransOrderModel(define.DbPayGateway).UpdateSomeData(orderInfo.Id, updateData)
+}
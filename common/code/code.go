@@ -20,8 +20,14 @@ const (
 	APP_NOTIFY_TYPE_SIGN                = "sign"
 	APP_NOTIFY_TYPE_UNSIGN              = "unsign"
 	APP_NOTIFY_TYPE_SIGN_FEE_FAILED     = "sign_fee_failed"
+	APP_NOTIFY_TYPE_SUBSCRIBE_LAPSED    = "subscribe_lapsed"         // 续费重试全部失败或协议失效，订阅失效
+	APP_NOTIFY_TYPE_SUBSCRIBE_REMIND    = "subscribe_remind"         // 续费扣款前提醒
 	APP_NOTIFY_HUAWEI_PRODUCT_SUBSCIRBE = "huawei_product_subscirbe" // 华为商品订阅
 	APP_NOTIFY_HUAWEI_PRODUCT_BUY       = "huawei_product_buy"       // 华为商品购买
+	APP_NOTIFY_HUAWEI_SUB_DELAY         = "huawei_sub_delay"         // 华为订阅延期
+	APP_NOTIFY_HUAWEI_SUB_RETURN_FEE    = "huawei_sub_return_fee"    // 华为订阅返还最近一期费用，订阅继续有效
+	APP_NOTIFY_HUAWEI_SUB_WITHDRAWAL    = "huawei_sub_withdrawal"    // 华为订阅撤销，退款并立即取消订阅
+	APP_NOTIFY_HUAWEI_REFUND            = "huawei_refund"            // 华为购买已取消或已退款(对账发现)
 )
 
 // 订单状态  1:关闭，0:未支付，1:已支付，2:支付失败，3:已退款 4：退款中
@@ -0,0 +1,178 @@
+// Package envelope 商户密钥的信封加密
+//
+// 每行配置生成一个数据密钥加密该行的密钥字段，数据密钥由主密钥加密后和配置一起存储；
+// 主密钥只从配置或环境变量读取，不落库。加密算法为AES-256-GCM
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// 未在配置中设置主密钥时读取的环境变量
+const EnvMasterKey = "PAY_GATEWAY_MASTER_KEY"
+
+const (
+	keySize        = 32         // 主密钥、数据密钥长度
+	dataKeyPrefix  = "v1:"      // 加密后的数据密钥前缀
+	cipherPrefix   = "enc:v1:"  // 加密后的字段前缀，没有前缀的是未迁移的明文
+	dataKeyAddData = "data_key" // 加密数据密钥时的附加数据
+)
+
+var (
+	ErrNoMasterKey = errors.New("未配置商户密钥加密主密钥")
+
+	masterMutex sync.RWMutex
+	masterAead  cipher.AEAD
+)
+
+// Init 设置主密钥，key为base64编码的32字节，为空时读取环境变量，都为空时不加密
+func Init(key string) error {
+	if key == "" {
+		key = os.Getenv(EnvMasterKey)
+	}
+	if key == "" {
+		return nil
+	}
+
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return fmt.Errorf("主密钥不是base64编码: %v", err)
+	}
+	if len(b) != keySize {
+		return fmt.Errorf("主密钥长度应为%d字节，实际%d字节", keySize, len(b))
+	}
+	aead, err := newAead(b)
+	if err != nil {
+		return err
+	}
+
+	masterMutex.Lock()
+	masterAead = aead
+	masterMutex.Unlock()
+	return nil
+}
+
+// Enabled 是否已配置主密钥
+func Enabled() bool {
+	masterMutex.RLock()
+	defer masterMutex.RUnlock()
+	return masterAead != nil
+}
+
+// IsEncrypted 字段是否已加密
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, cipherPrefix)
+}
+
+// NewDataKey 生成数据密钥，返回主密钥加密后的数据密钥
+func NewDataKey() (string, error) {
+	aead, err := getMasterAead()
+	if err != nil {
+		return "", err
+	}
+	key := make([]byte, keySize)
+	if _, err = io.ReadFull(rand.Reader, key); err != nil {
+		return "", err
+	}
+	sealed, err := seal(aead, key, []byte(dataKeyAddData))
+	if err != nil {
+		return "", err
+	}
+	return dataKeyPrefix + sealed, nil
+}
+
+// DataKey 解密后的数据密钥，只在进程内使用
+type DataKey struct {
+	aead cipher.AEAD
+}
+
+// OpenDataKey 用主密钥解密数据密钥
+func OpenDataKey(wrapped string) (*DataKey, error) {
+	if !strings.HasPrefix(wrapped, dataKeyPrefix) {
+		return nil, errors.New("数据密钥格式错误")
+	}
+	aead, err := getMasterAead()
+	if err != nil {
+		return nil, err
+	}
+	key, err := open(aead, strings.TrimPrefix(wrapped, dataKeyPrefix), []byte(dataKeyAddData))
+	if err != nil {
+		return nil, fmt.Errorf("解密数据密钥失败: %v", err)
+	}
+	aead, err = newAead(key)
+	if err != nil {
+		return nil, err
+	}
+	return &DataKey{aead: aead}, nil
+}
+
+// Encrypt 加密字段，addData一般为表名和字段名，防止密文被挪用到其他字段；空值和已加密的原样返回
+func (k *DataKey) Encrypt(value string, addData string) (string, error) {
+	if value == "" || IsEncrypted(value) {
+		return value, nil
+	}
+	sealed, err := seal(k.aead, []byte(value), []byte(addData))
+	if err != nil {
+		return "", err
+	}
+	return cipherPrefix + sealed, nil
+}
+
+// Decrypt 解密字段，未加密的明文原样返回
+func (k *DataKey) Decrypt(value string, addData string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	plain, err := open(k.aead, strings.TrimPrefix(value, cipherPrefix), []byte(addData))
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+func getMasterAead() (cipher.AEAD, error) {
+	masterMutex.RLock()
+	defer masterMutex.RUnlock()
+	if masterAead == nil {
+		return nil, ErrNoMasterKey
+	}
+	return masterAead, nil
+}
+
+func newAead(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// 随机nonce拼在密文前，base64编码
+func seal(aead cipher.AEAD, plain, addData []byte) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	out := aead.Seal(nonce, nonce, plain, addData)
+	return base64.StdEncoding.EncodeToString(out), nil
+}
+
+func open(aead cipher.AEAD, sealed string, addData []byte) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < aead.NonceSize() {
+		return nil, errors.New("密文长度错误")
+	}
+	return aead.Open(nil, b[:aead.NonceSize()], b[aead.NonceSize():], addData)
+}
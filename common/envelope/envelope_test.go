@@ -0,0 +1,196 @@
+package envelope
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func testMasterKey(b byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(b), keySize)))
+}
+
+// 切换主密钥，测试结束后恢复为未配置
+func setMasterKey(t *testing.T, key string) {
+	t.Helper()
+	masterMutex.Lock()
+	masterAead = nil
+	masterMutex.Unlock()
+	if err := Init(key); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() {
+		masterMutex.Lock()
+		masterAead = nil
+		masterMutex.Unlock()
+	})
+}
+
+func TestInit(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         string
+		wantErr     bool
+		wantEnabled bool
+	}{
+		{name: "未配置", key: "", wantEnabled: false},
+		{name: "32字节", key: testMasterKey('a'), wantEnabled: true},
+		{name: "不是base64", key: "not base64!", wantErr: true},
+		{name: "长度错误", key: base64.StdEncoding.EncodeToString([]byte("short")), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvMasterKey, "")
+			masterMutex.Lock()
+			masterAead = nil
+			masterMutex.Unlock()
+
+			err := Init(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Init() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && Enabled() != tt.wantEnabled {
+				t.Errorf("Enabled() = %v, want %v", Enabled(), tt.wantEnabled)
+			}
+		})
+	}
+}
+
+func TestDataKey_EncryptDecrypt(t *testing.T) {
+	setMasterKey(t, testMasterKey('a'))
+	wrapped, err := NewDataKey()
+	if err != nil {
+		t.Fatalf("NewDataKey() error = %v", err)
+	}
+	key, err := OpenDataKey(wrapped)
+	if err != nil {
+		t.Fatalf("OpenDataKey() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		value       string
+		addData     string
+		openAddData string
+		wantPlain   string
+		wantErr     bool
+	}{
+		{name: "往返", value: "secret", addData: "pm_pay_config_alipay.private_key", openAddData: "pm_pay_config_alipay.private_key", wantPlain: "secret"},
+		{name: "中文", value: "商户密钥", addData: "huawei_app.client_secret", openAddData: "huawei_app.client_secret", wantPlain: "商户密钥"},
+		{name: "空值不加密", value: "", addData: "pm_pay_config_ks.app_secret", openAddData: "pm_pay_config_ks.app_secret", wantPlain: ""},
+		{name: "挪到其他字段", value: "secret", addData: "pm_pay_config_wechat.api_key", openAddData: "pm_pay_config_wechat.api_key_v2", wantErr: true},
+		{name: "挪到其他表", value: "secret", addData: "pm_pay_config_tiktok.salt", openAddData: "pm_pay_config_alipay.private_key", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sealed, err := key.Encrypt(tt.value, tt.addData)
+			if err != nil {
+				t.Fatalf("Encrypt() error = %v", err)
+			}
+			if tt.value != "" && (!IsEncrypted(sealed) || strings.Contains(sealed, tt.value)) {
+				t.Fatalf("Encrypt() = %s, 未加密", sealed)
+			}
+			again, err := key.Encrypt(sealed, tt.addData)
+			if err != nil || again != sealed {
+				t.Errorf("Encrypt() 已加密的值应原样返回, got %s, err %v", again, err)
+			}
+
+			got, err := key.Decrypt(sealed, tt.openAddData)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decrypt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.wantPlain {
+				t.Errorf("Decrypt() = %s, want %s", got, tt.wantPlain)
+			}
+		})
+	}
+}
+
+func TestDataKey_DecryptPlain(t *testing.T) {
+	setMasterKey(t, testMasterKey('a'))
+	wrapped, _ := NewDataKey()
+	key, err := OpenDataKey(wrapped)
+	if err != nil {
+		t.Fatalf("OpenDataKey() error = %v", err)
+	}
+	got, err := key.Decrypt("plain-secret", "pm_pay_config_ks.app_secret")
+	if err != nil || got != "plain-secret" {
+		t.Errorf("Decrypt() 未迁移的明文应原样返回, got %s, err %v", got, err)
+	}
+}
+
+func TestOpenDataKey(t *testing.T) {
+	setMasterKey(t, testMasterKey('a'))
+	wrapped, err := NewDataKey()
+	if err != nil {
+		t.Fatalf("NewDataKey() error = %v", err)
+	}
+	key, _ := OpenDataKey(wrapped)
+	sealed, _ := key.Encrypt("secret", "huawei_app.client_secret")
+
+	tests := []struct {
+		name      string
+		masterKey string
+		wrapped   string
+		wantErr   bool
+	}{
+		{name: "同一主密钥", masterKey: testMasterKey('a'), wrapped: wrapped},
+		{name: "主密钥错误", masterKey: testMasterKey('b'), wrapped: wrapped, wantErr: true},
+		{name: "格式错误", masterKey: testMasterKey('a'), wrapped: strings.TrimPrefix(wrapped, dataKeyPrefix), wantErr: true},
+		{name: "数据密钥被篡改", masterKey: testMasterKey('a'), wrapped: dataKeyPrefix + base64.StdEncoding.EncodeToString([]byte(strings.Repeat("x", 60))), wantErr: true},
+		{name: "未配置主密钥", masterKey: "", wrapped: wrapped, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvMasterKey, "")
+			setMasterKey(t, tt.masterKey)
+
+			got, err := OpenDataKey(tt.wrapped)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OpenDataKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			plain, err := got.Decrypt(sealed, "huawei_app.client_secret")
+			if err != nil || plain != "secret" {
+				t.Errorf("Decrypt() = %s, err %v, want secret", plain, err)
+			}
+		})
+	}
+}
+
+func TestDataKey_DecryptTampered(t *testing.T) {
+	setMasterKey(t, testMasterKey('a'))
+	wrapped, _ := NewDataKey()
+	key, err := OpenDataKey(wrapped)
+	if err != nil {
+		t.Fatalf("OpenDataKey() error = %v", err)
+	}
+	sealed, _ := key.Encrypt("secret", "pm_pay_config_ks.app_secret")
+	b, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(sealed, cipherPrefix))
+
+	otherWrapped, _ := NewDataKey()
+	otherKey, _ := OpenDataKey(otherWrapped)
+
+	flipped := append([]byte(nil), b...)
+	flipped[len(flipped)-1] ^= 0x01
+
+	tests := []struct {
+		name  string
+		key   *DataKey
+		value string
+	}{
+		{name: "其他行的数据密钥", key: otherKey, value: sealed},
+		{name: "密文被修改", key: key, value: cipherPrefix + base64.StdEncoding.EncodeToString(flipped)},
+		{name: "密文被截断", key: key, value: cipherPrefix + base64.StdEncoding.EncodeToString(b[:4])},
+		{name: "不是base64", key: key, value: cipherPrefix + "!!!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.key.Decrypt(tt.value, "pm_pay_config_ks.app_secret"); err == nil {
+				t.Errorf("Decrypt() 应返回错误")
+			}
+		})
+	}
+}
@@ -1,5 +1,8 @@
 package huawei
 
+// 华为订单未记录业务回调地址时使用的默认地址
+const DefaultNotifyUrl = "http://quick4-go-pre.muchcloud.com/ver/user/notifyUser"
+
 // https://developer.huawei.com/consumer/cn/doc/HMSCore-References/api-notifications-about-subscription-events-v2-0000001385268541
 const (
 	// 通知类型 ORDER：订单
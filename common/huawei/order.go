@@ -1,8 +1,11 @@
 package huawei
 
 import (
+	"encoding/json"
 	"fmt"
-	"log"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
 )
 
 type OrderClient struct {
@@ -29,20 +32,112 @@ var OrderDemo = &OrderClient{}
 // 获取订单查询url
 const order_req_url = "https://orders-drcn.iap.cloud.huawei.com.cn"
 
-func (orderDemo *OrderClient) VerifyToken(authHeaderString, purchaseToken, productId string) {
+// 查询已取消或已退款购买记录的商品类型
+const (
+	CANCELLED_LIST_TYPE_ONE_TIME     = 0 // 一次性商品（消耗型和非消耗型）
+	CANCELLED_LIST_TYPE_SUBSCRIPTION = 2 // 订阅型商品
+)
+
+// 验证购买token返回
+type OrderVerifyResponse struct {
+	ResponseCode       string `json:"responseCode"`       // 返回码。0：成功。 其他：失败，具体请参见错误码。
+	ResponseMessage    string `json:"responseMessage"`    // 响应描述
+	PurchaseTokenData  string `json:"purchaseTokenData"`  // 包含购买详情的字符串（JSONString格式），格式请参见InappPurchaseDetails。
+	DataSignature      string `json:"dataSignature"`      // purchaseTokenData基于应用RSA IAP私钥的签名信息
+	SignatureAlgorithm string `json:"signatureAlgorithm"` // 签名算法
+}
+
+// 将purchaseTokenData解析成具体InAppPurchaseData数据
+func (r *OrderVerifyResponse) GetPurchaseData() (*InAppPurchaseData, error) {
+	var purchaseData InAppPurchaseData
+	err := json.Unmarshal([]byte(r.PurchaseTokenData), &purchaseData)
+	if err != nil {
+		return nil, err
+	}
+	return &purchaseData, nil
+}
+
+// 是否沙盒环境购买，purchaseType 0：沙盒环境，正式购买不会返回该参数
+func (r *OrderVerifyResponse) IsSandbox() bool {
+	var tmp struct {
+		PurchaseType *int `json:"purchaseType"`
+	}
+	if err := json.Unmarshal([]byte(r.PurchaseTokenData), &tmp); err != nil {
+		return false
+	}
+	return tmp.PurchaseType != nil && *tmp.PurchaseType == 0
+}
+
+// 确认购买返回
+type OrderConfirmResponse struct {
+	ResponseCode    string `json:"responseCode"`    // 返回码。0：成功。 其他：失败，具体请参见错误码。
+	ResponseMessage string `json:"responseMessage"` // 响应描述
+}
+
+// 已取消或已退款的购买记录查询返回
+type CancelledListResponse struct {
+	ResponseCode      string `json:"responseCode"`      // 返回码。0：成功。 其他：失败，具体请参见错误码。
+	ResponseMessage   string `json:"responseMessage"`   // 响应描述
+	CpList            string `json:"cpList"`            // 已取消或已退款的购买信息列表，JSON字符串格式，格式为CanceledPurchase的列表
+	ContinuationToken string `json:"continuationToken"` // 数据查询位置，有值时表示还有数据，作为下次查询的入参
+}
+
+// https://developer.huawei.com/consumer/cn/doc/HMSCore-References/server-data-model-0000001050986133#section1446124817286
+type CanceledPurchase struct {
+	PackageName      string `json:"packageName"`      // 应用包名
+	ProductId        string `json:"productId"`        // 商品ID
+	OrderId          string `json:"orderId"`          // 订单ID
+	PurchaseToken    string `json:"purchaseToken"`    // 购买令牌
+	PurchaseTime     int64  `json:"purchaseTime"`     // 购买时间，UTC时间戳，以毫秒为单位
+	CancelledTime    int64  `json:"cancelledTime"`    // 取消时间，UTC时间戳，以毫秒为单位
+	CancelledReason  int    `json:"cancelledReason"`  // 取消原因 0：其他 1：用户申请退款 2：开发者发起撤销 3：华为客服退款 4：欺诈支付撤销
+	CancelledWay     int    `json:"cancelledWay"`     // 取消途径 0：用户 1：开发者 2：华为
+	RefundPayOrderId string `json:"refundPayOrderId"` // 退款交易号
+}
+
+// 将cpList解析成具体CanceledPurchase列表
+func (r *CancelledListResponse) GetCanceledPurchases() ([]*CanceledPurchase, error) {
+	list := make([]*CanceledPurchase, 0)
+	if r.CpList == "" {
+		return list, nil
+	}
+
+	err := json.Unmarshal([]byte(r.CpList), &list)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// https://developer.huawei.com/consumer/cn/doc/HMSCore-References/api-purchase-token-verification-v2-0000001050988914
+//
+// 验证购买token, 只针对一次性商品（消耗型和非消耗型）
+func (orderDemo *OrderClient) VerifyToken(authHeaderString, purchaseToken, productId string) (*OrderVerifyResponse, error) {
 	bodyMap := map[string]string{"purchaseToken": purchaseToken, "productId": productId}
 	url := order_req_url + "/applications/purchases/tokens/verify"
-	bodyBytes, err := SendRequest(authHeaderString, url, bodyMap)
+	respStr, err := SendRequest(authHeaderString, url, bodyMap)
+	if err != nil {
+		return nil, err
+	}
+
+	var hwResp OrderVerifyResponse
+	err = json.Unmarshal([]byte(respStr), &hwResp)
 	if err != nil {
-		log.Printf("err is %s", err)
-		return
+		logx.Errorf("VerifyToken json.Unmarshal error: %v, raw data:%s", err, respStr)
+		return nil, err
+	}
+
+	if hwResp.ResponseCode != "0" {
+		return nil, fmt.Errorf("VerifyToken fail, responseCode: %s, responseMessage: %s", hwResp.ResponseCode, hwResp.ResponseMessage)
 	}
 
-	// TODO: display the response as string in console, you can replace it with your business logic.
-	log.Printf("%s", bodyBytes)
+	return &hwResp, nil
 }
 
-func (orderDemo *OrderClient) CancelledListPurchase(authHeaderString string, endAt int64, startAt int64, maxRows int, productType int, continuationToken string) {
+// https://developer.huawei.com/consumer/cn/doc/HMSCore-References/api-cancel-or-refund-record-v2-0000001050746117
+//
+// 查询已取消或已退款的购买记录，endAt、startAt是UTC时间戳，以毫秒为单位
+func (orderDemo *OrderClient) CancelledListPurchase(authHeaderString string, endAt int64, startAt int64, maxRows int, productType int, continuationToken string) (*CancelledListResponse, error) {
 	bodyMap := map[string]string{
 		"endAt":             fmt.Sprintf("%v", endAt),
 		"startAt":           fmt.Sprintf("%v", startAt),
@@ -51,24 +146,65 @@ func (orderDemo *OrderClient) CancelledListPurchase(authHeaderString string, end
 		"continuationToken": continuationToken,
 	}
 	url := order_req_url + "/applications/v2/purchases/cancelledList"
-	bodyBytes, err := SendRequest(authHeaderString, url, bodyMap)
+	respStr, err := SendRequest(authHeaderString, url, bodyMap)
+	if err != nil {
+		return nil, err
+	}
+
+	var hwResp CancelledListResponse
+	err = json.Unmarshal([]byte(respStr), &hwResp)
 	if err != nil {
-		log.Printf("err is %s", err)
+		logx.Errorf("CancelledListPurchase json.Unmarshal error: %v, raw data:%s", err, respStr)
+		return nil, err
+	}
+
+	if hwResp.ResponseCode != "0" {
+		return nil, fmt.Errorf("CancelledListPurchase fail, responseCode: %s, responseMessage: %s", hwResp.ResponseCode, hwResp.ResponseMessage)
 	}
-	// TODO: display the response as string in console, you can replace it with your business logic.
-	log.Printf("%s", bodyBytes)
+
+	return &hwResp, nil
 }
 
-func (orderDemo *OrderClient) ConfirmPurchase(authHeaderString, purchaseToken, productId string) {
+// https://developer.huawei.com/consumer/cn/doc/HMSCore-References/api-purchase-confirm-v2-0000001051066120
+//
+// 确认购买（即消耗），一次性商品发货后需要确认，否则华为会在一段时间后自动退款
+func (orderDemo *OrderClient) ConfirmPurchase(authHeaderString, purchaseToken, productId string) (*OrderConfirmResponse, error) {
 	bodyMap := map[string]string{
 		"purchaseToken": purchaseToken,
 		"productId":     productId,
 	}
 	url := order_req_url + "/applications/v2/purchases/confirm"
-	bodyBytes, err := SendRequest(authHeaderString, url, bodyMap)
+	respStr, err := SendRequest(authHeaderString, url, bodyMap)
+	if err != nil {
+		return nil, err
+	}
+
+	var hwResp OrderConfirmResponse
+	err = json.Unmarshal([]byte(respStr), &hwResp)
 	if err != nil {
-		log.Printf("err is %s", err)
+		logx.Errorf("ConfirmPurchase json.Unmarshal error: %v, raw data:%s", err, respStr)
+		return nil, err
+	}
+
+	if hwResp.ResponseCode != "0" {
+		return nil, fmt.Errorf("ConfirmPurchase fail, responseCode: %s, responseMessage: %s", hwResp.ResponseCode, hwResp.ResponseMessage)
+	}
+
+	return &hwResp, nil
+}
+
+// 确认购买，失败时重试，times为最多尝试次数
+func (orderDemo *OrderClient) ConfirmPurchaseWithRetry(authHeaderString, purchaseToken, productId string, times int) (resp *OrderConfirmResponse, err error) {
+	for i := 0; i < times; i++ {
+		if i > 0 {
+			time.Sleep(time.Duration(i) * time.Second)
+		}
+
+		resp, err = orderDemo.ConfirmPurchase(authHeaderString, purchaseToken, productId)
+		if err == nil {
+			return resp, nil
+		}
+		logx.Errorf("ConfirmPurchase error: %v, times: %d, purchaseToken: %s", err, i+1, purchaseToken)
 	}
-	// TODO: display the response as string in console, you can replace it with your business logic.
-	log.Printf("%s", bodyBytes)
+	return nil, err
 }
@@ -0,0 +1,262 @@
+package leader
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/exception"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+)
+
+// 基于redis租约的定时任务选主
+//
+// 抢到锁的节点成为leader，每隔租期的1/3续租一次，续租失败或发现锁已被其他节点持有时立即放弃leader
+// 每次成为leader时fencing token加1并写入数据库，任务执行过程中可以用CheckToken确认自己仍是当前leader，
+// 更新数据时携带token（model.LeaderFence），租约过期后旧leader的写入会被数据库拒绝
+
+var (
+	leaderChangeNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "leaderChangeNum", nil, "定时任务leader切换", nil})}
+)
+
+const (
+	redisLeaderLockKey  = "payGateway:leader:lock:%s"  // %s:选主名称，值为当前leader信息
+	redisLeaderTokenKey = "payGateway:leader:token:%s" // %s:选主名称，fencing token，只有持有锁的节点会递增，不设过期时间
+
+	leaderLeaseSecond = 30 // 租期，秒
+	leaderLeaseMs     = leaderLeaseSecond * 1000
+)
+
+// 锁的值等于ARGV[1]时才操作，判断和操作在redis中原子执行，避免续租、释放时覆盖其他节点刚抢到的锁
+const (
+	// 仍持有锁时递增token，返回新token，否则返回0
+	// redis中的token丢失或小于数据库中的token（ARGV[2]）时从数据库的token继续递增，保证新token大于数据库中的
+	incrTokenScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then
+	local token = redis.call("INCR", KEYS[2])
+	local floor = tonumber(ARGV[2])
+	if token <= floor then
+		token = floor + 1
+		redis.call("SET", KEYS[2], string.format("%d", token))
+	end
+	return token
+end
+return 0`
+	// 把锁的值换成leader信息，并重置租期
+	compareAndSetScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+	return 1
+end
+return 0`
+	compareAndExpireScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`
+	compareAndDelScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`
+)
+
+// 当前leader信息，作为锁的值，同一任期内不变
+type Info struct {
+	NodeId     string `json:"node_id"`     // 节点标识 主机名-ip-进程号-随机串
+	Token      int64  `json:"token"`       // fencing token，每次换主递增
+	AcquiredAt string `json:"acquired_at"` // 成为leader的时间
+}
+
+type Elector struct {
+	name   string
+	nodeId string
+	rdb    *cache.RedisInstance
+
+	mu       sync.RWMutex
+	isLeader bool
+	info     Info
+	value    string // 锁的值，即info的json
+	stopCh   chan struct{}
+}
+
+// NewElector 创建选主，同名的选主在所有节点中只有一个leader
+func NewElector(name string) *Elector {
+	return &Elector{
+		name:   name,
+		nodeId: newNodeId(),
+		rdb:    db.WithRedisDBContext(define.DbPayGateway),
+		stopCh: make(chan struct{}),
+	}
+}
+
+func newNodeId() string {
+	hostname, _ := os.Hostname()
+	ip := ""
+	if externalIP, err := util.ExternalIP(); err == nil {
+		ip = externalIP.String()
+	}
+	return fmt.Sprintf("%s-%s-%d-%s", hostname, ip, os.Getpid(), uuid.New().String()[:8])
+}
+
+// Start 后台抢锁和续租
+func (e *Elector) Start() {
+	go func() {
+		defer exception.Recover()
+		ticker := time.NewTicker(leaderLeaseSecond * time.Second / 3)
+		defer ticker.Stop()
+		for {
+			e.tick()
+			select {
+			case <-ticker.C:
+			case <-e.stopCh:
+				e.resign()
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止选主并主动释放锁
+func (e *Elector) Stop() {
+	close(e.stopCh)
+}
+
+// IsLeader 当前节点是否为leader，是的话返回本任期的fencing token
+func (e *Elector) IsLeader() (token int64, ok bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.info.Token, e.isLeader
+}
+
+// CheckToken 从redis确认token对应的任期仍然有效，长任务在每批处理前调用
+func (e *Elector) CheckToken(token int64) bool {
+	info, err := GetLeader(e.name)
+	if err != nil || info == nil {
+		return false
+	}
+	return info.NodeId == e.nodeId && info.Token == token
+}
+
+func (e *Elector) NodeId() string {
+	return e.nodeId
+}
+
+func (e *Elector) tick() {
+	if _, ok := e.IsLeader(); ok {
+		e.renew()
+		return
+	}
+	e.acquire()
+}
+
+// 抢锁，抢到后递增fencing token并写入数据库，写入失败时放弃本次抢锁
+func (e *Elector) acquire() {
+	ctx := context.Background()
+	lockKey := fmt.Sprintf(redisLeaderLockKey, e.name)
+	tmpValue := uuid.New().String()
+	isLock, err := e.rdb.TryLockWithTimeout(ctx, lockKey, tmpValue, leaderLeaseMs)
+	if err != nil || !isLock {
+		return
+	}
+
+	fenceModel := model.NewLeaderFenceModel(define.DbPayGateway)
+	fenceToken, err := fenceModel.GetToken(e.name)
+	if err != nil {
+		e.rdb.Eval(ctx, compareAndDelScript, []string{lockKey}, tmpValue)
+		return
+	}
+
+	tokenKey := fmt.Sprintf(redisLeaderTokenKey, e.name)
+	res, err := e.rdb.Eval(ctx, incrTokenScript, []string{lockKey, tokenKey}, tmpValue, fenceToken)
+	token, ok := res.(int64)
+	if err != nil || !ok || token <= 0 {
+		logx.Errorf("递增fencing token失败 name: %s, res: %v, err: %v", e.name, res, err)
+		e.rdb.Eval(ctx, compareAndDelScript, []string{lockKey}, tmpValue)
+		return
+	}
+	if err = fenceModel.Advance(e.name, token); err != nil {
+		e.rdb.Eval(ctx, compareAndDelScript, []string{lockKey}, tmpValue)
+		return
+	}
+
+	info := Info{
+		NodeId:     e.nodeId,
+		Token:      token,
+		AcquiredAt: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	value, _ := json.Marshal(info)
+	res, err = e.rdb.Eval(ctx, compareAndSetScript, []string{lockKey}, tmpValue, string(value), leaderLeaseMs)
+	if n, _ := res.(int64); err != nil || n != 1 {
+		logx.Errorf("写入leader信息失败 name: %s, err: %v", e.name, err)
+		return
+	}
+
+	e.mu.Lock()
+	e.isLeader, e.info, e.value = true, info, string(value)
+	e.mu.Unlock()
+
+	leaderChangeNum.CounterInc()
+	logx.Errorf("成为定时任务leader name: %s, nodeId: %s, token: %d", e.name, e.nodeId, token)
+}
+
+// 续租，锁已经不是自己的时放弃leader
+func (e *Elector) renew() {
+	lockKey := fmt.Sprintf(redisLeaderLockKey, e.name)
+
+	e.mu.RLock()
+	value := e.value
+	e.mu.RUnlock()
+
+	isLock, err := ExtendLock(e.rdb, lockKey, value, leaderLeaseMs)
+	if err != nil || !isLock {
+		e.lose(fmt.Sprintf("锁已失效或被其他节点持有 err: %v", err))
+	}
+}
+
+func (e *Elector) lose(reason string) {
+	e.mu.Lock()
+	e.isLeader = false
+	e.mu.Unlock()
+	logx.Errorf("失去定时任务leader name: %s, nodeId: %s, reason: %s", e.name, e.nodeId, reason)
+}
+
+// 主动释放锁，其他节点不用等租约过期
+func (e *Elector) resign() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.isLeader {
+		return
+	}
+	e.isLeader = false
+	e.rdb.Eval(context.Background(), compareAndDelScript, []string{fmt.Sprintf(redisLeaderLockKey, e.name)}, e.value)
+}
+
+// ExtendLock 锁的值仍为value时把过期时间重置为ms毫秒，返回是否仍持有锁
+func ExtendLock(rdb *cache.RedisInstance, lockKey, value string, ms int) (bool, error) {
+	res, err := rdb.Eval(context.Background(), compareAndExpireScript, []string{lockKey}, value, ms)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.(int64)
+	return n == 1, nil
+}
+
+// GetLeader 获取当前leader信息，没有leader时返回nil
+func GetLeader(name string) (*Info, error) {
+	value, err := db.WithRedisDBContext(define.DbPayGateway).GetString(context.Background(), fmt.Sprintf(redisLeaderLockKey, name))
+	if err != nil || value == "" {
+		return nil, err
+	}
+	info := new(Info)
+	if err = json.Unmarshal([]byte(value), info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
@@ -5,6 +5,65 @@ const (
 	WechatXPayHost = "https://api.weixin.qq.com"
 )
 
+// 虚拟支付的支付类型
+const (
+	XPayModeGoods = "short_series_goods" // 道具直购
+	XPayModeCoin  = "short_series_coin"  // 代币充值
+)
+
+// 虚拟支付消息推送事件
+const (
+	XPayEventGoodsDeliver = "xpay_goods_deliver_notify" // 道具发货推送
+	XPayEventCoinPay      = "xpay_coin_pay_notify"      // 代币支付推送
+)
+
+type XPaySignDataParam struct {
+	OfferId      string `json:"offerId"`              // offerId	string	在米大师侧申请的应用id, mp-支付基础配置中的offerid
+	BuyQuantity  int64  `json:"buyQuantity"`          // buyQuantity	number	购买数量
+	Env          int    `json:"env"`                  // env	number	0-正式环境 1-沙箱环境
+	CurrencyType string `json:"currencyType"`         // currencyType	string	币种，目前仅支持CNY
+	ProductId    string `json:"productId,omitempty"`  // productId	string	道具ID，道具直购时必填
+	GoodsPrice   int    `json:"goodsPrice,omitempty"` // goodsPrice	number	道具单价(分)，道具直购时必填
+	OutTradeNo   string `json:"outTradeNo"`           // outTradeNo	string	业务订单号，每个订单号只能使用一次
+	Attach       string `json:"attach"`               // attach	string	透传数据，发货通知时会透传给开发者
+}
+
+type XPayVirtualPaymentDTO struct {
+	SignData  string // 具体支付参数，json字符串
+	PaySig    string // 支付签名
+	Signature string // 用户态签名
+}
+
+// 虚拟支付消息推送内容（明文模式，json格式）
+type XPayNotifyMsg struct {
+	ToUserName    string `json:"ToUserName"`   // 小程序的原始ID
+	FromUserName  string `json:"FromUserName"` // 该事件消息的openid
+	CreateTime    int64  `json:"CreateTime"`   // 消息发送时间
+	MsgType       string `json:"MsgType"`      // 消息类型，固定为event
+	Event         string `json:"Event"`        // 事件类型 xpay_goods_deliver_notify / xpay_coin_pay_notify
+	OpenId        string `json:"OpenId"`       // 用户openid
+	OutTradeNo    string `json:"OutTradeNo"`   // 业务订单号
+	Env           int    `json:"Env"`          // 0-现网环境 1-沙箱环境
+	WeChatPayInfo struct {
+		MchOrderNo    string `json:"MchOrderNo"`    // 微信支付商户单号
+		TransactionId string `json:"TransactionId"` // 交易单号（微信支付订单号）
+		PaidTime      int64  `json:"PaidTime"`      // 用户支付时间，Linux秒级时间戳
+	} `json:"WeChatPayInfo"`
+	GoodsInfo struct {
+		ProductId   string `json:"ProductId"`   // 道具ID
+		Quantity    int64  `json:"Quantity"`    // 数量
+		OrigPrice   int64  `json:"OrigPrice"`   // 物品原始价格（单位：分）
+		ActualPrice int64  `json:"ActualPrice"` // 物品实际支付价格（单位：分）
+		Attach      string `json:"Attach"`      // 透传信息
+	} `json:"GoodsInfo"` // 道具直购时返回
+	CoinInfo struct {
+		Quantity    int64  `json:"Quantity"`    // 数量
+		OrigPrice   int64  `json:"OrigPrice"`   // 原始价格（单位：分）
+		ActualPrice int64  `json:"ActualPrice"` // 实际支付价格（单位：分）
+		Attach      string `json:"Attach"`      // 透传信息
+	} `json:"CoinInfo"` // 代币充值时返回
+}
+
 type XPayRefundOrderParam struct {
 	OpenId        string `json:"openid"`          // openid	string	下单时的用户openid
 	OrderId       string `json:"order_id"`        // order_id	string	下单时的单号，即jsapi接口传入的OutTradeNo，与wx_order_id字段二选一
@@ -3,10 +3,13 @@ package thirdApis
 import (
 	"context"
 	"crypto/hmac"
+	"crypto/sha1"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -27,6 +30,31 @@ func (*wechatXPayApi) createPaySig(appKey, data string) string {
 	return hex.EncodeToString(mac.Sum(nil))
 }
 
+// 虚拟支付 wx.requestVirtualPayment 所需的签名参数，paySig使用AppKey签名，signature使用用户session_key签名
+// https://developers.weixin.qq.com/miniprogram/dev/api/payment/wx.requestVirtualPayment.html
+func (this *wechatXPayApi) SignVirtualPayment(param *XPaySignDataParam, appKey, sessionKey string) (*XPayVirtualPaymentDTO, error) {
+	jsonByte, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	signData := string(jsonByte)
+
+	return &XPayVirtualPaymentDTO{
+		SignData:  signData,
+		PaySig:    this.createPaySig(appKey, "requestVirtualPayment&"+signData),
+		Signature: this.createPaySig(sessionKey, signData),
+	}, nil
+}
+
+// 校验小程序消息推送的签名 https://developers.weixin.qq.com/miniprogram/dev/framework/server-ability/message-push.html
+func (*wechatXPayApi) CheckPushSignature(token, signature, timestamp, nonce string) bool {
+	strs := []string{token, timestamp, nonce}
+	sort.Strings(strs)
+	sum := sha1.Sum([]byte(strings.Join(strs, "")))
+
+	return hex.EncodeToString(sum[:]) == signature
+}
+
 // 虚拟交易- 申请退款
 func (this *wechatXPayApi) RefundOrder(param *XPayRefundOrderParam, appKey, token string) (*XPayRefundOrderDTO, error) {
 	apiPath := "/xpay/refund_order"
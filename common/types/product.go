@@ -1,5 +1,12 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
 type Product struct {
 	Id              int     `json:"id"`
 	ProductId       string  `json:"product_id"`
@@ -13,3 +20,80 @@ type Product struct {
 	TryVipDay       int     `json:"try_vip_day"`
 	SubscribePeriod int     `json:"subscribe_period"`
 }
+
+// 支付宝周期扣款的周期类型
+const (
+	PeriodTypeDay   = "DAY"
+	PeriodTypeMonth = "MONTH"
+)
+
+// 支付宝按天扣款时周期不能小于7天
+const alipayMinPeriodDays = 7
+
+// 按月扣款时扣款日不能是29、30、31号
+const alipayMaxMonthDay = 28
+
+// 订阅周期天数对应的月数，能按月扣款的按月，避免按天扣款和自然月错位
+var subscribePeriodMonths = map[int]int{
+	30:  1,
+	31:  1,
+	60:  2,
+	90:  3,
+	180: 6,
+	360: 12,
+	365: 12,
+	366: 12,
+}
+
+// SubscribeSchedule 订阅的扣款计划
+type SubscribeSchedule struct {
+	PeriodType      string    // 周期类型 DAY|MONTH
+	Period          int       // 每期的周期数
+	FirstDeductTime time.Time // 首次续费扣款日期，首期(预付或试用)结束时
+	PeriodAmount    int       // 每期扣款金额（分）
+	FirstAmount     int       // 签约时支付的首期金额（分）
+	TrialDays       int       // 试用天数
+}
+
+// SubscribeSchedule 根据订阅周期和试用天数推算从signTime签约开始的扣款计划
+//
+// 签约时支付预付金额，有试用期的首次续费在试用期结束时，否则在首期结束时
+func (p *Product) SubscribeSchedule(signTime time.Time) (*SubscribeSchedule, error) {
+	if p.SubscribePeriod <= 0 {
+		return nil, errors.New("订阅周期错误")
+	}
+
+	schedule := &SubscribeSchedule{
+		PeriodType:   PeriodTypeDay,
+		Period:       p.SubscribePeriod,
+		PeriodAmount: int(math.Round(p.Amount * 100)),
+		FirstAmount:  int(math.Round(p.PrepaidAmount * 100)),
+		TrialDays:    p.TryVipDay,
+	}
+	if months, ok := subscribePeriodMonths[p.SubscribePeriod]; ok {
+		schedule.PeriodType = PeriodTypeMonth
+		schedule.Period = months
+	}
+	if schedule.PeriodType == PeriodTypeDay && schedule.Period < alipayMinPeriodDays {
+		return nil, fmt.Errorf("按天扣款的订阅周期不能小于%d天", alipayMinPeriodDays)
+	}
+
+	day := time.Date(signTime.Year(), signTime.Month(), signTime.Day(), 0, 0, 0, 0, signTime.Location())
+	switch {
+	case p.TryVipDay > 0:
+		schedule.FirstDeductTime = day.AddDate(0, 0, p.TryVipDay)
+	case schedule.PeriodType == PeriodTypeMonth && day.Day() > alipayMaxMonthDay:
+		// 扣款日顺延到下月1号，直接定位避免time.AddDate把1月31日加1个月算成3月3日
+		schedule.FirstDeductTime = time.Date(day.Year(), day.Month()+time.Month(schedule.Period)+1, 1, 0, 0, 0, 0, day.Location())
+	case schedule.PeriodType == PeriodTypeMonth:
+		schedule.FirstDeductTime = day.AddDate(0, schedule.Period, 0)
+	default:
+		schedule.FirstDeductTime = day.AddDate(0, 0, schedule.Period)
+	}
+
+	// 试用期结束在29号之后的，按月扣款的扣款日顺延到下月1号
+	if schedule.PeriodType == PeriodTypeMonth && schedule.FirstDeductTime.Day() > alipayMaxMonthDay {
+		schedule.FirstDeductTime = time.Date(schedule.FirstDeductTime.Year(), schedule.FirstDeductTime.Month()+1, 1, 0, 0, 0, 0, day.Location())
+	}
+	return schedule, nil
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestProduct_SubscribeSchedule(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+	}
+	signAt := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 15, 20, 0, 0, time.Local)
+	}
+	tests := []struct {
+		name     string
+		product  Product
+		signTime time.Time
+		want     *SubscribeSchedule
+		wantErr  bool
+	}{
+		{
+			name:     "按月",
+			product:  Product{Amount: 19.9, PrepaidAmount: 9.9, SubscribePeriod: 30},
+			signTime: signAt(2024, 1, 15),
+			want:     &SubscribeSchedule{PeriodType: PeriodTypeMonth, Period: 1, FirstDeductTime: date(2024, 2, 15), PeriodAmount: 1990, FirstAmount: 990},
+		},
+		{
+			name:     "29号签约顺延到下下月1号",
+			product:  Product{Amount: 19.9, PrepaidAmount: 9.9, SubscribePeriod: 30},
+			signTime: signAt(2024, 1, 29),
+			want:     &SubscribeSchedule{PeriodType: PeriodTypeMonth, Period: 1, FirstDeductTime: date(2024, 3, 1), PeriodAmount: 1990, FirstAmount: 990},
+		},
+		{
+			name:     "按季31号签约",
+			product:  Product{Amount: 58, PrepaidAmount: 58, SubscribePeriod: 90},
+			signTime: signAt(2024, 1, 31),
+			want:     &SubscribeSchedule{PeriodType: PeriodTypeMonth, Period: 3, FirstDeductTime: date(2024, 5, 1), PeriodAmount: 5800, FirstAmount: 5800},
+		},
+		{
+			name:     "按年跨年顺延",
+			product:  Product{Amount: 198, PrepaidAmount: 198, SubscribePeriod: 360},
+			signTime: signAt(2024, 12, 30),
+			want:     &SubscribeSchedule{PeriodType: PeriodTypeMonth, Period: 12, FirstDeductTime: date(2026, 1, 1), PeriodAmount: 19800, FirstAmount: 19800},
+		},
+		{
+			name:     "按年28号不顺延",
+			product:  Product{Amount: 198, PrepaidAmount: 198, SubscribePeriod: 365},
+			signTime: signAt(2024, 2, 28),
+			want:     &SubscribeSchedule{PeriodType: PeriodTypeMonth, Period: 12, FirstDeductTime: date(2025, 2, 28), PeriodAmount: 19800, FirstAmount: 19800},
+		},
+		{
+			name:     "试用期结束日为首次扣款日",
+			product:  Product{Amount: 19.9, PrepaidAmount: 0.01, TryVipDay: 3, SubscribePeriod: 30},
+			signTime: signAt(2024, 2, 10),
+			want:     &SubscribeSchedule{PeriodType: PeriodTypeMonth, Period: 1, FirstDeductTime: date(2024, 2, 13), PeriodAmount: 1990, FirstAmount: 1, TrialDays: 3},
+		},
+		{
+			name:     "试用期结束在29号之后顺延到下月1号",
+			product:  Product{Amount: 19.9, PrepaidAmount: 0.01, TryVipDay: 7, SubscribePeriod: 30},
+			signTime: signAt(2024, 1, 24),
+			want:     &SubscribeSchedule{PeriodType: PeriodTypeMonth, Period: 1, FirstDeductTime: date(2024, 2, 1), PeriodAmount: 1990, FirstAmount: 1, TrialDays: 7},
+		},
+		{
+			name:     "按天",
+			product:  Product{Amount: 6, PrepaidAmount: 1, SubscribePeriod: 7},
+			signTime: signAt(2024, 1, 30),
+			want:     &SubscribeSchedule{PeriodType: PeriodTypeDay, Period: 7, FirstDeductTime: date(2024, 2, 6), PeriodAmount: 600, FirstAmount: 100},
+		},
+		{
+			name:     "按天试用期结束在月末不顺延",
+			product:  Product{Amount: 6, PrepaidAmount: 1, TryVipDay: 5, SubscribePeriod: 14},
+			signTime: signAt(2024, 1, 25),
+			want:     &SubscribeSchedule{PeriodType: PeriodTypeDay, Period: 14, FirstDeductTime: date(2024, 1, 30), PeriodAmount: 600, FirstAmount: 100, TrialDays: 5},
+		},
+		{
+			name:     "按天周期小于7天",
+			product:  Product{Amount: 6, SubscribePeriod: 5},
+			signTime: signAt(2024, 1, 15),
+			wantErr:  true,
+		},
+		{
+			name:     "没有订阅周期",
+			product:  Product{Amount: 6},
+			signTime: signAt(2024, 1, 15),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.product.SubscribeSchedule(tt.signTime)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SubscribeSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SubscribeSchedule() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
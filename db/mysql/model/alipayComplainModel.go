@@ -0,0 +1,204 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+	"gorm.io/gorm"
+)
+
+var (
+	getAlipayComplainErr  = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "getAlipayComplainErr", nil, "获取支付宝投诉单失败", nil})}
+	saveAlipayComplainErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "saveAlipayComplainErr", nil, "保存支付宝投诉单失败", nil})}
+)
+
+// 投诉单退款状态
+const (
+	AlipayComplainRefundStatusNone    = 0 // 未退款
+	AlipayComplainRefundStatusSuccess = 1 // 退款成功
+	AlipayComplainRefundStatusFail    = 2 // 退款失败
+)
+
+// 支付宝交易投诉表，定时从支付宝同步
+type PmAlipayComplainTable struct {
+	ID               int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	PayAppId         string    `gorm:"column:pay_app_id;NOT NULL" json:"pay_app_id"`                           // 支付宝应用appid
+	MerchantNo       string    `gorm:"column:merchant_no;NOT NULL" json:"merchant_no"`                         // 商户号
+	ComplainEventId  string    `gorm:"column:complain_event_id;NOT NULL" json:"complain_event_id"`             // 支付宝投诉单号，唯一索引
+	Status           string    `gorm:"column:status;NOT NULL" json:"status"`                                   // 投诉单状态 MERCHANT_PROCESSING待商家处理 MERCHANT_FEEDBACKED商家已反馈 FINISHED已完结 CANCELLED已撤销 PLATFORM_PROCESSING平台处理中 PLATFORM_FINISH平台处理完结 CLOSED系统关闭
+	TradeNo          string    `gorm:"column:trade_no;NOT NULL" json:"trade_no"`                               // 支付宝交易号
+	OutTradeNo       string    `gorm:"column:out_trade_no;NOT NULL" json:"out_trade_no"`                       // 内部订单号
+	AppPkg           string    `gorm:"column:app_pkg;NOT NULL" json:"app_pkg"`                                 // 订单对应的包名，未匹配到订单时为空
+	ComplainAmount   int       `gorm:"column:complain_amount;default:0;NOT NULL" json:"complain_amount"`       // 投诉金额（分）
+	LeafCategoryName string    `gorm:"column:leaf_category_name;NOT NULL" json:"leaf_category_name"`           // 投诉原因分类
+	ComplainReason   string    `gorm:"column:complain_reason;NOT NULL" json:"complain_reason"`                 // 投诉原因
+	Content          string    `gorm:"column:content;NOT NULL" json:"content"`                                 // 投诉内容
+	Images           string    `gorm:"column:images;NOT NULL" json:"images"`                                   // 投诉图片，逗号分隔
+	PhoneNo          string    `gorm:"column:phone_no;NOT NULL" json:"phone_no"`                               // 投诉人电话
+	GmtComplain      time.Time `gorm:"column:gmt_complain;type:datetime" json:"gmt_complain"`                  // 投诉时间
+	GmtModified      time.Time `gorm:"column:gmt_modified;type:datetime" json:"gmt_modified"`                  // 支付宝侧修改时间
+	GmtFinished      time.Time `gorm:"column:gmt_finished;type:datetime" json:"gmt_finished"`                  // 投诉完结时间
+	ReplyContent     string    `gorm:"column:reply_content;NOT NULL" json:"reply_content"`                     // 最近一次回复内容
+	FeedbackCode     string    `gorm:"column:feedback_code;NOT NULL" json:"feedback_code"`                     // 处理结果码
+	FeedbackContent  string    `gorm:"column:feedback_content;NOT NULL" json:"feedback_content"`               // 处理描述
+	Operator         string    `gorm:"column:operator;NOT NULL" json:"operator"`                               // 处理人
+	RefundStatus     int       `gorm:"column:refund_status;default:0;NOT NULL" json:"refund_status"`           // 0未退款 1退款成功 2退款失败
+	OutTradeRefundNo string    `gorm:"column:out_trade_refund_no;NOT NULL" json:"out_trade_refund_no"`         // 商户退款单号
+	RefundAmount     int       `gorm:"column:refund_amount;default:0;NOT NULL" json:"refund_amount"`           // 退款金额（分）
+	CreatedAt        time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"` // 创建时间
+	UpdatedAt        time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"updated_at"` // 更新时间
+}
+
+const PmAlipayComplainTableName = "pm_alipay_complain"
+
+func (m *PmAlipayComplainTable) TableName() string {
+	return PmAlipayComplainTableName
+}
+
+// 投诉单列表筛选条件
+type AlipayComplainFilter struct {
+	PayAppId   string
+	AppPkg     string
+	Status     string
+	OutTradeNo string
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+// 商户投诉统计
+type AlipayComplainStat struct {
+	PayAppId    string `gorm:"column:pay_app_id" json:"pay_app_id"`
+	ComplainNum int64  `gorm:"column:complain_num" json:"complain_num"`
+}
+
+type PmAlipayComplainModel struct {
+	DB *gorm.DB
+}
+
+func NewPmAlipayComplainModel(dbName string) *PmAlipayComplainModel {
+	return &PmAlipayComplainModel{
+		DB: db.WithDBContext(dbName),
+	}
+}
+
+// 同步支付宝投诉单，已存在时只更新支付宝侧的字段，不覆盖本地的处理记录
+func (o *PmAlipayComplainModel) Sync(info *PmAlipayComplainTable) error {
+	existInfo, err := o.GetOneByComplainEventId(info.ComplainEventId)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return err
+	}
+
+	if existInfo.ID == 0 {
+		err = o.DB.Create(info).Error
+	} else {
+		info.ID = existInfo.ID
+		err = o.DB.Table(PmAlipayComplainTableName).Where("`id` = ?", existInfo.ID).Updates(map[string]interface{}{
+			"status":             info.Status,
+			"trade_no":           info.TradeNo,
+			"out_trade_no":       info.OutTradeNo,
+			"app_pkg":            info.AppPkg,
+			"complain_amount":    info.ComplainAmount,
+			"leaf_category_name": info.LeafCategoryName,
+			"complain_reason":    info.ComplainReason,
+			"content":            info.Content,
+			"images":             info.Images,
+			"phone_no":           info.PhoneNo,
+			"gmt_modified":       info.GmtModified,
+			"gmt_finished":       info.GmtFinished,
+		}).Error
+	}
+	if err != nil {
+		logx.Errorf("同步支付宝投诉单失败 err: %v, complainEventId: %s", err, info.ComplainEventId)
+		saveAlipayComplainErr.CounterInc()
+	}
+	return err
+}
+
+// 根据支付宝投诉单号获取投诉单
+func (o *PmAlipayComplainModel) GetOneByComplainEventId(complainEventId string) (*PmAlipayComplainTable, error) {
+	info := new(PmAlipayComplainTable)
+	err := o.DB.Table(PmAlipayComplainTableName).Where("`complain_event_id` = ?", complainEventId).First(info).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logx.Errorf("GetOneByComplainEventId 获取支付宝投诉单失败 err:%v, complainEventId:%s", err, complainEventId)
+		getAlipayComplainErr.CounterInc()
+	}
+	return info, err
+}
+
+// 更新数据
+func (o *PmAlipayComplainModel) UpdateSomeData(id int, updateData map[string]interface{}) error {
+	err := o.DB.Table(PmAlipayComplainTableName).Where("`id` = ?", id).Updates(updateData).Error
+	if err != nil {
+		err = fmt.Errorf("PmAlipayComplainModel UpdateSomeData Err: %v", err)
+		util.CheckError(err.Error())
+	}
+	return err
+}
+
+// 首次退款时写入退款单号，已有退款单号的不覆盖，返回是否写入成功
+func (o *PmAlipayComplainModel) SetRefundNo(id int, outTradeRefundNo string, refundAmount int) (bool, error) {
+	result := o.DB.Table(PmAlipayComplainTableName).Where("`id` = ? and `out_trade_refund_no` = ''", id).Updates(map[string]interface{}{
+		"out_trade_refund_no": outTradeRefundNo,
+		"refund_amount":       refundAmount,
+	})
+	if result.Error != nil {
+		err := fmt.Errorf("PmAlipayComplainModel SetRefundNo Err: %v", result.Error)
+		util.CheckError(err.Error())
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 按条件分页查询投诉单，按投诉时间倒序
+func (o *PmAlipayComplainModel) GetList(filter *AlipayComplainFilter, page, pageSize int) (list []*PmAlipayComplainTable, total int64, err error) {
+	query := o.DB.Table(PmAlipayComplainTableName)
+	if filter.PayAppId != "" {
+		query = query.Where("`pay_app_id` = ?", filter.PayAppId)
+	}
+	if filter.AppPkg != "" {
+		query = query.Where("`app_pkg` = ?", filter.AppPkg)
+	}
+	if filter.Status != "" {
+		query = query.Where("`status` = ?", filter.Status)
+	}
+	if filter.OutTradeNo != "" {
+		query = query.Where("`out_trade_no` = ?", filter.OutTradeNo)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("`gmt_complain` >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("`gmt_complain` < ?", filter.EndTime)
+	}
+
+	err = query.Count(&total).Error
+	if err != nil {
+		logx.Errorf("GetList 统计支付宝投诉单失败 err:%v, filter:%+v", err, filter)
+		getAlipayComplainErr.CounterInc()
+		return nil, 0, err
+	}
+
+	err = query.Order("`gmt_complain` desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetList 获取支付宝投诉单失败 err:%v, filter:%+v", err, filter)
+		getAlipayComplainErr.CounterInc()
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// 按商户统计时间段内的投诉数
+func (o *PmAlipayComplainModel) CountByPayAppId(startTime, endTime time.Time) (list []*AlipayComplainStat, err error) {
+	err = o.DB.Table(PmAlipayComplainTableName).Select("`pay_app_id`, count(*) as complain_num").
+		Where("`gmt_complain` >= ? and `gmt_complain` < ?", startTime, endTime).
+		Group("`pay_app_id`").Find(&list).Error
+	if err != nil {
+		logx.Errorf("CountByPayAppId 统计支付宝投诉单失败 err:%v", err)
+		getAlipayComplainErr.CounterInc()
+	}
+	return
+}
@@ -11,13 +11,18 @@ import (
 
 // 支付宝关联app配置
 type AppAlipayAppTable struct {
-	ID     int    `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
-	AppID  string `gorm:"column:app_id;NOT NULL" json:"app_id"`   // 关联的配置ID
-	AppPkg string `gorm:"column:app_pkg;NOT NULL" json:"app_pkg"` // 关联的应用包名
+	ID         int    `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	AppID      string `gorm:"column:app_id;NOT NULL" json:"app_id"`                     // 关联的配置ID
+	AppPkg     string `gorm:"column:app_pkg;NOT NULL" json:"app_pkg"`                   // 关联的应用包名
+	RelateType int    `gorm:"column:relate_type;default:1;NOT NULL" json:"relate_type"` // 关联类型 1备用 2兜底
+	SortNo     int    `gorm:"column:sort_no;default:0;NOT NULL" json:"sort_no"`         // 同类型内按升序选用
+	Status     int    `gorm:"column:status;default:1;NOT NULL" json:"status"`           // 0停用 1启用
 }
 
+const AppAlipayAppTableName = "app_alipay_app"
+
 func (m *AppAlipayAppTable) TableName() string {
-	return "app_alipay_app"
+	return AppAlipayAppTableName
 }
 
 type AppAlipayAppModel struct {
@@ -53,7 +58,7 @@ func (o *AppAlipayAppModel) GetValidConfig(appPkg string) (AppAlipayAppTable, er
 
 	// sort_no升序
 	// relate_type 关联类型（1：备用，2：兜底）
-	err = o.DB.Where("`app_pkg` = ? and `app_id` in ?", appPkg, tmpAppIds).Order("relate_type asc, sort_no asc").Find(&list).Error
+	err = o.DB.Where("`app_pkg` = ? and `status` = 1 and `app_id` in ?", appPkg, tmpAppIds).Order("relate_type asc, sort_no asc").Find(&list).Error
 	if err != nil {
 		logx.Errorf("app_alipay_app, pkg:%s, err:%v", appPkg, err)
 		return tbl, err
@@ -67,3 +72,8 @@ func (o *AppAlipayAppModel) GetValidConfig(appPkg string) (AppAlipayAppTable, er
 
 	return tbl, nil
 }
+
+// 配置变更后使包名的缓存失效
+func (o *AppAlipayAppModel) ExpireCache(appPkg string) {
+	ExpireCache(o.RDB, o.RDB.GetRedisKey(app_alipay_app_list_key, appPkg))
+}
@@ -21,12 +21,15 @@ type PmAppConfigTable struct {
 	WechatPayAppID string    `gorm:"column:wechat_pay_app_id;NOT NULL" json:"wechat_pay_app_id"` // 对应的微信支付appid
 	TiktokPayAppID string    `gorm:"column:tiktok_pay_app_id;NOT NULL" json:"tiktok_pay_app_id"` // 对应的字节支付appid
 	KsPayAppID     string    `gorm:"column:ks_pay_app_id;NOT NULL" json:"ks_pay_app_id"`         // 对应的快手支付appid
+	Status         int       `gorm:"column:status;default:1;NOT NULL" json:"status"`             // 0停用 1启用，停用后包名不能下单
 	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt      time.Time `gorm:"column:updated_at" json:"updated_at"`
 }
 
+const PmAppConfigTableName = "pm_app_config"
+
 func (m *PmAppConfigTable) TableName() string {
-	return "pm_app_config"
+	return PmAppConfigTableName
 }
 
 type PmAppConfigModel struct {
@@ -52,7 +55,7 @@ func (o *PmAppConfigModel) GetOneByPkgName(pkgName string) (appConfig *PmAppConf
 		return &cfg, nil
 	}
 
-	err = o.DB.Where(" `app_pkg_name` = ?", pkgName).First(&cfg).Error
+	err = o.DB.Where(" `app_pkg_name` = ? and `status` = 1", pkgName).First(&cfg).Error
 	if err != nil {
 		logx.Errorf("获取app配置信息失败，err:=%v,pkg=%s", err, pkgName)
 		getPayOrderErr.CounterInc()
@@ -64,3 +67,8 @@ func (o *PmAppConfigModel) GetOneByPkgName(pkgName string) (appConfig *PmAppConf
 
 	return &cfg, nil
 }
+
+// 配置变更后使包名的缓存失效
+func (o *PmAppConfigModel) ExpireCache(pkgName string) {
+	ExpireCache(o.RDB, o.RDB.GetRedisKey(pm_app_config_cache_key, pkgName))
+}
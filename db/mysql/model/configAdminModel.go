@@ -0,0 +1,123 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gorm.io/gorm"
+)
+
+var (
+	saveConfigErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "saveConfigErr", nil, "保存应用、商户配置失败", nil})}
+)
+
+// 配置状态
+const (
+	Config_Status_Disabled = 0 // 停用
+	Config_Status_Enabled  = 1 // 启用
+)
+
+// 配置审计操作
+const (
+	Config_Audit_Action_Create  = 1 // 新建
+	Config_Audit_Action_Update  = 2 // 更新
+	Config_Audit_Action_Disable = 3 // 停用
+	Config_Audit_Action_Enable  = 4 // 启用
+)
+
+// 应用、商户配置变更审计表
+type PmConfigAuditLogTable struct {
+	ID          int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	ConfigTable string    `gorm:"column:config_table;NOT NULL" json:"config_table"`                       // 配置表名
+	ConfigId    int       `gorm:"column:config_id;NOT NULL" json:"config_id"`                             // 配置id
+	Action      int       `gorm:"column:action;NOT NULL" json:"action"`                                   // 1新建 2更新 3停用 4启用
+	Operator    string    `gorm:"column:operator;NOT NULL" json:"operator"`                               // 操作人
+	Content     string    `gorm:"column:content;type:text" json:"content"`                                // 变更后的配置，密钥类字段已脱敏
+	Remark      string    `gorm:"column:remark;NOT NULL" json:"remark"`                                   // 备注
+	CreatedAt   time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"` // 创建时间
+}
+
+func (m *PmConfigAuditLogTable) TableName() string {
+	return "pm_config_audit_log"
+}
+
+type ConfigAdminModel struct {
+	DB *gorm.DB
+}
+
+func NewConfigAdminModel(dbName string) *ConfigAdminModel {
+	return &ConfigAdminModel{
+		DB: db.WithDBContext(dbName),
+	}
+}
+
+// 根据id获取配置，row为对应配置表的结构体指针
+func (o *ConfigAdminModel) GetById(id int, row interface{}) error {
+	return o.DB.Where("`id` = ?", id).First(row).Error
+}
+
+// 按条件统计配置数
+func (o *ConfigAdminModel) Count(table string, query string, args ...interface{}) (int64, error) {
+	var total int64
+	err := o.DB.Table(table).Where(query, args...).Count(&total).Error
+	if err != nil {
+		logx.Errorf("ConfigAdminModel Count err:%v, table:%s", err, table)
+	}
+	return total, err
+}
+
+// 按条件分页获取配置，list为对应配置表的结构体切片指针
+func (o *ConfigAdminModel) List(table string, where map[string]interface{}, page, pageSize int, list interface{}) (total int64, err error) {
+	query := o.DB.Table(table).Where(where)
+	err = query.Count(&total).Error
+	if err != nil {
+		logx.Errorf("ConfigAdminModel List count err:%v, table:%s", err, table)
+		return
+	}
+	err = query.Order("id asc").Offset((page - 1) * pageSize).Limit(pageSize).Find(list).Error
+	if err != nil {
+		logx.Errorf("ConfigAdminModel List err:%v, table:%s", err, table)
+	}
+	return
+}
+
+// 新建或更新配置并记录审计，id为row的主键字段，新建后回写
+func (o *ConfigAdminModel) Save(row interface{}, id *int, audit *PmConfigAuditLogTable) error {
+	err := o.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(row).Error; err != nil {
+			return err
+		}
+		audit.ConfigId = *id
+		return tx.Create(audit).Error
+	})
+	if err != nil {
+		logx.Errorf("保存配置失败 err:%v, table:%s, id:%d", err, audit.ConfigTable, *id)
+		saveConfigErr.CounterInc()
+	}
+	return err
+}
+
+// 更新配置状态并记录审计
+func (o *ConfigAdminModel) SetStatus(id int, status int, audit *PmConfigAuditLogTable) error {
+	err := o.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(audit.ConfigTable).Where("`id` = ?", id).Update("status", status).Error; err != nil {
+			return err
+		}
+		audit.ConfigId = id
+		return tx.Create(audit).Error
+	})
+	if err != nil {
+		logx.Errorf("更新配置状态失败 err:%v, table:%s, id:%d", err, audit.ConfigTable, id)
+		saveConfigErr.CounterInc()
+	}
+	return err
+}
+
+// ExpireCache 配置变更后使缓存失效：改为无法解析的空值并在1秒后过期，读取时回源数据库
+func ExpireCache(rdb *cache.RedisInstance, rkey string) {
+	rdb.Set(context.Background(), rkey, "", 1)
+}
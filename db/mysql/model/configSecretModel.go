@@ -0,0 +1,212 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/envelope"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+)
+
+var (
+	decryptConfigSecretErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "decryptConfigSecretErr", nil, "解密商户密钥失败", nil})}
+)
+
+// 加密存储的密钥字段
+type secretField struct {
+	column string
+	value  *string
+}
+
+// SecretConfig 密钥字段加密存储的商户配置
+//
+// 数据库和Redis缓存中只保存密文，读取配置后在进程内解密
+type SecretConfig interface {
+	TableName() string
+	ConfigId() int
+	// SecretColumns 加密存储的字段，包含数据密钥字段
+	SecretColumns() []string
+	// SecretValues 加密存储的字段和数据密钥的当前值，按字段名
+	SecretValues() map[string]string
+	// HasPlainSecrets 是否还有未加密的密钥字段
+	HasPlainSecrets() bool
+	EncryptSecrets() error
+	DecryptSecrets() error
+}
+
+// 需要加密存储密钥的配置表
+var SecretConfigTables = []string{
+	PmPayConfigAlipayTableName,
+	PmPayConfigTiktokTableName,
+	PmPayConfigWechatTableName,
+	PmPayConfigKsTableName,
+	HuaweiAppTableName,
+}
+
+func secretColumns(fields []secretField) []string {
+	columns := make([]string, 0, len(fields)+1)
+	for _, f := range fields {
+		columns = append(columns, f.column)
+	}
+	return append(columns, "data_key")
+}
+
+func secretValues(dataKey string, fields []secretField) map[string]string {
+	values := make(map[string]string, len(fields)+1)
+	for _, f := range fields {
+		values[f.column] = *f.value
+	}
+	values["data_key"] = dataKey
+	return values
+}
+
+func hasPlainSecrets(fields []secretField) bool {
+	for _, f := range fields {
+		if *f.value != "" && !envelope.IsEncrypted(*f.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// 用行的数据密钥加密密钥字段，没有数据密钥时生成
+func encryptSecrets(table string, dataKey *string, fields []secretField) error {
+	if !hasPlainSecrets(fields) {
+		return nil
+	}
+	if *dataKey == "" {
+		wrapped, err := envelope.NewDataKey()
+		if err != nil {
+			return err
+		}
+		*dataKey = wrapped
+	}
+	key, err := envelope.OpenDataKey(*dataKey)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		*f.value, err = key.Encrypt(*f.value, table+"."+f.column)
+		if err != nil {
+			return fmt.Errorf("加密%s.%s失败: %v", table, f.column, err)
+		}
+	}
+	return nil
+}
+
+// 解密密钥字段，未迁移的明文不处理
+func decryptSecrets(table string, id int, dataKey string, fields []secretField) error {
+	var encrypted bool
+	for _, f := range fields {
+		if envelope.IsEncrypted(*f.value) {
+			encrypted = true
+			break
+		}
+	}
+	if !encrypted {
+		return nil
+	}
+	if dataKey == "" {
+		return decryptSecretErr(table, id, errors.New("数据密钥为空"))
+	}
+
+	key, err := envelope.OpenDataKey(dataKey)
+	if err != nil {
+		return decryptSecretErr(table, id, err)
+	}
+	for _, f := range fields {
+		*f.value, err = key.Decrypt(*f.value, table+"."+f.column)
+		if err != nil {
+			return decryptSecretErr(table, id, fmt.Errorf("%s: %v", f.column, err))
+		}
+	}
+	return nil
+}
+
+func decryptSecretErr(table string, id int, err error) error {
+	logx.Errorf("解密商户密钥失败 table:%s, id:%d, err:%v", table, id, err)
+	decryptConfigSecretErr.CounterInc()
+	return fmt.Errorf("解密%s配置失败", table)
+}
+
+// 按id分批读取需要加密的配置表，不解密
+func (o *ConfigAdminModel) GetSecretConfigBatch(table string, lastId int, limit int) ([]SecretConfig, error) {
+	query := o.DB.Table(table).Where("`id` > ?", lastId).Order("id asc").Limit(limit)
+
+	var list []SecretConfig
+	var err error
+	switch table {
+	case PmPayConfigAlipayTableName:
+		var rows []*PmPayConfigAlipayTable
+		err = query.Find(&rows).Error
+		for _, row := range rows {
+			list = append(list, row)
+		}
+	case PmPayConfigTiktokTableName:
+		var rows []*PmPayConfigTiktokTable
+		err = query.Find(&rows).Error
+		for _, row := range rows {
+			list = append(list, row)
+		}
+	case PmPayConfigWechatTableName:
+		var rows []*PmPayConfigWechatTable
+		err = query.Find(&rows).Error
+		for _, row := range rows {
+			list = append(list, row)
+		}
+	case PmPayConfigKsTableName:
+		var rows []*PmPayConfigKsTable
+		err = query.Find(&rows).Error
+		for _, row := range rows {
+			list = append(list, row)
+		}
+	case HuaweiAppTableName:
+		var rows []*HuaweiAppTable
+		err = query.Find(&rows).Error
+		for _, row := range rows {
+			list = append(list, row)
+		}
+	default:
+		return nil, fmt.Errorf("%s不需要加密", table)
+	}
+	if err != nil {
+		logx.Errorf("ConfigAdminModel GetSecretConfigBatch err:%v, table:%s", err, table)
+	}
+	return list, err
+}
+
+// 只更新密钥字段和数据密钥，其他字段不变
+// oldValues为读取时的SecretValues，读取后密钥被修改过时不更新，返回是否更新成功
+func (o *ConfigAdminModel) UpdateSecrets(row SecretConfig, oldValues map[string]string) (bool, error) {
+	query := o.DB.Model(row).Select(row.SecretColumns())
+	for _, column := range row.SecretColumns() {
+		query = query.Where(fmt.Sprintf("`%s` = ?", column), oldValues[column])
+	}
+	result := query.Updates(row)
+	if result.Error != nil {
+		logx.Errorf("更新商户密钥失败 err:%v, table:%s, id:%d", result.Error, row.TableName(), row.ConfigId())
+		saveConfigErr.CounterInc()
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CheckMasterKey 未配置主密钥但已有加密的商户密钥时返回错误，这时加密的配置都无法解密
+func (o *ConfigAdminModel) CheckMasterKey() error {
+	if envelope.Enabled() {
+		return nil
+	}
+	for _, table := range SecretConfigTables {
+		var ids []int
+		err := o.DB.Table(table).Where("`data_key` <> ''").Limit(1).Pluck("id", &ids).Error
+		if err != nil {
+			logx.Errorf("ConfigAdminModel CheckMasterKey err:%v, table:%s", err, table)
+			return err
+		}
+		if len(ids) > 0 {
+			return fmt.Errorf("%s已有加密的商户密钥，%w", table, envelope.ErrNoMasterKey)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+	"gorm.io/gorm"
+)
+
+var (
+	getDyRefundAuditErr    = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "getDyRefundAuditErr", nil, "获取抖音退款审核单失败", nil})}
+	createDyRefundAuditErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "createDyRefundAuditErr", nil, "创建抖音退款审核单失败", nil})}
+)
+
+// 抖音退款审核状态
+const (
+	DyRefundAuditStatusWait   = 0 // 待人工审核
+	DyRefundAuditStatusPass   = 1 // 同意退款
+	DyRefundAuditStatusReject = 2 // 拒绝退款
+	DyRefundAuditStatusNoNeed = 3 // 抖音侧无需审核
+	DyRefundAuditStatusExpire = 4 // 超过审核截止时间未处理
+	DyRefundAuditStatusAuto   = 5 // 策略自动审核中，不能人工审核，自动审核失败时转为待人工审核
+)
+
+// 审核来源
+const (
+	DyRefundAuditSourceAuto   = 1 // 策略自动审核
+	DyRefundAuditSourceManual = 2 // 人工审核
+)
+
+// 抖音退款审核策略表，按包名配置
+type PmDyRefundAuditPolicyTable struct {
+	ID              int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	AppPkgName      string    `gorm:"column:app_pkg_name;NOT NULL" json:"app_pkg_name"`                       // 应用包名
+	AutoPassAmount  int       `gorm:"column:auto_pass_amount;default:0;NOT NULL" json:"auto_pass_amount"`     // 退款金额小于等于该值(分)自动同意，0不启用
+	AutoPassHours   int       `gorm:"column:auto_pass_hours;default:0;NOT NULL" json:"auto_pass_hours"`       // 支付后N小时内申请自动同意，0不启用
+	RejectConsumed  int       `gorm:"column:reject_consumed;default:0;NOT NULL" json:"reject_consumed"`       // 内容已消费自动拒绝 0否 1是
+	ConsumeQueryUrl string    `gorm:"column:consume_query_url;NOT NULL" json:"consume_query_url"`             // 业务方查询内容是否已消费的地址
+	DenyMessage     string    `gorm:"column:deny_message;NOT NULL" json:"deny_message"`                       // 自动拒绝时展示给用户的文案
+	Status          int       `gorm:"column:status;default:1;NOT NULL" json:"status"`                         // 0停用 1启用
+	CreatedAt       time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"` // 创建时间
+	UpdatedAt       time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"updated_at"` // 更新时间
+}
+
+func (m *PmDyRefundAuditPolicyTable) TableName() string {
+	return "pm_dy_refund_audit_policy"
+}
+
+// 抖音退款申请审核记录表
+type PmDyRefundAuditTable struct {
+	ID                  int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	AppId               string    `gorm:"column:app_id;NOT NULL" json:"app_id"`                                    // 抖音小程序appid
+	AppPkgName          string    `gorm:"column:app_pkg_name;NOT NULL" json:"app_pkg_name"`                        // 应用包名
+	RefundId            string    `gorm:"column:refund_id;NOT NULL" json:"refund_id"`                              // 抖音退款单号
+	OrderId             string    `gorm:"column:order_id;NOT NULL" json:"order_id"`                                // 抖音订单号
+	OutOrderNo          string    `gorm:"column:out_order_no;NOT NULL" json:"out_order_no"`                        // 内部订单号
+	RefundAmount        int       `gorm:"column:refund_amount;default:0;NOT NULL" json:"refund_amount"`            // 退款金额（分）
+	RefundReason        string    `gorm:"column:refund_reason;NOT NULL" json:"refund_reason"`                      // 用户退款原因
+	RefundAuditDeadline time.Time `gorm:"column:refund_audit_deadline;type:datetime" json:"refund_audit_deadline"` // 审核截止时间
+	AuditStatus         int       `gorm:"column:audit_status;default:0;NOT NULL" json:"audit_status"`              // 0待审核 1同意 2拒绝 3无需审核 4超时未审核
+	AuditSource         int       `gorm:"column:audit_source;default:0;NOT NULL" json:"audit_source"`              // 1策略自动审核 2人工审核
+	AuditRemark         string    `gorm:"column:audit_remark;NOT NULL" json:"audit_remark"`                        // 审核说明，自动审核时记录命中的规则
+	AutoAuditStatus     int       `gorm:"column:auto_audit_status;default:0;NOT NULL" json:"auto_audit_status"`    // 策略给出的审核结果 1同意 2拒绝，自动审核中断时按此重新审核
+	DenyMessage         string    `gorm:"column:deny_message;NOT NULL" json:"deny_message"`                        // 拒绝原因
+	Reviewer            string    `gorm:"column:reviewer;NOT NULL" json:"reviewer"`                                // 审核人员
+	NotifyData          string    `gorm:"column:notify_data;NOT NULL" json:"notify_data"`                          // 退款申请回调数据
+	AuditAt             time.Time `gorm:"column:audit_at;type:datetime" json:"audit_at"`                           // 审核时间 默认值2000-01-01 00:00:01
+	CreatedAt           time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"`  // 创建时间
+	UpdatedAt           time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"updated_at"`  // 更新时间
+}
+
+const PmDyRefundAuditTableName = "pm_dy_refund_audit"
+
+func (m *PmDyRefundAuditTable) TableName() string {
+	return PmDyRefundAuditTableName
+}
+
+type PmDyRefundAuditModel struct {
+	DB  *gorm.DB
+	RDB *cache.RedisInstance
+}
+
+func NewPmDyRefundAuditModel(dbName string) *PmDyRefundAuditModel {
+	return &PmDyRefundAuditModel{
+		DB:  db.WithDBContext(dbName),
+		RDB: db.WithRedisDBContext(dbName),
+	}
+}
+
+// 获取包名对应的退款审核策略，未配置时返回nil
+const pm_dy_refund_audit_policy_cache_key = "pm:dy:refund:audit:policy:%s" // %s是包名
+func (o *PmDyRefundAuditModel) GetPolicyByPkgName(pkgName string) (*PmDyRefundAuditPolicyTable, error) {
+	var policy PmDyRefundAuditPolicyTable
+
+	rkey := o.RDB.GetRedisKey(pm_dy_refund_audit_policy_cache_key, pkgName)
+	err := o.RDB.GetObject(context.Background(), rkey, &policy)
+	if err == nil && policy.ID > 0 {
+		return &policy, nil
+	}
+
+	err = o.DB.Where("`app_pkg_name` = ? and `status` = 1", pkgName).First(&policy).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logx.Errorf("获取抖音退款审核策略失败，err:=%v,pkg=%s", err, pkgName)
+		getDyRefundAuditErr.CounterInc()
+		return nil, err
+	}
+
+	// 设置缓存时间为3分钟
+	o.RDB.Set(context.Background(), rkey, policy, 180)
+	return &policy, nil
+}
+
+// 创建退款审核单
+func (o *PmDyRefundAuditModel) Create(info *PmDyRefundAuditTable) error {
+	err := o.DB.Create(info).Error
+	if err != nil {
+		logx.Errorf("创建抖音退款审核单失败 err: %v, refundId: %s", err, info.RefundId)
+		createDyRefundAuditErr.CounterInc()
+	}
+	return err
+}
+
+// 根据抖音退款单号获取审核单
+func (o *PmDyRefundAuditModel) GetOneByRefundId(refundId string) (*PmDyRefundAuditTable, error) {
+	info := new(PmDyRefundAuditTable)
+	err := o.DB.Table(PmDyRefundAuditTableName).Where("`refund_id` = ?", refundId).First(info).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logx.Errorf("GetOneByRefundId 获取抖音退款审核单失败 err:%v, refundId:%s", err, refundId)
+		getDyRefundAuditErr.CounterInc()
+	}
+	return info, err
+}
+
+// 更新数据
+func (o *PmDyRefundAuditModel) UpdateSomeData(id int, updateData map[string]interface{}) error {
+	err := o.DB.Table(PmDyRefundAuditTableName).Where("`id` = ?", id).Updates(updateData).Error
+	if err != nil {
+		err = fmt.Errorf("PmDyRefundAuditModel UpdateSomeData Err: %v", err)
+		util.CheckError(err.Error())
+	}
+	return err
+}
+
+// 审核状态仍为fromStatus时才更新，返回是否更新成功，避免自动审核和人工审核互相覆盖
+func (o *PmDyRefundAuditModel) UpdateByStatus(id int, fromStatus int, updateData map[string]interface{}) (bool, error) {
+	result := o.DB.Table(PmDyRefundAuditTableName).Where("`id` = ? and `audit_status` = ?", id, fromStatus).Updates(updateData)
+	if result.Error != nil {
+		err := fmt.Errorf("PmDyRefundAuditModel UpdateByStatus Err: %v", result.Error)
+		util.CheckError(err.Error())
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 获取创建时间早于before仍在策略自动审核中的申请，自动审核协程中断或最终更新失败时会停留在该状态
+func (o *PmDyRefundAuditModel) GetStaleAutoList(before time.Time, limit int) ([]*PmDyRefundAuditTable, error) {
+	var list []*PmDyRefundAuditTable
+	err := o.DB.Table(PmDyRefundAuditTableName).Where("`audit_status` = ? and `created_at` < ?", DyRefundAuditStatusAuto, before).
+		Order("`refund_audit_deadline` asc").Limit(limit).Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetStaleAutoList 获取自动审核中的退款单失败 err:%v", err)
+		getDyRefundAuditErr.CounterInc()
+	}
+	return list, err
+}
+
+// 获取待人工审核且未过审核截止时间的申请，pkgName为空时查询全部
+func (o *PmDyRefundAuditModel) GetWaitList(pkgName string, page, pageSize int) (list []*PmDyRefundAuditTable, total int64, err error) {
+	query := o.DB.Table(PmDyRefundAuditTableName).Where("`audit_status` = ? and `refund_audit_deadline` > ?", DyRefundAuditStatusWait, time.Now())
+	if pkgName != "" {
+		query = query.Where("`app_pkg_name` = ?", pkgName)
+	}
+
+	err = query.Count(&total).Error
+	if err != nil {
+		logx.Errorf("GetWaitList 统计待审核退款单失败 err:%v, pkg:%s", err, pkgName)
+		getDyRefundAuditErr.CounterInc()
+		return nil, 0, err
+	}
+
+	err = query.Order("`refund_audit_deadline` asc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetWaitList 获取待审核退款单失败 err:%v, pkg:%s", err, pkgName)
+		getDyRefundAuditErr.CounterInc()
+		return nil, 0, err
+	}
+	return list, total, nil
+}
@@ -1,28 +1,69 @@
 package model
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
 	"gorm.io/gorm"
 )
 
+var (
+	getFundTransOrderErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "getFundTransOrderErr", nil, "获取转账订单失败", nil})}
+)
+
+// 转账状态，0为历史数据（转账受理成功后才入库，视为成功）
+const (
+	PmFundTransOrderStatusDealing  = 1 // 处理中
+	PmFundTransOrderStatusSuccess  = 2 // 转账成功
+	PmFundTransOrderStatusFail     = 3 // 转账失败
+	PmFundTransOrderStatusUnknown  = 4 // 结果未知，如请求超时，需要查询确认
+	PmFundTransOrderStatusApproval = 5 // 待人工审核，审核通过后才发起转账
+)
+
+// 转账渠道，历史数据都是支付宝
+const (
+	PmFundTransOrderChannelAlipay = 1 // 支付宝转账到账户
+	PmFundTransOrderChannelWechat = 2 // 微信商家转账到零钱
+)
+
 // 转出订单
 type PmFundTransOrderTable struct {
-	Id         int       `gorm:"column:id;type:int(11);primary_key;AUTO_INCREMENT" json:"id"`
-	OrderSn    string    `gorm:"column:order_sn;type:varchar(50);comment:订单唯一标识;NOT NULL" json:"order_sn"`
-	AppPkgName string    `gorm:"column:app_pkg_name;type:varchar(30);comment:来源包名;NOT NULL" json:"app_pkg_name"`
-	Amount     int       `gorm:"column:amount;type:int(11);default:0;comment:订单金额（分）;NOT NULL" json:"amount"`
-	AliName    string    `gorm:"column:ali_name;type:varchar(100);comment:支付宝真实姓名;NOT NULL" json:"ali_name"`
-	AliAccount string    `gorm:"column:ali_account;type:varchar(100);comment:支付宝账号;NOT NULL" json:"ali_account"`
-	PayAppId   string    `gorm:"column:pay_app_id;type:varchar(50);comment:第三方支付的appid;NOT NULL" json:"pay_app_id"`
-	CreatedAt  time.Time `gorm:"column:created_at;type:datetime" json:"created_at"`
-	UpdatedAt  time.Time `gorm:"column:updated_at;type:datetime" json:"updated_at"`
+	Id             int       `gorm:"column:id;type:int(11);primary_key;AUTO_INCREMENT" json:"id"`
+	OrderSn        string    `gorm:"column:order_sn;type:varchar(50);comment:订单唯一标识;NOT NULL" json:"order_sn"`
+	BatchNo        string    `gorm:"column:batch_no;type:varchar(50);comment:批量转账批次号;NOT NULL" json:"batch_no"`
+	AppPkgName     string    `gorm:"column:app_pkg_name;type:varchar(30);comment:来源包名;NOT NULL" json:"app_pkg_name"`
+	Channel        int       `gorm:"column:channel;type:tinyint(4);default:1;comment:转账渠道 1支付宝 2微信;NOT NULL" json:"channel"`
+	Amount         int       `gorm:"column:amount;type:int(11);default:0;comment:订单金额（分）;NOT NULL" json:"amount"`
+	AliName        string    `gorm:"column:ali_name;type:varchar(100);comment:收款方真实姓名;NOT NULL" json:"ali_name"`
+	AliAccount     string    `gorm:"column:ali_account;type:varchar(100);comment:收款方支付宝账号;NOT NULL" json:"ali_account"`
+	PayeeAccount   string    `gorm:"column:payee_account;type:varchar(100);comment:收款账号，支付宝为收款方账号，微信为openid;NOT NULL" json:"payee_account"`
+	PayAppId       string    `gorm:"column:pay_app_id;type:varchar(50);comment:第三方支付的appid;NOT NULL" json:"pay_app_id"`
+	ProductCode    string    `gorm:"column:product_code;type:varchar(50);comment:业务产品码，微信为空;NOT NULL" json:"product_code"`
+	BizScene       string    `gorm:"column:biz_scene;type:varchar(50);comment:业务场景，微信为转账场景ID;NOT NULL" json:"biz_scene"`
+	Status         int       `gorm:"column:status;type:tinyint(4);default:0;comment:转账状态 1处理中 2成功 3失败 4结果未知 5待审核;NOT NULL" json:"status"`
+	AliOrderId     string    `gorm:"column:ali_order_id;type:varchar(64);comment:支付宝转账订单号;NOT NULL" json:"ali_order_id"`
+	ChannelBillNo  string    `gorm:"column:channel_bill_no;type:varchar(64);comment:渠道转账单号，支付宝为转账订单号，微信为transfer_bill_no;NOT NULL" json:"channel_bill_no"`
+	PayFundOrderId string    `gorm:"column:pay_fund_order_id;type:varchar(64);comment:支付宝支付资金流水号;NOT NULL" json:"pay_fund_order_id"`
+	PackageInfo    string    `gorm:"column:package_info;type:varchar(1024);comment:微信待用户确认收款时的package信息;NOT NULL" json:"package_info"`
+	FailReason     string    `gorm:"column:fail_reason;type:varchar(255);comment:失败原因;NOT NULL" json:"fail_reason"`
+	TransDate      string    `gorm:"column:trans_date;type:varchar(30);comment:转账完成时间;NOT NULL" json:"trans_date"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:datetime" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;type:datetime" json:"updated_at"`
 }
 
+const PmFundTransOrderTableName = "pm_fund_trans_order"
+
 func (m *PmFundTransOrderTable) TableName() string {
-	return "pm_fund_trans_order"
+	return PmFundTransOrderTableName
+}
+
+// 处理中、结果未知的转账需要查询支付宝确认结果，其他状态不需要
+func (m *PmFundTransOrderTable) NeedQuery() bool {
+	return m.Status == PmFundTransOrderStatusDealing || m.Status == PmFundTransOrderStatusUnknown
 }
 
 type PmFundTransOrderModel struct {
@@ -44,3 +85,60 @@ func (o *PmFundTransOrderModel) Create(info *PmFundTransOrderTable) (err error)
 	}
 	return err
 }
+
+// 根据商户订单号（即支付宝OutBizNo）获取转账订单
+func (o *PmFundTransOrderModel) GetOneByOrderSn(orderSn string) (*PmFundTransOrderTable, error) {
+	info := new(PmFundTransOrderTable)
+	err := o.DB.Table(PmFundTransOrderTableName).Where("`order_sn` = ?", orderSn).First(info).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logx.Errorf("GetOneByOrderSn 获取转账订单失败 err:%v, orderSn:%s", err, orderSn)
+		getFundTransOrderErr.CounterInc()
+	}
+	return info, err
+}
+
+// 更新数据
+func (o *PmFundTransOrderModel) UpdateSomeData(id int, updateData map[string]interface{}) error {
+	err := o.DB.Table(PmFundTransOrderTableName).Where("`id` = ?", id).Updates(updateData).Error
+	if err != nil {
+		err = fmt.Errorf("PmFundTransOrderModel UpdateSomeData Err: %v", err)
+		util.CheckError(err.Error())
+	}
+	return err
+}
+
+// 获取处理中、结果未知的转账订单，endTime用于跳过刚发起还在请求中的转账
+func (o *PmFundTransOrderModel) GetUncertainList(startTime, endTime time.Time, limit int) (list []*PmFundTransOrderTable, err error) {
+	err = o.DB.Table(PmFundTransOrderTableName).
+		Where("`status` in ?", []int{PmFundTransOrderStatusDealing, PmFundTransOrderStatusUnknown}).
+		Where("`created_at` >= ? and `created_at` <= ?", startTime, endTime).
+		Order("`id` asc").
+		Limit(limit).
+		Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetUncertainList 获取未确认的转账订单失败 err:%v", err)
+		getFundTransOrderErr.CounterInc()
+	}
+	return
+}
+
+// 统计当天的转账金额（分）和笔数，失败的转账不计入，field为统计维度的字段名
+func (o *PmFundTransOrderModel) GetDailyStat(field, value string) (amount int64, count int64, err error) {
+	var stat struct {
+		Amount int64
+		Count  int64
+	}
+	now := time.Now()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	err = o.DB.Table(PmFundTransOrderTableName).
+		Select("IFNULL(SUM(`amount`), 0) as amount, COUNT(*) as count").
+		Where(fmt.Sprintf("`%s` = ?", field), value).
+		Where("`created_at` >= ? and `status` <> ?", dayStart, PmFundTransOrderStatusFail).
+		Scan(&stat).Error
+	if err != nil {
+		logx.Errorf("GetDailyStat 统计当天转账失败 err:%v, %s:%s", err, field, value)
+		getFundTransOrderErr.CounterInc()
+		return 0, 0, err
+	}
+	return stat.Amount, stat.Count, nil
+}
@@ -0,0 +1,210 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+	"gorm.io/gorm"
+)
+
+var (
+	getFundTransRiskErr    = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "getFundTransRiskErr", nil, "获取转账风控数据失败", nil})}
+	createFundTransRiskErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "createFundTransRiskErr", nil, "创建转账审核数据失败", nil})}
+)
+
+// 未配置限额时的默认值，与原来接口写死的0.1~100元一致
+const (
+	FundTransDefaultSingleMinAmount = 10    // 分
+	FundTransDefaultSingleMaxAmount = 10000 // 分
+)
+
+// 转账审核状态
+const (
+	FundTransApprovalStatusWait   = 0 // 待审核
+	FundTransApprovalStatusPass   = 1 // 审核通过
+	FundTransApprovalStatusReject = 2 // 审核拒绝
+)
+
+// 转账审计动作
+const (
+	FundTransAuditActionBlock  = 1 // 风控拦截
+	FundTransAuditActionSubmit = 2 // 进入审核队列
+	FundTransAuditActionPass   = 3 // 审核通过
+	FundTransAuditActionReject = 4 // 审核拒绝
+)
+
+// 风控自动处理时的操作人
+const FundTransAuditOperatorSystem = "system"
+
+// 转账限额配置表，按包名配置，金额单位为分，0表示不限制
+type PmFundTransLimitTable struct {
+	ID                 int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	AppPkgName         string    `gorm:"column:app_pkg_name;NOT NULL" json:"app_pkg_name"`                           // 应用包名
+	SingleMinAmount    int       `gorm:"column:single_min_amount;default:0;NOT NULL" json:"single_min_amount"`       // 单笔最小金额
+	SingleMaxAmount    int       `gorm:"column:single_max_amount;default:0;NOT NULL" json:"single_max_amount"`       // 单笔最大金额
+	PayeeDailyAmount   int       `gorm:"column:payee_daily_amount;default:0;NOT NULL" json:"payee_daily_amount"`     // 同一收款账号每日累计金额
+	PkgDailyAmount     int       `gorm:"column:pkg_daily_amount;default:0;NOT NULL" json:"pkg_daily_amount"`         // 包名每日累计金额
+	IdentityDailyCount int       `gorm:"column:identity_daily_count;default:0;NOT NULL" json:"identity_daily_count"` // 同一收款账号每日转账次数
+	ApprovalAmount     int       `gorm:"column:approval_amount;default:0;NOT NULL" json:"approval_amount"`           // 单笔大于该金额需要人工审核
+	Status             int       `gorm:"column:status;default:1;NOT NULL" json:"status"`                             // 0停用 1启用
+	CreatedAt          time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"`     // 创建时间
+	UpdatedAt          time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"updated_at"`     // 更新时间
+}
+
+func (m *PmFundTransLimitTable) TableName() string {
+	return "pm_fund_trans_limit"
+}
+
+// 转账审核队列表
+type PmFundTransApprovalTable struct {
+	ID           int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	OrderSn      string    `gorm:"column:order_sn;NOT NULL" json:"order_sn"`                               // 商户订单号
+	AppPkgName   string    `gorm:"column:app_pkg_name;NOT NULL" json:"app_pkg_name"`                       // 应用包名
+	Amount       int       `gorm:"column:amount;default:0;NOT NULL" json:"amount"`                         // 转账金额（分）
+	PayeeName    string    `gorm:"column:payee_name;NOT NULL" json:"payee_name"`                           // 收款方真实姓名，微信为空
+	PayeeAccount string    `gorm:"column:payee_account;NOT NULL" json:"payee_account"`                     // 收款账号，支付宝为收款方账号，微信为openid
+	Channel      int       `gorm:"column:channel;default:1;NOT NULL" json:"channel"`                       // 转账渠道 1支付宝 2微信
+	PayAppId     string    `gorm:"column:pay_app_id;NOT NULL" json:"pay_app_id"`                           // 转账使用的支付宝或微信appid
+	TransParams  string    `gorm:"column:trans_params;NOT NULL" json:"-"`                                  // 转账请求参数，审核通过后按原参数发起转账
+	RiskReason   string    `gorm:"column:risk_reason;NOT NULL" json:"risk_reason"`                         // 进入审核的原因
+	Status       int       `gorm:"column:status;default:0;NOT NULL" json:"status"`                         // 0待审核 1通过 2拒绝
+	Reviewer     string    `gorm:"column:reviewer;NOT NULL" json:"reviewer"`                               // 审核人员
+	ReviewRemark string    `gorm:"column:review_remark;NOT NULL" json:"review_remark"`                     // 审核备注
+	ReviewAt     time.Time `gorm:"column:review_at;type:datetime" json:"review_at"`                        // 审核时间 默认值2000-01-01 00:00:01
+	CreatedAt    time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"` // 创建时间
+	UpdatedAt    time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"updated_at"` // 更新时间
+}
+
+const PmFundTransApprovalTableName = "pm_fund_trans_approval"
+
+func (m *PmFundTransApprovalTable) TableName() string {
+	return PmFundTransApprovalTableName
+}
+
+// 转账审计记录表，风控拦截、进入审核、审核通过/拒绝都会记录
+type PmFundTransAuditLogTable struct {
+	ID           int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	OrderSn      string    `gorm:"column:order_sn;NOT NULL" json:"order_sn"`                               // 商户订单号
+	AppPkgName   string    `gorm:"column:app_pkg_name;NOT NULL" json:"app_pkg_name"`                       // 应用包名
+	Amount       int       `gorm:"column:amount;default:0;NOT NULL" json:"amount"`                         // 转账金额（分）
+	PayeeAccount string    `gorm:"column:payee_account;NOT NULL" json:"payee_account"`                     // 收款账号，支付宝为收款方账号，微信为openid
+	Action       int       `gorm:"column:action;default:0;NOT NULL" json:"action"`                         // 1风控拦截 2进入审核 3审核通过 4审核拒绝
+	Operator     string    `gorm:"column:operator;NOT NULL" json:"operator"`                               // 操作人，风控自动处理时为system
+	Remark       string    `gorm:"column:remark;NOT NULL" json:"remark"`                                   // 命中的规则或审核备注
+	CreatedAt    time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"` // 创建时间
+}
+
+func (m *PmFundTransAuditLogTable) TableName() string {
+	return "pm_fund_trans_audit_log"
+}
+
+type PmFundTransRiskModel struct {
+	DB  *gorm.DB
+	RDB *cache.RedisInstance
+}
+
+func NewPmFundTransRiskModel(dbName string) *PmFundTransRiskModel {
+	return &PmFundTransRiskModel{
+		DB:  db.WithDBContext(dbName),
+		RDB: db.WithRedisDBContext(dbName),
+	}
+}
+
+// 获取包名对应的转账限额，未配置时返回默认限额
+const pm_fund_trans_limit_cache_key = "pm:fund:trans:limit:%s" // %s是包名
+func (o *PmFundTransRiskModel) GetLimitByPkgName(pkgName string) (*PmFundTransLimitTable, error) {
+	var limit PmFundTransLimitTable
+
+	rkey := o.RDB.GetRedisKey(pm_fund_trans_limit_cache_key, pkgName)
+	err := o.RDB.GetObject(context.Background(), rkey, &limit)
+	if err == nil && limit.AppPkgName != "" {
+		return &limit, nil
+	}
+
+	err = o.DB.Where("`app_pkg_name` = ? and `status` = 1", pkgName).First(&limit).Error
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			logx.Errorf("获取转账限额配置失败，err:=%v,pkg=%s", err, pkgName)
+			getFundTransRiskErr.CounterInc()
+			return nil, err
+		}
+		limit = PmFundTransLimitTable{
+			AppPkgName:      pkgName,
+			SingleMinAmount: FundTransDefaultSingleMinAmount,
+			SingleMaxAmount: FundTransDefaultSingleMaxAmount,
+		}
+	}
+
+	// 设置缓存时间为3分钟
+	o.RDB.Set(context.Background(), rkey, limit, 180)
+	return &limit, nil
+}
+
+// 创建审核单并记录审计
+func (o *PmFundTransRiskModel) CreateApproval(info *PmFundTransApprovalTable) error {
+	err := o.DB.Create(info).Error
+	if err != nil {
+		logx.Errorf("创建转账审核单失败 err: %v, orderSn: %s", err, info.OrderSn)
+		createFundTransRiskErr.CounterInc()
+	}
+	return err
+}
+
+// 根据订单号获取审核单
+func (o *PmFundTransRiskModel) GetApprovalByOrderSn(orderSn string) (*PmFundTransApprovalTable, error) {
+	info := new(PmFundTransApprovalTable)
+	err := o.DB.Table(PmFundTransApprovalTableName).Where("`order_sn` = ?", orderSn).First(info).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logx.Errorf("GetApprovalByOrderSn 获取转账审核单失败 err:%v, orderSn:%s", err, orderSn)
+		getFundTransRiskErr.CounterInc()
+	}
+	return info, err
+}
+
+// 更新审核单
+func (o *PmFundTransRiskModel) UpdateApproval(id int, updateData map[string]interface{}) error {
+	err := o.DB.Table(PmFundTransApprovalTableName).Where("`id` = ?", id).Updates(updateData).Error
+	if err != nil {
+		err = fmt.Errorf("PmFundTransRiskModel UpdateApproval Err: %v", err)
+		util.CheckError(err.Error())
+	}
+	return err
+}
+
+// 获取待审核的转账，pkgName为空时查询全部
+func (o *PmFundTransRiskModel) GetWaitApprovalList(pkgName string, page, pageSize int) (list []*PmFundTransApprovalTable, total int64, err error) {
+	query := o.DB.Table(PmFundTransApprovalTableName).Where("`status` = ?", FundTransApprovalStatusWait)
+	if pkgName != "" {
+		query = query.Where("`app_pkg_name` = ?", pkgName)
+	}
+
+	err = query.Count(&total).Error
+	if err != nil {
+		logx.Errorf("GetWaitApprovalList 统计待审核转账失败 err:%v, pkg:%s", err, pkgName)
+		getFundTransRiskErr.CounterInc()
+		return nil, 0, err
+	}
+
+	err = query.Order("`id` asc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetWaitApprovalList 获取待审核转账失败 err:%v, pkg:%s", err, pkgName)
+		getFundTransRiskErr.CounterInc()
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// 记录审计日志，失败只打日志不影响主流程
+func (o *PmFundTransRiskModel) AddAuditLog(info *PmFundTransAuditLogTable) {
+	err := o.DB.Create(info).Error
+	if err != nil {
+		logx.Errorf("记录转账审计日志失败 err: %v, orderSn: %s, action: %d", err, info.OrderSn, info.Action)
+		createFundTransRiskErr.CounterInc()
+	}
+}
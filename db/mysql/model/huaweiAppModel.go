@@ -20,10 +20,43 @@ type HuaweiAppTable struct {
 	ClientSecret      string `gorm:"column:client_secret;NOT NULL" json:"client_secret"`           // 应用client_secret 华为后台:我的项目-常规-应用-Client Secret
 	Sha256Fingerprint string `gorm:"column:sha256_fingerprint;NOT NULL" json:"sha256_fingerprint"` // sha256证书指纹 华为后台:我的项目-常规-应用-SHA256证书指纹
 	IapPublicKey      string `gorm:"column:iap_public_key;NOT NULL" json:"iap_public_key"`         // 应用内支付公钥 在“应用内支付服务”页面记录当前快应用的支付公钥，此公钥将用于IAP SDK接口返回数据的验签，以保证数据没有被篡改
+	Status            int    `gorm:"column:status;default:1;NOT NULL" json:"status"`               // 0停用 1启用，停用后不能按包名查询
+	DataKey           string `gorm:"column:data_key;NOT NULL" json:"data_key"`                     // 主密钥加密后的数据密钥，client_secret用它加密存储
 }
 
+const HuaweiAppTableName = "huawei_app"
+
 func (m *HuaweiAppTable) TableName() string {
-	return "huawei_app"
+	return HuaweiAppTableName
+}
+
+// 加密存储的密钥字段，实现SecretConfig
+func (m *HuaweiAppTable) secretFields() []secretField {
+	return []secretField{{"client_secret", &m.ClientSecret}}
+}
+
+func (m *HuaweiAppTable) ConfigId() int {
+	return m.ID
+}
+
+func (m *HuaweiAppTable) SecretColumns() []string {
+	return secretColumns(m.secretFields())
+}
+
+func (m *HuaweiAppTable) SecretValues() map[string]string {
+	return secretValues(m.DataKey, m.secretFields())
+}
+
+func (m *HuaweiAppTable) HasPlainSecrets() bool {
+	return hasPlainSecrets(m.secretFields())
+}
+
+func (m *HuaweiAppTable) EncryptSecrets() error {
+	return encryptSecrets(HuaweiAppTableName, &m.DataKey, m.secretFields())
+}
+
+func (m *HuaweiAppTable) DecryptSecrets() error {
+	return decryptSecrets(HuaweiAppTableName, m.ID, m.DataKey, m.secretFields())
 }
 
 type HuaweiAppModel struct {
@@ -50,13 +83,15 @@ func (o *HuaweiAppModel) GetInfo(appId string) (*HuaweiAppTable, error) {
 	rkey := o.RDB.GetRedisKey(huawei_app_info_key, appId)
 	err := o.RDB.GetObject(context.TODO(), rkey, &info)
 	if err == nil {
-		return &info, nil
+		err = info.DecryptSecrets()
+		return &info, err
 	}
 
 	err = o.DB.Where("`app_id` = ? ", appId).First(&info).Error
 	if err == nil {
-		// 缓存一下
+		// 缓存一下，缓存的是密文
 		o.RDB.Set(context.TODO(), rkey, info, 600)
+		err = info.DecryptSecrets()
 	} else {
 		logx.Errorf("GetInfo error: %v", err)
 	}
@@ -66,6 +101,33 @@ func (o *HuaweiAppModel) GetInfo(appId string) (*HuaweiAppTable, error) {
 
 func (o *HuaweiAppModel) GetInfoByPkg(pkg string) (HuaweiAppTable, error) {
 	var info HuaweiAppTable
-	err := o.DB.Where("`app_pkg` = ? ", pkg).First(&info).Error
+	err := o.DB.Where("`app_pkg` = ? and `status` = 1", pkg).First(&info).Error
+	if err == nil {
+		err = info.DecryptSecrets()
+	}
 	return info, err
 }
+
+// 获取所有配置了的华为应用
+func (o *HuaweiAppModel) GetAll() ([]*HuaweiAppTable, error) {
+	var list []*HuaweiAppTable
+	err := o.DB.Find(&list).Error
+	if err != nil {
+		logx.Errorf("HuaweiAppModel GetAll error: %v", err)
+		return list, err
+	}
+
+	// 解密失败的应用跳过，不影响其他应用
+	validList := list[:0]
+	for _, info := range list {
+		if info.DecryptSecrets() == nil {
+			validList = append(validList, info)
+		}
+	}
+	return validList, nil
+}
+
+// 配置变更后使appid的缓存失效
+func (o *HuaweiAppModel) ExpireCache(appId string) {
+	ExpireCache(o.RDB, o.RDB.GetRedisKey(huawei_app_info_key, appId))
+}
@@ -44,10 +44,45 @@ type HuaweiOrderTable struct {
 	PayAppId            string    `gorm:"column:pay_app_id" json:"payAppId"`                       // 第三方支付的appid
 	DeviceId            string    `gorm:"column:device_id" json:"deviceId"`                        // 用户设备号
 	DeductTime          time.Time `gorm:"column:deduct_time" json:"deductTime"`                    // 可开始扣款时间(默认是0,不需要关注,只是为了满足产品延迟扣款的需求)
+	ConfirmStatus       int       `gorm:"column:confirm_status" json:"confirmStatus"`              // 一次性商品确认购买(消耗)状态 0:未确认 1:已确认
+	ReturnFeeTime       int       `gorm:"column:return_fee_time" json:"returnFeeTime"`             // 返还最近一期订阅费用的时间戳，秒，0未返还；返还后订阅仍有效，不修改订单状态
+	DeliverStatus       int       `gorm:"column:deliver_status" json:"deliverStatus"`              // 一次性商品发货状态 0:无(上线前的订单) 1:待发货 2:已发货
+	RefundNotifyStatus  int       `gorm:"column:refund_notify_status" json:"refundNotifyStatus"`   // 退款对账回调业务方状态 0:无 1:待回调 2:已回调
+	RefundNotifyData    string    `gorm:"column:refund_notify_data" json:"refundNotifyData"`       // 退款对账回调业务方的数据，回调失败时按此重试
 	// CreatedAt           time.Time `gorm:"column:created_at" json:"createdAt"`                      // 创建时间
 	// UpdatedAt           time.Time `gorm:"column:updated_at" json:"updatedAt"`                      // 更新时间
 }
 
+// 华为订单状态
+const (
+	HuaweiOrderStatusClose     = -1 // 关闭
+	HuaweiOrderStatusNoPay     = 0  // 未支付
+	HuaweiOrderStatusPaid      = 1  // 已支付
+	HuaweiOrderStatusPayFail   = 2  // 支付失败
+	HuaweiOrderStatusRefunded  = 3  // 已退款
+	HuaweiOrderStatusRefunding = 4  // 退款中
+)
+
+// 一次性商品发货状态，支付成功时置为待发货，回调业务方成功后置为已发货
+const (
+	HuaweiOrderDeliverStatusNone      = 0 // 上线前的订单，不重试发货和确认购买
+	HuaweiOrderDeliverStatusPending   = 1 // 待发货
+	HuaweiOrderDeliverStatusDelivered = 2 // 已发货
+)
+
+// 退款对账回调业务方状态，置为已退款时同时置为待回调，回调成功后置为已回调
+const (
+	HuaweiOrderRefundNotifyNone    = 0
+	HuaweiOrderRefundNotifyPending = 1 // 待回调
+	HuaweiOrderRefundNotifyDone    = 2 // 已回调
+)
+
+// 一次性商品确认购买状态
+const (
+	HuaweiOrderConfirmStatusNo  = 0 // 未确认
+	HuaweiOrderConfirmStatusYes = 1 // 已确认
+)
+
 func (m *HuaweiOrderTable) TableName() string {
 	return "huawei_order"
 }
@@ -120,6 +155,49 @@ func (o *HuaweiOrderModel) GetOneByOutTradeNo(outTradeNo string) (*HuaweiOrderTa
 	return tbl, err
 }
 
+// 根据华为订单id或者购买token获取记录，用于对账
+//
+// 订阅每期续费的订单id不同但购买token相同，先按订单id匹配对应的那一期，匹配不到时再按token匹配
+func (o *HuaweiOrderModel) GetOneByTokenOrPayOrderId(appId, purchaseToken, payOrderId string) (*HuaweiOrderTable, error) {
+	if purchaseToken == "" && payOrderId == "" {
+		return nil, errors.New("购买token和订单id都为空")
+	}
+
+	tbl := new(HuaweiOrderTable)
+	err := gorm.ErrRecordNotFound
+	if payOrderId != "" {
+		err = o.DB.Table("huawei_order").Where("`app_id` = ? and `pay_order_id` = ?", appId, payOrderId).First(tbl).Error
+	}
+	if err == gorm.ErrRecordNotFound && purchaseToken != "" {
+		err = o.DB.Table("huawei_order").Where("`app_id` = ? and `purchase_token` = ?", appId, purchaseToken).First(tbl).Error
+	}
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logx.Errorf("GetOneByTokenOrPayOrderId err: %v, token: %s, payOrderId: %s", err, purchaseToken, payOrderId)
+	}
+	return tbl, err
+}
+
+// 未退款的订单置为已退款，返回是否更新
+func (o *HuaweiOrderModel) MarkRefunded(id int, data map[string]interface{}) (bool, error) {
+	result := o.DB.Table("huawei_order").Where("`id` = ? and `status` <> ?", id, HuaweiOrderStatusRefunded).Updates(data)
+	if result.Error != nil {
+		logx.Errorf("MarkRefunded 更新失败 err:%v, id:%d", result.Error, id)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 获取退款对账待回调业务方的订单，按id游标分批
+func (o *HuaweiOrderModel) GetRefundNotifyPendingList(lastId, limit int) ([]*HuaweiOrderTable, error) {
+	var list []*HuaweiOrderTable
+	err := o.DB.Table("huawei_order").Where("`refund_notify_status` = ? and `id` > ?", HuaweiOrderRefundNotifyPending, lastId).
+		Order("id asc").Limit(limit).Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetRefundNotifyPendingList err: %v", err)
+	}
+	return list, err
+}
+
 func (o *HuaweiOrderModel) UpdateData(id int, data map[string]interface{}) error {
 	err := o.DB.Table("huawei_order").Where("id", id).Updates(data).Error
 	if err != nil {
@@ -127,3 +205,36 @@ func (o *HuaweiOrderModel) UpdateData(id int, data map[string]interface{}) error
 	}
 	return err
 }
+
+// 未支付的订单置为已支付，返回是否更新，已被其他请求处理时不更新
+func (o *HuaweiOrderModel) PayOrder(id int, data map[string]interface{}) (bool, error) {
+	result := o.DB.Table("huawei_order").Where("`id` = ? and `status` = ?", id, HuaweiOrderStatusNoPay).Updates(data)
+	if result.Error != nil {
+		logx.Errorf("PayOrder 更新失败 err:%v, id:%d", result.Error, id)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 待发货的订单置为已发货
+func (o *HuaweiOrderModel) MarkDelivered(id int) error {
+	err := o.DB.Table("huawei_order").Where("`id` = ? and `deliver_status` = ?", id, HuaweiOrderDeliverStatusPending).
+		Update("deliver_status", HuaweiOrderDeliverStatusDelivered).Error
+	if err != nil {
+		logx.Errorf("MarkDelivered 更新失败 err:%v, id:%d", err, id)
+	}
+	return err
+}
+
+// 获取已发货但未确认购买的一次性商品订单，用于重试确认购买；上线前的订单没有发货状态，不会取到
+func (o *HuaweiOrderModel) GetUnconfirmedList(startTime time.Time, limit int) ([]*HuaweiOrderTable, error) {
+	var list []*HuaweiOrderTable
+	err := o.DB.Table("huawei_order").
+		Where("`event_type` = ? and `status` = ? and `deliver_status` = ? and `confirm_status` = ? and `created_at` >= ?",
+			"ORDER", HuaweiOrderStatusPaid, HuaweiOrderDeliverStatusDelivered, HuaweiOrderConfirmStatusNo, startTime).
+		Order("id asc").Limit(limit).Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetUnconfirmedList err: %v", err)
+	}
+	return list, err
+}
@@ -0,0 +1,208 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+	"gorm.io/gorm"
+)
+
+var (
+	getJobErr    = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "getJobErr", nil, "获取定时任务数据失败", nil})}
+	createJobErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "createJobErr", nil, "创建定时任务数据失败", nil})}
+)
+
+// 任务执行状态
+const (
+	JobRunStatusPending = 0 // 待执行，手动触发后等待leader执行
+	JobRunStatusRunning = 1 // 执行中
+	JobRunStatusSuccess = 2 // 成功
+	JobRunStatusFail    = 3 // 失败
+	JobRunStatusTimeout = 4 // 超时
+	JobRunStatusSkip    = 5 // 跳过，同一任务正在执行
+)
+
+// 任务触发方式
+const (
+	JobTriggerCron   = "cron"   // 定时触发
+	JobTriggerManual = "manual" // 手动触发
+)
+
+// 定时任务状态表，记录暂停状态
+type PmJobTable struct {
+	ID        int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	JobName   string    `gorm:"column:job_name;NOT NULL" json:"job_name"`                               // 任务名
+	Paused    int       `gorm:"column:paused;default:0;NOT NULL" json:"paused"`                         // 0正常 1暂停，暂停后不再定时执行，仍可手动触发
+	Operator  string    `gorm:"column:operator;NOT NULL" json:"operator"`                               // 最近一次暂停/恢复的操作人
+	CreatedAt time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"` // 创建时间
+	UpdatedAt time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"updated_at"` // 更新时间
+}
+
+const PmJobTableName = "pm_job"
+
+func (m *PmJobTable) TableName() string {
+	return PmJobTableName
+}
+
+// 任务执行记录表
+type PmJobRunTable struct {
+	ID        int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	JobName   string    `gorm:"column:job_name;NOT NULL" json:"job_name"`                               // 任务名
+	Trigger   string    `gorm:"column:trigger_type;NOT NULL" json:"trigger_type"`                       // 触发方式 cron定时 manual手动
+	Params    string    `gorm:"column:params;NOT NULL" json:"params"`                                   // 执行参数json
+	Operator  string    `gorm:"column:operator;NOT NULL" json:"operator"`                               // 手动触发的操作人
+	Status    int       `gorm:"column:status;default:0;NOT NULL" json:"status"`                         // 0待执行 1执行中 2成功 3失败 4超时 5跳过
+	NodeId    string    `gorm:"column:node_id;NOT NULL" json:"node_id"`                                 // 执行节点
+	Token     int64     `gorm:"column:token;default:0;NOT NULL" json:"token"`                           // 执行时的leader fencing token
+	Total     int64     `gorm:"column:total;default:0;NOT NULL" json:"total"`                           // 处理条数
+	Success   int64     `gorm:"column:success;default:0;NOT NULL" json:"success"`                       // 成功条数
+	Fail      int64     `gorm:"column:fail;default:0;NOT NULL" json:"fail"`                             // 失败条数
+	ErrMsg    string    `gorm:"column:err_msg;NOT NULL" json:"err_msg"`                                 // 错误信息
+	StartAt   time.Time `gorm:"column:start_at;type:datetime" json:"start_at"`                          // 开始时间
+	EndAt     time.Time `gorm:"column:end_at;type:datetime" json:"end_at"`                              // 结束时间
+	CreatedAt time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"` // 创建时间
+}
+
+const PmJobRunTableName = "pm_job_run"
+
+func (m *PmJobRunTable) TableName() string {
+	return PmJobRunTableName
+}
+
+type PmJobModel struct {
+	DB *gorm.DB
+}
+
+func NewPmJobModel(dbName string) *PmJobModel {
+	return &PmJobModel{
+		DB: db.WithDBContext(dbName),
+	}
+}
+
+// 获取所有任务状态
+func (o *PmJobModel) GetAllJob() (list []*PmJobTable, err error) {
+	err = o.DB.Table(PmJobTableName).Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetAllJob 获取定时任务状态失败 err:%v", err)
+		getJobErr.CounterInc()
+	}
+	return
+}
+
+// 任务是否暂停，未记录状态的任务为正常
+func (o *PmJobModel) IsPaused(jobName string) (bool, error) {
+	info := new(PmJobTable)
+	err := o.DB.Table(PmJobTableName).Where("`job_name` = ?", jobName).First(info).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		logx.Errorf("IsPaused 获取定时任务状态失败 err:%v, jobName:%s", err, jobName)
+		getJobErr.CounterInc()
+		return false, err
+	}
+	return info.Paused == 1, nil
+}
+
+// 暂停或恢复任务
+func (o *PmJobModel) SetPaused(jobName string, paused int, operator string) error {
+	info := new(PmJobTable)
+	err := o.DB.Table(PmJobTableName).Where("`job_name` = ?", jobName).First(info).Error
+	if err == gorm.ErrRecordNotFound {
+		err = o.DB.Create(&PmJobTable{JobName: jobName, Paused: paused, Operator: operator}).Error
+	} else if err == nil {
+		err = o.DB.Table(PmJobTableName).Where("`id` = ?", info.ID).Updates(map[string]interface{}{
+			"paused":   paused,
+			"operator": operator,
+		}).Error
+	}
+	if err != nil {
+		err = fmt.Errorf("PmJobModel SetPaused Err: %v", err)
+		util.CheckError(err.Error())
+	}
+	return err
+}
+
+// 创建执行记录
+func (o *PmJobModel) CreateRun(info *PmJobRunTable) error {
+	err := o.DB.Create(info).Error
+	if err != nil {
+		logx.Errorf("创建任务执行记录失败 err: %v, jobName: %s", err, info.JobName)
+		createJobErr.CounterInc()
+	}
+	return err
+}
+
+// 更新执行记录
+func (o *PmJobModel) UpdateRun(id int, updateData map[string]interface{}) error {
+	err := o.DB.Table(PmJobRunTableName).Where("`id` = ?", id).Updates(updateData).Error
+	if err != nil {
+		err = fmt.Errorf("PmJobModel UpdateRun Err: %v", err)
+		util.CheckError(err.Error())
+	}
+	return err
+}
+
+// 领取待执行的手动任务，状态从待执行改为执行中，返回是否领取成功
+func (o *PmJobModel) ClaimPendingRun(id int, nodeId string, token int64) (bool, error) {
+	result := o.DB.Table(PmJobRunTableName).Where("`id` = ? and `status` = ?", id, JobRunStatusPending).Updates(map[string]interface{}{
+		"status":   JobRunStatusRunning,
+		"node_id":  nodeId,
+		"token":    token,
+		"start_at": time.Now(),
+	})
+	if result.Error != nil {
+		logx.Errorf("ClaimPendingRun 领取任务失败 err:%v, id:%d", result.Error, id)
+		getJobErr.CounterInc()
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 获取待执行的手动任务
+func (o *PmJobModel) GetPendingRuns(limit int) (list []*PmJobRunTable, err error) {
+	err = o.DB.Table(PmJobRunTableName).Where("`status` = ?", JobRunStatusPending).Order("`id` asc").Limit(limit).Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetPendingRuns 获取待执行任务失败 err:%v", err)
+		getJobErr.CounterInc()
+	}
+	return
+}
+
+// 获取执行记录，jobName为空时查询全部
+func (o *PmJobModel) GetRunList(jobName string, page, pageSize int) (list []*PmJobRunTable, total int64, err error) {
+	query := o.DB.Table(PmJobRunTableName)
+	if jobName != "" {
+		query = query.Where("`job_name` = ?", jobName)
+	}
+
+	err = query.Count(&total).Error
+	if err != nil {
+		logx.Errorf("GetRunList 统计任务执行记录失败 err:%v, jobName:%s", err, jobName)
+		getJobErr.CounterInc()
+		return nil, 0, err
+	}
+
+	err = query.Order("`id` desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetRunList 获取任务执行记录失败 err:%v, jobName:%s", err, jobName)
+		getJobErr.CounterInc()
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// 获取任务最近一次执行记录
+func (o *PmJobModel) GetLastRun(jobName string) (*PmJobRunTable, error) {
+	info := new(PmJobRunTable)
+	err := o.DB.Table(PmJobRunTableName).Where("`job_name` = ? and `status` != ?", jobName, JobRunStatusPending).Order("`id` desc").First(info).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logx.Errorf("GetLastRun 获取任务执行记录失败 err:%v, jobName:%s", err, jobName)
+		getJobErr.CounterInc()
+	}
+	return info, err
+}
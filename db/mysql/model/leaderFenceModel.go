@@ -0,0 +1,93 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	leaderFencedNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "leaderFencedNum", nil, "旧leader的写入被拒绝", nil})}
+)
+
+var ErrLeaderFenced = errors.New("fencing token已过期，当前节点不是leader")
+
+// 定时任务leader的fencing token，新leader上任时写入，只增不减
+type PmLeaderFenceTable struct {
+	Name      string    `gorm:"column:name;primary_key" json:"name"`                                    // 选主名称
+	Token     int64     `gorm:"column:token;default:0;NOT NULL" json:"token"`                           // 当前leader的fencing token
+	UpdatedAt time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"updated_at"` // 更新时间
+}
+
+const PmLeaderFenceTableName = "pm_leader_fence"
+
+func (m *PmLeaderFenceTable) TableName() string {
+	return PmLeaderFenceTableName
+}
+
+// LeaderFence 定时任务写入时携带的fencing token，为nil时不校验
+type LeaderFence struct {
+	Name  string
+	Token int64
+}
+
+type LeaderFenceModel struct {
+	DB *gorm.DB
+}
+
+func NewLeaderFenceModel(dbName string) *LeaderFenceModel {
+	return &LeaderFenceModel{
+		DB: db.WithDBContext(dbName),
+	}
+}
+
+// GetToken 数据库中当前的fencing token，没有记录时为0
+func (o *LeaderFenceModel) GetToken(name string) (int64, error) {
+	info := new(PmLeaderFenceTable)
+	err := o.DB.Where("`name` = ?", name).First(info).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		logx.Errorf("LeaderFenceModel GetToken err:%v, name:%s", err, name)
+		return 0, err
+	}
+	return info.Token, nil
+}
+
+// Advance 新leader上任时写入token，已有更大的token时不变
+func (o *LeaderFenceModel) Advance(name string, token int64) error {
+	err := o.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"token": gorm.Expr("GREATEST(`token`, VALUES(`token`))")}),
+	}).Create(&PmLeaderFenceTable{Name: name, Token: token}).Error
+	if err != nil {
+		logx.Errorf("LeaderFenceModel Advance err:%v, name:%s, token:%d", err, name, token)
+	}
+	return err
+}
+
+// 在事务中锁住fence行，确认没有更新的leader后再执行写入，新leader上任写入token时会等待该事务结束
+func withLeaderFence(conn *gorm.DB, fence *LeaderFence, fn func(tx *gorm.DB) error) error {
+	if fence == nil {
+		return fn(conn)
+	}
+	return conn.Transaction(func(tx *gorm.DB) error {
+		info := new(PmLeaderFenceTable)
+		err := tx.Clauses(clause.Locking{Strength: "SHARE"}).Where("`name` = ?", fence.Name).First(info).Error
+		if err != nil {
+			return err
+		}
+		if info.Token != fence.Token {
+			logx.Errorf("旧leader的写入被拒绝 name:%s, token:%d, current:%d", fence.Name, fence.Token, info.Token)
+			leaderFencedNum.CounterInc()
+			return ErrLeaderFenced
+		}
+		return fn(tx)
+	})
+}
@@ -21,28 +21,38 @@ var (
 // 用户订单表
 type OrderTable struct {
 	ID                  int       `gorm:"column:id;primary_key;AUTO_INCREMENT"`
-	AppPkg              string    `gorm:"column:app_pkg;NOT NULL"`                                 // 包名
-	UserID              int       `gorm:"column:user_id;default:0;NOT NULL"`                       // 业务程序中的用户编号
-	OutTradeNo          string    `gorm:"column:out_trade_no;NOT NULL"`                            // 内部订单号
-	PlatformTradeNo     string    `gorm:"column:platform_trade_no;NOT NULL"`                       // 支付宝/微信等平台的订单号
-	Amount              int       `gorm:"column:amount;default:0;NOT NULL"`                        // 支付金额 单位分
-	Status              int       `gorm:"column:status;default:0;NOT NULL"`                        // -1:关闭，0:未支付，1:已支付，2:支付失败，3:已退款
-	PayType             int       `gorm:"column:pay_type;default:0;NOT NULL"`                      // 支付类型（1微信，3支付宝）
-	PayTime             time.Time `gorm:"column:pay_time;default:0000-00-00 00:00:00;NOT NULL"`    // 支付时间
-	Subject             string    `gorm:"column:subject;NOT NULL"`                                 // 订单标题
-	ProductType         int       `gorm:"column:product_type;default:0;NOT NULL"`                  // 商品类型，0:普通商品，1:会员商品，2:订阅商品，3:订阅商品续费
-	ProductID           int       `gorm:"column:product_id;NOT NULL"`                              // 商品id
-	ProductDesc         string    `gorm:"column:product_desc;NOT NULL"`                            // 商品信息描述(例如，使用AB配置的商品，可以将商品信息写在这)
-	AppNotifyUrl        string    `gorm:"column:app_notify_url;NOT NULL"`                          // 业务回调通知
-	AgreementNo         string    `gorm:"column:agreement_no;NOT NULL"`                            // 支付宝/微信平台订阅协议号
-	ExternalAgreementNo string    `gorm:"column:external_agreement_no;NOT NULL"`                   // 内部协议号
-	PayAppID            string    `gorm:"column:pay_app_id;NOT NULL"`                              // 第三方支付的appid
-	DeviceId            string    `gorm:"column:device_id;NOT NULL"`                               // 用户设备号
-	CreatedAt           time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL"`    // 创建时间
-	UpdatedAt           time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL"`    // 修改时间
-	DeductTime          time.Time `gorm:"column:deduct_time;default:0000-00-00 00:00:00;NOT NULL"` // 可开始扣款时间(默认是0,不需要关注,只是为了满足产品延迟扣款的需求)
+	AppPkg              string    `gorm:"column:app_pkg;NOT NULL"`                                        // 包名
+	UserID              int       `gorm:"column:user_id;default:0;NOT NULL"`                              // 业务程序中的用户编号
+	OutTradeNo          string    `gorm:"column:out_trade_no;NOT NULL"`                                   // 内部订单号
+	PlatformTradeNo     string    `gorm:"column:platform_trade_no;NOT NULL"`                              // 支付宝/微信等平台的订单号
+	Amount              int       `gorm:"column:amount;default:0;NOT NULL"`                               // 支付金额 单位分
+	Status              int       `gorm:"column:status;default:0;NOT NULL"`                               // -1:关闭，0:未支付，1:已支付，2:支付失败，3:已退款
+	PayType             int       `gorm:"column:pay_type;default:0;NOT NULL"`                             // 支付类型（1微信，3支付宝）
+	PayTime             time.Time `gorm:"column:pay_time;default:0000-00-00 00:00:00;NOT NULL"`           // 支付时间
+	Subject             string    `gorm:"column:subject;NOT NULL"`                                        // 订单标题
+	ProductType         int       `gorm:"column:product_type;default:0;NOT NULL"`                         // 商品类型，0:普通商品，1:会员商品，2:订阅商品，3:订阅商品续费
+	ProductID           int       `gorm:"column:product_id;NOT NULL"`                                     // 商品id
+	ProductDesc         string    `gorm:"column:product_desc;NOT NULL"`                                   // 商品信息描述(例如，使用AB配置的商品，可以将商品信息写在这)
+	AppNotifyUrl        string    `gorm:"column:app_notify_url;NOT NULL"`                                 // 业务回调通知
+	AgreementNo         string    `gorm:"column:agreement_no;NOT NULL"`                                   // 支付宝/微信平台订阅协议号
+	ExternalAgreementNo string    `gorm:"column:external_agreement_no;NOT NULL"`                          // 内部协议号
+	PayAppID            string    `gorm:"column:pay_app_id;NOT NULL"`                                     // 第三方支付的appid
+	DeviceId            string    `gorm:"column:device_id;NOT NULL"`                                      // 用户设备号
+	CreatedAt           time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL"`           // 创建时间
+	UpdatedAt           time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL"`           // 修改时间
+	DeductTime          time.Time `gorm:"column:deduct_time;default:0000-00-00 00:00:00;NOT NULL"`        // 可开始扣款时间(默认是0,不需要关注,只是为了满足产品延迟扣款的需求)，扣款失败后为下次重试时间
+	DeductAttempts      int       `gorm:"column:deduct_attempts;default:0;NOT NULL"`                      // 续费扣款已尝试次数
+	DeductErrCode       string    `gorm:"column:deduct_err_code;NOT NULL"`                                // 最近一次扣款失败的错误码
+	AgreementStatus     int       `gorm:"column:agreement_status;default:0;NOT NULL"`                     // 签约订单的协议状态 0未解约 1已解约
+	RemindDeductTime    time.Time `gorm:"column:remind_deduct_time;default:0000-00-00 00:00:00;NOT NULL"` // 签约订单已回调扣款前提醒的扣款日期
 }
 
+// 签约订单的协议状态，签约成功以协议号不为空为准
+const (
+	Agreement_Status_Normal = 0 // 未解约
+	Agreement_Status_Unsign = 1 // 已解约
+)
+
 func (m *OrderTable) TableName() string {
 	return "order"
 }
@@ -119,15 +129,71 @@ func (o *OrderModel) GetFirstUnpaidSubscribeFee() (table *OrderTable, err error)
 // 一次批量取数据条数
 const VIP_DATA_ONCE_LIMIT = 100
 
+// 首次扣款只扣最近30天创建的续费订单，更早的由GetExpiredFirstDeductData取出关闭
+const SubscribeFirstDeductExpireDays = 30
+
 func (o *OrderModel) GetRangeData(id int) (records []*OrderTable, err error) {
-	// 只取最近30天的
-	err = o.DB.Where("product_type = ? and status = 0 and id > ? and deduct_time < ? and created_at >= ?", code.PRODUCT_TYPE_SUBSCRIBE_FEE, id, time.Now(), time.Now().AddDate(0, 0, -30)).
+	// 只取最近30天的首次扣款，扣款失败过的订单只由GetRetryRangeData按重试计划扣款，避免两个任务同时扣同一订单
+	err = o.DB.Where("product_type = ? and status = 0 and id > ? and deduct_attempts = 0 and deduct_time < ? and created_at >= ?", code.PRODUCT_TYPE_SUBSCRIBE_FEE, id, time.Now(), time.Now().AddDate(0, 0, -SubscribeFirstDeductExpireDays)).
 		Order("id asc").
 		Limit(VIP_DATA_ONCE_LIMIT).
 		Find(&records).Error
 	return
 }
 
+// 获取创建超过30天仍未首次扣款的续费订单，这些订单不会再被扣款
+func (o *OrderModel) GetExpiredFirstDeductData(id int) (records []*OrderTable, err error) {
+	err = o.DB.Where("product_type = ? and status = 0 and id > ? and deduct_attempts = 0 and deduct_time < ? and created_at < ?", code.PRODUCT_TYPE_SUBSCRIBE_FEE, id, time.Now(), time.Now().AddDate(0, 0, -SubscribeFirstDeductExpireDays)).
+		Order("id asc").
+		Limit(VIP_DATA_ONCE_LIMIT).
+		Find(&records).Error
+	if err != nil {
+		logx.Errorf("GetExpiredFirstDeductData err:%v, id:%d", err, id)
+	}
+	return
+}
+
+// 获取到达重试时间的续费订单，只取扣款失败过的
+func (o *OrderModel) GetRetryRangeData(id int) (records []*OrderTable, err error) {
+	err = o.DB.Where("product_type = ? and status = 0 and id > ? and deduct_attempts > 0 and deduct_time < ?", code.PRODUCT_TYPE_SUBSCRIBE_FEE, id, time.Now()).
+		Order("id asc").
+		Limit(VIP_DATA_ONCE_LIMIT).
+		Find(&records).Error
+	return
+}
+
+// 记录续费扣款失败，更新尝试次数、错误码和下次重试时间，fence不为nil时只有当前leader能写入
+func (o *OrderModel) UpdateDeductRetry(id int, attempts int, errCode string, nextDeductTime time.Time, fence *LeaderFence) error {
+	err := withLeaderFence(o.DB, fence, func(tx *gorm.DB) error {
+		return tx.Table("order").Where("`id` = ?", id).Updates(map[string]interface{}{
+			"deduct_attempts": attempts,
+			"deduct_err_code": errCode,
+			"deduct_time":     nextDeductTime,
+		}).Error
+	})
+	if err != nil {
+		logx.Errorf("UpdateDeductRetry err:%v, id:%d", err, id)
+		updateOrderNotifyErr.CounterInc()
+	}
+	return err
+}
+
+// 续费扣款最终失败，关闭订单并记录尝试次数和错误码，fence不为nil时只有当前leader能写入
+func (o *OrderModel) LapseSubscribeFee(id int, attempts int, errCode string, fence *LeaderFence) error {
+	err := withLeaderFence(o.DB, fence, func(tx *gorm.DB) error {
+		return tx.Table("order").Where("`id` = ? and `status` = ?", id, code.ORDER_NO_PAY).Updates(map[string]interface{}{
+			"status":          code.ORDER_CLOSE,
+			"deduct_attempts": attempts,
+			"deduct_err_code": errCode,
+		}).Error
+	})
+	if err != nil {
+		logx.Errorf("LapseSubscribeFee err:%v, id:%d", err, id)
+		updateOrderNotifyErr.CounterInc()
+	}
+	return err
+}
+
 func (o *OrderModel) UpdateStatusByOutTradeNo(outTradeNo string, status int) error {
 	err := o.DB.Table("order").Where("`out_trade_no` = ? ", outTradeNo).Updates(map[string]interface{}{
 		"status": status,
@@ -149,3 +215,117 @@ func (o *OrderModel) CloseUnpaidSubscribeFeeOrderByExternalAgreementNo(externalA
 	}
 	return err
 }
+
+// 根据协议号标记签约订单已解约
+func (o *OrderModel) UnsignAgreementByExternalAgreementNo(externalAgreementNo string) error {
+	err := o.DB.Table("order").Where("`external_agreement_no` = ? and `product_type` = ?", externalAgreementNo, code.PRODUCT_TYPE_SUBSCRIBE).
+		Update("`agreement_status`", Agreement_Status_Unsign).Error
+	if err != nil {
+		logx.Errorf("标记协议已解约失败 err:%v, external_agreement_no:%s", err, externalAgreementNo)
+		updateOrderNotifyErr.CounterInc()
+	}
+	return err
+}
+
+// 按id游标分批获取扣款时间在[startTime, endTime)内的待扣款订单，用于扣款前提醒：
+// 未解约签约订单的首次续费扣款时间，以及未扣款失败过的续费订单的扣款时间
+func (o *OrderModel) GetUpcomingDeductBatch(startTime, endTime time.Time, lastId int, limit int) (records []*OrderTable, err error) {
+	err = o.DB.Where("`deduct_time` >= ? and `deduct_time` < ? and `id` > ? and "+
+		"((`product_type` = ? and `status` = ? and `agreement_no` != '' and `agreement_status` = ?) or (`product_type` = ? and `status` = ? and `deduct_attempts` = 0))",
+		startTime, endTime, lastId,
+		code.PRODUCT_TYPE_SUBSCRIBE, code.ORDER_SUCCESS, Agreement_Status_Normal,
+		code.PRODUCT_TYPE_SUBSCRIBE_FEE, code.ORDER_NO_PAY).
+		Order("id asc").
+		Limit(limit).
+		Find(&records).Error
+	if err != nil {
+		logx.Errorf("GetUpcomingDeductBatch 获取待扣款订单失败 err:%v", err)
+		getOrderErr.CounterInc()
+	}
+	return
+}
+
+// 签约订单记录已提醒的扣款日期，同一协议同一扣款日只提醒一次，返回是否由本次更新
+func (o *OrderModel) MarkDeductRemind(externalAgreementNo string, deductDate time.Time) (bool, error) {
+	result := o.DB.Table("order").Where("`external_agreement_no` = ? and `product_type` = ? and `remind_deduct_time` != ?", externalAgreementNo, code.PRODUCT_TYPE_SUBSCRIBE, deductDate).
+		Update("`remind_deduct_time`", deductDate)
+	if result.Error != nil {
+		logx.Errorf("MarkDeductRemind err:%v, external_agreement_no:%s", result.Error, externalAgreementNo)
+		updateOrderNotifyErr.CounterInc()
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 扣款提醒回调失败时撤销已提醒的标记，下次执行时重新提醒
+func (o *OrderModel) ResetDeductRemind(externalAgreementNo string, deductDate time.Time) error {
+	err := o.DB.Table("order").Where("`external_agreement_no` = ? and `product_type` = ? and `remind_deduct_time` = ?", externalAgreementNo, code.PRODUCT_TYPE_SUBSCRIBE, deductDate).
+		Update("`remind_deduct_time`", Default2000Date).Error
+	if err != nil {
+		logx.Errorf("ResetDeductRemind err:%v, external_agreement_no:%s", err, externalAgreementNo)
+		updateOrderNotifyErr.CounterInc()
+	}
+	return err
+}
+
+// 商户支付订单统计
+type PayAppOrderStat struct {
+	PayAppID string `gorm:"column:pay_app_id"`
+	OrderNum int64  `gorm:"column:order_num"`
+}
+
+// 按支付appid统计时间段内支付成功的订单数（含已退款），用于计算投诉率
+func (o *OrderModel) CountPaidByPayAppID(startTime, endTime time.Time) (list []*PayAppOrderStat, err error) {
+	err = o.DB.Table("order").Select("`pay_app_id`, count(*) as order_num").
+		Where("`pay_time` >= ? and `pay_time` < ? and `status` in (?)", startTime, endTime, []int{code.ORDER_SUCCESS, code.ORDER_REFUNDED}).
+		Group("`pay_app_id`").Find(&list).Error
+	if err != nil {
+		logx.Errorf("CountPaidByPayAppID 统计支付订单失败 err:%v", err)
+		getOrderErr.CounterInc()
+	}
+	return
+}
+
+// 获取存在未支付续费订单的签约订单，按id分页，用于协议状态对账
+func (o *OrderModel) GetSubscribeWithUnpaidFee(lastId int, limit int) (records []*OrderTable, err error) {
+	subQuery := o.DB.Table("order").Select("`external_agreement_no`").
+		Where("`product_type` = ? and `status` = ?", code.PRODUCT_TYPE_SUBSCRIBE_FEE, code.ORDER_NO_PAY)
+	err = o.DB.Where("`product_type` = ? and `id` > ? and `external_agreement_no` != '' and `external_agreement_no` in (?)", code.PRODUCT_TYPE_SUBSCRIBE, lastId, subQuery).
+		Order("id asc").
+		Limit(limit).
+		Find(&records).Error
+	if err != nil {
+		logx.Errorf("GetSubscribeWithUnpaidFee 获取签约订单失败 err:%v", err)
+		getOrderErr.CounterInc()
+	}
+	return
+}
+
+// 按id游标分批获取时间段内创建的未支付订单，用于补单，续费订单由扣款任务处理
+func (o *OrderModel) GetUnpaidBatchByCreateTimeRange(startTime, endTime time.Time, lastId int, limit int) (records []*OrderTable, err error) {
+	err = o.DB.Where("`created_at` >= ? and `created_at` <= ? and `status` = ? and `product_type` != ? and `id` > ?", startTime, endTime, code.ORDER_NO_PAY, code.PRODUCT_TYPE_SUBSCRIBE_FEE, lastId).
+		Order("id asc").
+		Limit(limit).
+		Find(&records).Error
+	if err != nil {
+		logx.Errorf("GetUnpaidBatchByCreateTimeRange 获取未支付订单失败 err:%v", err)
+		getOrderErr.CounterInc()
+	}
+	return
+}
+
+// QueryAfterUpdate 主动查询到支付成功后修改订单状态，只更新未支付的订单，返回是否由本次更新
+func (o *OrderModel) QueryAfterUpdate(outTradeNo, platformTradeNo string, payType int) (bool, error) {
+	result := o.DB.Table("order").Where("`out_trade_no` = ? and `status` = ?", outTradeNo, code.ORDER_NO_PAY).Updates(map[string]interface{}{
+		"status":            code.ORDER_SUCCESS,
+		"pay_type":          payType,
+		"pay_time":          time.Now(),
+		"platform_trade_no": platformTradeNo,
+	})
+	if result.Error != nil {
+		logx.Errorf("QueryAfterUpdate:更新订单失败 err:%v, out_trade_no:%s", result.Error, outTradeNo)
+		updateOrderNotifyErr.CounterInc()
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
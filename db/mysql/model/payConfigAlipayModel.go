@@ -29,12 +29,53 @@ type PmPayConfigAlipayTable struct {
 	NotifyUrl        string    `gorm:"column:notify_url;NOT NULL" json:"notify_url"`                   // 回调地址
 	MerchantNo       string    `gorm:"column:merchant_no;NOT NULL" json:"merchant_no"`                 // 商户号
 	MerchantName     string    `gorm:"column:merchant_name;NOT NULL" json:"merchant_name"`             // 商户名称
+	Status           int       `gorm:"column:status;default:1;NOT NULL" json:"status"`                 // 0停用 1启用，停用后不能再关联到应用
+	DataKey          string    `gorm:"column:data_key;NOT NULL" json:"data_key"`                       // 主密钥加密后的数据密钥，private_key用它加密存储
 	CreatedAt        time.Time `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt        time.Time `gorm:"column:updated_at" json:"updated_at"`
 }
 
+const PmPayConfigAlipayTableName = "pm_pay_config_alipay"
+
 func (m *PmPayConfigAlipayTable) TableName() string {
-	return "pm_pay_config_alipay"
+	return PmPayConfigAlipayTableName
+}
+
+// 加密存储的密钥字段，实现SecretConfig
+func (m *PmPayConfigAlipayTable) secretFields() []secretField {
+	return []secretField{{"private_key", &m.PrivateKey}}
+}
+
+func (m *PmPayConfigAlipayTable) ConfigId() int {
+	return m.ID
+}
+
+func (m *PmPayConfigAlipayTable) SecretColumns() []string {
+	return secretColumns(m.secretFields())
+}
+
+func (m *PmPayConfigAlipayTable) SecretValues() map[string]string {
+	return secretValues(m.DataKey, m.secretFields())
+}
+
+func (m *PmPayConfigAlipayTable) HasPlainSecrets() bool {
+	return hasPlainSecrets(m.secretFields())
+}
+
+func (m *PmPayConfigAlipayTable) EncryptSecrets() error {
+	return encryptSecrets(PmPayConfigAlipayTableName, &m.DataKey, m.secretFields())
+}
+
+func (m *PmPayConfigAlipayTable) DecryptSecrets() error {
+	return decryptSecrets(PmPayConfigAlipayTableName, m.ID, m.DataKey, m.secretFields())
+}
+
+// WithoutSecrets 去掉密钥字段的副本，用于缓存只需要appid、回调地址等的场景
+func (m *PmPayConfigAlipayTable) WithoutSecrets() PmPayConfigAlipayTable {
+	cfg := *m
+	cfg.PrivateKey = ""
+	cfg.DataKey = ""
+	return cfg
 }
 
 func (m *PmPayConfigAlipayTable) TransClientConfig() (clientCfg *client.AliPayConfig) {
@@ -73,5 +114,29 @@ func (o *PmPayConfigAlipayModel) GetOneByAppID(appID string) (appConfig *PmPayCo
 		getPayConfigAlipayErr.CounterInc()
 		return nil, err
 	}
+	if err = cfg.DecryptSecrets(); err != nil {
+		return nil, err
+	}
 	return &cfg, nil
 }
+
+// 获取支付宝配置列表
+func (o *PmPayConfigAlipayModel) GetAllList() (alipayCfgList []*PmPayConfigAlipayTable, err error) {
+	alipayCfgList = make([]*PmPayConfigAlipayTable, 0)
+
+	err = o.DB.Find(&alipayCfgList).Error
+	if err != nil {
+		logx.Errorf("获取支付宝配置列表失败，err:=%v", err)
+		getPayConfigAlipayErr.CounterInc()
+		return nil, err
+	}
+
+	// 解密失败的配置跳过，不影响其他商户
+	validList := alipayCfgList[:0]
+	for _, cfg := range alipayCfgList {
+		if cfg.DecryptSecrets() == nil {
+			validList = append(validList, cfg)
+		}
+	}
+	return validList, nil
+}
@@ -18,16 +18,49 @@ var (
 // 快手支付配置
 type PmPayConfigKsTable struct {
 	ID        int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
-	AppID     string    `gorm:"column:app_id;NOT NULL" json:"app_id"`         // 应用id
-	AppSecret string    `gorm:"column:app_secret;NOT NULL" json:"app_secret"` // 应用secret
-	NotifyUrl string    `gorm:"column:notify_url;NOT NULL" json:"notify_url"` // 回调地址
-	Remark    string    `gorm:"column:remark;NOT NULL" json:"remark"`         // 备注信息
+	AppID     string    `gorm:"column:app_id;NOT NULL" json:"app_id"`           // 应用id
+	AppSecret string    `gorm:"column:app_secret;NOT NULL" json:"app_secret"`   // 应用secret
+	NotifyUrl string    `gorm:"column:notify_url;NOT NULL" json:"notify_url"`   // 回调地址
+	Remark    string    `gorm:"column:remark;NOT NULL" json:"remark"`           // 备注信息
+	Status    int       `gorm:"column:status;default:1;NOT NULL" json:"status"` // 0停用 1启用，停用后不能再关联到应用
+	DataKey   string    `gorm:"column:data_key;NOT NULL" json:"data_key"`       // 主密钥加密后的数据密钥，app_secret用它加密存储
 	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
 	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
 }
 
+const PmPayConfigKsTableName = "pm_pay_config_ks"
+
 func (m *PmPayConfigKsTable) TableName() string {
-	return "pm_pay_config_ks"
+	return PmPayConfigKsTableName
+}
+
+// 加密存储的密钥字段，实现SecretConfig
+func (m *PmPayConfigKsTable) secretFields() []secretField {
+	return []secretField{{"app_secret", &m.AppSecret}}
+}
+
+func (m *PmPayConfigKsTable) ConfigId() int {
+	return m.ID
+}
+
+func (m *PmPayConfigKsTable) SecretColumns() []string {
+	return secretColumns(m.secretFields())
+}
+
+func (m *PmPayConfigKsTable) SecretValues() map[string]string {
+	return secretValues(m.DataKey, m.secretFields())
+}
+
+func (m *PmPayConfigKsTable) HasPlainSecrets() bool {
+	return hasPlainSecrets(m.secretFields())
+}
+
+func (m *PmPayConfigKsTable) EncryptSecrets() error {
+	return encryptSecrets(PmPayConfigKsTableName, &m.DataKey, m.secretFields())
+}
+
+func (m *PmPayConfigKsTable) DecryptSecrets() error {
+	return decryptSecrets(PmPayConfigKsTableName, m.ID, m.DataKey, m.secretFields())
 }
 
 func (m *PmPayConfigKsTable) TransClientConfig() (clientCfg *client.KsPayConfig) {
@@ -62,6 +95,9 @@ func (o *PmPayConfigKsModel) GetOneByAppID(appID string) (appConfig *PmPayConfig
 		getPayConfigKsErr.CounterInc()
 		return nil, err
 	}
+	if err = cfg.DecryptSecrets(); err != nil {
+		return nil, err
+	}
 
 	return &cfg, nil
 }
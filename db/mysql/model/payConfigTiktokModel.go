@@ -27,12 +27,45 @@ type PmPayConfigTiktokTable struct {
 	CustomerImId       string `gorm:"column:customer_im_id" json:"customer_im_id"`                        // 抖音客服id 用于ios支付
 	MerchantUid        string `gorm:"column:merchant_uid;NOT NULL" json:"merchant_uid"`                   // 自定义的商户号
 	SignPayMerchantUid string `gorm:"column:sign_pay_merchant_uid;NOT NULL" json:"sign_pay_merchant_uid"` // 抖音代扣收款商户号 一般跟merchant_uid一样
+	Status             int    `gorm:"column:status;default:1;NOT NULL" json:"status"`                     // 0停用 1启用，停用后不能再关联到应用
+	DataKey            string `gorm:"column:data_key;NOT NULL" json:"data_key"`                           // 主密钥加密后的数据密钥，private_key、salt、token用它加密存储
 	// CreatedAt          time.Time `gorm:"column:created_at" json:"created_at"`
 	// UpdatedAt          time.Time `gorm:"column:updated_at" json:"updated_at"`
 }
 
+const PmPayConfigTiktokTableName = "pm_pay_config_tiktok"
+
 func (m *PmPayConfigTiktokTable) TableName() string {
-	return "pm_pay_config_tiktok"
+	return PmPayConfigTiktokTableName
+}
+
+// 加密存储的密钥字段，实现SecretConfig
+func (m *PmPayConfigTiktokTable) secretFields() []secretField {
+	return []secretField{{"private_key", &m.PrivateKey}, {"salt", &m.Salt}, {"token", &m.Token}}
+}
+
+func (m *PmPayConfigTiktokTable) ConfigId() int {
+	return m.ID
+}
+
+func (m *PmPayConfigTiktokTable) SecretColumns() []string {
+	return secretColumns(m.secretFields())
+}
+
+func (m *PmPayConfigTiktokTable) SecretValues() map[string]string {
+	return secretValues(m.DataKey, m.secretFields())
+}
+
+func (m *PmPayConfigTiktokTable) HasPlainSecrets() bool {
+	return hasPlainSecrets(m.secretFields())
+}
+
+func (m *PmPayConfigTiktokTable) EncryptSecrets() error {
+	return encryptSecrets(PmPayConfigTiktokTableName, &m.DataKey, m.secretFields())
+}
+
+func (m *PmPayConfigTiktokTable) DecryptSecrets() error {
+	return decryptSecrets(PmPayConfigTiktokTableName, m.ID, m.DataKey, m.secretFields())
 }
 
 func (m *PmPayConfigTiktokTable) TransClientConfig() (clientCfg *client.TikTokPayConfig) {
@@ -77,5 +110,8 @@ func (o *PmPayConfigTiktokModel) GetOneByAppID(appID string) (appConfig *PmPayCo
 		getPayConfigTiktokErr.CounterInc()
 		return nil, err
 	}
+	if err = cfg.DecryptSecrets(); err != nil {
+		return nil, err
+	}
 	return &cfg, nil
 }
@@ -29,15 +29,50 @@ type PmPayConfigWechatTable struct {
 	SerialNumber   string `gorm:"column:serial_number;NOT NULL" json:"serial_number"`       // 商户证书序列号
 	Remark         string `gorm:"column:remark;NOT NULL" json:"remark"`                     // 备注信息
 	XPayAppKey     string `gorm:"column:xpay_appkey;NOT NULL" json:"xpay_appkey"`           // 虚拟支付现网AppKey
+	XPayOfferId    string `gorm:"column:xpay_offer_id;NOT NULL" json:"xpay_offer_id"`       // 虚拟支付OfferID
+	XPayMsgToken   string `gorm:"column:xpay_msg_token;NOT NULL" json:"xpay_msg_token"`     // 小程序消息推送Token，用于校验虚拟支付消息推送
 	PlatformNumer  string `gorm:"column:platform_numer;NOT NULL" json:"platform_numer"`     // 微信支付平台证书编号
 	WapUrl         string `gorm:"column:wap_url" json:"wap_url"`                            // 支付H5域名
 	WapName        string `gorm:"column:wap_name" json:"wap_name"`                          // 支付名称
+	Status         int    `gorm:"column:status;default:1;NOT NULL" json:"status"`           // 0停用 1启用，停用后不能再关联到应用
+	DataKey        string `gorm:"column:data_key;NOT NULL" json:"data_key"`                 // 主密钥加密后的数据密钥，api_key、api_key_v2、xpay_appkey用它加密存储
 	// CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
 	// UpdatedAt      time.Time `gorm:"column:updated_at" json:"updated_at"`
 }
 
+const PmPayConfigWechatTableName = "pm_pay_config_wechat"
+
 func (m *PmPayConfigWechatTable) TableName() string {
-	return "pm_pay_config_wechat"
+	return PmPayConfigWechatTableName
+}
+
+// 加密存储的密钥字段，实现SecretConfig
+func (m *PmPayConfigWechatTable) secretFields() []secretField {
+	return []secretField{{"api_key", &m.ApiKey}, {"api_key_v2", &m.ApiKeyV2}, {"xpay_appkey", &m.XPayAppKey}}
+}
+
+func (m *PmPayConfigWechatTable) ConfigId() int {
+	return m.ID
+}
+
+func (m *PmPayConfigWechatTable) SecretColumns() []string {
+	return secretColumns(m.secretFields())
+}
+
+func (m *PmPayConfigWechatTable) SecretValues() map[string]string {
+	return secretValues(m.DataKey, m.secretFields())
+}
+
+func (m *PmPayConfigWechatTable) HasPlainSecrets() bool {
+	return hasPlainSecrets(m.secretFields())
+}
+
+func (m *PmPayConfigWechatTable) EncryptSecrets() error {
+	return encryptSecrets(PmPayConfigWechatTableName, &m.DataKey, m.secretFields())
+}
+
+func (m *PmPayConfigWechatTable) DecryptSecrets() error {
+	return decryptSecrets(PmPayConfigWechatTableName, m.ID, m.DataKey, m.secretFields())
 }
 
 func (m *PmPayConfigWechatTable) TransClientConfig() (clientCfg *client.WechatPayConfig) {
@@ -78,6 +113,9 @@ func (o *PmPayConfigWechatModel) GetOneByAppID(appID string) (*PmPayConfigWechat
 	rkey := o.RDB.GetRedisKey(pm_pay_config_wechat_cache_key, appID)
 	err := o.RDB.GetObject(context.Background(), rkey, &cfg)
 	if err == nil && cfg.ID > 0 {
+		if err = cfg.DecryptSecrets(); err != nil {
+			return nil, err
+		}
 		return &cfg, nil
 	}
 
@@ -88,8 +126,11 @@ func (o *PmPayConfigWechatModel) GetOneByAppID(appID string) (*PmPayConfigWechat
 		return nil, err
 	}
 
-	// 设置缓存时间为3分钟
+	// 设置缓存时间为3分钟，缓存的是密文，读取后再解密
 	o.RDB.Set(context.Background(), rkey, cfg, 180)
+	if err = cfg.DecryptSecrets(); err != nil {
+		return nil, err
+	}
 
 	return &cfg, nil
 }
@@ -104,5 +145,18 @@ func (o *PmPayConfigWechatModel) GetAllList() (wechatCfgList []*PmPayConfigWecha
 		getPayConfigWechatErr.CounterInc()
 		return nil, err
 	}
-	return
+
+	// 解密失败的配置跳过，不影响其他商户
+	validList := wechatCfgList[:0]
+	for _, cfg := range wechatCfgList {
+		if cfg.DecryptSecrets() == nil {
+			validList = append(validList, cfg)
+		}
+	}
+	return validList, nil
+}
+
+// 配置变更后使appid的缓存失效
+func (o *PmPayConfigWechatModel) ExpireCache(appID string) {
+	ExpireCache(o.RDB, o.RDB.GetRedisKey(pm_pay_config_wechat_cache_key, appID))
 }
@@ -36,6 +36,8 @@ const (
 	PmPayOrderTablePayWxUnified              = 6 // 微信统一下单接口 ,暂未用到回调接口被误用为8
 	PmPayOrderTablePayWxV3H5                 = 7 // 微信h5支付
 	PmPayOrderTablePayTypeDouyinGeneralTrade = 8 // 抖音小程序支付-通用交易系统,由6调整为8和Pb入参一致
+	PmPayOrderTablePayTypeWechatXPay         = 9 // 微信小程序虚拟支付，和Pb入参一致
+	PmPayOrderTablePayTypeKsUniApp           = 5 // 快手小程序支付，和Pb入参一致(PayType_KsUniApp)
 
 )
 
@@ -153,35 +155,20 @@ func (o *PmPayOrderModel) UpdatePayAppID(orderSn string, payAppId string) (err e
 
 }
 
-// GetListByCreateTimeRange 获取指定时间区间的数据,批量获取，每次获取3000条
-func (o *PmPayOrderModel) GetListByCreateTimeRange(startTime, endTime time.Time) (pmPayList []*PmPayOrderTable, err error) {
-	pmPayList = make([]*PmPayOrderTable, 0)
-	batch := make([]*PmPayOrderTable, 0)
-
-	fromIndex := uint(0)
-	for {
-		err = o.DB.Where("created_at >= ?", startTime).
-			Where("created_at <= ?", endTime).
-			Where("pay_status = 0").
-			Where("id > ?", fromIndex).
-			Order("id asc").
-			Limit(3000).
-			Find(&batch).Error
-		if len(batch) == 0 {
-			break
-		}
-
-		lastOne := batch[len(batch)-1]
-		fromIndex = lastOne.ID
-		pmPayList = append(pmPayList, batch...)
-	}
-
+// GetUnpaidBatchByCreateTimeRange 按id游标分批获取指定时间区间的未支付订单，每次只取一批，避免整段时间的订单一次性加载到内存
+func (o *PmPayOrderModel) GetUnpaidBatchByCreateTimeRange(startTime, endTime time.Time, lastId uint, limit int) (pmPayList []*PmPayOrderTable, err error) {
+	err = o.DB.Where("created_at >= ?", startTime).
+		Where("created_at <= ?", endTime).
+		Where("pay_status = 0").
+		Where("id > ?", lastId).
+		Order("id asc").
+		Limit(limit).
+		Find(&pmPayList).Error
 	if err != nil {
-		logx.Errorf("GetListByCreateTimeRange err:%v, params:%v, %v", err, startTime, endTime)
-		return
+		logx.Errorf("GetUnpaidBatchByCreateTimeRange err:%v, params:%v, %v, %d", err, startTime, endTime, lastId)
+		getPayOrderErr.CounterInc()
 	}
-
-	return pmPayList, nil
+	return
 }
 
 func (o *PmPayOrderModel) GetOneByThirdOrderNoAndAppId(orderSn, appId string) (info *PmPayOrderTable, err error) {
@@ -195,3 +182,16 @@ func (o *PmPayOrderModel) GetOneByThirdOrderNoAndAppId(orderSn, appId string) (i
 
 	return &orderInfo, nil
 }
+
+// 根据三方订单号获取订单，用于微信投诉等只有微信支付订单号的场景
+func (o *PmPayOrderModel) GetOneByThirdOrderNo(thirdOrderNo string) (info *PmPayOrderTable, err error) {
+	var orderInfo PmPayOrderTable
+	err = o.DB.Where("`third_order_no` = ?", thirdOrderNo).First(&orderInfo).Error
+	if err != nil {
+		logx.Errorf("GetOneByThirdOrderNo 获取订单信息失败 err:%v, third_order_no:%s", err, thirdOrderNo)
+		getPayOrderErr.CounterInc()
+		return nil, err
+	}
+
+	return &orderInfo, nil
+}
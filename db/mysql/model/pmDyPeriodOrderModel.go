@@ -13,30 +13,39 @@ import (
 // 抖音周期签约订单表
 type PmDyPeriodOrderTable struct {
 	ID                int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
-	OrderSn           string    `gorm:"column:order_sn;NOT NULL" json:"order_sn"`                            // 内部 订单唯一标识 (开发者侧代扣单的单号)
-	SignNo            string    `gorm:"column:sign_no;NOT NULL" json:"sign_no"`                              // 内部 签约单号 (开发者侧签约单号)
-	AppPkgName        string    `gorm:"column:app_pkg_name;NOT NULL" json:"app_pkg_name"`                    // 来源包名
-	UserId            int       `gorm:"column:user_id;NOT NULL" json:"user_id"`                              // 内部用户id
-	Amount            int       `gorm:"column:amount;default:0;NOT NULL" json:"amount"`                      // 订单金额（分）
-	NotifyAmount      int       `gorm:"column:notify_amount;default:0;NOT NULL" json:"notify_amount"`        // 回调金额（分）
-	Subject           string    `gorm:"column:subject;NOT NULL" json:"subject"`                              // 订单标题
-	PayType           int       `gorm:"column:pay_type;default:0;NOT NULL" json:"pay_type"`                  // 支付方式  1微信小程序支付 2头条小程序支付
-	NotifyUrl         string    `gorm:"column:notify_url;NOT NULL" json:"notify_url"`                        // 回调通知地址
-	PayStatus         int       `gorm:"column:pay_status;NOT NULL" json:"pay_status"`                        // 支付状态 0未支付 1已支付
-	PayChannel        int       `gorm:"column:pay_channel;NOT NULL" json:"pay_channel"`                      // 支付渠道 扣款成功时才有
-	SignStatus        int       `gorm:"column:sign_status;NOT NULL" json:"sign_status"`                      // 签约状态, 0 待签约 , 1已签约 , 2取消签约 , 3 签约到期(服务已完成)
-	PayAppId          string    `gorm:"column:pay_app_id;NOT NULL" json:"pay_app_id"`                        // 第三方支付的appid
-	ThirdOrderSn      string    `gorm:"column:third_order_sn;NULL" json:"third_order_sn"`                    // 抖音平台返回的渠道支付单号
-	ThirdOrderNo      string    `gorm:"column:third_order_no;NULL" json:"third_order_no"`                    // 抖音平台返回的代扣单的单号
-	ThirdSignOrderNo  string    `gorm:"column:third_sign_order_no;NULL" json:"third_sign_order_no"`          // 抖音平台返回的签约单号
-	Currency          string    `gorm:"column:currency;type:varchar(16);NOT NULL"`                           // 支付币种
-	UserBillPayId     string    `gorm:"column:user_bill_pay_id;NULL" json:"user_bill_pay_id"`                // 用户抖音交易单号（账单号），和用户抖音钱包-账单中所展示的交易单号相同
-	SignDate          time.Time `gorm:"column:sign_date;type:datetime" json:"sign_date"`                     // 签约时间 默认值2000-01-01 00:00:01
-	UnsignDate        time.Time `gorm:"column:unsign_date;type:datetime" json:"unsign_date"`                 // 解约时间 默认值2000-01-01 00:00:01
-	ExpireDate        time.Time `gorm:"column:expire_date;type:datetime" json:"expire_date"`                 // 签约到期时间 默认值2000-01-01 00:00:01
-	NextDecuctionTime time.Time `gorm:"column:next_decuction_time;type:datetime" json:"next_decuction_time"` // 下次扣款时间 默认值2000-01-01 00:00:01
-	DyProductId       string    `gorm:"column:dy_product_id;NOT NULL" json:"dy_product_id"`                  // 抖音商品id
-	NthNum            int       `gorm:"column:nth_num;default:0;NOT NULL" json:"nth_num"`                    // 第几期代扣单
+	OrderSn           string    `gorm:"column:order_sn;NOT NULL" json:"order_sn"`                                                      // 内部 订单唯一标识 (开发者侧代扣单的单号)
+	SignNo            string    `gorm:"column:sign_no;NOT NULL" json:"sign_no"`                                                        // 内部 签约单号 (开发者侧签约单号)
+	AppPkgName        string    `gorm:"column:app_pkg_name;NOT NULL" json:"app_pkg_name"`                                              // 来源包名
+	UserId            int       `gorm:"column:user_id;NOT NULL" json:"user_id"`                                                        // 内部用户id
+	Amount            int       `gorm:"column:amount;default:0;NOT NULL" json:"amount"`                                                // 订单金额（分）
+	NotifyAmount      int       `gorm:"column:notify_amount;default:0;NOT NULL" json:"notify_amount"`                                  // 回调金额（分）
+	Subject           string    `gorm:"column:subject;NOT NULL" json:"subject"`                                                        // 订单标题
+	PayType           int       `gorm:"column:pay_type;default:0;NOT NULL" json:"pay_type"`                                            // 支付方式  1微信小程序支付 2头条小程序支付
+	NotifyUrl         string    `gorm:"column:notify_url;NOT NULL" json:"notify_url"`                                                  // 回调通知地址
+	PayStatus         int       `gorm:"column:pay_status;NOT NULL" json:"pay_status"`                                                  // 支付状态 0未支付 1已支付 2扣款失败
+	PayChannel        int       `gorm:"column:pay_channel;NOT NULL" json:"pay_channel"`                                                // 支付渠道 扣款成功时才有
+	SignStatus        int       `gorm:"column:sign_status;NOT NULL" json:"sign_status"`                                                // 签约状态, 0 待签约 , 1已签约 , 2取消签约 , 3 签约到期(服务已完成)
+	PayAppId          string    `gorm:"column:pay_app_id;NOT NULL" json:"pay_app_id"`                                                  // 第三方支付的appid
+	ThirdOrderSn      string    `gorm:"column:third_order_sn;NULL" json:"third_order_sn"`                                              // 抖音平台返回的渠道支付单号
+	ThirdOrderNo      string    `gorm:"column:third_order_no;NULL" json:"third_order_no"`                                              // 抖音平台返回的代扣单的单号
+	ThirdSignOrderNo  string    `gorm:"column:third_sign_order_no;NULL" json:"third_sign_order_no"`                                    // 抖音平台返回的签约单号
+	Currency          string    `gorm:"column:currency;type:varchar(16);NOT NULL"`                                                     // 支付币种
+	UserBillPayId     string    `gorm:"column:user_bill_pay_id;NULL" json:"user_bill_pay_id"`                                          // 用户抖音交易单号（账单号），和用户抖音钱包-账单中所展示的交易单号相同
+	SignDate          time.Time `gorm:"column:sign_date;type:datetime" json:"sign_date"`                                               // 签约时间 默认值2000-01-01 00:00:01
+	UnsignDate        time.Time `gorm:"column:unsign_date;type:datetime" json:"unsign_date"`                                           // 解约时间 默认值2000-01-01 00:00:01
+	ExpireDate        time.Time `gorm:"column:expire_date;type:datetime" json:"expire_date"`                                           // 签约到期时间 默认值2000-01-01 00:00:01
+	NextDecuctionTime time.Time `gorm:"column:next_decuction_time;type:datetime" json:"next_decuction_time"`                           // 下次扣款时间 默认值2000-01-01 00:00:01
+	DyProductId       string    `gorm:"column:dy_product_id;NOT NULL" json:"dy_product_id"`                                            // 抖音商品id
+	NthNum            int       `gorm:"column:nth_num;default:0;NOT NULL" json:"nth_num"`                                              // 第几期代扣单，网关扣款的签约单为已扣款成功的期数
+	SignOrderId       int       `gorm:"column:sign_order_id;default:0;NOT NULL" json:"sign_order_id"`                                  // 网关发起的代扣单对应的签约单id，签约单和业务方创建的代扣单为0
+	DeductStatus      int       `gorm:"column:deduct_status;default:0;NOT NULL" json:"deduct_status"`                                  // 签约单代扣状态 0正常 1多次扣款失败已停止代扣
+	DeductAttempts    int       `gorm:"column:deduct_attempts;default:0;NOT NULL" json:"deduct_attempts"`                              // 签约单当期已扣款失败次数
+	DeductErrCode     string    `gorm:"column:deduct_err_code;NOT NULL" json:"deduct_err_code"`                                        // 签约单最近一次扣款失败的错误码
+	DeductRetryTime   time.Time `gorm:"column:deduct_retry_time;type:datetime;default:2000-01-01 00:00:01" json:"deduct_retry_time"`   // 扣款失败后下次重试时间
+	PeriodUnit        string    `gorm:"column:period_unit;default:month;NOT NULL" json:"period_unit"`                                  // 扣款周期单位 day|week|month|year
+	PeriodCount       int       `gorm:"column:period_count;default:1;NOT NULL" json:"period_count"`                                    // 每期包含的周期单位数，如按季为3个月
+	TrialDays         int       `gorm:"column:trial_days;default:0;NOT NULL" json:"trial_days"`                                        // 试用天数，签约时支付首期金额，试用期结束后开始按周期扣款
+	RemindDeductTime  time.Time `gorm:"column:remind_deduct_time;type:datetime;default:2000-01-01 00:00:01" json:"remind_deduct_time"` // 签约单已回调扣款前提醒的扣款日期
 	// CreatedAt    time.Time `gorm:"column:created_at;type:datetime" json:"created_at"`
 	// UpdatedAt    time.Time `gorm:"column:updated_at;type:datetime" json:"updated_at"`
 }
@@ -49,6 +58,36 @@ const (
 	Sign_Status_Done    = 3 // 签约到期(服务已完成)
 )
 
+// 代扣单扣款状态
+const (
+	Dy_Pay_Status_Wait = 0 // 未扣款或等待抖音扣款结果
+	Dy_Pay_Status_Paid = 1 // 扣款成功
+	Dy_Pay_Status_Fail = 2 // 扣款失败
+)
+
+// 签约单代扣状态
+const (
+	Dy_Deduct_Status_Normal = 0 // 正常扣款
+	Dy_Deduct_Status_Lapsed = 1 // 多次扣款失败，停止代扣
+)
+
+// 扣款周期单位
+const (
+	Dy_Period_Unit_Day   = "day"
+	Dy_Period_Unit_Week  = "week"
+	Dy_Period_Unit_Month = "month"
+	Dy_Period_Unit_Year  = "year"
+)
+
+// 是否是支持的扣款周期单位，为空时按月
+func IsValidDyPeriodUnit(unit string) bool {
+	switch unit {
+	case "", Dy_Period_Unit_Day, Dy_Period_Unit_Week, Dy_Period_Unit_Month, Dy_Period_Unit_Year:
+		return true
+	}
+	return false
+}
+
 // 2000-01-01 00:00:01 对应的时间戳就是946656001
 var Default2000Date = time.Unix(946656001, 0)
 
@@ -59,6 +98,51 @@ func (m *PmDyPeriodOrderTable) TableName() string {
 	return PmDyPeriodOrderTableName
 }
 
+// NextDeductionTime from之后一期的扣款时间，按月和按年时以签约日(有试用期的为试用期结束日)为扣款日，当月没有这一天时取月末，如1月31日签约的扣款日依次为2月28日、3月31日
+func (m *PmDyPeriodOrderTable) NextDeductionTime(from time.Time) time.Time {
+	anchorDay := from.Day()
+	if m.SignDate.After(Default2000Date) {
+		anchorDay = m.SignDate.AddDate(0, 0, m.TrialDays).Day()
+	}
+	return AddDyPeriod(from, m.PeriodUnit, m.PeriodCount, anchorDay)
+}
+
+// FirstDeductionTime 签约单签约或首期扣款成功后的下次扣款时间，有试用期的在试用期结束时，代扣单和已扣过款的签约单顺延一期
+func (m *PmDyPeriodOrderTable) FirstDeductionTime(from time.Time) time.Time {
+	if m.TrialDays > 0 && m.SignOrderId == 0 && m.NthNum == 0 {
+		return from.AddDate(0, 0, m.TrialDays)
+	}
+	return m.NextDeductionTime(from)
+}
+
+// AddDyPeriod 时间加上count个周期单位，unit为空时按月，count小于1时按1；按月和按年时取anchorDay，目标月份没有这一天时取月末
+func AddDyPeriod(t time.Time, unit string, count int, anchorDay int) time.Time {
+	if count < 1 {
+		count = 1
+	}
+
+	months := count
+	switch unit {
+	case Dy_Period_Unit_Day:
+		return t.AddDate(0, 0, count)
+	case Dy_Period_Unit_Week:
+		return t.AddDate(0, 0, 7*count)
+	case Dy_Period_Unit_Year:
+		months = 12 * count
+	}
+
+	// 先定位到目标月份的1号，再取扣款日，避免time.AddDate把1月31日加1个月算成3月3日
+	firstDay := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+	lastDay := firstDay.AddDate(0, 1, -1).Day()
+	if anchorDay < 1 {
+		anchorDay = t.Day()
+	}
+	if anchorDay > lastDay {
+		anchorDay = lastDay
+	}
+	return firstDay.AddDate(0, 0, anchorDay-1)
+}
+
 type PmDyPeriodOrderModel struct {
 	DB *gorm.DB
 }
@@ -78,6 +162,17 @@ func (o *PmDyPeriodOrderModel) Create(info *PmDyPeriodOrderTable) error {
 	return err
 }
 
+// 周期代扣任务创建代扣单，只有当前leader能写入
+func (o *PmDyPeriodOrderModel) CreateDeductOrder(info *PmDyPeriodOrderTable, fence *LeaderFence) error {
+	err := withLeaderFence(o.DB, fence, func(tx *gorm.DB) error {
+		return tx.Create(info).Error
+	})
+	if err != nil {
+		logx.Errorf("创建代扣单失败 err: %v", err)
+	}
+	return err
+}
+
 // 根据内部订单号和包名获取订单信息
 func (o *PmDyPeriodOrderModel) GetOneByOrderSnAndPkg(orderSn, pkg string) (*PmDyPeriodOrderTable, error) {
 	orderInfo := new(PmDyPeriodOrderTable)
@@ -161,13 +256,17 @@ func (o *PmDyPeriodOrderModel) UpdateSomeData(id int, updateData map[string]inte
 	return err
 }
 
-// 获取今日可以扣款的签约订单信息
-func (o *PmDyPeriodOrderModel) GetSignedPayList() ([]PmDyPeriodOrderTable, error) {
+// 获取今日由业务方扣款的签约单，排除由网关扣款的包名和网关已扣过款的签约单（nth_num>0）
+func (o *PmDyPeriodOrderModel) GetSignedPayList(gatewayPkgs []string) ([]PmDyPeriodOrderTable, error) {
 	var info []PmDyPeriodOrderTable
 	startAt := time.Now().Format("2006-01-02")
 	endAt := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
 
-	err := o.DB.Table(PmDyPeriodOrderTableName).Where("`sign_status` = 1 and `next_decuction_time` >= ? and `next_decuction_time` < ?", startAt, endAt).Find(&info).Error
+	query := o.DB.Table(PmDyPeriodOrderTableName).Where("`sign_status` = 1 and `sign_order_id` = 0 and `nth_num` = 0 and `next_decuction_time` >= ? and `next_decuction_time` < ?", startAt, endAt)
+	if len(gatewayPkgs) > 0 {
+		query = query.Where("`app_pkg_name` not in (?)", gatewayPkgs)
+	}
+	err := query.Find(&info).Error
 	return info, err
 }
 
@@ -176,3 +275,171 @@ func (o *PmDyPeriodOrderModel) GetById(id int64) (PmDyPeriodOrderTable, error) {
 	err := o.DB.Table(PmDyPeriodOrderTableName).Where("id = ?", id).First(&info).Error
 	return info, err
 }
+
+// 按id游标分批获取时间段内创建的未扣款订单，用于补单
+func (o *PmDyPeriodOrderModel) GetUnpaidBatchByCreateTimeRange(startTime, endTime time.Time, lastId int, limit int) ([]*PmDyPeriodOrderTable, error) {
+	var list []*PmDyPeriodOrderTable
+	err := o.DB.Table(PmDyPeriodOrderTableName).Where("`created_at` >= ? and `created_at` <= ? and `pay_status` = 0 and `sign_status` in (?) and `id` > ?", startTime, endTime, []int{Sign_Status_Wait, Sign_Status_Success}, lastId).
+		Order("id asc").
+		Limit(limit).
+		Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetUnpaidBatchByCreateTimeRange 获取未扣款订单失败 err:%v", err)
+	}
+	return list, err
+}
+
+// QueryAfterUpdate 主动查询到扣款成功后修改订单，只更新未支付的订单，返回是否由本次更新
+func (o *PmDyPeriodOrderModel) QueryAfterUpdate(id int, updateData map[string]interface{}) (bool, error) {
+	result := o.DB.Table(PmDyPeriodOrderTableName).Where("`id` = ? and `pay_status` = 0", id).Updates(updateData)
+	if result.Error != nil {
+		err := fmt.Errorf("QueryAfterUpdate Err: %v", result.Error)
+		util.CheckError(err.Error())
+		return false, err
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 按id游标分批获取到期需要网关扣款的签约单，扣款失败过的需到达重试时间
+func (o *PmDyPeriodOrderModel) GetDueSignedBatch(pkgNames []string, now time.Time, lastId int, limit int) ([]*PmDyPeriodOrderTable, error) {
+	var list []*PmDyPeriodOrderTable
+	err := o.DB.Table(PmDyPeriodOrderTableName).Where("`sign_status` = ? and `sign_order_id` = 0 and `deduct_status` = ? and `app_pkg_name` in (?) and `next_decuction_time` <= ? and `deduct_retry_time` <= ? and `id` > ?",
+		Sign_Status_Success, Dy_Deduct_Status_Normal, pkgNames, now, now, lastId).
+		Order("id asc").
+		Limit(limit).
+		Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetDueSignedBatch 获取到期签约单失败 err:%v", err)
+	}
+	return list, err
+}
+
+// 获取签约单某一期最近一次网关发起的代扣单
+func (o *PmDyPeriodOrderModel) GetLastDeductOrder(signOrderId, nthNum int) (*PmDyPeriodOrderTable, error) {
+	orderInfo := new(PmDyPeriodOrderTable)
+	err := o.DB.Table(PmDyPeriodOrderTableName).Where("`sign_order_id` = ? and `nth_num` = ?", signOrderId, nthNum).Last(orderInfo).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logx.Errorf("GetLastDeductOrder 获取代扣单失败 err:%v, signOrderId:%d, nthNum:%d", err, signOrderId, nthNum)
+	}
+	return orderInfo, err
+}
+
+// 获取抖音签约单下已扣款成功的最大期数，用于从业务方扣款切换到网关扣款时确定下一期
+func (o *PmDyPeriodOrderModel) GetMaxPaidNthNum(thirdSignOrderNo, appId string) (int, error) {
+	var nthNum int
+	err := o.DB.Table(PmDyPeriodOrderTableName).Select("ifnull(max(`nth_num`), 0)").
+		Where("`third_sign_order_no` = ? and `pay_app_id` = ? and `pay_status` = ?", thirdSignOrderNo, appId, Dy_Pay_Status_Paid).
+		Scan(&nthNum).Error
+	if err != nil {
+		logx.Errorf("GetMaxPaidNthNum 获取已扣款期数失败 err:%v, thirdSignOrderNo:%s", err, thirdSignOrderNo)
+	}
+	return nthNum, err
+}
+
+// 签约单扣款成功，记录已扣款期数和下次扣款时间并清除失败记录，只更新期数小于nthNum的，返回是否由本次更新
+func (o *PmDyPeriodOrderModel) AdvanceSignedOrder(id, nthNum int, nextDeductTime time.Time) (bool, error) {
+	result := o.DB.Table(PmDyPeriodOrderTableName).Where("`id` = ? and `nth_num` < ?", id, nthNum).Updates(map[string]interface{}{
+		"nth_num":             nthNum,
+		"next_decuction_time": nextDeductTime.Format("2006-01-02 15:04:05"),
+		"deduct_attempts":     0,
+		"deduct_err_code":     "",
+		"deduct_retry_time":   Default2000Date.Format("2006-01-02 15:04:05"),
+	})
+	if result.Error != nil {
+		err := fmt.Errorf("AdvanceSignedOrder Err: %v", result.Error)
+		util.CheckError(err.Error())
+		return false, err
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 签约单扣款失败，记录失败次数、错误码和下次重试时间，lapsed为true时停止代扣
+func (o *PmDyPeriodOrderModel) UpdateDeductRetry(id, attempts int, errCode string, retryTime time.Time, lapsed bool) error {
+	updateData := map[string]interface{}{
+		"deduct_attempts":   attempts,
+		"deduct_err_code":   errCode,
+		"deduct_retry_time": retryTime.Format("2006-01-02 15:04:05"),
+	}
+	if lapsed {
+		updateData["deduct_status"] = Dy_Deduct_Status_Lapsed
+	}
+	err := o.DB.Table(PmDyPeriodOrderTableName).Where("`id` = ?", id).Updates(updateData).Error
+	if err != nil {
+		err = fmt.Errorf("UpdateDeductRetry Err: %v", err)
+		util.CheckError(err.Error())
+	}
+	return err
+}
+
+// 代扣单扣款失败，只更新等待结果的代扣单，返回是否由本次更新
+func (o *PmDyPeriodOrderModel) MarkDeductFail(id int) (bool, error) {
+	result := o.DB.Table(PmDyPeriodOrderTableName).Where("`id` = ? and `pay_status` = ?", id, Dy_Pay_Status_Wait).Update("pay_status", Dy_Pay_Status_Fail)
+	if result.Error != nil {
+		err := fmt.Errorf("MarkDeductFail Err: %v", result.Error)
+		util.CheckError(err.Error())
+		return false, err
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 按id游标分批获取已签约的签约单，用于签约状态对账
+func (o *PmDyPeriodOrderModel) GetSignedBatch(payAppId string, lastId int, limit int) ([]*PmDyPeriodOrderTable, error) {
+	var list []*PmDyPeriodOrderTable
+	query := o.DB.Table(PmDyPeriodOrderTableName).Where("`sign_status` = ? and `sign_order_id` = 0 and `id` > ?", Sign_Status_Success, lastId)
+	if payAppId != "" {
+		query = query.Where("`pay_app_id` = ?", payAppId)
+	}
+	err := query.Order("id asc").Limit(limit).Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetSignedBatch 获取已签约订单失败 err:%v", err)
+	}
+	return list, err
+}
+
+// 已签约的签约单更新为解约或到期，只更新仍是已签约状态的，返回是否由本次更新
+func (o *PmDyPeriodOrderModel) UpdateSignedStatus(id int, updateData map[string]interface{}) (bool, error) {
+	result := o.DB.Table(PmDyPeriodOrderTableName).Where("`id` = ? and `sign_status` = ?", id, Sign_Status_Success).Updates(updateData)
+	if result.Error != nil {
+		err := fmt.Errorf("UpdateSignedStatus Err: %v", result.Error)
+		util.CheckError(err.Error())
+		return false, err
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 按id游标分批获取下次扣款时间在[startTime, endTime)内的签约单，用于扣款前提醒，扣款失败重试中的不提醒
+func (o *PmDyPeriodOrderModel) GetUpcomingSignedBatch(startTime, endTime time.Time, lastId int, limit int) ([]*PmDyPeriodOrderTable, error) {
+	var list []*PmDyPeriodOrderTable
+	err := o.DB.Table(PmDyPeriodOrderTableName).Where("`sign_status` = ? and `sign_order_id` = 0 and `deduct_status` = ? and `deduct_attempts` = 0 and `next_decuction_time` >= ? and `next_decuction_time` < ? and `id` > ?",
+		Sign_Status_Success, Dy_Deduct_Status_Normal, startTime, endTime, lastId).
+		Order("id asc").
+		Limit(limit).
+		Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetUpcomingSignedBatch 获取待扣款签约单失败 err:%v", err)
+	}
+	return list, err
+}
+
+// 签约单记录已提醒的扣款日期，同一扣款日只提醒一次，返回是否由本次更新
+func (o *PmDyPeriodOrderModel) MarkDeductRemind(id int, deductDate time.Time) (bool, error) {
+	deductDateStr := deductDate.Format("2006-01-02 15:04:05")
+	result := o.DB.Table(PmDyPeriodOrderTableName).Where("`id` = ? and `remind_deduct_time` != ?", id, deductDateStr).Update("remind_deduct_time", deductDateStr)
+	if result.Error != nil {
+		err := fmt.Errorf("MarkDeductRemind Err: %v", result.Error)
+		util.CheckError(err.Error())
+		return false, err
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// 扣款提醒回调失败时撤销已提醒的标记，下次执行时重新提醒
+func (o *PmDyPeriodOrderModel) ResetDeductRemind(id int, deductDate time.Time) error {
+	err := o.DB.Table(PmDyPeriodOrderTableName).Where("`id` = ? and `remind_deduct_time` = ?", id, deductDate.Format("2006-01-02 15:04:05")).
+		Update("remind_deduct_time", Default2000Date.Format("2006-01-02 15:04:05")).Error
+	if err != nil {
+		err = fmt.Errorf("ResetDeductRemind Err: %v", err)
+		util.CheckError(err.Error())
+	}
+	return err
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAddDyPeriod(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 10, 30, 0, 0, time.Local)
+	}
+	tests := []struct {
+		name      string
+		t         time.Time
+		unit      string
+		count     int
+		anchorDay int
+		want      time.Time
+	}{
+		{name: "按天", t: date(2024, 1, 31), unit: Dy_Period_Unit_Day, count: 3, want: date(2024, 2, 3)},
+		{name: "按周", t: date(2024, 12, 30), unit: Dy_Period_Unit_Week, count: 1, want: date(2025, 1, 6)},
+		{name: "按月", t: date(2024, 1, 15), unit: Dy_Period_Unit_Month, count: 1, anchorDay: 15, want: date(2024, 2, 15)},
+		{name: "单位为空按月", t: date(2024, 1, 15), unit: "", count: 1, anchorDay: 15, want: date(2024, 2, 15)},
+		{name: "count小于1按1", t: date(2024, 1, 15), unit: Dy_Period_Unit_Month, count: 0, anchorDay: 15, want: date(2024, 2, 15)},
+		{name: "1月31日到闰年2月取月末", t: date(2024, 1, 31), unit: Dy_Period_Unit_Month, count: 1, anchorDay: 31, want: date(2024, 2, 29)},
+		{name: "1月31日到平年2月取月末", t: date(2023, 1, 31), unit: Dy_Period_Unit_Month, count: 1, anchorDay: 31, want: date(2023, 2, 28)},
+		{name: "月末后回到扣款日", t: date(2024, 2, 29), unit: Dy_Period_Unit_Month, count: 1, anchorDay: 31, want: date(2024, 3, 31)},
+		{name: "30日到31天的月份不顺延", t: date(2024, 4, 30), unit: Dy_Period_Unit_Month, count: 1, anchorDay: 30, want: date(2024, 5, 30)},
+		{name: "跨年", t: date(2024, 12, 31), unit: Dy_Period_Unit_Month, count: 2, anchorDay: 31, want: date(2025, 2, 28)},
+		{name: "多个月", t: date(2024, 8, 31), unit: Dy_Period_Unit_Month, count: 3, anchorDay: 31, want: date(2024, 11, 30)},
+		{name: "按年闰日", t: date(2024, 2, 29), unit: Dy_Period_Unit_Year, count: 1, anchorDay: 29, want: date(2025, 2, 28)},
+		{name: "按年闰日到闰年", t: date(2024, 2, 29), unit: Dy_Period_Unit_Year, count: 4, anchorDay: 29, want: date(2028, 2, 29)},
+		{name: "未传扣款日取当前日", t: date(2024, 3, 31), unit: Dy_Period_Unit_Month, count: 1, anchorDay: 0, want: date(2024, 4, 30)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AddDyPeriod(tt.t, tt.unit, tt.count, tt.anchorDay); !got.Equal(tt.want) {
+				t.Errorf("AddDyPeriod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPmDyPeriodOrderTable_NextDeductionTime(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+	}
+	tests := []struct {
+		name  string
+		order PmDyPeriodOrderTable
+		from  time.Time
+		want  time.Time
+	}{
+		{
+			name:  "31日签约按签约日扣款",
+			order: PmDyPeriodOrderTable{SignDate: date(2024, 1, 31), PeriodUnit: Dy_Period_Unit_Month, PeriodCount: 1},
+			from:  date(2024, 2, 29),
+			want:  date(2024, 3, 31),
+		},
+		{
+			name:  "试用期结束日为扣款日",
+			order: PmDyPeriodOrderTable{SignDate: date(2024, 1, 24), TrialDays: 7, PeriodUnit: Dy_Period_Unit_Month, PeriodCount: 1},
+			from:  date(2024, 1, 31),
+			want:  date(2024, 2, 29),
+		},
+		{
+			name:  "未签约时取from的日期",
+			order: PmDyPeriodOrderTable{SignDate: Default2000Date, PeriodUnit: Dy_Period_Unit_Month, PeriodCount: 1},
+			from:  date(2024, 1, 31),
+			want:  date(2024, 2, 29),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.order.NextDeductionTime(tt.from); !got.Equal(tt.want) {
+				t.Errorf("NextDeductionTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gorm.io/gorm"
+)
+
+var (
+	getSubscribeRemindErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "getSubscribeRemindErr", nil, "获取续费提醒配置失败", nil})}
+)
+
+// 未配置的包名不提醒，需业务方接入提醒回调后按包名配置；最多提前7天
+const (
+	SubscribeRemindDefaultDays = 0
+	SubscribeRemindMaxDays     = 7
+)
+
+// 续费扣款前提醒配置表，按包名配置
+type PmSubscribeRemindConfigTable struct {
+	ID         int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	AppPkgName string    `gorm:"column:app_pkg_name;NOT NULL" json:"app_pkg_name"`                       // 应用包名
+	RemindDays int       `gorm:"column:remind_days;default:3;NOT NULL" json:"remind_days"`               // 扣款前几天回调提醒，0不提醒
+	Status     int       `gorm:"column:status;default:1;NOT NULL" json:"status"`                         // 0停用 1启用
+	CreatedAt  time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"` // 创建时间
+	UpdatedAt  time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"updated_at"` // 更新时间
+}
+
+func (m *PmSubscribeRemindConfigTable) TableName() string {
+	return "pm_subscribe_remind_config"
+}
+
+// 提前提醒的天数，超过上限的按上限
+func (m *PmSubscribeRemindConfigTable) Days() int {
+	if m.RemindDays <= 0 {
+		return 0
+	}
+	if m.RemindDays > SubscribeRemindMaxDays {
+		return SubscribeRemindMaxDays
+	}
+	return m.RemindDays
+}
+
+type PmSubscribeRemindConfigModel struct {
+	DB  *gorm.DB
+	RDB *cache.RedisInstance
+}
+
+func NewPmSubscribeRemindConfigModel(dbName string) *PmSubscribeRemindConfigModel {
+	return &PmSubscribeRemindConfigModel{
+		DB:  db.WithDBContext(dbName),
+		RDB: db.WithRedisDBContext(dbName),
+	}
+}
+
+// 获取包名对应的提醒配置，未配置时返回默认配置（不提醒）
+const pm_subscribe_remind_config_cache_key = "pm:subscribe:remind:config:%s" // %s是包名
+func (o *PmSubscribeRemindConfigModel) GetByPkgName(pkgName string) (*PmSubscribeRemindConfigTable, error) {
+	var cfg PmSubscribeRemindConfigTable
+
+	rkey := o.RDB.GetRedisKey(pm_subscribe_remind_config_cache_key, pkgName)
+	err := o.RDB.GetObject(context.Background(), rkey, &cfg)
+	if err == nil && cfg.AppPkgName != "" {
+		return &cfg, nil
+	}
+
+	err = o.DB.Where("`app_pkg_name` = ? and `status` = 1", pkgName).First(&cfg).Error
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			logx.Errorf("获取续费提醒配置失败，err:=%v,pkg=%s", err, pkgName)
+			getSubscribeRemindErr.CounterInc()
+			return nil, err
+		}
+		cfg = PmSubscribeRemindConfigTable{
+			AppPkgName: pkgName,
+			RemindDays: SubscribeRemindDefaultDays,
+		}
+	}
+
+	// 设置缓存时间为3分钟
+	o.RDB.Set(context.Background(), rkey, cfg, 180)
+	return &cfg, nil
+}
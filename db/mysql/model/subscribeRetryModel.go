@@ -0,0 +1,110 @@
+package model
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/cache"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gorm.io/gorm"
+)
+
+var (
+	getSubscribeRetryErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "getSubscribeRetryErr", nil, "获取续费重试配置失败", nil})}
+)
+
+// 未配置时的默认重试计划：首次扣款失败后第1、3、7天的11点重试
+const (
+	SubscribeRetryDefaultDays  = "1,3,7"
+	SubscribeRetryDefaultHours = "11,11,11"
+)
+
+// 续费扣款失败重试配置表，按包名配置
+type PmSubscribeRetryConfigTable struct {
+	ID         int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	AppPkgName string    `gorm:"column:app_pkg_name;NOT NULL" json:"app_pkg_name"`                       // 应用包名
+	RetryDays  string    `gorm:"column:retry_days;NOT NULL" json:"retry_days"`                           // 首次扣款失败后第几天重试，逗号分隔，如1,3,7
+	RetryHours string    `gorm:"column:retry_hours;NOT NULL" json:"retry_hours"`                         // 每次重试的整点，与retry_days一一对应，如11,15,20
+	Status     int       `gorm:"column:status;default:1;NOT NULL" json:"status"`                         // 0停用 1启用
+	CreatedAt  time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"` // 创建时间
+	UpdatedAt  time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"updated_at"` // 更新时间
+}
+
+func (m *PmSubscribeRetryConfigTable) TableName() string {
+	return "pm_subscribe_retry_config"
+}
+
+// 续费重试的一次计划
+type SubscribeRetryStep struct {
+	Day  int // 首次扣款失败后第几天
+	Hour int // 重试的整点
+}
+
+// 解析重试计划，格式错误的项忽略，小时缺省时取11点
+func (m *PmSubscribeRetryConfigTable) Steps() []SubscribeRetryStep {
+	days := strings.Split(m.RetryDays, ",")
+	hours := strings.Split(m.RetryHours, ",")
+	steps := make([]SubscribeRetryStep, 0, len(days))
+	lastDay := 0
+	for i, v := range days {
+		day, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil || day <= lastDay {
+			continue
+		}
+		hour := 11
+		if i < len(hours) {
+			if h, err := strconv.Atoi(strings.TrimSpace(hours[i])); err == nil && h >= 0 && h < 24 {
+				hour = h
+			}
+		}
+		steps = append(steps, SubscribeRetryStep{Day: day, Hour: hour})
+		lastDay = day
+	}
+	return steps
+}
+
+type PmSubscribeRetryConfigModel struct {
+	DB  *gorm.DB
+	RDB *cache.RedisInstance
+}
+
+func NewPmSubscribeRetryConfigModel(dbName string) *PmSubscribeRetryConfigModel {
+	return &PmSubscribeRetryConfigModel{
+		DB:  db.WithDBContext(dbName),
+		RDB: db.WithRedisDBContext(dbName),
+	}
+}
+
+// 获取包名对应的重试配置，未配置时返回默认配置
+const pm_subscribe_retry_config_cache_key = "pm:subscribe:retry:config:%s" // %s是包名
+func (o *PmSubscribeRetryConfigModel) GetByPkgName(pkgName string) (*PmSubscribeRetryConfigTable, error) {
+	var cfg PmSubscribeRetryConfigTable
+
+	rkey := o.RDB.GetRedisKey(pm_subscribe_retry_config_cache_key, pkgName)
+	err := o.RDB.GetObject(context.Background(), rkey, &cfg)
+	if err == nil && cfg.AppPkgName != "" {
+		return &cfg, nil
+	}
+
+	err = o.DB.Where("`app_pkg_name` = ? and `status` = 1", pkgName).First(&cfg).Error
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			logx.Errorf("获取续费重试配置失败，err:=%v,pkg=%s", err, pkgName)
+			getSubscribeRetryErr.CounterInc()
+			return nil, err
+		}
+		cfg = PmSubscribeRetryConfigTable{
+			AppPkgName: pkgName,
+			RetryDays:  SubscribeRetryDefaultDays,
+			RetryHours: SubscribeRetryDefaultHours,
+		}
+	}
+
+	// 设置缓存时间为3分钟
+	o.RDB.Set(context.Background(), rkey, cfg, 180)
+	return &cfg, nil
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPmSubscribeRetryConfigTable_Steps(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryDays  string
+		retryHours string
+		want       []SubscribeRetryStep
+	}{
+		{
+			name:       "默认计划",
+			retryDays:  SubscribeRetryDefaultDays,
+			retryHours: SubscribeRetryDefaultHours,
+			want:       []SubscribeRetryStep{{Day: 1, Hour: 11}, {Day: 3, Hour: 11}, {Day: 7, Hour: 11}},
+		},
+		{
+			name:       "每次不同整点",
+			retryDays:  "1,2,5",
+			retryHours: "9,15,20",
+			want:       []SubscribeRetryStep{{Day: 1, Hour: 9}, {Day: 2, Hour: 15}, {Day: 5, Hour: 20}},
+		},
+		{
+			name:       "带空格",
+			retryDays:  " 1, 3 ",
+			retryHours: " 8 , 18",
+			want:       []SubscribeRetryStep{{Day: 1, Hour: 8}, {Day: 3, Hour: 18}},
+		},
+		{
+			name:       "小时缺省取11点",
+			retryDays:  "1,3,7",
+			retryHours: "9",
+			want:       []SubscribeRetryStep{{Day: 1, Hour: 9}, {Day: 3, Hour: 11}, {Day: 7, Hour: 11}},
+		},
+		{
+			name:       "小时超出范围取11点",
+			retryDays:  "1,3",
+			retryHours: "24,-1",
+			want:       []SubscribeRetryStep{{Day: 1, Hour: 11}, {Day: 3, Hour: 11}},
+		},
+		{
+			name:       "天数不递增的项忽略",
+			retryDays:  "3,1,3,5",
+			retryHours: "10,11,12,13",
+			want:       []SubscribeRetryStep{{Day: 3, Hour: 10}, {Day: 5, Hour: 13}},
+		},
+		{
+			name:       "格式错误和非正数的项忽略",
+			retryDays:  "0,a,2,,4",
+			retryHours: "10,11,12,13,14",
+			want:       []SubscribeRetryStep{{Day: 2, Hour: 12}, {Day: 4, Hour: 14}},
+		},
+		{
+			name:       "空配置不重试",
+			retryDays:  "",
+			retryHours: "",
+			want:       []SubscribeRetryStep{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &PmSubscribeRetryConfigTable{RetryDays: tt.retryDays, RetryHours: tt.retryHours}
+			if got := m.Steps(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Steps() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,441 @@
+package model
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/types"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gorm.io/gorm"
+)
+
+// 订阅渠道
+const (
+	Subscription_Channel_Alipay = "alipay" // 支付宝周期扣款协议，数据在order表
+	Subscription_Channel_Douyin = "douyin" // 抖音周期代扣签约，数据在pm_dy_period_order表
+	Subscription_Channel_Huawei = "huawei" // 华为订阅，数据在huawei_order表
+)
+
+// 统一订阅状态
+const (
+	Subscription_Status_Active   = 1 // 生效中，到期自动续费
+	Subscription_Status_Canceled = 2 // 已解约或已取消自动续费
+	Subscription_Status_Expired  = 3 // 已失效：续费扣款失败、签约到期或已退款
+)
+
+// UserSubscription 用户订阅的统一视图，由各渠道的订单表实时汇总，不单独落表
+type UserSubscription struct {
+	Channel        string    `json:"channel"`          // 订阅渠道
+	SubscriptionNo string    `json:"subscription_no"`  // 订阅标识：支付宝为内部协议号，抖音为内部签约单号，华为为订阅id
+	AppPkg         string    `json:"app_pkg"`          // 包名
+	UserId         int       `json:"user_id"`          // 用户id
+	OutTradeNo     string    `json:"out_trade_no"`     // 签约(首期)订单号
+	Status         int       `json:"status"`           // 统一订阅状态
+	Amount         int       `json:"amount"`           // 每期扣款金额（分）
+	PeriodUnit     string    `json:"period_unit"`      // 扣款周期单位 day|week|month|year，华为由商品配置决定，为空
+	PeriodCount    int       `json:"period_count"`     // 每期包含的周期单位数
+	SignTime       time.Time `json:"sign_time"`        // 签约(首次购买)时间
+	NextChargeTime time.Time `json:"next_charge_time"` // 下次扣款时间，非生效中的为零值
+}
+
+// SubscriptionCharge 订阅的一次扣款记录
+type SubscriptionCharge struct {
+	Channel        string    `json:"channel"`         // 订阅渠道
+	SubscriptionNo string    `json:"subscription_no"` // 所属订阅标识
+	OutTradeNo     string    `json:"out_trade_no"`    // 内部订单号
+	Amount         int       `json:"amount"`          // 金额（分）
+	Status         int       `json:"status"`          // 订单状态，同code.ORDER_*
+	ChargeTime     time.Time `json:"charge_time"`     // 扣款时间，未扣款的为创建时间
+}
+
+type SubscriptionModel struct {
+	DB *gorm.DB
+}
+
+func NewSubscriptionModel(dbName string) *SubscriptionModel {
+	return &SubscriptionModel{
+		DB: db.WithDBContext(dbName),
+	}
+}
+
+// GetUserSubscriptions 获取用户在包名下各渠道的订阅，按签约时间倒序
+func (o *SubscriptionModel) GetUserSubscriptions(userId int, pkg string) ([]*UserSubscription, error) {
+	var list []*UserSubscription
+
+	alipayList, err := o.getAlipaySubscriptions(userId, pkg, "")
+	if err != nil {
+		return nil, err
+	}
+	list = append(list, alipayList...)
+
+	douyinList, err := o.getDouyinSubscriptions(userId, pkg, "")
+	if err != nil {
+		return nil, err
+	}
+	list = append(list, douyinList...)
+
+	huaweiList, err := o.getHuaweiSubscriptions(userId, pkg, "")
+	if err != nil {
+		return nil, err
+	}
+	list = append(list, huaweiList...)
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].SignTime.After(list[j].SignTime)
+	})
+	return list, nil
+}
+
+// GetUserSubscription 获取用户的某个订阅，不存在时返回gorm.ErrRecordNotFound
+func (o *SubscriptionModel) GetUserSubscription(userId int, pkg, channel, subscriptionNo string) (*UserSubscription, error) {
+	var list []*UserSubscription
+	var err error
+	switch channel {
+	case Subscription_Channel_Alipay:
+		list, err = o.getAlipaySubscriptions(userId, pkg, subscriptionNo)
+	case Subscription_Channel_Douyin:
+		list, err = o.getDouyinSubscriptions(userId, pkg, subscriptionNo)
+	case Subscription_Channel_Huawei:
+		list, err = o.getHuaweiSubscriptions(userId, pkg, subscriptionNo)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return list[0], nil
+}
+
+// GetSubscriptionHistory 获取用户订阅的扣款记录，按扣款时间倒序，channel和subscriptionNo为空时不过滤
+//
+// 华为续费不单独落订单，每个订阅只有首次购买一条记录
+func (o *SubscriptionModel) GetSubscriptionHistory(userId int, pkg, channel, subscriptionNo string) ([]*SubscriptionCharge, error) {
+	var list []*SubscriptionCharge
+
+	if channel == "" || channel == Subscription_Channel_Alipay {
+		charges, err := o.getAlipayCharges(userId, pkg, subscriptionNo)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, charges...)
+	}
+
+	if channel == "" || channel == Subscription_Channel_Douyin {
+		charges, err := o.getDouyinCharges(userId, pkg, subscriptionNo)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, charges...)
+	}
+
+	if channel == "" || channel == Subscription_Channel_Huawei {
+		charges, err := o.getHuaweiCharges(userId, pkg, subscriptionNo)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, charges...)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].ChargeTime.After(list[j].ChargeTime)
+	})
+	return list, nil
+}
+
+// 已签约的支付宝签约订单，协议号由签约通知写入
+func (o *SubscriptionModel) getAlipaySignOrders(userId int, pkg, externalAgreementNo string) ([]*OrderTable, error) {
+	var signOrders []*OrderTable
+	query := o.DB.Table("order").Where("`user_id` = ? and `app_pkg` = ? and `product_type` = ? and `external_agreement_no` != '' and `agreement_no` != ''",
+		userId, pkg, code.PRODUCT_TYPE_SUBSCRIBE)
+	if externalAgreementNo != "" {
+		query = query.Where("`external_agreement_no` = ?", externalAgreementNo)
+	}
+	err := query.Order("id desc").Find(&signOrders).Error
+	if err != nil {
+		logx.Errorf("getAlipaySignOrders 获取签约订单失败 err:%v, userId:%d, pkg:%s", err, userId, pkg)
+		getOrderErr.CounterInc()
+	}
+	return signOrders, err
+}
+
+// 支付宝：协议状态以签约订单的协议状态和最近一笔续费订单为准
+func (o *SubscriptionModel) getAlipaySubscriptions(userId int, pkg, externalAgreementNo string) ([]*UserSubscription, error) {
+	signOrders, err := o.getAlipaySignOrders(userId, pkg, externalAgreementNo)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*UserSubscription
+	for _, signOrder := range signOrders {
+		sub := &UserSubscription{
+			Channel:        Subscription_Channel_Alipay,
+			SubscriptionNo: signOrder.ExternalAgreementNo,
+			AppPkg:         signOrder.AppPkg,
+			UserId:         signOrder.UserID,
+			OutTradeNo:     signOrder.OutTradeNo,
+			Status:         Subscription_Status_Active,
+			Amount:         signOrder.Amount,
+			SignTime:       signOrder.PayTime,
+		}
+		// 每期金额和扣款周期在商品信息里
+		product := types.Product{}
+		if json.Unmarshal([]byte(signOrder.ProductDesc), &product) == nil {
+			sub.Amount = int(math.Round(product.Amount * 100))
+			if schedule, err := product.SubscribeSchedule(signOrder.PayTime); err == nil {
+				sub.PeriodUnit = Dy_Period_Unit_Day
+				if schedule.PeriodType == types.PeriodTypeMonth {
+					sub.PeriodUnit = Dy_Period_Unit_Month
+				}
+				sub.PeriodCount = schedule.Period
+			}
+		}
+
+		lastFee := new(OrderTable)
+		err = o.DB.Table("order").Where("`external_agreement_no` = ? and `product_type` = ?", signOrder.ExternalAgreementNo, code.PRODUCT_TYPE_SUBSCRIBE_FEE).
+			Order("id desc").Limit(1).Find(lastFee).Error
+		if err != nil {
+			logx.Errorf("getAlipaySubscriptions 获取续费订单失败 err:%v, external_agreement_no:%s", err, signOrder.ExternalAgreementNo)
+			getOrderErr.CounterInc()
+			return nil, err
+		}
+
+		switch {
+		case signOrder.AgreementStatus == Agreement_Status_Unsign:
+			sub.Status = Subscription_Status_Canceled
+		case lastFee.ID > 0 && lastFee.Status == code.ORDER_CLOSE && lastFee.DeductErrCode != "":
+			// 续费重试全部失败
+			sub.Status = Subscription_Status_Expired
+		case lastFee.ID > 0 && lastFee.Status == code.ORDER_CLOSE:
+			// 解约时关闭了待扣款的续费订单
+			sub.Status = Subscription_Status_Canceled
+		case lastFee.ID > 0 && lastFee.Status == code.ORDER_NO_PAY:
+			sub.Amount = lastFee.Amount
+			sub.NextChargeTime = lastFee.CreatedAt
+			if lastFee.DeductTime.After(sub.NextChargeTime) {
+				sub.NextChargeTime = lastFee.DeductTime
+			}
+		case lastFee.ID == 0 && signOrder.DeductTime.After(Default2000Date):
+			// 还没有续费订单，签约时确定的首次续费扣款日期
+			sub.NextChargeTime = signOrder.DeductTime
+		case sub.PeriodCount > 0:
+			// 没有待扣款的续费订单，按最近一次扣款时间推算
+			lastPayTime := signOrder.PayTime
+			if lastFee.ID > 0 && lastFee.Status == code.ORDER_SUCCESS {
+				lastPayTime = lastFee.PayTime
+			}
+			sub.NextChargeTime = AddDyPeriod(lastPayTime, sub.PeriodUnit, sub.PeriodCount, lastPayTime.Day())
+		}
+		list = append(list, sub)
+	}
+	return list, nil
+}
+
+// 支付宝：签约订单和续费订单
+func (o *SubscriptionModel) getAlipayCharges(userId int, pkg, externalAgreementNo string) ([]*SubscriptionCharge, error) {
+	var orders []*OrderTable
+	query := o.DB.Table("order").Where("`user_id` = ? and `app_pkg` = ? and `product_type` in (?) and `external_agreement_no` != ''",
+		userId, pkg, []int{code.PRODUCT_TYPE_SUBSCRIBE, code.PRODUCT_TYPE_SUBSCRIBE_FEE})
+	if externalAgreementNo != "" {
+		query = query.Where("`external_agreement_no` = ?", externalAgreementNo)
+	}
+	err := query.Order("id desc").Find(&orders).Error
+	if err != nil {
+		logx.Errorf("getAlipayCharges 获取订阅订单失败 err:%v, userId:%d, pkg:%s", err, userId, pkg)
+		getOrderErr.CounterInc()
+		return nil, err
+	}
+
+	var list []*SubscriptionCharge
+	for _, order := range orders {
+		chargeTime := order.CreatedAt
+		if order.PayTime.After(Default2000Date) {
+			chargeTime = order.PayTime
+		}
+		list = append(list, &SubscriptionCharge{
+			Channel:        Subscription_Channel_Alipay,
+			SubscriptionNo: order.ExternalAgreementNo,
+			OutTradeNo:     order.OutTradeNo,
+			Amount:         order.Amount,
+			Status:         order.Status,
+			ChargeTime:     chargeTime,
+		})
+	}
+	return list, nil
+}
+
+// 抖音签约过的签约单，网关和业务方发起的代扣单都不是签约单
+func (o *SubscriptionModel) getDouyinContracts(userId int, pkg, signNo string) ([]*PmDyPeriodOrderTable, error) {
+	var contracts []*PmDyPeriodOrderTable
+	query := o.DB.Table(PmDyPeriodOrderTableName).Where("`user_id` = ? and `app_pkg_name` = ? and `sign_order_id` = 0 and `sign_status` in (?)",
+		userId, pkg, []int{Sign_Status_Success, Sign_Status_Cancel, Sign_Status_Done})
+	if signNo != "" {
+		query = query.Where("`sign_no` = ?", signNo)
+	}
+	err := query.Order("id desc").Find(&contracts).Error
+	if err != nil {
+		logx.Errorf("getDouyinContracts 获取签约单失败 err:%v, userId:%d, pkg:%s", err, userId, pkg)
+	}
+	return contracts, err
+}
+
+// 抖音：以签约单的签约状态和代扣状态为准
+func (o *SubscriptionModel) getDouyinSubscriptions(userId int, pkg, signNo string) ([]*UserSubscription, error) {
+	contracts, err := o.getDouyinContracts(userId, pkg, signNo)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*UserSubscription
+	for _, contract := range contracts {
+		sub := &UserSubscription{
+			Channel:        Subscription_Channel_Douyin,
+			SubscriptionNo: contract.SignNo,
+			AppPkg:         contract.AppPkgName,
+			UserId:         contract.UserId,
+			OutTradeNo:     contract.OrderSn,
+			Amount:         contract.Amount,
+			PeriodUnit:     contract.PeriodUnit,
+			PeriodCount:    contract.PeriodCount,
+			SignTime:       contract.SignDate,
+		}
+		switch {
+		case contract.SignStatus == Sign_Status_Success && contract.DeductStatus == Dy_Deduct_Status_Lapsed:
+			sub.Status = Subscription_Status_Expired
+		case contract.SignStatus == Sign_Status_Success:
+			sub.Status = Subscription_Status_Active
+			sub.NextChargeTime = contract.NextDecuctionTime
+		case contract.SignStatus == Sign_Status_Cancel:
+			sub.Status = Subscription_Status_Canceled
+		default:
+			sub.Status = Subscription_Status_Expired
+		}
+		list = append(list, sub)
+	}
+	return list, nil
+}
+
+// 抖音代扣单，带上创建时间
+type dyChargeOrder struct {
+	PmDyPeriodOrderTable `gorm:"embedded"`
+	CreatedAt            time.Time `gorm:"column:created_at"`
+}
+
+// 抖音：同一签约下的签约单和代扣单抖音签约单号相同，只取已扣款和扣款失败的
+func (o *SubscriptionModel) getDouyinCharges(userId int, pkg, signNo string) ([]*SubscriptionCharge, error) {
+	contracts, err := o.getDouyinContracts(userId, pkg, signNo)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*SubscriptionCharge
+	for _, contract := range contracts {
+		if contract.ThirdSignOrderNo == "" {
+			continue
+		}
+		var orders []*dyChargeOrder
+		err = o.DB.Table(PmDyPeriodOrderTableName).Where("`third_sign_order_no` = ? and `pay_app_id` = ? and `pay_status` in (?)",
+			contract.ThirdSignOrderNo, contract.PayAppId, []int{Dy_Pay_Status_Paid, Dy_Pay_Status_Fail}).
+			Order("id desc").Find(&orders).Error
+		if err != nil {
+			logx.Errorf("getDouyinCharges 获取代扣单失败 err:%v, third_sign_order_no:%s", err, contract.ThirdSignOrderNo)
+			return nil, err
+		}
+		for _, order := range orders {
+			status := code.ORDER_SUCCESS
+			if order.PayStatus == Dy_Pay_Status_Fail {
+				status = code.ORDER_FAIL
+			}
+			list = append(list, &SubscriptionCharge{
+				Channel:        Subscription_Channel_Douyin,
+				SubscriptionNo: contract.SignNo,
+				OutTradeNo:     order.OrderSn,
+				Amount:         order.Amount,
+				Status:         status,
+				ChargeTime:     order.CreatedAt,
+			})
+		}
+	}
+	return list, nil
+}
+
+// 已处理过购买通知的华为订阅订单
+func (o *SubscriptionModel) getHuaweiOrders(userId int, pkg, subscriptionId string) ([]*HuaweiOrderTable, error) {
+	var orders []*HuaweiOrderTable
+	query := o.DB.Table("huawei_order").Where("`user_id` = ? and `app_pkg` = ? and `subscription_id` != '' and `status` in (?)",
+		userId, pkg, []int{HuaweiOrderStatusPaid, HuaweiOrderStatusRefunded})
+	if subscriptionId != "" {
+		query = query.Where("`subscription_id` = ?", subscriptionId)
+	}
+	err := query.Order("id desc").Find(&orders).Error
+	if err != nil {
+		logx.Errorf("getHuaweiOrders 获取华为订阅订单失败 err:%v, userId:%d, pkg:%s", err, userId, pkg)
+	}
+	return orders, err
+}
+
+// 华为：以续期状态和订阅过期时间为准，过期时间即下次扣款时间
+func (o *SubscriptionModel) getHuaweiSubscriptions(userId int, pkg, subscriptionId string) ([]*UserSubscription, error) {
+	orders, err := o.getHuaweiOrders(userId, pkg, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var list []*UserSubscription
+	for _, order := range orders {
+		sub := &UserSubscription{
+			Channel:        Subscription_Channel_Huawei,
+			SubscriptionNo: order.SubscriptionId,
+			AppPkg:         order.AppPkg,
+			UserId:         order.UserId,
+			OutTradeNo:     order.OutTradeNo,
+			Amount:         order.Amount,
+			SignTime:       order.PayTime,
+		}
+		expirationTime := time.Unix(int64(order.ExpirationDate), 0)
+		switch {
+		case order.Status == HuaweiOrderStatusRefunded:
+			sub.Status = Subscription_Status_Expired
+		case order.ExpirationDate > 0 && expirationTime.Before(now):
+			sub.Status = Subscription_Status_Expired
+		case order.AutoRenewStatus == 1:
+			sub.Status = Subscription_Status_Active
+			sub.NextChargeTime = expirationTime
+		default:
+			sub.Status = Subscription_Status_Canceled
+		}
+		list = append(list, sub)
+	}
+	return list, nil
+}
+
+// 华为：续费不单独落订单，只有首次购买的订单
+func (o *SubscriptionModel) getHuaweiCharges(userId int, pkg, subscriptionId string) ([]*SubscriptionCharge, error) {
+	orders, err := o.getHuaweiOrders(userId, pkg, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*SubscriptionCharge
+	for _, order := range orders {
+		// 返还过费用的扣款记录按已退款展示，订阅状态不受影响
+		status := order.Status
+		if order.ReturnFeeTime > 0 {
+			status = HuaweiOrderStatusRefunded
+		}
+		list = append(list, &SubscriptionCharge{
+			Channel:        Subscription_Channel_Huawei,
+			SubscriptionNo: order.SubscriptionId,
+			OutTradeNo:     order.OutTradeNo,
+			Amount:         order.Amount,
+			Status:         status,
+			ChargeTime:     order.PayTime,
+		})
+	}
+	return list, nil
+}
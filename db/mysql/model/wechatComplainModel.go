@@ -0,0 +1,165 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+	"gorm.io/gorm"
+)
+
+var (
+	getWechatComplainErr  = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "getWechatComplainErr", nil, "获取微信投诉单失败", nil})}
+	saveWechatComplainErr = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "saveWechatComplainErr", nil, "保存微信投诉单失败", nil})}
+)
+
+// 微信消费者投诉表，定时同步及投诉通知回调时更新
+type PmWechatComplainTable struct {
+	ID                    int       `gorm:"column:id;primary_key;AUTO_INCREMENT" json:"id"`
+	MchId                 string    `gorm:"column:mch_id;NOT NULL" json:"mch_id"`                                             // 被诉商户号
+	PayAppId              string    `gorm:"column:pay_app_id;NOT NULL" json:"pay_app_id"`                                     // 拉取投诉使用的微信支付appid
+	ComplaintId           string    `gorm:"column:complaint_id;NOT NULL" json:"complaint_id"`                                 // 微信投诉单号，唯一索引
+	ComplaintState        string    `gorm:"column:complaint_state;NOT NULL" json:"complaint_state"`                           // 投诉单状态 PENDING待处理 PROCESSING处理中 PROCESSED已处理完成
+	ComplaintTime         time.Time `gorm:"column:complaint_time;type:datetime" json:"complaint_time"`                        // 投诉时间
+	ComplaintDetail       string    `gorm:"column:complaint_detail;NOT NULL" json:"complaint_detail"`                         // 投诉详情
+	ProblemType           string    `gorm:"column:problem_type;NOT NULL" json:"problem_type"`                                 // 问题类型 REFUND申请退款 SERVICE_NOT_WORK服务权益未生效 OTHERS其他
+	ProblemDescription    string    `gorm:"column:problem_description;NOT NULL" json:"problem_description"`                   // 问题描述
+	ApplyRefundAmount     int       `gorm:"column:apply_refund_amount;default:0;NOT NULL" json:"apply_refund_amount"`         // 申请退款金额（分）
+	ComplaintFullRefunded int       `gorm:"column:complaint_full_refunded;default:0;NOT NULL" json:"complaint_full_refunded"` // 是否已全额退款 0否 1是
+	UserComplaintTimes    int       `gorm:"column:user_complaint_times;default:0;NOT NULL" json:"user_complaint_times"`       // 用户投诉次数
+	TransactionId         string    `gorm:"column:transaction_id;NOT NULL" json:"transaction_id"`                             // 微信支付订单号
+	OrderSn               string    `gorm:"column:order_sn;NOT NULL" json:"order_sn"`                                         // 关联的pm_pay_order订单号，未匹配到订单时为空
+	AppPkgName            string    `gorm:"column:app_pkg_name;NOT NULL" json:"app_pkg_name"`                                 // 订单来源包名
+	OrderAmount           int       `gorm:"column:order_amount;default:0;NOT NULL" json:"order_amount"`                       // 订单金额（分）
+	ResponseContent       string    `gorm:"column:response_content;NOT NULL" json:"response_content"`                         // 最近一次回复内容
+	Operator              string    `gorm:"column:operator;NOT NULL" json:"operator"`                                         // 处理人
+	CreatedAt             time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"created_at"`           // 创建时间
+	UpdatedAt             time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP;NOT NULL" json:"updated_at"`           // 更新时间
+}
+
+const PmWechatComplainTableName = "pm_wechat_complain"
+
+func (m *PmWechatComplainTable) TableName() string {
+	return PmWechatComplainTableName
+}
+
+// 投诉单列表筛选条件
+type WechatComplainFilter struct {
+	MchId          string
+	AppPkgName     string
+	ComplaintState string
+	OrderSn        string
+	StartTime      time.Time
+	EndTime        time.Time
+}
+
+type PmWechatComplainModel struct {
+	DB *gorm.DB
+}
+
+func NewPmWechatComplainModel(dbName string) *PmWechatComplainModel {
+	return &PmWechatComplainModel{
+		DB: db.WithDBContext(dbName),
+	}
+}
+
+// 同步微信投诉单，已存在时只更新微信侧的字段，不覆盖本地的处理记录；isNew表示是否为新投诉
+func (o *PmWechatComplainModel) Sync(info *PmWechatComplainTable) (isNew bool, err error) {
+	existInfo, err := o.GetOneByComplaintId(info.ComplaintId)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return false, err
+	}
+
+	if existInfo.ID == 0 {
+		isNew = true
+		err = o.DB.Create(info).Error
+	} else {
+		info.ID = existInfo.ID
+		err = o.DB.Table(PmWechatComplainTableName).Where("`id` = ?", existInfo.ID).Updates(map[string]interface{}{
+			"complaint_state":         info.ComplaintState,
+			"complaint_detail":        info.ComplaintDetail,
+			"problem_type":            info.ProblemType,
+			"problem_description":     info.ProblemDescription,
+			"apply_refund_amount":     info.ApplyRefundAmount,
+			"complaint_full_refunded": info.ComplaintFullRefunded,
+			"user_complaint_times":    info.UserComplaintTimes,
+		}).Error
+	}
+	if err != nil {
+		logx.Errorf("同步微信投诉单失败 err: %v, complaintId: %s", err, info.ComplaintId)
+		saveWechatComplainErr.CounterInc()
+	}
+	return isNew, err
+}
+
+// 根据微信投诉单号获取投诉单
+func (o *PmWechatComplainModel) GetOneByComplaintId(complaintId string) (*PmWechatComplainTable, error) {
+	info := new(PmWechatComplainTable)
+	err := o.DB.Table(PmWechatComplainTableName).Where("`complaint_id` = ?", complaintId).First(info).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		logx.Errorf("GetOneByComplaintId 获取微信投诉单失败 err:%v, complaintId:%s", err, complaintId)
+		getWechatComplainErr.CounterInc()
+	}
+	return info, err
+}
+
+// 更新数据
+func (o *PmWechatComplainModel) UpdateSomeData(id int, updateData map[string]interface{}) error {
+	err := o.DB.Table(PmWechatComplainTableName).Where("`id` = ?", id).Updates(updateData).Error
+	if err != nil {
+		err = fmt.Errorf("PmWechatComplainModel UpdateSomeData Err: %v", err)
+		util.CheckError(err.Error())
+	}
+	return err
+}
+
+// 按条件分页查询投诉单，按投诉时间倒序
+func (o *PmWechatComplainModel) GetList(filter *WechatComplainFilter, page, pageSize int) (list []*PmWechatComplainTable, total int64, err error) {
+	query := o.DB.Table(PmWechatComplainTableName)
+	if filter.MchId != "" {
+		query = query.Where("`mch_id` = ?", filter.MchId)
+	}
+	if filter.AppPkgName != "" {
+		query = query.Where("`app_pkg_name` = ?", filter.AppPkgName)
+	}
+	if filter.ComplaintState != "" {
+		query = query.Where("`complaint_state` = ?", filter.ComplaintState)
+	}
+	if filter.OrderSn != "" {
+		query = query.Where("`order_sn` = ?", filter.OrderSn)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("`complaint_time` >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("`complaint_time` < ?", filter.EndTime)
+	}
+
+	err = query.Count(&total).Error
+	if err != nil {
+		logx.Errorf("GetList 统计微信投诉单失败 err:%v, filter:%+v", err, filter)
+		getWechatComplainErr.CounterInc()
+		return nil, 0, err
+	}
+
+	err = query.Order("`complaint_time` desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error
+	if err != nil {
+		logx.Errorf("GetList 获取微信投诉单失败 err:%v, filter:%+v", err, filter)
+		getWechatComplainErr.CounterInc()
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// 统计商户时间段内的投诉数
+func (o *PmWechatComplainModel) CountByMchId(mchId string, startTime, endTime time.Time) (total int64, err error) {
+	err = o.DB.Table(PmWechatComplainTableName).Where("`mch_id` = ? and `complaint_time` >= ? and `complaint_time` < ?", mchId, startTime, endTime).Count(&total).Error
+	if err != nil {
+		logx.Errorf("CountByMchId 统计微信投诉单失败 err:%v, mchId:%s", err, mchId)
+		getWechatComplainErr.CounterInc()
+	}
+	return
+}
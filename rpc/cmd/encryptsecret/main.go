@@ -0,0 +1,146 @@
+// encryptsecret 把商户配置表中的明文密钥加密存储，可重复执行，已加密的字段不再处理
+//
+// 使用rpc服务的nacos配置连接数据库，主密钥读取配置中的SecretMasterKey或环境变量PAY_GATEWAY_MASTER_KEY，
+// 需与rpc、api服务使用同一个主密钥。先用-dry-run查看需要加密的行数
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/envelope"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/config"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/nacos"
+)
+
+var (
+	nacosConfigFile = flag.String("nacos", "etc/nacos.yaml", "the nacos config file")
+	tables          = flag.String("tables", strings.Join(model.SecretConfigTables, ","), "需要加密的配置表，逗号分隔")
+	batchSize       = flag.Int("batch", 100, "每批读取的行数")
+	dryRun          = flag.Bool("dry-run", false, "只统计需要加密的行数，不写入")
+)
+
+// 加密期间配置被修改时重新读取的次数
+const encryptRetry = 3
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	var nacosConfig nacos.Config
+	conf.MustLoad(*nacosConfigFile, &nacosConfig)
+	nacosClient, nacosErr := nacos.InitNacosClient(nacosConfig)
+	if nacosErr != nil {
+		logx.Errorf("初始化nacos客户端失败: " + nacosErr.Error())
+		os.Exit(1)
+	}
+	err := nacosClient.GetConfig(nacosConfig.DataId, nacosConfig.GroupId, &c)
+	nacosClient.CloseClient()
+	if err != nil {
+		logx.Errorf("获取配置失败：" + err.Error())
+		os.Exit(1)
+	}
+
+	if err = envelope.Init(c.SecretMasterKey); err != nil {
+		logx.Errorf("初始化商户密钥加密主密钥失败：" + err.Error())
+		os.Exit(1)
+	}
+	if !envelope.Enabled() {
+		logx.Errorf("未配置主密钥，请设置SecretMasterKey或环境变量%s", envelope.EnvMasterKey)
+		os.Exit(1)
+	}
+
+	db.DBInit(c.Mysql, c.RedisConfig)
+
+	configAdminModel := model.NewConfigAdminModel(define.DbPayGateway)
+	var failed bool
+	for _, table := range strings.Split(*tables, ",") {
+		table = strings.TrimSpace(table)
+		if table == "" {
+			continue
+		}
+		total, encrypted, err := encryptTable(configAdminModel, table)
+		if err != nil {
+			failed = true
+		}
+		fmt.Printf("%s: 共%d行，需要加密%d行，dry-run=%v, err=%v\n", table, total, encrypted, *dryRun, err)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// 分批加密一张表，单行失败的记录日志后继续，返回最后一个错误
+func encryptTable(configAdminModel *model.ConfigAdminModel, table string) (total int, encrypted int, lastErr error) {
+	lastId := 0
+	for {
+		list, err := configAdminModel.GetSecretConfigBatch(table, lastId, *batchSize)
+		if err != nil {
+			return total, encrypted, err
+		}
+		for _, row := range list {
+			lastId = row.ConfigId()
+			total++
+			if !row.HasPlainSecrets() {
+				continue
+			}
+			encrypted++
+			if *dryRun {
+				continue
+			}
+
+			if err = encryptRow(configAdminModel, row); err != nil {
+				logx.Errorf("加密商户密钥失败 table:%s, id:%d, err:%v", table, row.ConfigId(), err)
+				lastErr = err
+			}
+		}
+		if len(list) < *batchSize {
+			return
+		}
+	}
+}
+
+// 加密一行配置，只在密钥字段和读取时一致时写入；读取后配置被修改（如SaveConfig更换了密钥）时重新读取后再加密
+func encryptRow(configAdminModel *model.ConfigAdminModel, row model.SecretConfig) error {
+	for i := 0; i < encryptRetry; i++ {
+		if !row.HasPlainSecrets() {
+			return nil
+		}
+		oldValues := row.SecretValues()
+		if err := row.EncryptSecrets(); err != nil {
+			return err
+		}
+		updated, err := configAdminModel.UpdateSecrets(row, oldValues)
+		if err != nil {
+			return err
+		}
+		if updated {
+			expireCache(row)
+			return nil
+		}
+		if err = configAdminModel.GetById(row.ConfigId(), row); err != nil {
+			return fmt.Errorf("重新读取配置失败: %v", err)
+		}
+	}
+	return fmt.Errorf("配置在加密期间被修改%d次，请重新执行", encryptRetry)
+}
+
+// 加密后使缓存失效，避免继续读到缓存中的明文
+func expireCache(row model.SecretConfig) {
+	switch cfg := row.(type) {
+	case *model.PmPayConfigAlipayTable:
+		clientMgr.ExpireAlipayClientCache("", cfg.AppID)
+	case *model.PmPayConfigWechatTable:
+		model.NewPmPayConfigWechatModel(define.DbPayGateway).ExpireCache(cfg.AppID)
+	case *model.HuaweiAppTable:
+		model.NewHuaweiAppModel(define.DbPayGateway).ExpireCache(cfg.AppID)
+	}
+}
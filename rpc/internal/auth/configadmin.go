@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/config"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	configAdminAuthFailNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "configAdminAuthFailNum", nil, "配置管理接口认证失败", nil})}
+)
+
+// 调用方在grpc metadata中传入的管理员token
+const ConfigAdminTokenHeader = "x-config-admin-token"
+
+// 需要管理员认证的配置管理接口
+var configAdminMethods = map[string]bool{
+	pb.Payment_SaveConfig_FullMethodName:      true,
+	pb.Payment_ListConfig_FullMethodName:      true,
+	pb.Payment_SetConfigStatus_FullMethodName: true,
+}
+
+type operatorKey struct{}
+
+// ConfigAdminInterceptor 配置管理接口校验管理员token，认证通过后把管理员名称作为操作人放入ctx
+// 未配置管理员时拒绝所有配置管理请求
+func ConfigAdminInterceptor(admins []config.ConfigAdmin) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !configAdminMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		var token string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(ConfigAdminTokenHeader); len(values) > 0 {
+				token = values[0]
+			}
+		}
+		name := matchConfigAdmin(admins, token)
+		if name == "" {
+			configAdminAuthFailNum.CounterInc()
+			logx.WithContext(ctx).Errorf("配置管理接口认证失败 method: %s", info.FullMethod)
+			return nil, status.Error(codes.Unauthenticated, "配置管理接口认证失败")
+		}
+		return handler(context.WithValue(ctx, operatorKey{}, name), req)
+	}
+}
+
+// 按token匹配管理员，返回管理员名称，未匹配时为空
+func matchConfigAdmin(admins []config.ConfigAdmin, token string) string {
+	if token == "" {
+		return ""
+	}
+	for _, admin := range admins {
+		if admin.Name == "" || admin.Token == "" {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(admin.Token), []byte(token)) == 1 {
+			return admin.Name
+		}
+	}
+	return ""
+}
+
+// Operator 认证通过的管理员名称，用作配置变更的操作人
+func Operator(ctx context.Context) string {
+	name, _ := ctx.Value(operatorKey{}).(string)
+	return name
+}
@@ -11,8 +11,22 @@ type Config struct {
 	Mysql                  []*db.DbConfig `json:"Mysql"`
 	Nacos                  NacosConfig
 	RedisConfig            []*cache.RedisConfigs `json:"RedisConfig"`
-	SnowFlake              SnowFlake             `json:"SnowFlake,optional"`     //雪花算法参数
-	BaseAppConfigServerUrl string                `json:"BaseAppConfigServerUrl"` // baseAppConfigServer地址
+	SnowFlake              SnowFlake             `json:"SnowFlake,optional"`       //雪花算法参数
+	BaseAppConfigServerUrl string                `json:"BaseAppConfigServerUrl"`   // baseAppConfigServer地址
+	SecretMasterKey        string                `json:"SecretMasterKey,optional"` // 商户密钥加密主密钥，base64编码的32字节，为空时读取环境变量PAY_GATEWAY_MASTER_KEY
+	ConfigAdmins           []ConfigAdmin         `json:"ConfigAdmins,optional"`    // 配置管理接口的管理员，未配置时不能调用配置管理接口
+	DyPeriodDeduct         DyPeriodDeduct        `json:"DyPeriodDeduct,optional"`  // 抖音周期代扣
+}
+
+// 抖音周期代扣，AppPkgs需与api的DyPeriodDeduct.AppPkgs一致
+type DyPeriodDeduct struct {
+	AppPkgs []string `json:",optional"` // 由网关扣款的包名，这些包名的签约单不再返回给业务方扣款，也不能基于签约单创建代扣单
+}
+
+// 配置管理接口的管理员，调用方在grpc metadata的x-config-admin-token中传入token
+type ConfigAdmin struct {
+	Name  string // 管理员名称，作为审计日志的操作人
+	Token string // 认证token
 }
 
 // mysql配置
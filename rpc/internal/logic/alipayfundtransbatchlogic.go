@@ -0,0 +1,117 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+
+	alipay2 "gitlab.muchcloud.com/consumer-project/alipay"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 单批最多转账笔数
+const alipayFundTransBatchMax = 100
+
+type AlipayFundTransBatchLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAlipayFundTransBatchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AlipayFundTransBatchLogic {
+	return &AlipayFundTransBatchLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 支付宝批量转出，逐笔调用单笔转账，每笔按OrderSn幂等，失败或结果未知的可以用相同参数重新提交整批
+func (l *AlipayFundTransBatchLogic) AlipayFundTransBatch(in *pb.AlipayFundTransBatchReq) (*pb.AlipayFundTransBatchResp, error) {
+	if in.BatchNo == "" {
+		return nil, errors.New("批次号不能为空")
+	}
+	if len(in.Items) == 0 || len(in.Items) > alipayFundTransBatchMax {
+		return nil, errors.New("转账笔数必须在1~100之间")
+	}
+
+	payClient, payAppId, err := NewAlipayFundTransUniTransferLogic(l.ctx, l.svcCtx).getTransClient(in.AppPkgName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AlipayFundTransBatchResp{
+		BatchNo: in.BatchNo,
+		List:    make([]*pb.AlipayFundTransResp, 0, len(in.Items)),
+	}
+	for _, item := range in.Items {
+		if item.PayeeInfo == nil {
+			resp.FailNum++
+			resp.List = append(resp.List, &pb.AlipayFundTransResp{OrderSn: item.OrderSn, Status: model.PmFundTransOrderStatusFail, FailReason: "收款方信息为空"})
+			continue
+		}
+
+		remark := item.Remark
+		if remark == "" {
+			remark = in.Remark
+		}
+		param := alipay2.FundTransUniTransfer{
+			OutBizNo:    item.OrderSn,
+			TransAmount: item.TransAmount,
+			ProductCode: in.ProductCode,
+			BizScene:    in.BizScene,
+			OrderTitle:  in.OrderTitle,
+			PayeeInfo: &alipay2.PayeeInfo{
+				Identity:     item.PayeeInfo.Identity,
+				IdentityType: item.PayeeInfo.IdentityType,
+				Name:         item.PayeeInfo.Name,
+			},
+			Remark:         remark,
+			BusinessParams: in.BusinessParams,
+		}
+
+		amount, _ := strconv.ParseFloat(item.TransAmount, 64)
+		orderInfo := &model.PmFundTransOrderTable{
+			OrderSn:      item.OrderSn,
+			BatchNo:      in.BatchNo,
+			AppPkgName:   in.AppPkgName,
+			Amount:       int(math.Round(amount * 100)),
+			AliName:      item.PayeeInfo.Name,
+			AliAccount:   item.PayeeInfo.Identity,
+			PayeeAccount: item.PayeeInfo.Identity,
+			PayAppId:     payAppId,
+		}
+		result, transErr := clientMgr.AlipayFundTransfer(payClient, orderInfo, param)
+		if transErr != nil {
+			alipayFundTransUniTransferFailNum.CounterInc()
+			l.Errorf("AlipayFundTransBatch batchNo: %s, orderSn: %s, err: %v", in.BatchNo, item.OrderSn, transErr)
+		}
+
+		var itemResp *pb.AlipayFundTransResp
+		if result != nil {
+			itemResp = toFundTransResp(result)
+		} else {
+			itemResp = &pb.AlipayFundTransResp{OrderSn: item.OrderSn, Status: model.PmFundTransOrderStatusUnknown}
+		}
+		if transErr != nil && itemResp.FailReason == "" {
+			itemResp.FailReason = transErr.Error()
+		}
+
+		switch itemResp.Status {
+		case model.PmFundTransOrderStatusSuccess:
+			resp.SuccessNum++
+		case model.PmFundTransOrderStatusFail:
+			resp.FailNum++
+		}
+		resp.List = append(resp.List, itemResp)
+	}
+
+	l.Sloww("AlipayFundTransBatch finish", logx.Field("batchNo", in.BatchNo), logx.Field("total", len(in.Items)), logx.Field("successNum", resp.SuccessNum), logx.Field("failNum", resp.FailNum))
+	return resp, nil
+}
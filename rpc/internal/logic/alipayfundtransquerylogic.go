@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type AlipayFundTransQueryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	fundTransOrderModel *model.PmFundTransOrderModel
+}
+
+func NewAlipayFundTransQueryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AlipayFundTransQueryLogic {
+	return &AlipayFundTransQueryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		fundTransOrderModel: model.NewPmFundTransOrderModel(define.DbPayGateway),
+	}
+}
+
+// 转账结果查询（支付宝、微信），处理中、结果未知的订单会实时查询对应渠道
+func (l *AlipayFundTransQueryLogic) AlipayFundTransQuery(in *pb.AlipayFundTransQueryReq) (*pb.AlipayFundTransResp, error) {
+	orderInfo, err := l.fundTransOrderModel.GetOneByOrderSn(in.OrderSn)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("转账订单不存在 orderSn: %s", in.OrderSn)
+		}
+		return nil, err
+	}
+	if in.AppPkgName != "" && orderInfo.AppPkgName != in.AppPkgName {
+		return nil, fmt.Errorf("转账订单不存在 orderSn: %s, pkgName: %s", in.OrderSn, in.AppPkgName)
+	}
+
+	// 查询失败时返回本地记录的状态，由业务方稍后再查
+	if queryErr := clientMgr.FundTransQuery(orderInfo); queryErr != nil {
+		l.Errorf("AlipayFundTransQuery err: %v", queryErr)
+	}
+
+	return toFundTransResp(orderInfo), nil
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 
 	alipay2 "gitlab.muchcloud.com/consumer-project/alipay"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
@@ -44,29 +46,13 @@ func NewAlipayFundTransUniTransferLogic(ctx context.Context, svcCtx *svc.Service
 	}
 }
 
-// 支付宝转出
-func (l *AlipayFundTransUniTransferLogic) AlipayFundTransUniTransfer(in *pb.AlipayFundTransUniTransferReq) (res *pb.Empty, err error) {
-	//读取应用配置
-	pkgCfg, err := l.appConfigModel.GetOneByPkgName(in.AppPkgName)
+// 支付宝转出，同一OrderSn重复调用不会重复打款
+func (l *AlipayFundTransUniTransferLogic) AlipayFundTransUniTransfer(in *pb.AlipayFundTransUniTransferReq) (res *pb.AlipayFundTransResp, err error) {
+	payClient, payAppId, err := l.getTransClient(in.AppPkgName)
 	if err != nil {
-		util.CheckError("pkgName= %s, 读取应用配置失败，err:=%v", in.AppPkgName, err)
-		err = errors.New("读取应用配置失败")
-		return
-	}
-
-	payCfg, cfgErr := l.payConfigAlipayModel.GetOneByAppID(pkgCfg.AlipayAppID)
-	if cfgErr != nil {
-		err = fmt.Errorf("pkgName= %s, 读取支付宝配置失败，err:=%v", in.AppPkgName, cfgErr)
-		util.CheckError(err.Error())
 		return
 	}
 
-	// 将 key 的验证调整到初始化阶段
-	payClient, err := client.GetAlipayClient(*payCfg.TransClientConfig())
-	if err != nil {
-		util.CheckError("pkgName= %s, 初使化支付错误，err:=%v", in.AppPkgName, err)
-		return
-	}
 	userInfo := &alipay2.PayeeInfo{
 		Identity:     in.PayeeInfo.Identity,
 		IdentityType: in.PayeeInfo.IdentityType,
@@ -82,34 +68,69 @@ func (l *AlipayFundTransUniTransferLogic) AlipayFundTransUniTransfer(in *pb.Alip
 		Remark:         in.Remark,
 		BusinessParams: in.BusinessParams,
 	}
-	rest, err := payClient.FundTransUniTransfer(fundTransUniTransfer)
+
+	amount, _ := strconv.ParseFloat(in.TransAmount, 64)
+	orderInfo := &model.PmFundTransOrderTable{
+		OrderSn:      in.OrderSn,
+		AppPkgName:   in.AppPkgName,
+		Amount:       int(math.Round(amount * 100)),
+		AliName:      in.PayeeInfo.Name,
+		AliAccount:   in.PayeeInfo.Identity,
+		PayeeAccount: in.PayeeInfo.Identity,
+		PayAppId:     payAppId,
+	}
+	orderInfo, err = clientMgr.AlipayFundTransfer(payClient, orderInfo, fundTransUniTransfer)
 	if err != nil {
 		err = fmt.Errorf("pkgName= %s, 支付宝转账失败，err:=%v", in.AppPkgName, err)
 		logx.Errorf(err.Error())
 		alipayFundTransUniTransferFailNum.CounterInc()
-		return
+		return nil, err
 	}
 
-	if !rest.IsSuccess() {
-		err = fmt.Errorf("调用转账失败 err: %s %s", rest.Content.SubCode, rest.Content.SubMsg)
-		logx.Errorf(err.Error())
-		alipayFundTransUniTransferFailNum.CounterInc()
+	return toFundTransResp(orderInfo), nil
+}
+
+// 根据包名获取转账使用的支付宝client
+func (l *AlipayFundTransUniTransferLogic) getTransClient(pkgName string) (payClient *alipay2.Client, payAppId string, err error) {
+	//读取应用配置
+	pkgCfg, err := l.appConfigModel.GetOneByPkgName(pkgName)
+	if err != nil {
+		util.CheckError("pkgName= %s, 读取应用配置失败，err:=%v", pkgName, err)
+		err = errors.New("读取应用配置失败")
 		return
 	}
 
-	amount, _ := strconv.ParseFloat(in.TransAmount, 64)
-	orderInfo := &model.PmFundTransOrderTable{
-		OrderSn:    in.OrderSn,
-		AppPkgName: in.AppPkgName,
-		Amount:     int(amount * 100),
-		AliName:    in.PayeeInfo.Name,
-		AliAccount: in.PayeeInfo.Identity,
-		PayAppId:   payCfg.AppID,
+	payCfg, cfgErr := l.payConfigAlipayModel.GetOneByAppID(pkgCfg.AlipayAppID)
+	if cfgErr != nil {
+		err = fmt.Errorf("pkgName= %s, 读取支付宝配置失败，err:=%v", pkgName, cfgErr)
+		util.CheckError(err.Error())
+		return
 	}
-	err = l.fundTransOrderModel.Create(orderInfo)
+
+	// 将 key 的验证调整到初始化阶段
+	payClient, err = client.GetAlipayClient(*payCfg.TransClientConfig())
 	if err != nil {
-		err = fmt.Errorf("fundTransOrderModel Create err: %v", err)
-		util.CheckError(err.Error())
+		util.CheckError("pkgName= %s, 初使化支付错误，err:=%v", pkgName, err)
+		return
+	}
+	return payClient, payCfg.AppID, nil
+}
+
+func toFundTransResp(orderInfo *model.PmFundTransOrderTable) *pb.AlipayFundTransResp {
+	status := orderInfo.Status
+	if status == 0 {
+		// 历史数据是转账受理成功后才入库的
+		status = model.PmFundTransOrderStatusSuccess
+	}
+	return &pb.AlipayFundTransResp{
+		OrderSn:        orderInfo.OrderSn,
+		Status:         int64(status),
+		AliOrderId:     orderInfo.AliOrderId,
+		PayFundOrderId: orderInfo.PayFundOrderId,
+		TransDate:      orderInfo.TransDate,
+		FailReason:     orderInfo.FailReason,
+		PackageInfo:    orderInfo.PackageInfo,
+		ChannelBillNo:  orderInfo.ChannelBillNo,
+		Channel:        int64(orderInfo.Channel),
 	}
-	return &pb.Empty{}, nil
 }
@@ -64,13 +64,13 @@ func (l *AlipayPagePayAndSignChoiceAccountLogic) AlipayPagePayAndSignChoiceAccou
 	}
 
 	var amount, prepaidAmount string
-	var productType, intAmount, period int
+	var productType, intAmount int
 
 	productType = int(in.ProductType)
 
+	product := types.Product{}
 	if in.ProductId == 0 {
 		// 目前没有商品的配置，通过解析商品详情来获取商品的内容
-		product := types.Product{}
 		err = json.Unmarshal([]byte(in.ProductDesc), &product)
 		if err != nil {
 			parseProductDescErr.CounterInc()
@@ -84,7 +84,6 @@ func (l *AlipayPagePayAndSignChoiceAccountLogic) AlipayPagePayAndSignChoiceAccou
 		} else {
 			intAmount = int(product.Amount * 100)
 		}
-		period = product.SubscribePeriod
 		prepaidAmount = fmt.Sprintf("%.2f", product.PrepaidAmount)
 		amount = fmt.Sprintf("%.2f", product.Amount)
 	}
@@ -121,17 +120,29 @@ func (l *AlipayPagePayAndSignChoiceAccountLogic) AlipayPagePayAndSignChoiceAccou
 	}
 
 	externalAgreementNo := ""
+	var schedule *types.SubscribeSchedule
 
 	if productType == code.PRODUCT_TYPE_SUBSCRIBE {
-		// 订阅商品
+		// 订阅商品，按试用天数和订阅周期确定首次扣款日期和扣款周期
+		schedule, err = product.SubscribeSchedule(time.Now())
+		if err != nil {
+			parseProductDescErr.CounterInc()
+			logx.Errorf("创建订单异常：订阅周期错误 err = %s product = %s", err.Error(), in.ProductDesc)
+			return nil, errors.New("商品信息错误")
+		}
+		if in.ProductType != code.PRODUCT_TYPE_SUBSCRIBE_FEE {
+			// 签约订单记录首次续费扣款日期
+			orderInfo.DeductTime = schedule.FirstDeductTime
+		}
+
 		accessParam := &alipay2.AccessParams{
 			Channel: "ALIPAYAPP",
 		}
 
 		rule := &alipay2.PeriodRuleParams{
-			PeriodType:   "DAY",
-			Period:       strconv.Itoa(period),
-			ExecuteTime:  time.Now().Format("2006-01-02"),
+			PeriodType:   schedule.PeriodType,
+			Period:       strconv.Itoa(schedule.Period),
+			ExecuteTime:  schedule.FirstDeductTime.Format("2006-01-02"),
 			SingleAmount: amount,
 		}
 		trade.TotalAmount = prepaidAmount // 订阅商品，首次付款的金额是预付金额
@@ -203,6 +214,7 @@ func (l *AlipayPagePayAndSignChoiceAccountLogic) AlipayPagePayAndSignChoiceAccou
 		URL:                 result,
 		OutTradeNo:          orderInfo.OutTradeNo,
 		ExternalAgreementNo: externalAgreementNo,
+		Schedule:            toPbSubscribeSchedule(schedule),
 	}, nil
 }
 
@@ -56,7 +56,7 @@ func (l *AlipayPagePayAndSignLogic) AlipayPagePayAndSign(in *pb.AlipayPageSignRe
 	}
 
 	var amount, prepaidAmount string
-	var productType, intAmount, period int
+	var productType, intAmount int
 
 	product := types.Product{}
 	err = json.Unmarshal([]byte(in.ProductDesc), &product)
@@ -72,7 +72,6 @@ func (l *AlipayPagePayAndSignLogic) AlipayPagePayAndSign(in *pb.AlipayPageSignRe
 	} else {
 		intAmount = int(product.Amount * 100)
 	}
-	period = product.SubscribePeriod
 	prepaidAmount = fmt.Sprintf("%.2f", product.PrepaidAmount)
 	amount = fmt.Sprintf("%.2f", product.Amount)
 
@@ -106,17 +105,29 @@ func (l *AlipayPagePayAndSignLogic) AlipayPagePayAndSign(in *pb.AlipayPageSignRe
 	}
 
 	externalAgreementNo := ""
+	var schedule *types.SubscribeSchedule
 
 	if productType == code.PRODUCT_TYPE_SUBSCRIBE {
+		// 按试用天数和订阅周期确定首次扣款日期和扣款周期
+		schedule, err = product.SubscribeSchedule(time.Now())
+		if err != nil {
+			parseProductDescErr.CounterInc()
+			logx.Errorf("创建订单异常：订阅周期错误 err = %s product = %s", err.Error(), in.ProductDesc)
+			return nil, errors.New("商品信息错误")
+		}
+		if in.ProductType != code.PRODUCT_TYPE_SUBSCRIBE_FEE {
+			// 签约订单记录首次续费扣款日期
+			orderInfo.DeductTime = schedule.FirstDeductTime
+		}
 
 		accessParam := &alipay2.AccessParams{
 			Channel: "ALIPAYAPP",
 		}
 
 		rule := &alipay2.PeriodRuleParams{
-			PeriodType:   "DAY",
-			Period:       strconv.Itoa(period),
-			ExecuteTime:  time.Now().Format("2006-01-02"),
+			PeriodType:   schedule.PeriodType,
+			Period:       strconv.Itoa(schedule.Period),
+			ExecuteTime:  schedule.FirstDeductTime.Format("2006-01-02"),
 			SingleAmount: amount,
 		}
 		trade.TotalAmount = prepaidAmount // 订阅商品，首次付款的金额是预付金额
@@ -174,5 +185,21 @@ func (l *AlipayPagePayAndSignLogic) AlipayPagePayAndSign(in *pb.AlipayPageSignRe
 		URL:                 result,
 		OutTradeNo:          orderInfo.OutTradeNo,
 		ExternalAgreementNo: externalAgreementNo,
+		Schedule:            toPbSubscribeSchedule(schedule),
 	}, nil
 }
+
+// 扣款计划转换为返回结构，非订阅商品为nil
+func toPbSubscribeSchedule(schedule *types.SubscribeSchedule) *pb.SubscribeSchedule {
+	if schedule == nil {
+		return nil
+	}
+	return &pb.SubscribeSchedule{
+		FirstDeductTime: schedule.FirstDeductTime.Format("2006-01-02"),
+		PeriodType:      schedule.PeriodType,
+		Period:          int32(schedule.Period),
+		PeriodAmount:    int64(schedule.PeriodAmount),
+		FirstAmount:     int64(schedule.FirstAmount),
+		TrialDays:       int32(schedule.TrialDays),
+	}
+}
@@ -0,0 +1,162 @@
+package logic
+
+import (
+	"context"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type CancelSubscriptionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	subscriptionModel     *model.SubscriptionModel
+	orderModel            *model.OrderModel
+	payDyPeriodOrderModel *model.PmDyPeriodOrderModel
+	huaweiOrderModel      *model.HuaweiOrderModel
+}
+
+func NewCancelSubscriptionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelSubscriptionLogic {
+	return &CancelSubscriptionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		subscriptionModel:     model.NewSubscriptionModel(define.DbPayGateway),
+		orderModel:            model.NewOrderModel(define.DbPayGateway),
+		payDyPeriodOrderModel: model.NewPmDyPeriodOrderModel(define.DbPayGateway),
+		huaweiOrderModel:      model.NewHuaweiOrderModel(define.DbPayGateway),
+	}
+}
+
+// CancelSubscription 取消订阅，按渠道解约或取消自动续费
+//
+// 支付宝、抖音解约后渠道的解约通知仍会回调业务方，华为为取消自动续费，当期到期前权益不变
+func (l *CancelSubscriptionLogic) CancelSubscription(in *pb.CancelSubscriptionReq) (*pb.CancelSubscriptionResp, error) {
+	// 记录一下参数
+	l.Sloww("CancelSubscription", logx.Field("in", in))
+
+	sub, err := l.subscriptionModel.GetUserSubscription(int(in.GetUserId()), in.GetPkg(), in.GetChannel(), in.GetSubscriptionNo())
+	if err == gorm.ErrRecordNotFound {
+		return &pb.CancelSubscriptionResp{
+			Code: 1,
+			Msg:  "订阅不存在",
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.Status != model.Subscription_Status_Active {
+		return &pb.CancelSubscriptionResp{
+			Code: 0,
+			Msg:  "订阅已取消或已失效",
+		}, nil
+	}
+
+	switch sub.Channel {
+	case model.Subscription_Channel_Alipay:
+		return l.cancelAlipay(in, sub)
+	case model.Subscription_Channel_Douyin:
+		return l.cancelDouyin(in, sub)
+	default:
+		return l.cancelHuawei(in, sub)
+	}
+}
+
+// 支付宝：解约周期扣款协议，关闭待扣款的续费订单
+func (l *CancelSubscriptionLogic) cancelAlipay(in *pb.CancelSubscriptionReq, sub *model.UserSubscription) (*pb.CancelSubscriptionResp, error) {
+	unsignResp, err := NewAlipayPageUnSignLogic(l.ctx, l.svcCtx).AlipayPageUnSign(&pb.AlipayPageUnSignReq{
+		OutTradeNo: sub.OutTradeNo,
+		AppPkgName: in.GetPkg(),
+	})
+	if err != nil || unsignResp == nil || unsignResp.Status != code.ALI_PAY_SUCCESS {
+		l.Errorw("支付宝解约失败", logx.Field("externalAgreementNo", sub.SubscriptionNo), logx.Field("resp", unsignResp), logx.Field("err", err))
+		msg := "支付宝解约失败"
+		if unsignResp != nil && unsignResp.Desc != "" {
+			msg += ": " + unsignResp.Desc
+		}
+		return &pb.CancelSubscriptionResp{
+			Code: 1,
+			Msg:  msg,
+		}, nil
+	}
+
+	_ = l.orderModel.UnsignAgreementByExternalAgreementNo(sub.SubscriptionNo)
+	_ = l.orderModel.CloseUnpaidSubscribeFeeOrderByExternalAgreementNo(sub.SubscriptionNo)
+
+	return &pb.CancelSubscriptionResp{
+		Code: 0,
+		Msg:  "解约成功",
+	}, nil
+}
+
+// 抖音：解约周期代扣签约
+func (l *CancelSubscriptionLogic) cancelDouyin(in *pb.CancelSubscriptionReq, sub *model.UserSubscription) (*pb.CancelSubscriptionResp, error) {
+	contract, err := l.payDyPeriodOrderModel.GetOneByOrderSnAndPkg(sub.OutTradeNo, in.GetPkg())
+	if err != nil || contract == nil || contract.ID < 1 {
+		l.Errorf("获取抖音签约单失败 orderSn: %s, err: %v", sub.OutTradeNo, err)
+		return &pb.CancelSubscriptionResp{
+			Code: 1,
+			Msg:  "获取抖音签约单失败",
+		}, nil
+	}
+
+	terminateRes, err := NewDouyinPeriodOrderLogic(l.ctx, l.svcCtx).terminateSignOrder(contract)
+	if err != nil || !terminateRes.IsUnsignSuccess {
+		l.Errorw("抖音解约失败", logx.Field("signNo", contract.SignNo), logx.Field("resp", terminateRes), logx.Field("err", err))
+		return &pb.CancelSubscriptionResp{
+			Code: 1,
+			Msg:  "抖音解约失败请稍后再试",
+		}, nil
+	}
+
+	return &pb.CancelSubscriptionResp{
+		Code: 0,
+		Msg:  terminateRes.Msg,
+	}, nil
+}
+
+// 华为：取消自动续费
+func (l *CancelSubscriptionLogic) cancelHuawei(in *pb.CancelSubscriptionReq, sub *model.UserSubscription) (*pb.CancelSubscriptionResp, error) {
+	hworder, err := l.huaweiOrderModel.GetOneByOutTradeNo(sub.OutTradeNo)
+	if err != nil || hworder.Id < 1 {
+		return &pb.CancelSubscriptionResp{
+			Code: 1,
+			Msg:  "获取华为订阅订单失败",
+		}, nil
+	}
+
+	unsubscribeResp, err := NewUnsubscribeHuaweiLogic(l.ctx, l.svcCtx).UnsubscribeHuawei(&pb.UnsubscribeHuaweiReq{
+		Pkg:            in.GetPkg(),
+		SubscriptionId: hworder.SubscriptionId,
+		PurchaseToken:  hworder.PurchaseToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if unsubscribeResp.Code != 0 {
+		return &pb.CancelSubscriptionResp{
+			Code: 1,
+			Msg:  unsubscribeResp.Msg,
+		}, nil
+	}
+
+	// 华为的续期状态通知到达前先更新本地状态
+	_ = l.huaweiOrderModel.UpdateData(hworder.Id, map[string]interface{}{
+		"auto_renew_status": 0,
+	})
+
+	return &pb.CancelSubscriptionResp{
+		Code: 0,
+		Msg:  "取消自动续费成功",
+	}, nil
+}
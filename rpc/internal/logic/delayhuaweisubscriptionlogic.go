@@ -0,0 +1,145 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/huawei"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DelayHuaweiSubscriptionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	huaweiOrderModel *model.HuaweiOrderModel
+}
+
+func NewDelayHuaweiSubscriptionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DelayHuaweiSubscriptionLogic {
+	return &DelayHuaweiSubscriptionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		huaweiOrderModel: model.NewHuaweiOrderModel(define.DbPayGateway),
+	}
+}
+
+type HuaweiDelaySubscriptionResp struct {
+	ResponseCode      string `json:"responseCode"`      // 返回码。0：成功。 其他：失败，具体请参见错误码。
+	ResponseMessage   string `json:"responseMessage"`   // 失败原因描述信息。
+	NewExpirationTime int64  `json:"newExpirationTime"` // 延期后的过期时间，UTC时间戳，以毫秒为单位
+}
+
+// 华为订阅延期，用于客服补偿
+//
+// https://developer.huawei.com/consumer/cn/doc/HMSCore-References/api-postpone-subscription-0000001050706084
+func (l *DelayHuaweiSubscriptionLogic) DelayHuaweiSubscription(in *pb.DelayHuaweiSubscriptionReq) (*pb.DelayHuaweiSubscriptionResp, error) {
+	// 记录一下参数
+	l.Sloww("DelayHuaweiSubscription", logx.Field("in", in))
+
+	if in.GetDelayDays() <= 0 {
+		return &pb.DelayHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  "延期天数必须大于0",
+		}, nil
+	}
+
+	// 获取应用配置
+	appConfig, err := model.NewHuaweiAppModel(define.DbPayGateway).GetInfoByPkg(in.GetPkg())
+	if appConfig.ID < 1 || err != nil {
+		l.Errorf("获取华为应用配置失败 error: %v, pkg: %s", err, in.GetPkg())
+		return &pb.DelayHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  fmt.Sprintf("获取华为应用配置失败: %v", err),
+		}, nil
+	}
+
+	authHeaderString, err := huawei.NewClient(l.ctx, define.DbPayGateway, appConfig.ClientId, appConfig.ClientSecret, appConfig.AppSecret).BuildAuthorization()
+	if err != nil {
+		l.Errorf("华为BuildAuthorization失败 error: %v, pkg: %s", err, in.GetPkg())
+		return &pb.DelayHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  "华为BuildAuthorization失败: " + err.Error(),
+		}, nil
+	}
+
+	// 先查询订阅当前的过期时间
+	hwResp, err := huawei.SubscriptionDemo.GetSubscription(authHeaderString, in.GetSubscriptionId(), in.GetPurchaseToken())
+	if err != nil {
+		l.Errorf("华为查询订阅失败 err: %v", err)
+		return &pb.DelayHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  "华为查询订阅失败: " + err.Error(),
+		}, nil
+	}
+
+	var purchaseData huawei.InAppPurchaseData
+	err = json.Unmarshal([]byte(hwResp.InappPurchaseData), &purchaseData)
+	if err != nil {
+		l.Errorf("json.Unmarshal error: %v, raw string: %s", err, hwResp.InappPurchaseData)
+		return &pb.DelayHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  "华为查询订阅失败: " + err.Error(),
+		}, nil
+	}
+
+	if !purchaseData.SubIsvalid {
+		return &pb.DelayHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  "订阅已失效，不能延期",
+		}, nil
+	}
+
+	currentExpirationTime := purchaseData.ExpirationDate
+	desiredExpirationTime := currentExpirationTime + in.GetDelayDays()*24*3600*1000
+	tmpResult, err := huawei.SubscriptionDemo.DelaySubscription(authHeaderString, in.GetSubscriptionId(), in.GetPurchaseToken(), currentExpirationTime, desiredExpirationTime)
+	l.Sloww("huawei DelaySubscription", logx.Field("result", tmpResult), logx.Field("err", err))
+	if err != nil {
+		l.Errorf("华为订阅延期失败 err: %v", err)
+		return &pb.DelayHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  "华为订阅延期失败: " + err.Error(),
+		}, nil
+	}
+
+	var tmpRe HuaweiDelaySubscriptionResp
+	err = json.Unmarshal([]byte(tmpResult), &tmpRe)
+	if err != nil || tmpRe.ResponseCode != "0" {
+		msg := fmt.Sprintf("华为订阅延期失败 err: %v, responseCode: %s, responseMessage: %s", err, tmpRe.ResponseCode, tmpRe.ResponseMessage)
+		l.Error(msg)
+		return &pb.DelayHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  msg,
+		}, nil
+	}
+
+	// 更新订单过期时间并回调业务方
+	hworder, err := l.huaweiOrderModel.GetOneByTokenAndSubId(in.GetPurchaseToken(), in.GetSubscriptionId(), "", "")
+	if err == nil && hworder.Id > 0 {
+		newExpirationDate := int(tmpRe.NewExpirationTime / 1000)
+		_ = l.huaweiOrderModel.UpdateData(hworder.Id, map[string]interface{}{
+			"expiration_date": newExpirationDate,
+		})
+
+		huaweiSubscriptionCallback(l.ctx, hworder, code.APP_NOTIFY_HUAWEI_SUB_DELAY, map[string]interface{}{
+			"delay_days":      in.GetDelayDays(),
+			"expiration_date": newExpirationDate,
+		})
+	}
+
+	// 成功
+	return &pb.DelayHuaweiSubscriptionResp{
+		Code:              0,
+		Msg:               tmpResult,
+		NewExpirationTime: tmpRe.NewExpirationTime,
+	}, nil
+}
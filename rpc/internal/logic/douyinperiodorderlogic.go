@@ -49,7 +49,7 @@ func (l *DouyinPeriodOrderLogic) DouyinPeriodOrder(in *pb.DouyinPeriodOrderReq)
 	}
 
 	if in.GetAction() == pb.DouyinPeriodOrderReqAction_DyPeriodActionGetPayList {
-		// 获取今日可以扣款的签约订单信息
+		// 获取今日可以扣款的签约订单信息，不包含由网关扣款的包名(DyPeriodDeduct.AppPkgs)和签约单
 		return l.getSignedPayList()
 	}
 
@@ -79,7 +79,7 @@ func (l *DouyinPeriodOrderLogic) updateNexDecuctionTime(in *pb.DouyinPeriodOrder
 	}
 
 	err = mdl.UpdateSomeData(int(in.GetPmDyPeriodOrderId()), map[string]interface{}{
-		"next_decuction_time": tbl.NextDecuctionTime.AddDate(0, 1, 0).Format("2006-01-02 15:04:05"),
+		"next_decuction_time": tbl.NextDeductionTime(tbl.NextDecuctionTime).Format("2006-01-02 15:04:05"),
 	})
 	if err != nil {
 		l.Errorf("UpdateSomeData failed: %v", err)
@@ -94,7 +94,7 @@ func (l *DouyinPeriodOrderLogic) updateNexDecuctionTime(in *pb.DouyinPeriodOrder
 func (l *DouyinPeriodOrderLogic) getSignedPayList() (*pb.DouyinPeriodOrderResp, error) {
 	resp := pb.DouyinPeriodOrderResp{}
 
-	list, err := model.NewPmDyPeriodOrderModel(define.DbPayGateway).GetSignedPayList()
+	list, err := model.NewPmDyPeriodOrderModel(define.DbPayGateway).GetSignedPayList(l.svcCtx.Config.DyPeriodDeduct.AppPkgs)
 	if err != nil {
 		l.Errorf("getSignedPayList failed: %v", err)
 		return &resp, nil
@@ -170,7 +170,7 @@ func (l *DouyinPeriodOrderLogic) querySignOrder(in *pb.DouyinPeriodOrderReq) (*p
 
 	if signResult.UserSignData.Status == douyin.Dy_Sign_Status_Query_SERVING {
 		// 已签约
-		nextDecuctionTimeStr := time.Unix(signResult.UserSignData.SignTime/1000, 0).AddDate(0, 1, 0).Format("2006-01-02 15:04:05")
+		nextDecuctionTimeStr := periodModel.FirstDeductionTime(time.Unix(signResult.UserSignData.SignTime/1000, 0)).Format("2006-01-02 15:04:05")
 		updateData := map[string]interface{}{
 			"sign_status":         model.Sign_Status_Success,
 			"sign_date":           time.Unix(signResult.UserSignData.SignTime/1000, 0).Format("2006-01-02 15:04:05"), // 签约时间
@@ -218,6 +218,17 @@ func (l *DouyinPeriodOrderLogic) terminateSign(in *pb.DouyinPeriodOrderReq) (*pb
 		return &resp, nil
 	}
 
+	return l.terminateSignOrder(periodModel)
+}
+
+// 解约指定的已签约签约单
+func (l *DouyinPeriodOrderLogic) terminateSignOrder(periodModel *model.PmDyPeriodOrderTable) (*pb.DouyinPeriodOrderResp, error) {
+	resp := pb.DouyinPeriodOrderResp{
+		UserId:          int64(periodModel.UserId),
+		IsUnsignSuccess: false,
+		Msg:             "你未签约",
+	}
+
 	clientToken, err := l.svcCtx.BaseAppConfigServerApi.GetDyClientToken(l.ctx, periodModel.PayAppId)
 	if err != nil || clientToken == "" {
 		l.Errorw("get douyin client token fail", logx.Field("err", err), logx.Field("appId", periodModel.PayAppId))
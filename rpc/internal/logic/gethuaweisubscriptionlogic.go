@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/huawei"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetHuaweiSubscriptionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	huaweiOrderModel *model.HuaweiOrderModel
+}
+
+func NewGetHuaweiSubscriptionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetHuaweiSubscriptionLogic {
+	return &GetHuaweiSubscriptionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		huaweiOrderModel: model.NewHuaweiOrderModel(define.DbPayGateway),
+	}
+}
+
+// 查询华为订阅实时状态，同时同步订单的过期时间和续期状态
+//
+// https://developer.huawei.com/consumer/cn/doc/HMSCore-References/api-subscription-verify-purchase-token-0000001050706080
+func (l *GetHuaweiSubscriptionLogic) GetHuaweiSubscription(in *pb.UnsubscribeHuaweiReq) (*pb.GetHuaweiSubscriptionResp, error) {
+	// 获取应用配置
+	appConfig, err := model.NewHuaweiAppModel(define.DbPayGateway).GetInfoByPkg(in.GetPkg())
+	if appConfig.ID < 1 || err != nil {
+		l.Errorf("获取华为应用配置失败 error: %v, pkg: %s", err, in.GetPkg())
+		return &pb.GetHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  fmt.Sprintf("获取华为应用配置失败: %v", err),
+		}, nil
+	}
+
+	authHeaderString, err := huawei.NewClient(l.ctx, define.DbPayGateway, appConfig.ClientId, appConfig.ClientSecret, appConfig.AppSecret).BuildAuthorization()
+	if err != nil {
+		l.Errorf("华为BuildAuthorization失败 error: %v, pkg: %s", err, in.GetPkg())
+		return &pb.GetHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  "华为BuildAuthorization失败: " + err.Error(),
+		}, nil
+	}
+
+	hwResp, err := huawei.SubscriptionDemo.GetSubscription(authHeaderString, in.GetSubscriptionId(), in.GetPurchaseToken())
+	if err != nil {
+		l.Errorf("华为查询订阅失败 err: %v", err)
+		return &pb.GetHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  "华为查询订阅失败: " + err.Error(),
+		}, nil
+	}
+
+	var purchaseData huawei.InAppPurchaseData
+	err = json.Unmarshal([]byte(hwResp.InappPurchaseData), &purchaseData)
+	if err != nil {
+		l.Errorf("json.Unmarshal error: %v, raw string: %s", err, hwResp.InappPurchaseData)
+		return &pb.GetHuaweiSubscriptionResp{
+			Code: 1,
+			Msg:  "华为查询订阅失败: " + err.Error(),
+		}, nil
+	}
+
+	// 同步订单的过期时间和续期状态
+	hworder, err := l.huaweiOrderModel.GetOneByTokenAndSubId(in.GetPurchaseToken(), in.GetSubscriptionId(), "", "")
+	if err == nil && hworder.Id > 0 {
+		updateData := map[string]interface{}{
+			"auto_renew_status": purchaseData.RenewStatus,
+		}
+		if purchaseData.ExpirationDate > 1000 {
+			updateData["expiration_date"] = int(purchaseData.ExpirationDate / 1000)
+		}
+		_ = l.huaweiOrderModel.UpdateData(hworder.Id, updateData)
+	}
+
+	return &pb.GetHuaweiSubscriptionResp{
+		Code:              0,
+		Msg:               "success",
+		SubIsvalid:        purchaseData.SubIsvalid,
+		AutoRenewing:      purchaseData.AutoRenewing,
+		RenewStatus:       int32(purchaseData.RenewStatus),
+		PurchaseState:     int32(purchaseData.PurchaseState),
+		ProductId:         purchaseData.ProductId,
+		ExpirationDate:    purchaseData.ExpirationDate,
+		CancellationTime:  purchaseData.CancellationTime,
+		OrderId:           purchaseData.OrderId,
+		InappPurchaseData: hwResp.InappPurchaseData,
+	}, nil
+}
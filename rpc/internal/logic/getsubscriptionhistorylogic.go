@@ -0,0 +1,52 @@
+package logic
+
+import (
+	"context"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSubscriptionHistoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	subscriptionModel *model.SubscriptionModel
+}
+
+func NewGetSubscriptionHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSubscriptionHistoryLogic {
+	return &GetSubscriptionHistoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		subscriptionModel: model.NewSubscriptionModel(define.DbPayGateway),
+	}
+}
+
+// GetSubscriptionHistory 查询用户订阅的扣款记录
+func (l *GetSubscriptionHistoryLogic) GetSubscriptionHistory(in *pb.GetSubscriptionHistoryReq) (*pb.GetSubscriptionHistoryResp, error) {
+	list, err := l.subscriptionModel.GetSubscriptionHistory(int(in.GetUserId()), in.GetPkg(), in.GetChannel(), in.GetSubscriptionNo())
+	if err != nil {
+		l.Errorf("GetSubscriptionHistory failed: %v, userId: %d, pkg: %s", err, in.GetUserId(), in.GetPkg())
+		return nil, err
+	}
+
+	resp := &pb.GetSubscriptionHistoryResp{}
+	for _, charge := range list {
+		resp.List = append(resp.List, &pb.SubscriptionChargeInfo{
+			Channel:        charge.Channel,
+			SubscriptionNo: charge.SubscriptionNo,
+			OutTradeNo:     charge.OutTradeNo,
+			Amount:         int64(charge.Amount),
+			Status:         int32(charge.Status),
+			ChargeTime:     formatSubscriptionTime(charge.ChargeTime),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserSubscriptionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	subscriptionModel *model.SubscriptionModel
+}
+
+func NewGetUserSubscriptionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserSubscriptionsLogic {
+	return &GetUserSubscriptionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		subscriptionModel: model.NewSubscriptionModel(define.DbPayGateway),
+	}
+}
+
+// GetUserSubscriptions 查询用户在支付宝、抖音、华为的订阅
+func (l *GetUserSubscriptionsLogic) GetUserSubscriptions(in *pb.GetUserSubscriptionsReq) (*pb.GetUserSubscriptionsResp, error) {
+	list, err := l.subscriptionModel.GetUserSubscriptions(int(in.GetUserId()), in.GetPkg())
+	if err != nil {
+		l.Errorf("GetUserSubscriptions failed: %v, userId: %d, pkg: %s", err, in.GetUserId(), in.GetPkg())
+		return nil, err
+	}
+
+	resp := &pb.GetUserSubscriptionsResp{}
+	for _, sub := range list {
+		if in.GetOnlyActive() && sub.Status != model.Subscription_Status_Active {
+			continue
+		}
+		resp.List = append(resp.List, toSubscriptionInfo(sub))
+	}
+	return resp, nil
+}
+
+func toSubscriptionInfo(sub *model.UserSubscription) *pb.UserSubscriptionInfo {
+	return &pb.UserSubscriptionInfo{
+		Channel:        sub.Channel,
+		SubscriptionNo: sub.SubscriptionNo,
+		AppPkg:         sub.AppPkg,
+		UserId:         int64(sub.UserId),
+		OutTradeNo:     sub.OutTradeNo,
+		Status:         int32(sub.Status),
+		Amount:         int64(sub.Amount),
+		PeriodUnit:     sub.PeriodUnit,
+		PeriodCount:    int32(sub.PeriodCount),
+		SignTime:       formatSubscriptionTime(sub.SignTime),
+		NextChargeTime: formatSubscriptionTime(sub.NextChargeTime),
+	}
+}
+
+// 零值和默认时间返回空
+func formatSubscriptionTime(t time.Time) string {
+	if !t.After(model.Default2000Date) {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,258 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 配置列表默认和最大每页条数
+const (
+	listConfigDefaultPageSize = 20
+	listConfigMaxPageSize     = 100
+)
+
+type ListConfigLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	configAdminModel *model.ConfigAdminModel
+}
+
+func NewListConfigLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListConfigLogic {
+	return &ListConfigLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		configAdminModel: model.NewConfigAdminModel(define.DbPayGateway),
+	}
+}
+
+// ListConfig 分页查询应用、商户配置，密钥类字段脱敏返回
+func (l *ListConfigLogic) ListConfig(in *pb.ListConfigReq) (*pb.ListConfigResp, error) {
+	page := int(in.GetPage())
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(in.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = listConfigDefaultPageSize
+	} else if pageSize > listConfigMaxPageSize {
+		pageSize = listConfigMaxPageSize
+	}
+
+	where, err := listConfigWhere(in)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListConfigResp{}
+	switch in.GetTable() {
+	case model.PmAppConfigTableName:
+		var list []*model.PmAppConfigTable
+		resp.Total, err = l.configAdminModel.List(in.Table, where, page, pageSize, &list)
+		for _, row := range list {
+			resp.AppConfigs = append(resp.AppConfigs, toAppConfigInfo(row))
+		}
+	case model.PmPayConfigWechatTableName:
+		var list []*model.PmPayConfigWechatTable
+		resp.Total, err = l.configAdminModel.List(in.Table, where, page, pageSize, &list)
+		for _, row := range list {
+			decryptForList(row)
+			resp.Wechat = append(resp.Wechat, toWechatPayConfigInfo(row))
+		}
+	case model.PmPayConfigAlipayTableName:
+		var list []*model.PmPayConfigAlipayTable
+		resp.Total, err = l.configAdminModel.List(in.Table, where, page, pageSize, &list)
+		for _, row := range list {
+			decryptForList(row)
+			resp.Alipay = append(resp.Alipay, toAlipayPayConfigInfo(row))
+		}
+	case model.PmPayConfigTiktokTableName:
+		var list []*model.PmPayConfigTiktokTable
+		resp.Total, err = l.configAdminModel.List(in.Table, where, page, pageSize, &list)
+		for _, row := range list {
+			decryptForList(row)
+			resp.Tiktok = append(resp.Tiktok, toTiktokPayConfigInfo(row))
+		}
+	case model.PmPayConfigKsTableName:
+		var list []*model.PmPayConfigKsTable
+		resp.Total, err = l.configAdminModel.List(in.Table, where, page, pageSize, &list)
+		for _, row := range list {
+			decryptForList(row)
+			resp.Ks = append(resp.Ks, toKsPayConfigInfo(row))
+		}
+	case model.AppAlipayAppTableName:
+		var list []*model.AppAlipayAppTable
+		resp.Total, err = l.configAdminModel.List(in.Table, where, page, pageSize, &list)
+		for _, row := range list {
+			resp.AppAlipayApps = append(resp.AppAlipayApps, toAppAlipayAppInfo(row))
+		}
+	case model.HuaweiAppTableName:
+		var list []*model.HuaweiAppTable
+		resp.Total, err = l.configAdminModel.List(in.Table, where, page, pageSize, &list)
+		for _, row := range list {
+			decryptForList(row)
+			resp.HuaweiApps = append(resp.HuaweiApps, toHuaweiAppInfo(row))
+		}
+	default:
+		return nil, errors.New("不支持的配置表")
+	}
+	if err != nil {
+		return nil, errors.New("查询配置失败")
+	}
+	return resp, nil
+}
+
+// 按表拼接筛选条件，包名字段各表不同，商户配置表没有包名
+func listConfigWhere(in *pb.ListConfigReq) (map[string]interface{}, error) {
+	where := make(map[string]interface{})
+	if in.GetAppPkg() != "" {
+		switch in.GetTable() {
+		case model.PmAppConfigTableName:
+			where["app_pkg_name"] = in.AppPkg
+		case model.AppAlipayAppTableName, model.HuaweiAppTableName:
+			where["app_pkg"] = in.AppPkg
+		default:
+			return nil, errors.New("该配置表不支持按包名筛选")
+		}
+	}
+	if in.GetAppId() != "" {
+		if in.GetTable() == model.PmAppConfigTableName {
+			return nil, errors.New("该配置表不支持按appid筛选")
+		}
+		where["app_id"] = in.AppId
+	}
+	return where, nil
+}
+
+// 解密后再脱敏，解密失败的按密文脱敏返回，不影响其他配置
+func decryptForList(row model.SecretConfig) {
+	_ = row.DecryptSecrets()
+}
+
+// 密钥类字段脱敏，仅保留首尾4位
+func maskSecret(s string) string {
+	if s == "" {
+		return ""
+	}
+	if len(s) <= 8 {
+		return "****"
+	}
+	return s[:4] + "****" + s[len(s)-4:]
+}
+
+func toAppConfigInfo(row *model.PmAppConfigTable) *pb.AppConfigInfo {
+	return &pb.AppConfigInfo{
+		Id:             int64(row.ID),
+		AppPkgName:     row.AppPkgName,
+		AlipayAppId:    row.AlipayAppID,
+		WechatPayAppId: row.WechatPayAppID,
+		TiktokPayAppId: row.TiktokPayAppID,
+		KsPayAppId:     row.KsPayAppID,
+		Status:         int32(row.Status),
+		UpdatedAt:      row.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func toWechatPayConfigInfo(row *model.PmPayConfigWechatTable) *pb.WechatPayConfigInfo {
+	return &pb.WechatPayConfigInfo{
+		Id:             int64(row.ID),
+		AppId:          row.AppID,
+		MchId:          row.MchID,
+		ApiKey:         maskSecret(row.ApiKey),
+		ApiKeyV2:       maskSecret(row.ApiKeyV2),
+		NotifyUrl:      row.NotifyUrl,
+		PrivateKeyPath: row.PrivateKeyPath,
+		PublicKeyId:    row.PublicKeyId,
+		PublicKeyPath:  row.PublicKeyPath,
+		SerialNumber:   row.SerialNumber,
+		Remark:         row.Remark,
+		XPayAppKey:     maskSecret(row.XPayAppKey),
+		XPayOfferId:    row.XPayOfferId,
+		XPayMsgToken:   maskSecret(row.XPayMsgToken),
+		PlatformNumer:  row.PlatformNumer,
+		WapUrl:         row.WapUrl,
+		WapName:        row.WapName,
+		Status:         int32(row.Status),
+	}
+}
+
+func toAlipayPayConfigInfo(row *model.PmPayConfigAlipayTable) *pb.AlipayPayConfigInfo {
+	return &pb.AlipayPayConfigInfo{
+		Id:               int64(row.ID),
+		AppId:            row.AppID,
+		PrivateKey:       maskSecret(row.PrivateKey),
+		AppCertPublicKey: maskSecret(row.AppCertPublicKey),
+		PublicKey:        maskSecret(row.PublicKey),
+		PayRootCert:      maskSecret(row.PayRootCert),
+		IsProduction:     int32(row.IsProduction),
+		Remark:           row.Remark,
+		NotifyUrl:        row.NotifyUrl,
+		MerchantNo:       row.MerchantNo,
+		MerchantName:     row.MerchantName,
+		Status:           int32(row.Status),
+	}
+}
+
+func toTiktokPayConfigInfo(row *model.PmPayConfigTiktokTable) *pb.TiktokPayConfigInfo {
+	return &pb.TiktokPayConfigInfo{
+		Id:                 int64(row.ID),
+		AppId:              row.AppID,
+		Salt:               maskSecret(row.Salt),
+		NotifyUrl:          row.NotifyUrl,
+		Token:              maskSecret(row.Token),
+		Remark:             row.Remark,
+		PrivateKey:         maskSecret(row.PrivateKey),
+		KeyVersion:         row.KeyVersion,
+		PlatformPublicKey:  maskSecret(row.PlatformPublicKey),
+		CustomerImId:       row.CustomerImId,
+		MerchantUid:        row.MerchantUid,
+		SignPayMerchantUid: row.SignPayMerchantUid,
+		Status:             int32(row.Status),
+	}
+}
+
+func toKsPayConfigInfo(row *model.PmPayConfigKsTable) *pb.KsPayConfigInfo {
+	return &pb.KsPayConfigInfo{
+		Id:        int64(row.ID),
+		AppId:     row.AppID,
+		AppSecret: maskSecret(row.AppSecret),
+		NotifyUrl: row.NotifyUrl,
+		Remark:    row.Remark,
+		Status:    int32(row.Status),
+	}
+}
+
+func toAppAlipayAppInfo(row *model.AppAlipayAppTable) *pb.AppAlipayAppInfo {
+	return &pb.AppAlipayAppInfo{
+		Id:         int64(row.ID),
+		AppId:      row.AppID,
+		AppPkg:     row.AppPkg,
+		RelateType: int32(row.RelateType),
+		SortNo:     int32(row.SortNo),
+		Status:     int32(row.Status),
+	}
+}
+
+func toHuaweiAppInfo(row *model.HuaweiAppTable) *pb.HuaweiAppInfo {
+	return &pb.HuaweiAppInfo{
+		Id:                int64(row.ID),
+		AppId:             row.AppID,
+		AppPkg:            row.AppPkg,
+		AppSecret:         maskSecret(row.AppSecret),
+		ClientId:          row.ClientId,
+		ClientSecret:      maskSecret(row.ClientSecret),
+		Sha256Fingerprint: row.Sha256Fingerprint,
+		IapPublicKey:      maskSecret(row.IapPublicKey),
+		Status:            int32(row.Status),
+	}
+}
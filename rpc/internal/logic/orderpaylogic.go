@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +17,8 @@ import (
 	douyin "gitlab.muchcloud.com/consumer-project/pay-gateway/common/client/douyinGeneralTrade"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/thirdApis"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/types"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/utils"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
@@ -32,6 +35,7 @@ var (
 	tiktokEcPayFailNum     = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "tiktokEcPayFailNum", nil, "字节支付下单失败", nil})}
 	alipayWebPayFailNum    = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "alipayWebPayFailNum", nil, "支付宝下单失败", nil})}
 	ksPayFailNum           = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "ksPayFailNum", nil, "快手支付下单失败", nil})}
+	wechatXPayFailNum      = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "wechatXPayFailNum", nil, "微信虚拟支付签名失败", nil})}
 
 	orderTableIOFailNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "orderTableIOFailNum", nil, "订单io失败", nil})}
 )
@@ -94,14 +98,32 @@ func (l *OrderPayLogic) OrderPay(in *pb.OrderPayReq) (out *pb.OrderPayResp, err
 		payAppId = pkgCfg.KsPayAppID
 	case pb.PayType_WxUnified:
 		payAppId = pkgCfg.WechatPayAppID
+	case pb.PayType_WxXPay:
+		payAppId = pkgCfg.WechatPayAppID
 	}
 
 	tmpOrderSn := ""
 	tmpOrderAmount := 0
 	tmpOrderSubject := ""
+	var schedule *types.SubscribeSchedule
 
 	if in.IsPeriodProduct {
 		// 周期签约订单 (目前只有抖音有)
+		if !model.IsValidDyPeriodUnit(in.GetPeriodUnit()) {
+			err = fmt.Errorf("不支持的扣款周期单位: %s", in.GetPeriodUnit())
+			return
+		}
+		periodUnit, periodCount := in.GetPeriodUnit(), int(in.GetPeriodCount())
+		if periodUnit == "" {
+			periodUnit = model.Dy_Period_Unit_Month
+		}
+		if periodCount < 1 {
+			periodCount = 1
+		}
+		if in.GetTrialDays() < 0 {
+			err = fmt.Errorf("试用天数错误: %d", in.GetTrialDays())
+			return
+		}
 		orderInfo := &model.PmDyPeriodOrderTable{
 			OrderSn:           in.GetOrderSn(),                        // 内部 订单唯一标识
 			SignNo:            in.GetOrderSn() + dy_sign_order_suffix, // 内部 签约单号
@@ -119,6 +141,9 @@ func (l *OrderPayLogic) OrderPay(in *pb.OrderPayReq) (out *pb.OrderPayResp, err
 			ExpireDate:        model.Default2000Date,
 			NextDecuctionTime: model.Default2000Date,
 			DyProductId:       in.DouyinGeneralTradeReq.GetSkuId(), // 抖音商品id
+			PeriodUnit:        periodUnit,
+			PeriodCount:       periodCount,
+			TrialDays:         int(in.GetTrialDays()),
 		}
 		// 数据库有唯一约束 如果重复 创建的时候会报错
 		err = l.payDyPeriodOrderModel.Create(orderInfo)
@@ -129,6 +154,20 @@ func (l *OrderPayLogic) OrderPay(in *pb.OrderPayReq) (out *pb.OrderPayResp, err
 			return
 		}
 
+		// 按现在签约推算扣款计划，首期金额为首期优惠金额，没有时为订单金额
+		firstAmount := int(in.GetFirstDeductionAmount())
+		if firstAmount <= 0 {
+			firstAmount = orderInfo.Amount
+		}
+		schedule = &types.SubscribeSchedule{
+			PeriodType:      periodUnit,
+			Period:          periodCount,
+			FirstDeductTime: orderInfo.FirstDeductionTime(time.Now()),
+			PeriodAmount:    orderInfo.Amount,
+			FirstAmount:     firstAmount,
+			TrialDays:       orderInfo.TrialDays,
+		}
+
 		tmpOrderSn = orderInfo.OrderSn
 		tmpOrderAmount = orderInfo.Amount
 		tmpOrderSubject = orderInfo.Subject
@@ -177,6 +216,7 @@ func (l *OrderPayLogic) OrderPay(in *pb.OrderPayReq) (out *pb.OrderPayResp, err
 
 	out = new(pb.OrderPayResp)
 	out.PayType = in.PayType
+	out.Schedule = toPbSubscribeSchedule(schedule)
 
 	payOrder := &client.PayOrder{
 		OrderSn: tmpOrderSn,
@@ -275,6 +315,15 @@ func (l *OrderPayLogic) OrderPay(in *pb.OrderPayReq) (out *pb.OrderPayResp, err
 		}
 
 		out.DouyinGeneralTrade, err = l.createDouyinGeneralTradeOrder(in, payCfg.GetGeneralTradeConfig())
+	case pb.PayType_WxXPay:
+		// 微信小程序虚拟支付
+		payCfg, cfgErr := l.payConfigWechatModel.GetOneByAppID(pkgCfg.WechatPayAppID)
+		if cfgErr != nil || payCfg.XPayAppKey == "" || payCfg.XPayOfferId == "" {
+			err = fmt.Errorf("pkgName= %s, 读取微信xpay支付配置失败，err:=%v", in.AppPkgName, cfgErr)
+			util.Error(l.ctx, err.Error())
+			return
+		}
+		out.WxXPay, err = l.createWeChatXPayOrder(in, payOrder, payCfg)
 	}
 
 	return
@@ -299,6 +348,11 @@ func (l *OrderPayLogic) createOrderBaseSignedOrder(in *pb.OrderPayReq) (*pb.Orde
 		return out, errors.New("用户ID不匹配")
 	}
 
+	// 由网关扣款的签约单不能再由业务方创建代扣单，避免同一签约重复扣款
+	if slices.Contains(l.svcCtx.Config.DyPeriodDeduct.AppPkgs, tbl.AppPkgName) || tbl.SignOrderId > 0 || tbl.NthNum > 0 {
+		return out, errors.New("该签约单由网关扣款，不能创建代扣单")
+	}
+
 	newAmount := int(in.GetAmount())
 	newOrderSn := utils.GenerateOrderCode(l.svcCtx.Config.SnowFlake.MachineNo, l.svcCtx.Config.SnowFlake.WorkerNo)
 
@@ -317,9 +371,11 @@ func (l *OrderPayLogic) createOrderBaseSignedOrder(in *pb.OrderPayReq) (*pb.Orde
 		SignDate:          tbl.SignDate, // 默认时间
 		UnsignDate:        tbl.UnsignDate,
 		ExpireDate:        tbl.ExpireDate,
-		NextDecuctionTime: tbl.NextDecuctionTime.AddDate(0, 1, 0),
+		NextDecuctionTime: tbl.NextDeductionTime(tbl.NextDecuctionTime),
 		DyProductId:       tbl.DyProductId,     // 抖音商品id
 		NthNum:            int(in.GetNthNum()), // 第几期代扣单
+		PeriodUnit:        tbl.PeriodUnit,
+		PeriodCount:       tbl.PeriodCount,
 	}
 
 	// 数据库有唯一约束 如果重复 创建的时候会报错
@@ -521,6 +577,72 @@ func (l *OrderPayLogic) createKsOrder(in *pb.OrderPayReq, info *client.PayOrder,
 	return
 }
 
+// 微信小程序虚拟支付，返回 wx.requestVirtualPayment 所需的签名参数
+func (l *OrderPayLogic) createWeChatXPayOrder(in *pb.OrderPayReq, info *client.PayOrder, payConf *model.PmPayConfigWechatTable) (reply *pb.WxXPayReply, err error) {
+	xpayReq := in.GetWxXPayReq()
+	if xpayReq == nil || xpayReq.SessionKey == "" {
+		err = errors.New("参数WxXPayReq.SessionKey为空")
+		return
+	}
+
+	signParam := &thirdApis.XPaySignDataParam{
+		OfferId:      payConf.XPayOfferId,
+		BuyQuantity:  xpayReq.BuyQuantity,
+		Env:          0, // 只使用现网环境
+		CurrencyType: "CNY",
+		OutTradeNo:   info.OrderSn,
+		Attach:       xpayReq.Attach,
+	}
+
+	mode := xpayReq.Mode
+	if mode == "" {
+		mode = thirdApis.XPayModeGoods
+	}
+	switch mode {
+	case thirdApis.XPayModeGoods:
+		// 道具直购，需要道具id和单价
+		if xpayReq.ProductId == "" {
+			err = errors.New("道具直购参数ProductId为空")
+			return
+		}
+		if signParam.BuyQuantity <= 0 {
+			signParam.BuyQuantity = 1
+		}
+		// 单价为整数分，订单金额必须能按数量整除，否则实际扣款与订单金额不一致
+		if info.Amount%int(signParam.BuyQuantity) != 0 {
+			err = fmt.Errorf("订单金额%d分不能按购买数量%d整除", info.Amount, signParam.BuyQuantity)
+			return
+		}
+		signParam.ProductId = xpayReq.ProductId
+		signParam.GoodsPrice = info.Amount / int(signParam.BuyQuantity)
+	case thirdApis.XPayModeCoin:
+		// 代币充值，购买数量即代币数量
+		if signParam.BuyQuantity <= 0 {
+			err = errors.New("代币充值参数BuyQuantity必须大于0")
+			return
+		}
+	default:
+		err = fmt.Errorf("不支持的虚拟支付类型: %s", mode)
+		return
+	}
+
+	res, err := thirdApis.WechatXPayApi.SignVirtualPayment(signParam, payConf.XPayAppKey, xpayReq.SessionKey)
+	if err != nil {
+		wechatXPayFailNum.CounterInc()
+		util.Error(l.ctx, "SignVirtualPayment pkgName: %s, err: %v", in.AppPkgName, err)
+		return
+	}
+
+	reply = &pb.WxXPayReply{
+		Mode:      mode,
+		SignData:  res.SignData,
+		PaySig:    res.PaySig,
+		Signature: res.Signature,
+		OrderSn:   info.OrderSn,
+	}
+	return
+}
+
 func (l *OrderPayLogic) checkDouyinGeneralTradeParam(in *pb.OrderPayReq) error {
 	if in.DouyinGeneralTradeReq == nil {
 		return errors.New("invalid DouyinGeneralTradeReq")
@@ -0,0 +1,94 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/huawei"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReturnFeeHuaweiSubscriptionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	huaweiOrderModel *model.HuaweiOrderModel
+}
+
+func NewReturnFeeHuaweiSubscriptionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReturnFeeHuaweiSubscriptionLogic {
+	return &ReturnFeeHuaweiSubscriptionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		huaweiOrderModel: model.NewHuaweiOrderModel(define.DbPayGateway),
+	}
+}
+
+// 华为订阅返还最近一期费用，不取消订阅
+//
+// 订阅继续有效，到期后正常续费，业务方根据回调自行决定是否收回本期权益
+//
+// https://developer.huawei.com/consumer/cn/doc/HMSCore-References/api-refund-subscription-fee-0000001050986131
+func (l *ReturnFeeHuaweiSubscriptionLogic) ReturnFeeHuaweiSubscription(in *pb.UnsubscribeHuaweiReq) (*pb.UnsubscribeHuaweiResp, error) {
+	// 记录一下参数
+	l.Sloww("ReturnFeeHuaweiSubscription", logx.Field("in", in))
+
+	// 获取应用配置
+	appConfig, err := model.NewHuaweiAppModel(define.DbPayGateway).GetInfoByPkg(in.GetPkg())
+	if appConfig.ID < 1 || err != nil {
+		l.Errorf("获取华为应用配置失败 error: %v, pkg: %s", err, in.GetPkg())
+		return &pb.UnsubscribeHuaweiResp{
+			Code: 1,
+			Msg:  fmt.Sprintf("获取华为应用配置失败: %v", err),
+		}, nil
+	}
+
+	authHeaderString, err := huawei.NewClient(l.ctx, define.DbPayGateway, appConfig.ClientId, appConfig.ClientSecret, appConfig.AppSecret).BuildAuthorization()
+	if err != nil {
+		l.Errorf("华为BuildAuthorization失败 error: %v, pkg: %s", err, in.GetPkg())
+		return &pb.UnsubscribeHuaweiResp{
+			Code: 1,
+			Msg:  "华为BuildAuthorization失败: " + err.Error(),
+		}, nil
+	}
+
+	tmpResult, err := huawei.SubscriptionDemo.ReturnFeeSubscription(authHeaderString, in.GetSubscriptionId(), in.GetPurchaseToken())
+	l.Sloww("huawei ReturnFeeSubscription", logx.Field("result", tmpResult), logx.Field("err", err))
+	if err == nil {
+		err = checkHuaweiSubscriptionResult(tmpResult)
+	}
+	if err != nil {
+		l.Errorf("华为返还订阅费用失败 err: %v", err)
+		return &pb.UnsubscribeHuaweiResp{
+			Code: 1,
+			Msg:  "华为返还订阅费用失败: " + err.Error(),
+		}, nil
+	}
+
+	// 记录返还时间并回调业务方，订阅仍有效，订单状态不变
+	hworder, err := l.huaweiOrderModel.GetOneByTokenAndSubId(in.GetPurchaseToken(), in.GetSubscriptionId(), "", "")
+	if err == nil && hworder.Id > 0 {
+		_ = l.huaweiOrderModel.UpdateData(hworder.Id, map[string]interface{}{
+			"return_fee_time": time.Now().Unix(),
+		})
+
+		huaweiSubscriptionCallback(l.ctx, hworder, code.APP_NOTIFY_HUAWEI_SUB_RETURN_FEE, map[string]interface{}{
+			"platform_trade_no": hworder.PlatformTradeNo,
+		})
+	}
+
+	// 成功
+	return &pb.UnsubscribeHuaweiResp{
+		Code: 0,
+		Msg:  tmpResult,
+	}, nil
+}
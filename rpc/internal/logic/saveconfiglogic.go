@@ -0,0 +1,592 @@
+package logic
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	douyin "gitlab.muchcloud.com/consumer-project/pay-gateway/common/client/douyinGeneralTrade"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/envelope"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/auth"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 各平台appid、商户号等的格式
+var (
+	wechatAppIdReg       = regexp.MustCompile(`^wx[0-9a-f]{16}$`)
+	wechatMchIdReg       = regexp.MustCompile(`^\d{8,10}$`)
+	wechatApiKeyReg      = regexp.MustCompile(`^[0-9A-Za-z]{32}$`)
+	wechatSerialNoReg    = regexp.MustCompile(`^[0-9A-Fa-f]{40}$`)
+	alipayAppIdReg       = regexp.MustCompile(`^\d{16}$`)
+	tiktokAppIdReg       = regexp.MustCompile(`^tt[0-9a-z]{16}$`)
+	ksAppIdReg           = regexp.MustCompile(`^ks\d+$`)
+	huaweiIdReg          = regexp.MustCompile(`^\d+$`)
+	huaweiFingerprintReg = regexp.MustCompile(`^[0-9A-Fa-f]{2}(:?[0-9A-Fa-f]{2}){31}$`)
+)
+
+type SaveConfigLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	configAdminModel *model.ConfigAdminModel
+}
+
+func NewSaveConfigLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SaveConfigLogic {
+	return &SaveConfigLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		configAdminModel: model.NewConfigAdminModel(define.DbPayGateway),
+	}
+}
+
+// SaveConfig 新建或更新应用、商户配置
+//
+// Id为0时新建，新建的配置为启用状态；更新时商户配置中为空的字段不修改，应用配置整行覆盖。保存后记录审计日志并使相关缓存失效
+func (l *SaveConfigLogic) SaveConfig(in *pb.SaveConfigReq) (*pb.SaveConfigResp, error) {
+	// 操作人取认证通过的管理员，不使用请求中的Operator
+	operator := auth.Operator(l.ctx)
+	if operator == "" {
+		return &pb.SaveConfigResp{
+			Code: 1,
+			Msg:  "未认证的操作人",
+		}, nil
+	}
+
+	var id int
+	var err error
+	switch in.GetTable() {
+	case model.PmAppConfigTableName:
+		id, err = l.saveAppConfig(in.GetAppConfig(), operator)
+	case model.PmPayConfigWechatTableName:
+		id, err = l.saveWechat(in.GetWechat(), operator)
+	case model.PmPayConfigAlipayTableName:
+		id, err = l.saveAlipay(in.GetAlipay(), operator)
+	case model.PmPayConfigTiktokTableName:
+		id, err = l.saveTiktok(in.GetTiktok(), operator)
+	case model.PmPayConfigKsTableName:
+		id, err = l.saveKs(in.GetKs(), operator)
+	case model.AppAlipayAppTableName:
+		id, err = l.saveAppAlipayApp(in.GetAppAlipayApp(), operator)
+	case model.HuaweiAppTableName:
+		id, err = l.saveHuaweiApp(in.GetHuaweiApp(), operator)
+	default:
+		err = errors.New("不支持的配置表")
+	}
+	if err != nil {
+		l.Errorw("保存配置失败", logx.Field("table", in.GetTable()), logx.Field("operator", operator), logx.Field("err", err))
+		return &pb.SaveConfigResp{
+			Code: 1,
+			Msg:  err.Error(),
+		}, nil
+	}
+
+	return &pb.SaveConfigResp{
+		Code: 0,
+		Msg:  "保存成功",
+		Id:   int64(id),
+	}, nil
+}
+
+func (l *SaveConfigLogic) saveAppConfig(info *pb.AppConfigInfo, operator string) (int, error) {
+	if info == nil {
+		return 0, errors.New("AppConfig不能为空")
+	}
+
+	row := &model.PmAppConfigTable{}
+	if err := l.loadForSave(model.PmAppConfigTableName, int(info.Id), row); err != nil {
+		return 0, err
+	}
+	if row.ID == 0 {
+		if info.AppPkgName == "" {
+			return 0, errors.New("包名不能为空")
+		}
+		if err := l.checkUnique(model.PmAppConfigTableName, "`app_pkg_name` = ?", info.AppPkgName); err != nil {
+			return 0, err
+		}
+		row.AppPkgName = info.AppPkgName
+		row.Status = model.Config_Status_Enabled
+		row.CreatedAt = time.Now()
+	} else if info.AppPkgName != "" && info.AppPkgName != row.AppPkgName {
+		return 0, errors.New("不能修改包名")
+	}
+
+	row.AlipayAppID = info.AlipayAppId
+	row.WechatPayAppID = info.WechatPayAppId
+	row.TiktokPayAppID = info.TiktokPayAppId
+	row.KsPayAppID = info.KsPayAppId
+	row.UpdatedAt = time.Now()
+	if err := l.checkAppConfigRefs(row); err != nil {
+		return 0, err
+	}
+
+	if err := l.save(row, &row.ID, newConfigAudit(model.PmAppConfigTableName, int(info.Id), operator, toAppConfigInfo(row))); err != nil {
+		return 0, err
+	}
+	model.NewPmAppConfigModel(define.DbPayGateway).ExpireCache(row.AppPkgName)
+	clientMgr.ExpireAlipayClientCache(row.AppPkgName, "")
+	return row.ID, nil
+}
+
+func (l *SaveConfigLogic) saveWechat(info *pb.WechatPayConfigInfo, operator string) (int, error) {
+	if info == nil {
+		return 0, errors.New("Wechat不能为空")
+	}
+
+	row := &model.PmPayConfigWechatTable{}
+	if err := l.loadPayConfigForSave(model.PmPayConfigWechatTableName, int(info.Id), info.AppId, &row.AppID, row); err != nil {
+		return 0, err
+	}
+	if row.ID == 0 {
+		row.Status = model.Config_Status_Enabled
+	}
+	setIfNotEmpty(&row.MchID, info.MchId)
+	setIfNotEmpty(&row.ApiKey, info.ApiKey)
+	setIfNotEmpty(&row.ApiKeyV2, info.ApiKeyV2)
+	setIfNotEmpty(&row.NotifyUrl, info.NotifyUrl)
+	setIfNotEmpty(&row.PrivateKeyPath, info.PrivateKeyPath)
+	setIfNotEmpty(&row.PublicKeyId, info.PublicKeyId)
+	setIfNotEmpty(&row.PublicKeyPath, info.PublicKeyPath)
+	setIfNotEmpty(&row.SerialNumber, info.SerialNumber)
+	setIfNotEmpty(&row.Remark, info.Remark)
+	setIfNotEmpty(&row.XPayAppKey, info.XPayAppKey)
+	setIfNotEmpty(&row.XPayOfferId, info.XPayOfferId)
+	setIfNotEmpty(&row.XPayMsgToken, info.XPayMsgToken)
+	setIfNotEmpty(&row.PlatformNumer, info.PlatformNumer)
+	setIfNotEmpty(&row.WapUrl, info.WapUrl)
+	setIfNotEmpty(&row.WapName, info.WapName)
+	if err := validateWechatPayConfig(row); err != nil {
+		return 0, err
+	}
+
+	if err := l.save(row, &row.ID, newConfigAudit(model.PmPayConfigWechatTableName, int(info.Id), operator, toWechatPayConfigInfo(row))); err != nil {
+		return 0, err
+	}
+	model.NewPmPayConfigWechatModel(define.DbPayGateway).ExpireCache(row.AppID)
+	return row.ID, nil
+}
+
+func (l *SaveConfigLogic) saveAlipay(info *pb.AlipayPayConfigInfo, operator string) (int, error) {
+	if info == nil {
+		return 0, errors.New("Alipay不能为空")
+	}
+
+	row := &model.PmPayConfigAlipayTable{}
+	if err := l.loadPayConfigForSave(model.PmPayConfigAlipayTableName, int(info.Id), info.AppId, &row.AppID, row); err != nil {
+		return 0, err
+	}
+	if row.ID == 0 {
+		row.Status = model.Config_Status_Enabled
+		row.CreatedAt = time.Now()
+	}
+	setIfNotEmpty(&row.PrivateKey, info.PrivateKey)
+	setIfNotEmpty(&row.AppCertPublicKey, info.AppCertPublicKey)
+	setIfNotEmpty(&row.PublicKey, info.PublicKey)
+	setIfNotEmpty(&row.PayRootCert, info.PayRootCert)
+	setIfNotEmpty(&row.Remark, info.Remark)
+	setIfNotEmpty(&row.NotifyUrl, info.NotifyUrl)
+	setIfNotEmpty(&row.MerchantNo, info.MerchantNo)
+	setIfNotEmpty(&row.MerchantName, info.MerchantName)
+	row.IsProduction = int(info.IsProduction)
+	row.UpdatedAt = time.Now()
+	if err := validateAlipayPayConfig(row); err != nil {
+		return 0, err
+	}
+
+	if err := l.save(row, &row.ID, newConfigAudit(model.PmPayConfigAlipayTableName, int(info.Id), operator, toAlipayPayConfigInfo(row))); err != nil {
+		return 0, err
+	}
+	clientMgr.ExpireAlipayClientCache("", row.AppID)
+	return row.ID, nil
+}
+
+func (l *SaveConfigLogic) saveTiktok(info *pb.TiktokPayConfigInfo, operator string) (int, error) {
+	if info == nil {
+		return 0, errors.New("Tiktok不能为空")
+	}
+
+	row := &model.PmPayConfigTiktokTable{}
+	if err := l.loadPayConfigForSave(model.PmPayConfigTiktokTableName, int(info.Id), info.AppId, &row.AppID, row); err != nil {
+		return 0, err
+	}
+	if row.ID == 0 {
+		row.Status = model.Config_Status_Enabled
+	}
+	setIfNotEmpty(&row.Salt, info.Salt)
+	setIfNotEmpty(&row.NotifyUrl, info.NotifyUrl)
+	setIfNotEmpty(&row.Token, info.Token)
+	setIfNotEmpty(&row.Remark, info.Remark)
+	setIfNotEmpty(&row.PrivateKey, info.PrivateKey)
+	setIfNotEmpty(&row.KeyVersion, info.KeyVersion)
+	setIfNotEmpty(&row.PlatformPublicKey, info.PlatformPublicKey)
+	setIfNotEmpty(&row.CustomerImId, info.CustomerImId)
+	setIfNotEmpty(&row.MerchantUid, info.MerchantUid)
+	setIfNotEmpty(&row.SignPayMerchantUid, info.SignPayMerchantUid)
+	if err := validateTiktokPayConfig(row); err != nil {
+		return 0, err
+	}
+
+	if err := l.save(row, &row.ID, newConfigAudit(model.PmPayConfigTiktokTableName, int(info.Id), operator, toTiktokPayConfigInfo(row))); err != nil {
+		return 0, err
+	}
+	return row.ID, nil
+}
+
+func (l *SaveConfigLogic) saveKs(info *pb.KsPayConfigInfo, operator string) (int, error) {
+	if info == nil {
+		return 0, errors.New("Ks不能为空")
+	}
+
+	row := &model.PmPayConfigKsTable{}
+	if err := l.loadPayConfigForSave(model.PmPayConfigKsTableName, int(info.Id), info.AppId, &row.AppID, row); err != nil {
+		return 0, err
+	}
+	if row.ID == 0 {
+		row.Status = model.Config_Status_Enabled
+		row.CreatedAt = time.Now()
+	}
+	setIfNotEmpty(&row.AppSecret, info.AppSecret)
+	setIfNotEmpty(&row.NotifyUrl, info.NotifyUrl)
+	setIfNotEmpty(&row.Remark, info.Remark)
+	row.UpdatedAt = time.Now()
+	if !ksAppIdReg.MatchString(row.AppID) {
+		return 0, errors.New("快手appid格式错误")
+	}
+	if row.AppSecret == "" {
+		return 0, errors.New("AppSecret不能为空")
+	}
+	if err := checkNotifyUrl(row.NotifyUrl, true); err != nil {
+		return 0, err
+	}
+
+	if err := l.save(row, &row.ID, newConfigAudit(model.PmPayConfigKsTableName, int(info.Id), operator, toKsPayConfigInfo(row))); err != nil {
+		return 0, err
+	}
+	return row.ID, nil
+}
+
+func (l *SaveConfigLogic) saveAppAlipayApp(info *pb.AppAlipayAppInfo, operator string) (int, error) {
+	if info == nil {
+		return 0, errors.New("AppAlipayApp不能为空")
+	}
+
+	row := &model.AppAlipayAppTable{}
+	if err := l.loadForSave(model.AppAlipayAppTableName, int(info.Id), row); err != nil {
+		return 0, err
+	}
+	if row.ID == 0 {
+		if info.AppPkg == "" {
+			return 0, errors.New("包名不能为空")
+		}
+		if err := l.checkUnique(model.AppAlipayAppTableName, "`app_pkg` = ? and `app_id` = ?", info.AppPkg, info.AppId); err != nil {
+			return 0, err
+		}
+		row.AppPkg = info.AppPkg
+		row.Status = model.Config_Status_Enabled
+	} else if info.AppPkg != "" && info.AppPkg != row.AppPkg {
+		return 0, errors.New("不能修改包名")
+	}
+
+	setIfNotEmpty(&row.AppID, info.AppId)
+	row.RelateType = int(info.RelateType)
+	row.SortNo = int(info.SortNo)
+	if row.RelateType != 1 && row.RelateType != 2 {
+		return 0, errors.New("关联类型只能是1备用或2兜底")
+	}
+	if err := l.checkPayAppEnabled(model.PmPayConfigAlipayTableName, row.AppID); err != nil {
+		return 0, err
+	}
+
+	if err := l.save(row, &row.ID, newConfigAudit(model.AppAlipayAppTableName, int(info.Id), operator, toAppAlipayAppInfo(row))); err != nil {
+		return 0, err
+	}
+	model.NewAppAlipayAppModel(define.DbPayGateway).ExpireCache(row.AppPkg)
+	return row.ID, nil
+}
+
+func (l *SaveConfigLogic) saveHuaweiApp(info *pb.HuaweiAppInfo, operator string) (int, error) {
+	if info == nil {
+		return 0, errors.New("HuaweiApp不能为空")
+	}
+
+	row := &model.HuaweiAppTable{}
+	if err := l.loadPayConfigForSave(model.HuaweiAppTableName, int(info.Id), info.AppId, &row.AppID, row); err != nil {
+		return 0, err
+	}
+	if row.ID == 0 {
+		row.Status = model.Config_Status_Enabled
+	}
+	setIfNotEmpty(&row.AppPkg, info.AppPkg)
+	setIfNotEmpty(&row.AppSecret, info.AppSecret)
+	setIfNotEmpty(&row.ClientId, info.ClientId)
+	setIfNotEmpty(&row.ClientSecret, info.ClientSecret)
+	setIfNotEmpty(&row.Sha256Fingerprint, info.Sha256Fingerprint)
+	setIfNotEmpty(&row.IapPublicKey, info.IapPublicKey)
+	if err := validateHuaweiApp(row); err != nil {
+		return 0, err
+	}
+
+	if err := l.save(row, &row.ID, newConfigAudit(model.HuaweiAppTableName, int(info.Id), operator, toHuaweiAppInfo(row))); err != nil {
+		return 0, err
+	}
+	model.NewHuaweiAppModel(define.DbPayGateway).ExpireCache(row.AppID)
+	return row.ID, nil
+}
+
+// 更新时读取原配置，新建时row保持零值
+func (l *SaveConfigLogic) loadForSave(table string, id int, row interface{}) error {
+	if id == 0 {
+		return nil
+	}
+	if err := l.configAdminModel.GetById(id, row); err != nil {
+		return fmt.Errorf("%s配置不存在 id: %d", table, id)
+	}
+	// 商户配置的密钥字段解密后再合并和校验
+	if secretCfg, ok := row.(model.SecretConfig); ok {
+		return secretCfg.DecryptSecrets()
+	}
+	return nil
+}
+
+// 保存配置，商户配置的密钥字段加密后落库；审计内容在加密前已脱敏生成
+func (l *SaveConfigLogic) save(row interface{}, rowId *int, audit *model.PmConfigAuditLogTable) error {
+	if secretCfg, ok := row.(model.SecretConfig); ok {
+		if err := secretCfg.EncryptSecrets(); err != nil {
+			l.Errorf("加密商户密钥失败 table: %s, err: %v", audit.ConfigTable, err)
+			if errors.Is(err, envelope.ErrNoMasterKey) {
+				return err
+			}
+			return errors.New("加密密钥失败")
+		}
+	}
+	if err := l.configAdminModel.Save(row, rowId, audit); err != nil {
+		return errors.New("保存失败")
+	}
+	return nil
+}
+
+// 以appid为唯一标识的配置：新建时appid不能重复，更新时不能修改appid
+func (l *SaveConfigLogic) loadPayConfigForSave(table string, id int, appId string, rowAppId *string, row interface{}) error {
+	if err := l.loadForSave(table, id, row); err != nil {
+		return err
+	}
+	if id > 0 {
+		if appId != "" && appId != *rowAppId {
+			return errors.New("不能修改appid")
+		}
+		return nil
+	}
+	if appId == "" {
+		return errors.New("appid不能为空")
+	}
+	if err := l.checkUnique(table, "`app_id` = ?", appId); err != nil {
+		return err
+	}
+	*rowAppId = appId
+	return nil
+}
+
+func (l *SaveConfigLogic) checkUnique(table string, query string, args ...interface{}) error {
+	total, err := l.configAdminModel.Count(table, query, args...)
+	if err != nil {
+		return errors.New("查询配置失败")
+	}
+	if total > 0 {
+		return fmt.Errorf("%s中已存在相同配置", table)
+	}
+	return nil
+}
+
+// 应用配置关联的各支付appid需已启用
+func (l *SaveConfigLogic) checkAppConfigRefs(row *model.PmAppConfigTable) error {
+	refs := []struct {
+		table string
+		appId string
+	}{
+		{model.PmPayConfigAlipayTableName, row.AlipayAppID},
+		{model.PmPayConfigWechatTableName, row.WechatPayAppID},
+		{model.PmPayConfigTiktokTableName, row.TiktokPayAppID},
+		{model.PmPayConfigKsTableName, row.KsPayAppID},
+	}
+	for _, ref := range refs {
+		if err := l.checkPayAppEnabled(ref.table, ref.appId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 支付appid需已在商户配置表中启用，为空的不检查
+func (l *SaveConfigLogic) checkPayAppEnabled(table string, appId string) error {
+	if appId == "" {
+		return nil
+	}
+	total, err := l.configAdminModel.Count(table, "`app_id` = ? and `status` = ?", appId, model.Config_Status_Enabled)
+	if err != nil {
+		return errors.New("查询商户配置失败")
+	}
+	if total == 0 {
+		return fmt.Errorf("appid %s 在%s中不存在或已停用", appId, table)
+	}
+	return nil
+}
+
+func newConfigAudit(table string, id int, operator string, content interface{}) *model.PmConfigAuditLogTable {
+	action := model.Config_Audit_Action_Update
+	if id == 0 {
+		action = model.Config_Audit_Action_Create
+	}
+	contentByte, _ := json.Marshal(content)
+	return &model.PmConfigAuditLogTable{
+		ConfigTable: table,
+		Action:      action,
+		Operator:    operator,
+		Content:     string(contentByte),
+	}
+}
+
+func setIfNotEmpty(dst *string, v string) {
+	if v != "" {
+		*dst = v
+	}
+}
+
+func validateWechatPayConfig(row *model.PmPayConfigWechatTable) error {
+	if !wechatAppIdReg.MatchString(row.AppID) {
+		return errors.New("微信appid格式错误")
+	}
+	if !wechatMchIdReg.MatchString(row.MchID) {
+		return errors.New("微信商户号格式错误")
+	}
+	if row.ApiKey != "" && !wechatApiKeyReg.MatchString(row.ApiKey) {
+		return errors.New("apiV3密钥应为32位字母和数字")
+	}
+	if row.ApiKeyV2 != "" && !wechatApiKeyReg.MatchString(row.ApiKeyV2) {
+		return errors.New("apiV2密钥应为32位字母和数字")
+	}
+	if row.SerialNumber != "" && !wechatSerialNoReg.MatchString(row.SerialNumber) {
+		return errors.New("商户证书序列号格式错误")
+	}
+	return checkNotifyUrl(row.NotifyUrl, false)
+}
+
+func validateAlipayPayConfig(row *model.PmPayConfigAlipayTable) error {
+	if !alipayAppIdReg.MatchString(row.AppID) {
+		return errors.New("支付宝appid格式错误")
+	}
+	if err := parseRsaPrivateKey(row.PrivateKey); err != nil {
+		return fmt.Errorf("应用私钥格式错误: %v", err)
+	}
+	if row.AppCertPublicKey == "" || row.PublicKey == "" || row.PayRootCert == "" {
+		return errors.New("应用公钥证书、支付宝公钥证书和根证书不能为空")
+	}
+	if row.IsProduction != 0 && row.IsProduction != 1 {
+		return errors.New("IsProduction只能是0或1")
+	}
+	return checkNotifyUrl(row.NotifyUrl, true)
+}
+
+func validateTiktokPayConfig(row *model.PmPayConfigTiktokTable) error {
+	if !tiktokAppIdReg.MatchString(row.AppID) {
+		return errors.New("抖音appid格式错误")
+	}
+	if row.Salt == "" && row.PrivateKey == "" {
+		return errors.New("担保支付Salt和通用交易私钥至少配置一个")
+	}
+	if row.PrivateKey != "" {
+		if _, err := douyin.ParsePKCS1And8PrivateKey([]byte(row.PrivateKey)); err != nil {
+			return fmt.Errorf("应用私钥格式错误: %v", err)
+		}
+	}
+	if row.PlatformPublicKey != "" {
+		if err := parsePublicKey(row.PlatformPublicKey); err != nil {
+			return fmt.Errorf("平台公钥格式错误: %v", err)
+		}
+	}
+	return checkNotifyUrl(row.NotifyUrl, true)
+}
+
+func validateHuaweiApp(row *model.HuaweiAppTable) error {
+	if !huaweiIdReg.MatchString(row.AppID) {
+		return errors.New("华为appid格式错误")
+	}
+	if row.AppPkg == "" {
+		return errors.New("包名不能为空")
+	}
+	if !huaweiIdReg.MatchString(row.ClientId) || row.ClientSecret == "" {
+		return errors.New("ClientId格式错误或ClientSecret为空")
+	}
+	if err := parsePublicKey(row.AppSecret); err != nil {
+		return fmt.Errorf("验证公钥格式错误: %v", err)
+	}
+	if row.IapPublicKey != "" {
+		if err := parsePublicKey(row.IapPublicKey); err != nil {
+			return fmt.Errorf("应用内支付公钥格式错误: %v", err)
+		}
+	}
+	if row.Sha256Fingerprint != "" && !huaweiFingerprintReg.MatchString(row.Sha256Fingerprint) {
+		return errors.New("SHA256证书指纹格式错误")
+	}
+	return nil
+}
+
+// 回调地址需为http或https地址，required为false时可以为空
+func checkNotifyUrl(notifyUrl string, required bool) error {
+	if notifyUrl == "" && !required {
+		return nil
+	}
+	u, err := url.Parse(notifyUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("回调地址格式错误")
+	}
+	return nil
+}
+
+// 解析RSA私钥，支持PEM和不带头尾的base64，PKCS1和PKCS8
+func parseRsaPrivateKey(key string) error {
+	if strings.Contains(key, "-----BEGIN") {
+		_, err := douyin.ParsePKCS1And8PrivateKey([]byte(key))
+		return err
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return err
+	}
+	if _, err = x509.ParsePKCS1PrivateKey(der); err == nil {
+		return nil
+	}
+	_, err = x509.ParsePKCS8PrivateKey(der)
+	return err
+}
+
+// 解析公钥，支持PEM和不带头尾的base64
+func parsePublicKey(key string) error {
+	var der []byte
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		der = block.Bytes
+	} else {
+		var err error
+		der, err = base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+		if err != nil {
+			return err
+		}
+	}
+	_, err := x509.ParsePKIXPublicKey(der)
+	return err
+}
@@ -0,0 +1,210 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/auth"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetConfigStatusLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	configAdminModel *model.ConfigAdminModel
+}
+
+func NewSetConfigStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetConfigStatusLogic {
+	return &SetConfigStatusLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		configAdminModel: model.NewConfigAdminModel(define.DbPayGateway),
+	}
+}
+
+// SetConfigStatus 停用或启用应用、商户配置
+//
+// 停用的包名不能再下单，已下单的回调按appid读取配置不受影响；商户配置仍被启用的应用配置引用时不能停用
+func (l *SetConfigStatusLogic) SetConfigStatus(in *pb.SetConfigStatusReq) (*pb.SetConfigStatusResp, error) {
+	// 操作人取认证通过的管理员，不使用请求中的Operator
+	operator := auth.Operator(l.ctx)
+	if operator == "" {
+		return &pb.SetConfigStatusResp{
+			Code: 1,
+			Msg:  "未认证的操作人",
+		}, nil
+	}
+	if in.GetStatus() != model.Config_Status_Disabled && in.GetStatus() != model.Config_Status_Enabled {
+		return &pb.SetConfigStatusResp{
+			Code: 1,
+			Msg:  "状态只能是0或1",
+		}, nil
+	}
+
+	err := l.setConfigStatus(in, operator)
+	if err != nil {
+		l.Errorw("更新配置状态失败", logx.Field("table", in.GetTable()), logx.Field("id", in.GetId()), logx.Field("operator", operator), logx.Field("err", err))
+		return &pb.SetConfigStatusResp{
+			Code: 1,
+			Msg:  err.Error(),
+		}, nil
+	}
+
+	return &pb.SetConfigStatusResp{
+		Code: 0,
+		Msg:  "操作成功",
+	}, nil
+}
+
+func (l *SetConfigStatusLogic) setConfigStatus(in *pb.SetConfigStatusReq, operator string) error {
+	id := int(in.GetId())
+	status := int(in.GetStatus())
+	saveLogic := NewSaveConfigLogic(l.ctx, l.svcCtx)
+
+	// 校验引用关系，并记录状态变更后需要失效的缓存
+	var expireCache func()
+	var content interface{}
+	switch in.GetTable() {
+	case model.PmAppConfigTableName:
+		row := &model.PmAppConfigTable{}
+		if err := saveLogic.loadForSave(in.Table, id, row); err != nil {
+			return err
+		}
+		if status == model.Config_Status_Enabled {
+			if err := saveLogic.checkAppConfigRefs(row); err != nil {
+				return err
+			}
+		}
+		row.Status = status
+		content = toAppConfigInfo(row)
+		expireCache = func() {
+			model.NewPmAppConfigModel(define.DbPayGateway).ExpireCache(row.AppPkgName)
+			clientMgr.ExpireAlipayClientCache(row.AppPkgName, "")
+		}
+	case model.PmPayConfigWechatTableName:
+		row := &model.PmPayConfigWechatTable{}
+		if err := saveLogic.loadForSave(in.Table, id, row); err != nil {
+			return err
+		}
+		if status == model.Config_Status_Disabled {
+			if err := l.checkPayAppUnused("wechat_pay_app_id", row.AppID); err != nil {
+				return err
+			}
+		}
+		row.Status = status
+		content = toWechatPayConfigInfo(row)
+		expireCache = func() {
+			model.NewPmPayConfigWechatModel(define.DbPayGateway).ExpireCache(row.AppID)
+		}
+	case model.PmPayConfigAlipayTableName:
+		row := &model.PmPayConfigAlipayTable{}
+		if err := saveLogic.loadForSave(in.Table, id, row); err != nil {
+			return err
+		}
+		if status == model.Config_Status_Disabled {
+			if err := l.checkPayAppUnused("alipay_app_id", row.AppID); err != nil {
+				return err
+			}
+			total, err := l.configAdminModel.Count(model.AppAlipayAppTableName, "`app_id` = ? and `status` = ?", row.AppID, model.Config_Status_Enabled)
+			if err != nil {
+				return errors.New("查询配置失败")
+			}
+			if total > 0 {
+				return fmt.Errorf("appid %s 仍被%s中%d个启用的配置引用", row.AppID, model.AppAlipayAppTableName, total)
+			}
+		}
+		row.Status = status
+		content = toAlipayPayConfigInfo(row)
+		expireCache = func() {
+			clientMgr.ExpireAlipayClientCache("", row.AppID)
+		}
+	case model.PmPayConfigTiktokTableName:
+		row := &model.PmPayConfigTiktokTable{}
+		if err := saveLogic.loadForSave(in.Table, id, row); err != nil {
+			return err
+		}
+		if status == model.Config_Status_Disabled {
+			if err := l.checkPayAppUnused("tiktok_pay_app_id", row.AppID); err != nil {
+				return err
+			}
+		}
+		row.Status = status
+		content = toTiktokPayConfigInfo(row)
+	case model.PmPayConfigKsTableName:
+		row := &model.PmPayConfigKsTable{}
+		if err := saveLogic.loadForSave(in.Table, id, row); err != nil {
+			return err
+		}
+		if status == model.Config_Status_Disabled {
+			if err := l.checkPayAppUnused("ks_pay_app_id", row.AppID); err != nil {
+				return err
+			}
+		}
+		row.Status = status
+		content = toKsPayConfigInfo(row)
+	case model.AppAlipayAppTableName:
+		row := &model.AppAlipayAppTable{}
+		if err := saveLogic.loadForSave(in.Table, id, row); err != nil {
+			return err
+		}
+		if status == model.Config_Status_Enabled {
+			if err := saveLogic.checkPayAppEnabled(model.PmPayConfigAlipayTableName, row.AppID); err != nil {
+				return err
+			}
+		}
+		row.Status = status
+		content = toAppAlipayAppInfo(row)
+		expireCache = func() {
+			model.NewAppAlipayAppModel(define.DbPayGateway).ExpireCache(row.AppPkg)
+		}
+	case model.HuaweiAppTableName:
+		row := &model.HuaweiAppTable{}
+		if err := saveLogic.loadForSave(in.Table, id, row); err != nil {
+			return err
+		}
+		row.Status = status
+		content = toHuaweiAppInfo(row)
+		expireCache = func() {
+			model.NewHuaweiAppModel(define.DbPayGateway).ExpireCache(row.AppID)
+		}
+	default:
+		return errors.New("不支持的配置表")
+	}
+
+	audit := newConfigAudit(in.Table, id, operator, content)
+	audit.Action = model.Config_Audit_Action_Disable
+	if status == model.Config_Status_Enabled {
+		audit.Action = model.Config_Audit_Action_Enable
+	}
+	audit.Remark = in.Remark
+	if err := l.configAdminModel.SetStatus(id, status, audit); err != nil {
+		return errors.New("更新状态失败")
+	}
+	if expireCache != nil {
+		expireCache()
+	}
+	return nil
+}
+
+// 停用商户配置前检查是否仍被启用的应用配置引用，column为pm_app_config中对应的appid字段
+func (l *SetConfigStatusLogic) checkPayAppUnused(column string, appId string) error {
+	total, err := l.configAdminModel.Count(model.PmAppConfigTableName, "`"+column+"` = ? and `status` = ?", appId, model.Config_Status_Enabled)
+	if err != nil {
+		return errors.New("查询配置失败")
+	}
+	if total > 0 {
+		return fmt.Errorf("appid %s 仍被%s中%d个启用的配置引用", appId, model.PmAppConfigTableName, total)
+	}
+	return nil
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/huawei"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/utils"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -94,3 +97,48 @@ type HuaweiStopSubscriptionResp struct {
 	ResponseCode    string `json:"responseCode"`    // 返回码。0：成功。 其他：失败，具体请参见错误码。
 	ResponseMessage string `json:"responseMessage"` // 失败原因描述信息。
 }
+
+// 检查华为订阅接口的返回结果
+func checkHuaweiSubscriptionResult(tmpResult string) error {
+	var tmpRe HuaweiStopSubscriptionResp
+	err := json.Unmarshal([]byte(tmpResult), &tmpRe)
+	if err != nil {
+		return err
+	}
+
+	if tmpRe.ResponseCode != "0" {
+		return fmt.Errorf("responseCode: %s, responseMessage: %s", tmpRe.ResponseCode, tmpRe.ResponseMessage)
+	}
+	return nil
+}
+
+// 华为订阅变更后异步回调业务方
+func huaweiSubscriptionCallback(ctx context.Context, hworder *model.HuaweiOrderTable, notifyType string, extraData map[string]interface{}) {
+	notifyUrl := huawei.DefaultNotifyUrl
+	if hworder.AppNotifyUrl != "" {
+		notifyUrl = hworder.AppNotifyUrl
+	}
+
+	callbackData := map[string]interface{}{
+		"notify_type":     notifyType,
+		"user_id":         hworder.UserId,
+		"out_trade_no":    hworder.OutTradeNo,
+		"pkg":             hworder.AppPkg,
+		"subscription_id": hworder.SubscriptionId,
+		"product_id":      hworder.ProductId,
+	}
+	for k, v := range extraData {
+		callbackData[k] = v
+	}
+
+	go util.SafeRun(func() {
+		headerMap := map[string]string{
+			"App-Origin": hworder.AppPkg,
+		}
+		tmpErr := utils.CallbackWithRetry(notifyUrl, headerMap, callbackData, 5*time.Second)
+		logx.WithContext(ctx).Sloww("华为订阅变更回调app数据", logx.Field("call back data", callbackData), logx.Field("tmpErr", tmpErr))
+		if tmpErr != nil {
+			logx.WithContext(ctx).Errorf("callback error: %v, call back data: %v", tmpErr, callbackData)
+		}
+	})
+}
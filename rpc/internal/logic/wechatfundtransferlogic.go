@@ -0,0 +1,99 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/client"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/clientMgr"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+	kv_m "gitlab.muchcloud.com/consumer-project/zhuyun-core/kv_monitor"
+	"gitlab.muchcloud.com/consumer-project/zhuyun-core/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	wechatFundTransferFailNum = kv_m.Register{kv_m.Regist(&kv_m.Monitor{kv_m.CounterValue, kv_m.KvLabels{"kind": "common"}, "wechatFundTransferFailNum", nil, "微信商家转账失败", nil})}
+)
+
+type WechatFundTransferLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	appConfigModel       *model.PmAppConfigModel
+	payConfigWechatModel *model.PmPayConfigWechatModel
+}
+
+func NewWechatFundTransferLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WechatFundTransferLogic {
+	return &WechatFundTransferLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		appConfigModel:       model.NewPmAppConfigModel(define.DbPayGateway),
+		payConfigWechatModel: model.NewPmPayConfigWechatModel(define.DbPayGateway),
+	}
+}
+
+// 微信商家转账到零钱，同一OrderSn重复调用不会重复打款
+func (l *WechatFundTransferLogic) WechatFundTransfer(in *pb.WechatFundTransferReq) (*pb.AlipayFundTransResp, error) {
+	if in.OrderSn == "" || in.OpenId == "" || in.TransAmount <= 0 || in.TransferSceneId == "" {
+		return nil, errors.New("参数错误")
+	}
+
+	//读取应用配置
+	pkgCfg, err := l.appConfigModel.GetOneByPkgName(in.AppPkgName)
+	if err != nil {
+		util.CheckError("pkgName= %s, 读取应用配置失败，err:=%v", in.AppPkgName, err)
+		return nil, errors.New("读取应用配置失败")
+	}
+
+	payCfg, err := l.payConfigWechatModel.GetOneByAppID(pkgCfg.WechatPayAppID)
+	if err != nil {
+		err = fmt.Errorf("pkgName= %s, 读取微信支付配置失败，err:=%v", in.AppPkgName, err)
+		util.CheckError(err.Error())
+		return nil, err
+	}
+	payClient := client.NewWeChatCommPay(*payCfg.TransClientConfig())
+
+	reportInfos := make([]*client.TransferSceneReportInfo, 0, len(in.ReportInfos))
+	for _, info := range in.ReportInfos {
+		reportInfos = append(reportInfos, &client.TransferSceneReportInfo{
+			InfoType:    info.InfoType,
+			InfoContent: info.InfoContent,
+		})
+	}
+	transferBillReq := &client.TransferBillReq{
+		Appid:                    payCfg.AppID,
+		OutBillNo:                in.OrderSn,
+		TransferSceneId:          in.TransferSceneId,
+		Openid:                   in.OpenId,
+		TransferAmount:           in.TransAmount,
+		TransferRemark:           in.TransferRemark,
+		UserRecvPerception:       in.UserRecvPerception,
+		TransferSceneReportInfos: reportInfos,
+	}
+
+	orderInfo := &model.PmFundTransOrderTable{
+		OrderSn:      in.OrderSn,
+		AppPkgName:   in.AppPkgName,
+		Amount:       int(in.TransAmount),
+		PayeeAccount: in.OpenId,
+		PayAppId:     payCfg.AppID,
+	}
+	orderInfo, err = clientMgr.WechatFundTransfer(payClient, orderInfo, transferBillReq)
+	if err != nil {
+		err = fmt.Errorf("pkgName= %s, 微信转账失败，err:=%v", in.AppPkgName, err)
+		logx.Errorf(err.Error())
+		wechatFundTransferFailNum.CounterInc()
+		return nil, err
+	}
+
+	return toFundTransResp(orderInfo), nil
+}
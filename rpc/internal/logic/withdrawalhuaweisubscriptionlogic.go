@@ -0,0 +1,98 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/code"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/huawei"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/pb/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WithdrawalHuaweiSubscriptionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+
+	huaweiOrderModel *model.HuaweiOrderModel
+}
+
+func NewWithdrawalHuaweiSubscriptionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WithdrawalHuaweiSubscriptionLogic {
+	return &WithdrawalHuaweiSubscriptionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+
+		huaweiOrderModel: model.NewHuaweiOrderModel(define.DbPayGateway),
+	}
+}
+
+// 华为订阅撤销，退还最近一期费用并立即取消订阅
+//
+// 订阅立即失效，业务方收到回调后需要收回权益
+//
+// https://developer.huawei.com/consumer/cn/doc/HMSCore-References/api-revoke-subscription-0000001050706086
+func (l *WithdrawalHuaweiSubscriptionLogic) WithdrawalHuaweiSubscription(in *pb.UnsubscribeHuaweiReq) (*pb.UnsubscribeHuaweiResp, error) {
+	// 记录一下参数
+	l.Sloww("WithdrawalHuaweiSubscription", logx.Field("in", in))
+
+	// 获取应用配置
+	appConfig, err := model.NewHuaweiAppModel(define.DbPayGateway).GetInfoByPkg(in.GetPkg())
+	if appConfig.ID < 1 || err != nil {
+		l.Errorf("获取华为应用配置失败 error: %v, pkg: %s", err, in.GetPkg())
+		return &pb.UnsubscribeHuaweiResp{
+			Code: 1,
+			Msg:  fmt.Sprintf("获取华为应用配置失败: %v", err),
+		}, nil
+	}
+
+	authHeaderString, err := huawei.NewClient(l.ctx, define.DbPayGateway, appConfig.ClientId, appConfig.ClientSecret, appConfig.AppSecret).BuildAuthorization()
+	if err != nil {
+		l.Errorf("华为BuildAuthorization失败 error: %v, pkg: %s", err, in.GetPkg())
+		return &pb.UnsubscribeHuaweiResp{
+			Code: 1,
+			Msg:  "华为BuildAuthorization失败: " + err.Error(),
+		}, nil
+	}
+
+	tmpResult, err := huawei.SubscriptionDemo.WithdrawalSubscription(authHeaderString, in.GetSubscriptionId(), in.GetPurchaseToken())
+	l.Sloww("huawei WithdrawalSubscription", logx.Field("result", tmpResult), logx.Field("err", err))
+	if err == nil {
+		err = checkHuaweiSubscriptionResult(tmpResult)
+	}
+	if err != nil {
+		l.Errorf("华为撤销订阅失败 err: %v", err)
+		return &pb.UnsubscribeHuaweiResp{
+			Code: 1,
+			Msg:  "华为撤销订阅失败: " + err.Error(),
+		}, nil
+	}
+
+	// 更新订单状态并回调业务方
+	hworder, err := l.huaweiOrderModel.GetOneByTokenAndSubId(in.GetPurchaseToken(), in.GetSubscriptionId(), "", "")
+	if err == nil && hworder.Id > 0 {
+		cancellationTime := int(time.Now().Unix())
+		_ = l.huaweiOrderModel.UpdateData(hworder.Id, map[string]interface{}{
+			"status":            model.HuaweiOrderStatusRefunded,
+			"auto_renew_status": 0,
+			"cancellation_date": cancellationTime,
+		})
+
+		huaweiSubscriptionCallback(l.ctx, hworder, code.APP_NOTIFY_HUAWEI_SUB_WITHDRAWAL, map[string]interface{}{
+			"platform_trade_no": hworder.PlatformTradeNo,
+			"cancellation_time": cancellationTime,
+		})
+	}
+
+	// 成功
+	return &pb.UnsubscribeHuaweiResp{
+		Code: 0,
+		Msg:  tmpResult,
+	}, nil
+}
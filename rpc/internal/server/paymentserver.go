@@ -36,11 +36,29 @@ func (s *PaymentServer) ClosePayOrder(ctx context.Context, in *pb.ClosePayOrderR
 }
 
 // 支付宝转出
-func (s *PaymentServer) AlipayFundTransUniTransfer(ctx context.Context, in *pb.AlipayFundTransUniTransferReq) (*pb.Empty, error) {
+func (s *PaymentServer) AlipayFundTransUniTransfer(ctx context.Context, in *pb.AlipayFundTransUniTransferReq) (*pb.AlipayFundTransResp, error) {
 	l := logic.NewAlipayFundTransUniTransferLogic(ctx, s.svcCtx)
 	return l.AlipayFundTransUniTransfer(in)
 }
 
+// 支付宝转出结果查询
+func (s *PaymentServer) AlipayFundTransQuery(ctx context.Context, in *pb.AlipayFundTransQueryReq) (*pb.AlipayFundTransResp, error) {
+	l := logic.NewAlipayFundTransQueryLogic(ctx, s.svcCtx)
+	return l.AlipayFundTransQuery(in)
+}
+
+// 支付宝批量转出
+func (s *PaymentServer) AlipayFundTransBatch(ctx context.Context, in *pb.AlipayFundTransBatchReq) (*pb.AlipayFundTransBatchResp, error) {
+	l := logic.NewAlipayFundTransBatchLogic(ctx, s.svcCtx)
+	return l.AlipayFundTransBatch(in)
+}
+
+// 微信商家转账到零钱
+func (s *PaymentServer) WechatFundTransfer(ctx context.Context, in *pb.WechatFundTransferReq) (*pb.AlipayFundTransResp, error) {
+	l := logic.NewWechatFundTransferLogic(ctx, s.svcCtx)
+	return l.WechatFundTransfer(in)
+}
+
 // 查询订单
 func (s *PaymentServer) OrderStatus(ctx context.Context, in *pb.OrderStatusReq) (*pb.OrderStatusResp, error) {
 	l := logic.NewOrderStatusLogic(ctx, s.svcCtx)
@@ -155,6 +173,30 @@ func (s *PaymentServer) UnsubscribeHuaweiRefund(ctx context.Context, in *pb.Unsu
 	return l.UnsubscribeHuaweiRefund(in)
 }
 
+// 华为订阅延期，用于客服补偿
+func (s *PaymentServer) DelayHuaweiSubscription(ctx context.Context, in *pb.DelayHuaweiSubscriptionReq) (*pb.DelayHuaweiSubscriptionResp, error) {
+	l := logic.NewDelayHuaweiSubscriptionLogic(ctx, s.svcCtx)
+	return l.DelayHuaweiSubscription(in)
+}
+
+// 华为订阅返还最近一期费用，不取消订阅
+func (s *PaymentServer) ReturnFeeHuaweiSubscription(ctx context.Context, in *pb.UnsubscribeHuaweiReq) (*pb.UnsubscribeHuaweiResp, error) {
+	l := logic.NewReturnFeeHuaweiSubscriptionLogic(ctx, s.svcCtx)
+	return l.ReturnFeeHuaweiSubscription(in)
+}
+
+// 华为订阅撤销，退还最近一期费用并立即取消订阅
+func (s *PaymentServer) WithdrawalHuaweiSubscription(ctx context.Context, in *pb.UnsubscribeHuaweiReq) (*pb.UnsubscribeHuaweiResp, error) {
+	l := logic.NewWithdrawalHuaweiSubscriptionLogic(ctx, s.svcCtx)
+	return l.WithdrawalHuaweiSubscription(in)
+}
+
+// 查询华为订阅实时状态
+func (s *PaymentServer) GetHuaweiSubscription(ctx context.Context, in *pb.UnsubscribeHuaweiReq) (*pb.GetHuaweiSubscriptionResp, error) {
+	l := logic.NewGetHuaweiSubscriptionLogic(ctx, s.svcCtx)
+	return l.GetHuaweiSubscription(in)
+}
+
 // 抖音周期代扣相关查询和修改(包括解约)
 func (s *PaymentServer) DouyinPeriodOrder(ctx context.Context, in *pb.DouyinPeriodOrderReq) (*pb.DouyinPeriodOrderResp, error) {
 	l := logic.NewDouyinPeriodOrderLogic(ctx, s.svcCtx)
@@ -185,14 +227,44 @@ func (s *PaymentServer) WechatMiniXPayQueryOrder(ctx context.Context, in *pb.Wec
 	return l.WechatMiniXPayQueryOrder(in)
 }
 
-// AutoPkgAdd 自动创建应用
-func (s *PaymentServer) AutoPkgAdd(ctx context.Context, in *pb.AutoPkgAddReq) (*pb.AutoPkgAddResp, error) {
-	l := logic.NewAutoPkgAddLogic(ctx, s.svcCtx)
-	return l.AutoPkgAdd(in)
-}
-
 // DyPeriodOrder 查询抖音周期代扣订单
 func (s *PaymentServer) DyPeriodOrder(ctx context.Context, in *pb.DyPeriodOrderReq) (*pb.DyPeriodOrderResp, error) {
 	l := logic.NewDyPeriodOrderLogic(ctx, s.svcCtx)
 	return l.DyPeriodOrder(in)
 }
+
+// GetUserSubscriptions 查询用户在支付宝、抖音、华为的订阅
+func (s *PaymentServer) GetUserSubscriptions(ctx context.Context, in *pb.GetUserSubscriptionsReq) (*pb.GetUserSubscriptionsResp, error) {
+	l := logic.NewGetUserSubscriptionsLogic(ctx, s.svcCtx)
+	return l.GetUserSubscriptions(in)
+}
+
+// CancelSubscription 取消订阅，按渠道解约或取消自动续费
+func (s *PaymentServer) CancelSubscription(ctx context.Context, in *pb.CancelSubscriptionReq) (*pb.CancelSubscriptionResp, error) {
+	l := logic.NewCancelSubscriptionLogic(ctx, s.svcCtx)
+	return l.CancelSubscription(in)
+}
+
+// GetSubscriptionHistory 查询用户订阅的扣款记录
+func (s *PaymentServer) GetSubscriptionHistory(ctx context.Context, in *pb.GetSubscriptionHistoryReq) (*pb.GetSubscriptionHistoryResp, error) {
+	l := logic.NewGetSubscriptionHistoryLogic(ctx, s.svcCtx)
+	return l.GetSubscriptionHistory(in)
+}
+
+// SaveConfig 新建或更新应用、商户配置
+func (s *PaymentServer) SaveConfig(ctx context.Context, in *pb.SaveConfigReq) (*pb.SaveConfigResp, error) {
+	l := logic.NewSaveConfigLogic(ctx, s.svcCtx)
+	return l.SaveConfig(in)
+}
+
+// ListConfig 查询应用、商户配置
+func (s *PaymentServer) ListConfig(ctx context.Context, in *pb.ListConfigReq) (*pb.ListConfigResp, error) {
+	l := logic.NewListConfigLogic(ctx, s.svcCtx)
+	return l.ListConfig(in)
+}
+
+// SetConfigStatus 停用或启用应用、商户配置
+func (s *PaymentServer) SetConfigStatus(ctx context.Context, in *pb.SetConfigStatusReq) (*pb.SetConfigStatusResp, error) {
+	l := logic.NewSetConfigStatusLogic(ctx, s.svcCtx)
+	return l.SetConfigStatus(in)
+}
@@ -7,8 +7,12 @@ import (
 	"github.com/nacos-group/nacos-sdk-go/v2/common/constant"
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/define"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/envelope"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/common/global"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/db"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/db/mysql/model"
+	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/auth"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/config"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/server"
 	"gitlab.muchcloud.com/consumer-project/pay-gateway/rpc/internal/svc"
@@ -53,6 +57,16 @@ func main() {
 
 	// 初始化数据库
 	db.DBInit(c.Mysql, c.RedisConfig)
+
+	// 初始化商户密钥加密主密钥
+	if err = envelope.Init(c.SecretMasterKey); err != nil {
+		logx.Errorf("初始化商户密钥加密主密钥失败：" + err.Error())
+		return
+	}
+	if err = model.NewConfigAdminModel(define.DbPayGateway).CheckMasterKey(); err != nil {
+		logx.Errorf("检查商户密钥加密主密钥失败：" + err.Error())
+		return
+	}
 	ctx := svc.NewServiceContext(c)
 
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
@@ -62,6 +76,7 @@ func main() {
 			reflection.Register(grpcServer)
 		}
 	})
+	s.AddUnaryInterceptors(auth.ConfigAdminInterceptor(c.ConfigAdmins))
 	defer s.Stop()
 
 	var sc []constant.ServerConfig
@@ -19,21 +19,31 @@ type (
 	AlipayCheckAccountReq         = pb.AlipayCheckAccountReq
 	AlipayCheckAccountResp        = pb.AlipayCheckAccountResp
 	AlipayCommonResp              = pb.AlipayCommonResp
+	AlipayFundTransBatchItem      = pb.AlipayFundTransBatchItem
+	AlipayFundTransBatchReq       = pb.AlipayFundTransBatchReq
+	AlipayFundTransBatchResp      = pb.AlipayFundTransBatchResp
+	AlipayFundTransQueryReq       = pb.AlipayFundTransQueryReq
+	AlipayFundTransResp           = pb.AlipayFundTransResp
 	AlipayFundTransUniTransferReq = pb.AlipayFundTransUniTransferReq
 	AlipayPageSignReq             = pb.AlipayPageSignReq
 	AlipayPageSignResp            = pb.AlipayPageSignResp
 	AlipayPageUnSignReq           = pb.AlipayPageUnSignReq
+	AlipayPayConfigInfo           = pb.AlipayPayConfigInfo
 	AlipayRefundReq               = pb.AlipayRefundReq
 	AlipayTradePayReq             = pb.AlipayTradePayReq
 	AlipayTradeReq                = pb.AlipayTradeReq
-	AutoPkgAddReq                 = pb.AutoPkgAddReq
-	AutoPkgAddResp                = pb.AutoPkgAddResp
+	AppAlipayAppInfo              = pb.AppAlipayAppInfo
+	AppConfigInfo                 = pb.AppConfigInfo
 	BindHuaweiPayDataReq          = pb.BindHuaweiPayDataReq
 	BindHuaweiPayDataResp         = pb.BindHuaweiPayDataResp
+	CancelSubscriptionReq         = pb.CancelSubscriptionReq
+	CancelSubscriptionResp        = pb.CancelSubscriptionResp
 	ClosePayOrderReq              = pb.ClosePayOrderReq
 	CreateDouyinRefundReq         = pb.CreateDouyinRefundReq
 	CreateDouyinRefundResp        = pb.CreateDouyinRefundResp
 	CreateRefundResp              = pb.CreateRefundResp
+	DelayHuaweiSubscriptionReq    = pb.DelayHuaweiSubscriptionReq
+	DelayHuaweiSubscriptionResp   = pb.DelayHuaweiSubscriptionResp
 	DouyinGeneralTradeReply       = pb.DouyinGeneralTradeReply
 	DouyinGeneralTradeReq         = pb.DouyinGeneralTradeReq
 	DouyinPeriodOrderReq          = pb.DouyinPeriodOrderReq
@@ -44,16 +54,34 @@ type (
 	DyPeriodOrderResp             = pb.DyPeriodOrderResp
 	DySignedOrderInfo             = pb.DySignedOrderInfo
 	Empty                         = pb.Empty
+	GetHuaweiSubscriptionResp     = pb.GetHuaweiSubscriptionResp
+	GetSubscriptionHistoryReq     = pb.GetSubscriptionHistoryReq
+	GetSubscriptionHistoryResp    = pb.GetSubscriptionHistoryResp
+	GetUserSubscriptionsReq       = pb.GetUserSubscriptionsReq
+	GetUserSubscriptionsResp      = pb.GetUserSubscriptionsResp
+	HuaweiAppInfo                 = pb.HuaweiAppInfo
+	KsPayConfigInfo               = pb.KsPayConfigInfo
 	KsUniAppReply                 = pb.KsUniAppReply
+	ListConfigReq                 = pb.ListConfigReq
+	ListConfigResp                = pb.ListConfigResp
 	OrderPayReq                   = pb.OrderPayReq
 	OrderPayResp                  = pb.OrderPayResp
 	OrderStatusReq                = pb.OrderStatusReq
 	OrderStatusResp               = pb.OrderStatusResp
 	PayeeInfo                     = pb.PayeeInfo
+	SaveConfigReq                 = pb.SaveConfigReq
+	SaveConfigResp                = pb.SaveConfigResp
 	Schema                        = pb.Schema
+	SetConfigStatusReq            = pb.SetConfigStatusReq
+	SetConfigStatusResp           = pb.SetConfigStatusResp
+	SubscribeSchedule             = pb.SubscribeSchedule
+	SubscriptionChargeInfo        = pb.SubscriptionChargeInfo
 	TiktokEcPayReply              = pb.TiktokEcPayReply
+	TiktokPayConfigInfo           = pb.TiktokPayConfigInfo
 	UnsubscribeHuaweiReq          = pb.UnsubscribeHuaweiReq
 	UnsubscribeHuaweiResp         = pb.UnsubscribeHuaweiResp
+	UserSubscriptionInfo          = pb.UserSubscriptionInfo
+	WechatFundTransferReq         = pb.WechatFundTransferReq
 	WechatMiniRefundQueryReq      = pb.WechatMiniRefundQueryReq
 	WechatMiniRefundQueryResp     = pb.WechatMiniRefundQueryResp
 	WechatMiniRefundReq           = pb.WechatMiniRefundReq
@@ -62,11 +90,15 @@ type (
 	WechatMiniXPayQueryOrderResp  = pb.WechatMiniXPayQueryOrderResp
 	WechatMiniXPayRefundReq       = pb.WechatMiniXPayRefundReq
 	WechatMiniXPayRefundResp      = pb.WechatMiniXPayRefundResp
+	WechatPayConfigInfo           = pb.WechatPayConfigInfo
 	WechatRefundOrderReq          = pb.WechatRefundOrderReq
+	WechatTransferSceneReportInfo = pb.WechatTransferSceneReportInfo
 	WxH5PayReplay                 = pb.WxH5PayReplay
 	WxNativePayReply              = pb.WxNativePayReply
 	WxUniAppPayReply              = pb.WxUniAppPayReply
 	WxUnifiedPayReply             = pb.WxUnifiedPayReply
+	WxXPayReply                   = pb.WxXPayReply
+	WxXPayReq                     = pb.WxXPayReq
 
 	Payment interface {
 		// 创建支付订单
@@ -74,7 +106,13 @@ type (
 		// 关闭订单
 		ClosePayOrder(ctx context.Context, in *ClosePayOrderReq, opts ...grpc.CallOption) (*Empty, error)
 		// 支付宝转出
-		AlipayFundTransUniTransfer(ctx context.Context, in *AlipayFundTransUniTransferReq, opts ...grpc.CallOption) (*Empty, error)
+		AlipayFundTransUniTransfer(ctx context.Context, in *AlipayFundTransUniTransferReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error)
+		// 支付宝转出结果查询
+		AlipayFundTransQuery(ctx context.Context, in *AlipayFundTransQueryReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error)
+		// 支付宝批量转出
+		AlipayFundTransBatch(ctx context.Context, in *AlipayFundTransBatchReq, opts ...grpc.CallOption) (*AlipayFundTransBatchResp, error)
+		// 微信商家转账到零钱
+		WechatFundTransfer(ctx context.Context, in *WechatFundTransferReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error)
 		// 查询订单
 		OrderStatus(ctx context.Context, in *OrderStatusReq, opts ...grpc.CallOption) (*OrderStatusResp, error)
 		// 支付宝转出账号校验
@@ -113,6 +151,14 @@ type (
 		UnsubscribeHuawei(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error)
 		// 用户主动解除华为订阅后的退款操作
 		UnsubscribeHuaweiRefund(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error)
+		// 华为订阅延期，用于客服补偿
+		DelayHuaweiSubscription(ctx context.Context, in *DelayHuaweiSubscriptionReq, opts ...grpc.CallOption) (*DelayHuaweiSubscriptionResp, error)
+		// 华为订阅返还最近一期费用，不取消订阅
+		ReturnFeeHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error)
+		// 华为订阅撤销，退还最近一期费用并立即取消订阅
+		WithdrawalHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error)
+		// 查询华为订阅实时状态
+		GetHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*GetHuaweiSubscriptionResp, error)
 		// 抖音周期代扣相关查询和修改(包括解约)
 		DouyinPeriodOrder(ctx context.Context, in *DouyinPeriodOrderReq, opts ...grpc.CallOption) (*DouyinPeriodOrderResp, error)
 		// 小程序-微信的退款申请
@@ -123,10 +169,20 @@ type (
 		WechatMiniXPayRefund(ctx context.Context, in *WechatMiniXPayRefundReq, opts ...grpc.CallOption) (*WechatMiniXPayRefundResp, error)
 		// 微信虚拟支付-退款/订单详情
 		WechatMiniXPayQueryOrder(ctx context.Context, in *WechatMiniXPayQueryOrderReq, opts ...grpc.CallOption) (*WechatMiniXPayQueryOrderResp, error)
-		// AutoPkgAdd 自动创建应用
-		AutoPkgAdd(ctx context.Context, in *AutoPkgAddReq, opts ...grpc.CallOption) (*AutoPkgAddResp, error)
 		// DyPeriodOrder 查询抖音周期代扣订单
 		DyPeriodOrder(ctx context.Context, in *DyPeriodOrderReq, opts ...grpc.CallOption) (*DyPeriodOrderResp, error)
+		// GetUserSubscriptions 查询用户在支付宝、抖音、华为的订阅
+		GetUserSubscriptions(ctx context.Context, in *GetUserSubscriptionsReq, opts ...grpc.CallOption) (*GetUserSubscriptionsResp, error)
+		// CancelSubscription 取消订阅，按渠道解约或取消自动续费
+		CancelSubscription(ctx context.Context, in *CancelSubscriptionReq, opts ...grpc.CallOption) (*CancelSubscriptionResp, error)
+		// GetSubscriptionHistory 查询用户订阅的扣款记录
+		GetSubscriptionHistory(ctx context.Context, in *GetSubscriptionHistoryReq, opts ...grpc.CallOption) (*GetSubscriptionHistoryResp, error)
+		// SaveConfig 新建或更新应用、商户配置
+		SaveConfig(ctx context.Context, in *SaveConfigReq, opts ...grpc.CallOption) (*SaveConfigResp, error)
+		// ListConfig 查询应用、商户配置
+		ListConfig(ctx context.Context, in *ListConfigReq, opts ...grpc.CallOption) (*ListConfigResp, error)
+		// SetConfigStatus 停用或启用应用、商户配置
+		SetConfigStatus(ctx context.Context, in *SetConfigStatusReq, opts ...grpc.CallOption) (*SetConfigStatusResp, error)
 	}
 
 	defaultPayment struct {
@@ -153,11 +209,29 @@ func (m *defaultPayment) ClosePayOrder(ctx context.Context, in *ClosePayOrderReq
 }
 
 // 支付宝转出
-func (m *defaultPayment) AlipayFundTransUniTransfer(ctx context.Context, in *AlipayFundTransUniTransferReq, opts ...grpc.CallOption) (*Empty, error) {
+func (m *defaultPayment) AlipayFundTransUniTransfer(ctx context.Context, in *AlipayFundTransUniTransferReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error) {
 	client := pb.NewPaymentClient(m.cli.Conn())
 	return client.AlipayFundTransUniTransfer(ctx, in, opts...)
 }
 
+// 支付宝转出结果查询
+func (m *defaultPayment) AlipayFundTransQuery(ctx context.Context, in *AlipayFundTransQueryReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.AlipayFundTransQuery(ctx, in, opts...)
+}
+
+// 支付宝批量转出
+func (m *defaultPayment) AlipayFundTransBatch(ctx context.Context, in *AlipayFundTransBatchReq, opts ...grpc.CallOption) (*AlipayFundTransBatchResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.AlipayFundTransBatch(ctx, in, opts...)
+}
+
+// 微信商家转账到零钱
+func (m *defaultPayment) WechatFundTransfer(ctx context.Context, in *WechatFundTransferReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.WechatFundTransfer(ctx, in, opts...)
+}
+
 // 查询订单
 func (m *defaultPayment) OrderStatus(ctx context.Context, in *OrderStatusReq, opts ...grpc.CallOption) (*OrderStatusResp, error) {
 	client := pb.NewPaymentClient(m.cli.Conn())
@@ -272,6 +346,30 @@ func (m *defaultPayment) UnsubscribeHuaweiRefund(ctx context.Context, in *Unsubs
 	return client.UnsubscribeHuaweiRefund(ctx, in, opts...)
 }
 
+// 华为订阅延期，用于客服补偿
+func (m *defaultPayment) DelayHuaweiSubscription(ctx context.Context, in *DelayHuaweiSubscriptionReq, opts ...grpc.CallOption) (*DelayHuaweiSubscriptionResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.DelayHuaweiSubscription(ctx, in, opts...)
+}
+
+// 华为订阅返还最近一期费用，不取消订阅
+func (m *defaultPayment) ReturnFeeHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.ReturnFeeHuaweiSubscription(ctx, in, opts...)
+}
+
+// 华为订阅撤销，退还最近一期费用并立即取消订阅
+func (m *defaultPayment) WithdrawalHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.WithdrawalHuaweiSubscription(ctx, in, opts...)
+}
+
+// 查询华为订阅实时状态
+func (m *defaultPayment) GetHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*GetHuaweiSubscriptionResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.GetHuaweiSubscription(ctx, in, opts...)
+}
+
 // 抖音周期代扣相关查询和修改(包括解约)
 func (m *defaultPayment) DouyinPeriodOrder(ctx context.Context, in *DouyinPeriodOrderReq, opts ...grpc.CallOption) (*DouyinPeriodOrderResp, error) {
 	client := pb.NewPaymentClient(m.cli.Conn())
@@ -302,14 +400,44 @@ func (m *defaultPayment) WechatMiniXPayQueryOrder(ctx context.Context, in *Wecha
 	return client.WechatMiniXPayQueryOrder(ctx, in, opts...)
 }
 
-// AutoPkgAdd 自动创建应用
-func (m *defaultPayment) AutoPkgAdd(ctx context.Context, in *AutoPkgAddReq, opts ...grpc.CallOption) (*AutoPkgAddResp, error) {
-	client := pb.NewPaymentClient(m.cli.Conn())
-	return client.AutoPkgAdd(ctx, in, opts...)
-}
-
 // DyPeriodOrder 查询抖音周期代扣订单
 func (m *defaultPayment) DyPeriodOrder(ctx context.Context, in *DyPeriodOrderReq, opts ...grpc.CallOption) (*DyPeriodOrderResp, error) {
 	client := pb.NewPaymentClient(m.cli.Conn())
 	return client.DyPeriodOrder(ctx, in, opts...)
 }
+
+// GetUserSubscriptions 查询用户在支付宝、抖音、华为的订阅
+func (m *defaultPayment) GetUserSubscriptions(ctx context.Context, in *GetUserSubscriptionsReq, opts ...grpc.CallOption) (*GetUserSubscriptionsResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.GetUserSubscriptions(ctx, in, opts...)
+}
+
+// CancelSubscription 取消订阅，按渠道解约或取消自动续费
+func (m *defaultPayment) CancelSubscription(ctx context.Context, in *CancelSubscriptionReq, opts ...grpc.CallOption) (*CancelSubscriptionResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.CancelSubscription(ctx, in, opts...)
+}
+
+// GetSubscriptionHistory 查询用户订阅的扣款记录
+func (m *defaultPayment) GetSubscriptionHistory(ctx context.Context, in *GetSubscriptionHistoryReq, opts ...grpc.CallOption) (*GetSubscriptionHistoryResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.GetSubscriptionHistory(ctx, in, opts...)
+}
+
+// SaveConfig 新建或更新应用、商户配置
+func (m *defaultPayment) SaveConfig(ctx context.Context, in *SaveConfigReq, opts ...grpc.CallOption) (*SaveConfigResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.SaveConfig(ctx, in, opts...)
+}
+
+// ListConfig 查询应用、商户配置
+func (m *defaultPayment) ListConfig(ctx context.Context, in *ListConfigReq, opts ...grpc.CallOption) (*ListConfigResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.ListConfig(ctx, in, opts...)
+}
+
+// SetConfigStatus 停用或启用应用、商户配置
+func (m *defaultPayment) SetConfigStatus(ctx context.Context, in *SetConfigStatusReq, opts ...grpc.CallOption) (*SetConfigStatusResp, error) {
+	client := pb.NewPaymentClient(m.cli.Conn())
+	return client.SetConfigStatus(ctx, in, opts...)
+}
@@ -80,6 +80,7 @@ const (
 	PayType_WxUnified          PayType = 6 //微信统一下单接口
 	PayType_WxV3H5             PayType = 7 // 微信h5支付
 	PayType_DouyinGeneralTrade PayType = 8 //抖音小程序支付-通用交易系统
+	PayType_WxXPay             PayType = 9 //微信小程序虚拟支付
 )
 
 // Enum value maps for PayType.
@@ -94,6 +95,7 @@ var (
 		6: "WxUnified",
 		7: "WxV3H5",
 		8: "DouyinGeneralTrade",
+		9: "WxXPay",
 	}
 	PayType_value = map[string]int32{
 		"AlipayWap":          0,
@@ -105,6 +107,7 @@ var (
 		"WxUnified":          6,
 		"WxV3H5":             7,
 		"DouyinGeneralTrade": 8,
+		"WxXPay":             9,
 	}
 )
 
@@ -243,7 +246,7 @@ func (x DouyinGeneralTradeReq_SkuType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DouyinGeneralTradeReq_SkuType.Descriptor instead.
 func (DouyinGeneralTradeReq_SkuType) EnumDescriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{29, 0}
+	return file_payment_proto_rawDescGZIP(), []int{39, 0}
 }
 
 type DouyinGeneralTradeReq_IosPayType int32
@@ -292,7 +295,7 @@ func (x DouyinGeneralTradeReq_IosPayType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DouyinGeneralTradeReq_IosPayType.Descriptor instead.
 func (DouyinGeneralTradeReq_IosPayType) EnumDescriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{29, 1}
+	return file_payment_proto_rawDescGZIP(), []int{39, 1}
 }
 
 // 创建支付订单
@@ -320,6 +323,10 @@ type OrderPayReq struct {
 	IsBaseExistSignedOrder bool                   `protobuf:"varint,17,opt,name=IsBaseExistSignedOrder,proto3" json:"IsBaseExistSignedOrder,omitempty"` // 是否基于已有的签约订单生成代扣单
 	ExistSignedOrderNo     string                 `protobuf:"bytes,18,opt,name=ExistSignedOrderNo,proto3" json:"ExistSignedOrderNo,omitempty"`          // 已有的签约订单号
 	NthNum                 int32                  `protobuf:"varint,19,opt,name=NthNum,proto3" json:"NthNum,omitempty"`                                 // 第几期代扣单
+	WxXPayReq              *WxXPayReq             `protobuf:"bytes,20,opt,name=wxXPayReq,proto3" json:"wxXPayReq,omitempty"`                            //微信小程序虚拟支付下单信息
+	PeriodUnit             string                 `protobuf:"bytes,21,opt,name=PeriodUnit,proto3" json:"PeriodUnit,omitempty"`                          // 周期代扣扣款周期单位 day|week|month|year，为空按月，需与抖音签约模板一致
+	PeriodCount            int32                  `protobuf:"varint,22,opt,name=PeriodCount,proto3" json:"PeriodCount,omitempty"`                       // 周期代扣每期包含的周期单位数，如按季为3个月，为0时按1
+	TrialDays              int32                  `protobuf:"varint,23,opt,name=TrialDays,proto3" json:"TrialDays,omitempty"`                           // 周期代扣试用天数，签约时支付首期金额，试用期结束后开始按周期扣款
 }
 
 func (x *OrderPayReq) Reset() {
@@ -487,6 +494,34 @@ func (x *OrderPayReq) GetNthNum() int32 {
 	return 0
 }
 
+func (x *OrderPayReq) GetWxXPayReq() *WxXPayReq {
+	if x != nil {
+		return x.WxXPayReq
+	}
+	return nil
+}
+
+func (x *OrderPayReq) GetPeriodUnit() string {
+	if x != nil {
+		return x.PeriodUnit
+	}
+	return ""
+}
+
+func (x *OrderPayReq) GetPeriodCount() int32 {
+	if x != nil {
+		return x.PeriodCount
+	}
+	return 0
+}
+
+func (x *OrderPayReq) GetTrialDays() int32 {
+	if x != nil {
+		return x.TrialDays
+	}
+	return 0
+}
+
 // 创建支付订单返回
 type OrderPayResp struct {
 	state         protoimpl.MessageState
@@ -503,6 +538,8 @@ type OrderPayResp struct {
 	KsUniApp           *KsUniAppReply           `protobuf:"bytes,9,opt,name=KsUniApp,proto3" json:"KsUniApp,omitempty"`                      //快手小程序
 	WxUnified          *WxUnifiedPayReply       `protobuf:"bytes,10,opt,name=WxUnified,proto3" json:"WxUnified,omitempty"`                   ///微信统一下单支付
 	DouyinGeneralTrade *DouyinGeneralTradeReply `protobuf:"bytes,11,opt,name=DouyinGeneralTrade,proto3" json:"DouyinGeneralTrade,omitempty"` //抖音小程序-通用交易系统
+	WxXPay             *WxXPayReply             `protobuf:"bytes,12,opt,name=WxXPay,proto3" json:"WxXPay,omitempty"`                         //微信小程序虚拟支付
+	Schedule           *SubscribeSchedule       `protobuf:"bytes,13,opt,name=Schedule,proto3" json:"Schedule,omitempty"`                     //周期代扣的扣款计划
 }
 
 func (x *OrderPayResp) Reset() {
@@ -607,6 +644,108 @@ func (x *OrderPayResp) GetDouyinGeneralTrade() *DouyinGeneralTradeReply {
 	return nil
 }
 
+func (x *OrderPayResp) GetWxXPay() *WxXPayReply {
+	if x != nil {
+		return x.WxXPay
+	}
+	return nil
+}
+
+func (x *OrderPayResp) GetSchedule() *SubscribeSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// 订阅扣款计划，按下单时间推算，实际以签约时间为准
+type SubscribeSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstDeductTime string `protobuf:"bytes,1,opt,name=FirstDeductTime,proto3" json:"FirstDeductTime,omitempty"` // 首次续费扣款日期
+	PeriodType      string `protobuf:"bytes,2,opt,name=PeriodType,proto3" json:"PeriodType,omitempty"`           // 周期类型，支付宝 DAY|MONTH，抖音 day|week|month|year
+	Period          int32  `protobuf:"varint,3,opt,name=Period,proto3" json:"Period,omitempty"`                  // 每期的周期数
+	PeriodAmount    int64  `protobuf:"varint,4,opt,name=PeriodAmount,proto3" json:"PeriodAmount,omitempty"`      // 每期扣款金额，单位分
+	FirstAmount     int64  `protobuf:"varint,5,opt,name=FirstAmount,proto3" json:"FirstAmount,omitempty"`        // 签约时支付的首期金额，单位分
+	TrialDays       int32  `protobuf:"varint,6,opt,name=TrialDays,proto3" json:"TrialDays,omitempty"`            // 试用天数
+}
+
+func (x *SubscribeSchedule) Reset() {
+	*x = SubscribeSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSchedule) ProtoMessage() {}
+
+func (x *SubscribeSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSchedule.ProtoReflect.Descriptor instead.
+func (*SubscribeSchedule) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubscribeSchedule) GetFirstDeductTime() string {
+	if x != nil {
+		return x.FirstDeductTime
+	}
+	return ""
+}
+
+func (x *SubscribeSchedule) GetPeriodType() string {
+	if x != nil {
+		return x.PeriodType
+	}
+	return ""
+}
+
+func (x *SubscribeSchedule) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *SubscribeSchedule) GetPeriodAmount() int64 {
+	if x != nil {
+		return x.PeriodAmount
+	}
+	return 0
+}
+
+func (x *SubscribeSchedule) GetFirstAmount() int64 {
+	if x != nil {
+		return x.FirstAmount
+	}
+	return 0
+}
+
+func (x *SubscribeSchedule) GetTrialDays() int32 {
+	if x != nil {
+		return x.TrialDays
+	}
+	return 0
+}
+
 // 微信uniapp支付返回
 type WxUniAppPayReply struct {
 	state         protoimpl.MessageState
@@ -625,7 +764,7 @@ type WxUniAppPayReply struct {
 func (x *WxUniAppPayReply) Reset() {
 	*x = WxUniAppPayReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[2]
+		mi := &file_payment_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -638,7 +777,7 @@ func (x *WxUniAppPayReply) String() string {
 func (*WxUniAppPayReply) ProtoMessage() {}
 
 func (x *WxUniAppPayReply) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[2]
+	mi := &file_payment_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,7 +790,7 @@ func (x *WxUniAppPayReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WxUniAppPayReply.ProtoReflect.Descriptor instead.
 func (*WxUniAppPayReply) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{2}
+	return file_payment_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *WxUniAppPayReply) GetOrderInfo() string {
@@ -715,7 +854,7 @@ type WxNativePayReply struct {
 func (x *WxNativePayReply) Reset() {
 	*x = WxNativePayReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[3]
+		mi := &file_payment_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -728,7 +867,7 @@ func (x *WxNativePayReply) String() string {
 func (*WxNativePayReply) ProtoMessage() {}
 
 func (x *WxNativePayReply) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[3]
+	mi := &file_payment_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,7 +880,7 @@ func (x *WxNativePayReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WxNativePayReply.ProtoReflect.Descriptor instead.
 func (*WxNativePayReply) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{3}
+	return file_payment_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *WxNativePayReply) GetCodeUrl() string {
@@ -774,7 +913,7 @@ type WxUnifiedPayReply struct {
 func (x *WxUnifiedPayReply) Reset() {
 	*x = WxUnifiedPayReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[4]
+		mi := &file_payment_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -787,7 +926,7 @@ func (x *WxUnifiedPayReply) String() string {
 func (*WxUnifiedPayReply) ProtoMessage() {}
 
 func (x *WxUnifiedPayReply) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[4]
+	mi := &file_payment_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +939,7 @@ func (x *WxUnifiedPayReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WxUnifiedPayReply.ProtoReflect.Descriptor instead.
 func (*WxUnifiedPayReply) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{4}
+	return file_payment_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *WxUnifiedPayReply) GetPrepayid() string {
@@ -857,7 +996,7 @@ type WxH5PayReplay struct {
 func (x *WxH5PayReplay) Reset() {
 	*x = WxH5PayReplay{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[5]
+		mi := &file_payment_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -870,7 +1009,7 @@ func (x *WxH5PayReplay) String() string {
 func (*WxH5PayReplay) ProtoMessage() {}
 
 func (x *WxH5PayReplay) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[5]
+	mi := &file_payment_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,7 +1022,7 @@ func (x *WxH5PayReplay) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WxH5PayReplay.ProtoReflect.Descriptor instead.
 func (*WxH5PayReplay) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{5}
+	return file_payment_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *WxH5PayReplay) GetH5Url() string {
@@ -913,7 +1052,7 @@ type TiktokEcPayReply struct {
 func (x *TiktokEcPayReply) Reset() {
 	*x = TiktokEcPayReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[6]
+		mi := &file_payment_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -926,7 +1065,7 @@ func (x *TiktokEcPayReply) String() string {
 func (*TiktokEcPayReply) ProtoMessage() {}
 
 func (x *TiktokEcPayReply) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[6]
+	mi := &file_payment_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -939,7 +1078,7 @@ func (x *TiktokEcPayReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TiktokEcPayReply.ProtoReflect.Descriptor instead.
 func (*TiktokEcPayReply) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{6}
+	return file_payment_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TiktokEcPayReply) GetOrderId() string {
@@ -969,7 +1108,7 @@ type KsUniAppReply struct {
 func (x *KsUniAppReply) Reset() {
 	*x = KsUniAppReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[7]
+		mi := &file_payment_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -982,7 +1121,7 @@ func (x *KsUniAppReply) String() string {
 func (*KsUniAppReply) ProtoMessage() {}
 
 func (x *KsUniAppReply) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[7]
+	mi := &file_payment_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,7 +1134,7 @@ func (x *KsUniAppReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KsUniAppReply.ProtoReflect.Descriptor instead.
 func (*KsUniAppReply) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{7}
+	return file_payment_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *KsUniAppReply) GetOrderNo() string {
@@ -1012,34 +1151,36 @@ func (x *KsUniAppReply) GetOrderInfoToken() string {
 	return ""
 }
 
-// 关闭微信订单
-type ClosePayOrderReq struct {
+// 微信小程序虚拟支付下单信息 https://developers.weixin.qq.com/miniprogram/dev/api/payment/wx.requestVirtualPayment.html
+type WxXPayReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderSn    string  `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`                       //商户订单号
-	PayType    PayType `protobuf:"varint,2,opt,name=PayType,proto3,enum=payment.PayType" json:"PayType,omitempty"` //支付方式
-	AppPkgName string  `protobuf:"bytes,3,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`                 //应用包名
+	Mode        string `protobuf:"bytes,1,opt,name=Mode,proto3" json:"Mode,omitempty"`                //支付类型 short_series_goods 道具直购，short_series_coin 代币充值
+	SessionKey  string `protobuf:"bytes,2,opt,name=SessionKey,proto3" json:"SessionKey,omitempty"`    //用户登录态session_key，用于生成用户态签名
+	ProductId   string `protobuf:"bytes,3,opt,name=ProductId,proto3" json:"ProductId,omitempty"`      //道具id，道具直购时必传
+	BuyQuantity int64  `protobuf:"varint,4,opt,name=BuyQuantity,proto3" json:"BuyQuantity,omitempty"` //购买数量，代币充值时为代币数量
+	Attach      string `protobuf:"bytes,5,opt,name=Attach,proto3" json:"Attach,omitempty"`            //透传数据，发货通知时原样返回
 }
 
-func (x *ClosePayOrderReq) Reset() {
-	*x = ClosePayOrderReq{}
+func (x *WxXPayReq) Reset() {
+	*x = WxXPayReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[8]
+		mi := &file_payment_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClosePayOrderReq) String() string {
+func (x *WxXPayReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClosePayOrderReq) ProtoMessage() {}
+func (*WxXPayReq) ProtoMessage() {}
 
-func (x *ClosePayOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[8]
+func (x *WxXPayReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,83 +1191,61 @@ func (x *ClosePayOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClosePayOrderReq.ProtoReflect.Descriptor instead.
-func (*ClosePayOrderReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use WxXPayReq.ProtoReflect.Descriptor instead.
+func (*WxXPayReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ClosePayOrderReq) GetOrderSn() string {
+func (x *WxXPayReq) GetMode() string {
 	if x != nil {
-		return x.OrderSn
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *ClosePayOrderReq) GetPayType() PayType {
+func (x *WxXPayReq) GetSessionKey() string {
 	if x != nil {
-		return x.PayType
+		return x.SessionKey
 	}
-	return PayType_AlipayWap
+	return ""
 }
 
-func (x *ClosePayOrderReq) GetAppPkgName() string {
+func (x *WxXPayReq) GetProductId() string {
 	if x != nil {
-		return x.AppPkgName
+		return x.ProductId
 	}
 	return ""
 }
 
-type Empty struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *Empty) Reset() {
-	*x = Empty{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *WxXPayReq) GetBuyQuantity() int64 {
+	if x != nil {
+		return x.BuyQuantity
 	}
+	return 0
 }
 
-func (x *Empty) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Empty) ProtoMessage() {}
-
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *WxXPayReq) GetAttach() string {
+	if x != nil {
+		return x.Attach
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{9}
+	return ""
 }
 
-// 查询订单状态req
-type OrderStatusReq struct {
+// 微信小程序虚拟支付 返回，直接作为wx.requestVirtualPayment的参数
+type WxXPayReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderSn    string  `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`                       //商户订单号
-	PayType    PayType `protobuf:"varint,2,opt,name=PayType,proto3,enum=payment.PayType" json:"PayType,omitempty"` //支付方式
-	AppPkgName string  `protobuf:"bytes,3,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`                 //应用包名
+	Mode      string `protobuf:"bytes,1,opt,name=Mode,proto3" json:"Mode,omitempty"`           //支付类型
+	SignData  string `protobuf:"bytes,2,opt,name=SignData,proto3" json:"SignData,omitempty"`   //支付参数json串
+	PaySig    string `protobuf:"bytes,3,opt,name=PaySig,proto3" json:"PaySig,omitempty"`       //支付签名
+	Signature string `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"` //用户态签名
+	OrderSn   string `protobuf:"bytes,5,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`     //商户订单号
 }
 
-func (x *OrderStatusReq) Reset() {
-	*x = OrderStatusReq{}
+func (x *WxXPayReply) Reset() {
+	*x = WxXPayReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1134,13 +1253,13 @@ func (x *OrderStatusReq) Reset() {
 	}
 }
 
-func (x *OrderStatusReq) String() string {
+func (x *WxXPayReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderStatusReq) ProtoMessage() {}
+func (*WxXPayReply) ProtoMessage() {}
 
-func (x *OrderStatusReq) ProtoReflect() protoreflect.Message {
+func (x *WxXPayReply) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1152,46 +1271,59 @@ func (x *OrderStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderStatusReq.ProtoReflect.Descriptor instead.
-func (*OrderStatusReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use WxXPayReply.ProtoReflect.Descriptor instead.
+func (*WxXPayReply) Descriptor() ([]byte, []int) {
 	return file_payment_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *OrderStatusReq) GetOrderSn() string {
+func (x *WxXPayReply) GetMode() string {
 	if x != nil {
-		return x.OrderSn
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *OrderStatusReq) GetPayType() PayType {
+func (x *WxXPayReply) GetSignData() string {
 	if x != nil {
-		return x.PayType
+		return x.SignData
 	}
-	return PayType_AlipayWap
+	return ""
 }
 
-func (x *OrderStatusReq) GetAppPkgName() string {
+func (x *WxXPayReply) GetPaySig() string {
 	if x != nil {
-		return x.AppPkgName
+		return x.PaySig
 	}
 	return ""
 }
 
-// 查询订单状态resp
-type OrderStatusResp struct {
+func (x *WxXPayReply) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *WxXPayReply) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+// 关闭微信订单
+type ClosePayOrderReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderSn       string `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`             //商户订单号
-	Status        int64  `protobuf:"varint,2,opt,name=Status,proto3" json:"Status,omitempty"`              //支付状态 1支付成功  0其他
-	PayAmount     int64  `protobuf:"varint,3,opt,name=PayAmount,proto3" json:"PayAmount,omitempty"`        //支付金额 单位分
-	ThirdRespJson string `protobuf:"bytes,4,opt,name=ThirdRespJson,proto3" json:"ThirdRespJson,omitempty"` //第三方支付请求返回json
+	OrderSn    string  `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`                       //商户订单号
+	PayType    PayType `protobuf:"varint,2,opt,name=PayType,proto3,enum=payment.PayType" json:"PayType,omitempty"` //支付方式
+	AppPkgName string  `protobuf:"bytes,3,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`                 //应用包名
 }
 
-func (x *OrderStatusResp) Reset() {
-	*x = OrderStatusResp{}
+func (x *ClosePayOrderReq) Reset() {
+	*x = ClosePayOrderReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_payment_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1199,13 +1331,13 @@ func (x *OrderStatusResp) Reset() {
 	}
 }
 
-func (x *OrderStatusResp) String() string {
+func (x *ClosePayOrderReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderStatusResp) ProtoMessage() {}
+func (*ClosePayOrderReq) ProtoMessage() {}
 
-func (x *OrderStatusResp) ProtoReflect() protoreflect.Message {
+func (x *ClosePayOrderReq) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1217,26 +1349,193 @@ func (x *OrderStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderStatusResp.ProtoReflect.Descriptor instead.
-func (*OrderStatusResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClosePayOrderReq.ProtoReflect.Descriptor instead.
+func (*ClosePayOrderReq) Descriptor() ([]byte, []int) {
 	return file_payment_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *OrderStatusResp) GetOrderSn() string {
+func (x *ClosePayOrderReq) GetOrderSn() string {
 	if x != nil {
 		return x.OrderSn
 	}
 	return ""
 }
 
-func (x *OrderStatusResp) GetStatus() int64 {
+func (x *ClosePayOrderReq) GetPayType() PayType {
 	if x != nil {
-		return x.Status
+		return x.PayType
 	}
-	return 0
+	return PayType_AlipayWap
 }
 
-func (x *OrderStatusResp) GetPayAmount() int64 {
+func (x *ClosePayOrderReq) GetAppPkgName() string {
+	if x != nil {
+		return x.AppPkgName
+	}
+	return ""
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{12}
+}
+
+// 查询订单状态req
+type OrderStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderSn    string  `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`                       //商户订单号
+	PayType    PayType `protobuf:"varint,2,opt,name=PayType,proto3,enum=payment.PayType" json:"PayType,omitempty"` //支付方式
+	AppPkgName string  `protobuf:"bytes,3,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`                 //应用包名
+}
+
+func (x *OrderStatusReq) Reset() {
+	*x = OrderStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusReq) ProtoMessage() {}
+
+func (x *OrderStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusReq.ProtoReflect.Descriptor instead.
+func (*OrderStatusReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OrderStatusReq) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *OrderStatusReq) GetPayType() PayType {
+	if x != nil {
+		return x.PayType
+	}
+	return PayType_AlipayWap
+}
+
+func (x *OrderStatusReq) GetAppPkgName() string {
+	if x != nil {
+		return x.AppPkgName
+	}
+	return ""
+}
+
+// 查询订单状态resp
+type OrderStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderSn       string `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`             //商户订单号
+	Status        int64  `protobuf:"varint,2,opt,name=Status,proto3" json:"Status,omitempty"`              //支付状态 1支付成功  0其他
+	PayAmount     int64  `protobuf:"varint,3,opt,name=PayAmount,proto3" json:"PayAmount,omitempty"`        //支付金额 单位分
+	ThirdRespJson string `protobuf:"bytes,4,opt,name=ThirdRespJson,proto3" json:"ThirdRespJson,omitempty"` //第三方支付请求返回json
+}
+
+func (x *OrderStatusResp) Reset() {
+	*x = OrderStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusResp) ProtoMessage() {}
+
+func (x *OrderStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusResp.ProtoReflect.Descriptor instead.
+func (*OrderStatusResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OrderStatusResp) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *OrderStatusResp) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *OrderStatusResp) GetPayAmount() int64 {
 	if x != nil {
 		return x.PayAmount
 	}
@@ -1269,7 +1568,7 @@ type AlipayFundTransUniTransferReq struct {
 func (x *AlipayFundTransUniTransferReq) Reset() {
 	*x = AlipayFundTransUniTransferReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[12]
+		mi := &file_payment_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1282,7 +1581,7 @@ func (x *AlipayFundTransUniTransferReq) String() string {
 func (*AlipayFundTransUniTransferReq) ProtoMessage() {}
 
 func (x *AlipayFundTransUniTransferReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[12]
+	mi := &file_payment_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,7 +1594,7 @@ func (x *AlipayFundTransUniTransferReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlipayFundTransUniTransferReq.ProtoReflect.Descriptor instead.
 func (*AlipayFundTransUniTransferReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{12}
+	return file_payment_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AlipayFundTransUniTransferReq) GetOrderSn() string {
@@ -1374,7 +1673,7 @@ type PayeeInfo struct {
 func (x *PayeeInfo) Reset() {
 	*x = PayeeInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[13]
+		mi := &file_payment_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1387,7 +1686,7 @@ func (x *PayeeInfo) String() string {
 func (*PayeeInfo) ProtoMessage() {}
 
 func (x *PayeeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[13]
+	mi := &file_payment_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,7 +1699,7 @@ func (x *PayeeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayeeInfo.ProtoReflect.Descriptor instead.
 func (*PayeeInfo) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{13}
+	return file_payment_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PayeeInfo) GetIdentity() string {
@@ -1424,34 +1723,39 @@ func (x *PayeeInfo) GetName() string {
 	return ""
 }
 
-type AlipayCheckAccountReq struct {
+type AlipayFundTransResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Account    string `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`
-	AppPkgName string `protobuf:"bytes,3,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`
-	UserId     int64  `protobuf:"varint,4,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	OrderSn        string `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`               //商户唯一订单号
+	Status         int64  `protobuf:"varint,2,opt,name=Status,proto3" json:"Status,omitempty"`                //转账状态 1处理中 2成功 3失败 4结果未知（需稍后查询） 5待人工审核
+	AliOrderId     string `protobuf:"bytes,3,opt,name=AliOrderId,proto3" json:"AliOrderId,omitempty"`         //支付宝转账订单号
+	PayFundOrderId string `protobuf:"bytes,4,opt,name=PayFundOrderId,proto3" json:"PayFundOrderId,omitempty"` //支付宝支付资金流水号
+	TransDate      string `protobuf:"bytes,5,opt,name=TransDate,proto3" json:"TransDate,omitempty"`           //转账完成时间
+	FailReason     string `protobuf:"bytes,6,opt,name=FailReason,proto3" json:"FailReason,omitempty"`         //失败原因
+	PackageInfo    string `protobuf:"bytes,7,opt,name=PackageInfo,proto3" json:"PackageInfo,omitempty"`       //微信转账待用户确认收款时，小程序调起确认收款页面使用的package信息
+	Channel        int64  `protobuf:"varint,8,opt,name=Channel,proto3" json:"Channel,omitempty"`              //转账渠道 1支付宝 2微信
+	ChannelBillNo  string `protobuf:"bytes,9,opt,name=ChannelBillNo,proto3" json:"ChannelBillNo,omitempty"`   //渠道转账单号，支付宝为转账订单号，微信为transfer_bill_no
 }
 
-func (x *AlipayCheckAccountReq) Reset() {
-	*x = AlipayCheckAccountReq{}
+func (x *AlipayFundTransResp) Reset() {
+	*x = AlipayFundTransResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[14]
+		mi := &file_payment_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AlipayCheckAccountReq) String() string {
+func (x *AlipayFundTransResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlipayCheckAccountReq) ProtoMessage() {}
+func (*AlipayFundTransResp) ProtoMessage() {}
 
-func (x *AlipayCheckAccountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[14]
+func (x *AlipayFundTransResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1462,65 +1766,100 @@ func (x *AlipayCheckAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlipayCheckAccountReq.ProtoReflect.Descriptor instead.
-func (*AlipayCheckAccountReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AlipayFundTransResp.ProtoReflect.Descriptor instead.
+func (*AlipayFundTransResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *AlipayCheckAccountReq) GetName() string {
+func (x *AlipayFundTransResp) GetOrderSn() string {
 	if x != nil {
-		return x.Name
+		return x.OrderSn
 	}
 	return ""
 }
 
-func (x *AlipayCheckAccountReq) GetAccount() string {
+func (x *AlipayFundTransResp) GetStatus() int64 {
 	if x != nil {
-		return x.Account
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AlipayFundTransResp) GetAliOrderId() string {
+	if x != nil {
+		return x.AliOrderId
 	}
 	return ""
 }
 
-func (x *AlipayCheckAccountReq) GetAppPkgName() string {
+func (x *AlipayFundTransResp) GetPayFundOrderId() string {
 	if x != nil {
-		return x.AppPkgName
+		return x.PayFundOrderId
 	}
 	return ""
 }
 
-func (x *AlipayCheckAccountReq) GetUserId() int64 {
+func (x *AlipayFundTransResp) GetTransDate() string {
 	if x != nil {
-		return x.UserId
+		return x.TransDate
+	}
+	return ""
+}
+
+func (x *AlipayFundTransResp) GetFailReason() string {
+	if x != nil {
+		return x.FailReason
+	}
+	return ""
+}
+
+func (x *AlipayFundTransResp) GetPackageInfo() string {
+	if x != nil {
+		return x.PackageInfo
+	}
+	return ""
+}
+
+func (x *AlipayFundTransResp) GetChannel() int64 {
+	if x != nil {
+		return x.Channel
 	}
 	return 0
 }
 
-type AlipayCheckAccountResp struct {
+func (x *AlipayFundTransResp) GetChannelBillNo() string {
+	if x != nil {
+		return x.ChannelBillNo
+	}
+	return ""
+}
+
+type AlipayFundTransQueryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64  `protobuf:"varint,1,opt,name=Status,proto3" json:"Status,omitempty"`
-	Desc   string `protobuf:"bytes,2,opt,name=Desc,proto3" json:"Desc,omitempty"`
+	OrderSn    string `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`       //商户唯一订单号
+	AppPkgName string `protobuf:"bytes,2,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"` //应用包名
 }
 
-func (x *AlipayCheckAccountResp) Reset() {
-	*x = AlipayCheckAccountResp{}
+func (x *AlipayFundTransQueryReq) Reset() {
+	*x = AlipayFundTransQueryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[15]
+		mi := &file_payment_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AlipayCheckAccountResp) String() string {
+func (x *AlipayFundTransQueryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlipayCheckAccountResp) ProtoMessage() {}
+func (*AlipayFundTransQueryReq) ProtoMessage() {}
 
-func (x *AlipayCheckAccountResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[15]
+func (x *AlipayFundTransQueryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,56 +1870,53 @@ func (x *AlipayCheckAccountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlipayCheckAccountResp.ProtoReflect.Descriptor instead.
-func (*AlipayCheckAccountResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AlipayFundTransQueryReq.ProtoReflect.Descriptor instead.
+func (*AlipayFundTransQueryReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AlipayCheckAccountResp) GetStatus() int64 {
+func (x *AlipayFundTransQueryReq) GetOrderSn() string {
 	if x != nil {
-		return x.Status
+		return x.OrderSn
 	}
-	return 0
+	return ""
 }
 
-func (x *AlipayCheckAccountResp) GetDesc() string {
+func (x *AlipayFundTransQueryReq) GetAppPkgName() string {
 	if x != nil {
-		return x.Desc
+		return x.AppPkgName
 	}
 	return ""
 }
 
-// 抖音支付-退款
-type DyOrderRefundReq struct {
+type AlipayFundTransBatchItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppPkgName   string `protobuf:"bytes,1,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`      //小程序pkgName
-	OutOrderNo   string `protobuf:"bytes,2,opt,name=OutOrderNo,proto3" json:"OutOrderNo,omitempty"`      //商户分配支付单号，标识进行退款的订单
-	OutRefundNo  string `protobuf:"bytes,3,opt,name=OutRefundNo,proto3" json:"OutRefundNo,omitempty"`    //商户分配退款号，保证在商户中唯一
-	Reason       string `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`              //退款原因
-	RefundAmount int64  `protobuf:"varint,5,opt,name=RefundAmount,proto3" json:"RefundAmount,omitempty"` //退款金额，单位分
-	NotifyUrl    string `protobuf:"bytes,6,opt,name=NotifyUrl,proto3" json:"NotifyUrl,omitempty"`        //商户自定义回调地址，必须以 https 开头，支持 443 端口
+	OrderSn     string     `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`         //商户唯一订单号
+	TransAmount string     `protobuf:"bytes,2,opt,name=TransAmount,proto3" json:"TransAmount,omitempty"` //转账金额，单位为元
+	PayeeInfo   *PayeeInfo `protobuf:"bytes,3,opt,name=payeeInfo,proto3" json:"payeeInfo,omitempty"`     //收款方
+	Remark      string     `protobuf:"bytes,4,opt,name=Remark,proto3" json:"Remark,omitempty"`           //业务备注，为空时使用批次的备注
 }
 
-func (x *DyOrderRefundReq) Reset() {
-	*x = DyOrderRefundReq{}
+func (x *AlipayFundTransBatchItem) Reset() {
+	*x = AlipayFundTransBatchItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[16]
+		mi := &file_payment_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DyOrderRefundReq) String() string {
+func (x *AlipayFundTransBatchItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DyOrderRefundReq) ProtoMessage() {}
+func (*AlipayFundTransBatchItem) ProtoMessage() {}
 
-func (x *DyOrderRefundReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[16]
+func (x *AlipayFundTransBatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1591,80 +1927,71 @@ func (x *DyOrderRefundReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DyOrderRefundReq.ProtoReflect.Descriptor instead.
-func (*DyOrderRefundReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *DyOrderRefundReq) GetAppPkgName() string {
-	if x != nil {
-		return x.AppPkgName
-	}
-	return ""
-}
-
-func (x *DyOrderRefundReq) GetOutOrderNo() string {
-	if x != nil {
-		return x.OutOrderNo
-	}
-	return ""
+// Deprecated: Use AlipayFundTransBatchItem.ProtoReflect.Descriptor instead.
+func (*AlipayFundTransBatchItem) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DyOrderRefundReq) GetOutRefundNo() string {
+func (x *AlipayFundTransBatchItem) GetOrderSn() string {
 	if x != nil {
-		return x.OutRefundNo
+		return x.OrderSn
 	}
 	return ""
 }
 
-func (x *DyOrderRefundReq) GetReason() string {
+func (x *AlipayFundTransBatchItem) GetTransAmount() string {
 	if x != nil {
-		return x.Reason
+		return x.TransAmount
 	}
 	return ""
 }
 
-func (x *DyOrderRefundReq) GetRefundAmount() int64 {
+func (x *AlipayFundTransBatchItem) GetPayeeInfo() *PayeeInfo {
 	if x != nil {
-		return x.RefundAmount
+		return x.PayeeInfo
 	}
-	return 0
+	return nil
 }
 
-func (x *DyOrderRefundReq) GetNotifyUrl() string {
+func (x *AlipayFundTransBatchItem) GetRemark() string {
 	if x != nil {
-		return x.NotifyUrl
+		return x.Remark
 	}
 	return ""
 }
 
-// 抖音支付-退款
-type DyOrderRefundResp struct {
+type AlipayFundTransBatchReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrNo   int64  `protobuf:"varint,1,opt,name=ErrNo,proto3" json:"ErrNo,omitempty"`    //详见错误码
-	ErrTips string `protobuf:"bytes,2,opt,name=ErrTips,proto3" json:"ErrTips,omitempty"` //详见错误描述
+	BatchNo        string                      `protobuf:"bytes,1,opt,name=BatchNo,proto3" json:"BatchNo,omitempty"`               //批次号
+	AppPkgName     string                      `protobuf:"bytes,2,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`         //应用包名
+	ProductCode    string                      `protobuf:"bytes,3,opt,name=ProductCode,proto3" json:"ProductCode,omitempty"`       //业务产品码，同单笔转账
+	BizScene       string                      `protobuf:"bytes,4,opt,name=BizScene,proto3" json:"BizScene,omitempty"`             //业务场景，同单笔转账
+	OrderTitle     string                      `protobuf:"bytes,5,opt,name=OrderTitle,proto3" json:"OrderTitle,omitempty"`         //转账业务的标题
+	BusinessParams string                      `protobuf:"bytes,6,opt,name=BusinessParams,proto3" json:"BusinessParams,omitempty"` //转账业务请求的扩展参数
+	Remark         string                      `protobuf:"bytes,7,opt,name=Remark,proto3" json:"Remark,omitempty"`                 //业务备注
+	Items          []*AlipayFundTransBatchItem `protobuf:"bytes,8,rep,name=Items,proto3" json:"Items,omitempty"`                   //转账明细，单批最多100笔
 }
 
-func (x *DyOrderRefundResp) Reset() {
-	*x = DyOrderRefundResp{}
+func (x *AlipayFundTransBatchReq) Reset() {
+	*x = AlipayFundTransBatchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[17]
+		mi := &file_payment_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DyOrderRefundResp) String() string {
+func (x *AlipayFundTransBatchReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DyOrderRefundResp) ProtoMessage() {}
+func (*AlipayFundTransBatchReq) ProtoMessage() {}
 
-func (x *DyOrderRefundResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[17]
+func (x *AlipayFundTransBatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,65 +2002,95 @@ func (x *DyOrderRefundResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DyOrderRefundResp.ProtoReflect.Descriptor instead.
-func (*DyOrderRefundResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use AlipayFundTransBatchReq.ProtoReflect.Descriptor instead.
+func (*AlipayFundTransBatchReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DyOrderRefundResp) GetErrNo() int64 {
+func (x *AlipayFundTransBatchReq) GetBatchNo() string {
 	if x != nil {
-		return x.ErrNo
+		return x.BatchNo
 	}
-	return 0
+	return ""
 }
 
-func (x *DyOrderRefundResp) GetErrTips() string {
+func (x *AlipayFundTransBatchReq) GetAppPkgName() string {
 	if x != nil {
-		return x.ErrTips
+		return x.AppPkgName
 	}
 	return ""
 }
 
-type AlipayPageSignReq struct {
+func (x *AlipayFundTransBatchReq) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *AlipayFundTransBatchReq) GetBizScene() string {
+	if x != nil {
+		return x.BizScene
+	}
+	return ""
+}
+
+func (x *AlipayFundTransBatchReq) GetOrderTitle() string {
+	if x != nil {
+		return x.OrderTitle
+	}
+	return ""
+}
+
+func (x *AlipayFundTransBatchReq) GetBusinessParams() string {
+	if x != nil {
+		return x.BusinessParams
+	}
+	return ""
+}
+
+func (x *AlipayFundTransBatchReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *AlipayFundTransBatchReq) GetItems() []*AlipayFundTransBatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AlipayFundTransBatchResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId          int64  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`                  //商户唯一订单号
-	Subject         string `protobuf:"bytes,2,opt,name=Subject,proto3" json:"Subject,omitempty"`                 //订单标题
-	NotifyURL       string `protobuf:"bytes,3,opt,name=NotifyURL,proto3" json:"NotifyURL,omitempty"`             //通知回调
-	AppPkgName      string `protobuf:"bytes,4,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`           //应用包名
-	ProductType     int64  `protobuf:"varint,5,opt,name=ProductType,proto3" json:"ProductType,omitempty"`        //商品类型
-	ProductId       int64  `protobuf:"varint,6,opt,name=ProductId,proto3" json:"ProductId,omitempty"`            //商品id 数据库主键
-	ProductDesc     string `protobuf:"bytes,7,opt,name=ProductDesc,proto3" json:"ProductDesc,omitempty"`         //商品详情
-	BelongSignOrder string `protobuf:"bytes,8,opt,name=BelongSignOrder,proto3" json:"BelongSignOrder,omitempty"` //对应的签约订单单号（订阅续费时需要）
-	Ip              string `protobuf:"bytes,9,opt,name=Ip,proto3" json:"Ip,omitempty"`                           //客户端ip地址
-	RedirectUrl     string `protobuf:"bytes,10,opt,name=RedirectUrl,proto3" json:"RedirectUrl,omitempty"`        // 支付成功后重定向地址
-	DeviceId        string `protobuf:"bytes,11,opt,name=DeviceId,proto3" json:"DeviceId,omitempty"`              // 用户设备号
-	IsHwPayProduct  bool   `protobuf:"varint,12,opt,name=IsHwPayProduct,proto3" json:"IsHwPayProduct,omitempty"` // 是否是华为订阅商品
-	AppId           string `protobuf:"bytes,13,opt,name=AppId,proto3" json:"AppId,omitempty"`                    // app id 跟AppPkgName相对应
-	PurchaseEnv     string `protobuf:"bytes,14,opt,name=PurchaseEnv,proto3" json:"PurchaseEnv,omitempty"`        // 订阅购买环境 prod：正式环境, sandbox：沙盒测试
-	Amount          int64  `protobuf:"varint,15,opt,name=Amount,proto3" json:"Amount,omitempty"`                 //商品金额 单位分
-	ProductIdStr    string `protobuf:"bytes,16,opt,name=ProductIdStr,proto3" json:"ProductIdStr,omitempty"`      //商品id 唯一字符串表示
+	BatchNo    string                 `protobuf:"bytes,1,opt,name=BatchNo,proto3" json:"BatchNo,omitempty"`        //批次号
+	SuccessNum int64                  `protobuf:"varint,2,opt,name=SuccessNum,proto3" json:"SuccessNum,omitempty"` //转账成功笔数
+	FailNum    int64                  `protobuf:"varint,3,opt,name=FailNum,proto3" json:"FailNum,omitempty"`       //转账失败笔数，其余为处理中、结果未知或待审核
+	List       []*AlipayFundTransResp `protobuf:"bytes,4,rep,name=List,proto3" json:"List,omitempty"`              //每笔转账结果
 }
 
-func (x *AlipayPageSignReq) Reset() {
-	*x = AlipayPageSignReq{}
+func (x *AlipayFundTransBatchResp) Reset() {
+	*x = AlipayFundTransBatchResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[18]
+		mi := &file_payment_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AlipayPageSignReq) String() string {
+func (x *AlipayFundTransBatchResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlipayPageSignReq) ProtoMessage() {}
+func (*AlipayFundTransBatchResp) ProtoMessage() {}
 
-func (x *AlipayPageSignReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[18]
+func (x *AlipayFundTransBatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1744,152 +2101,126 @@ func (x *AlipayPageSignReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlipayPageSignReq.ProtoReflect.Descriptor instead.
-func (*AlipayPageSignReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *AlipayPageSignReq) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AlipayPageSignReq) GetSubject() string {
-	if x != nil {
-		return x.Subject
-	}
-	return ""
-}
-
-func (x *AlipayPageSignReq) GetNotifyURL() string {
-	if x != nil {
-		return x.NotifyURL
-	}
-	return ""
+// Deprecated: Use AlipayFundTransBatchResp.ProtoReflect.Descriptor instead.
+func (*AlipayFundTransBatchResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AlipayPageSignReq) GetAppPkgName() string {
+func (x *AlipayFundTransBatchResp) GetBatchNo() string {
 	if x != nil {
-		return x.AppPkgName
+		return x.BatchNo
 	}
 	return ""
 }
 
-func (x *AlipayPageSignReq) GetProductType() int64 {
+func (x *AlipayFundTransBatchResp) GetSuccessNum() int64 {
 	if x != nil {
-		return x.ProductType
+		return x.SuccessNum
 	}
 	return 0
 }
 
-func (x *AlipayPageSignReq) GetProductId() int64 {
+func (x *AlipayFundTransBatchResp) GetFailNum() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.FailNum
 	}
 	return 0
 }
 
-func (x *AlipayPageSignReq) GetProductDesc() string {
+func (x *AlipayFundTransBatchResp) GetList() []*AlipayFundTransResp {
 	if x != nil {
-		return x.ProductDesc
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-func (x *AlipayPageSignReq) GetBelongSignOrder() string {
-	if x != nil {
-		return x.BelongSignOrder
-	}
-	return ""
-}
+type WechatTransferSceneReportInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *AlipayPageSignReq) GetIp() string {
-	if x != nil {
-		return x.Ip
-	}
-	return ""
+	InfoType    string `protobuf:"bytes,1,opt,name=InfoType,proto3" json:"InfoType,omitempty"`       //信息类型，如：活动名称、奖励说明
+	InfoContent string `protobuf:"bytes,2,opt,name=InfoContent,proto3" json:"InfoContent,omitempty"` //信息内容
 }
 
-func (x *AlipayPageSignReq) GetRedirectUrl() string {
-	if x != nil {
-		return x.RedirectUrl
+func (x *WechatTransferSceneReportInfo) Reset() {
+	*x = WechatTransferSceneReportInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AlipayPageSignReq) GetDeviceId() string {
-	if x != nil {
-		return x.DeviceId
-	}
-	return ""
+func (x *WechatTransferSceneReportInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AlipayPageSignReq) GetIsHwPayProduct() bool {
-	if x != nil {
-		return x.IsHwPayProduct
-	}
-	return false
-}
+func (*WechatTransferSceneReportInfo) ProtoMessage() {}
 
-func (x *AlipayPageSignReq) GetAppId() string {
-	if x != nil {
-		return x.AppId
+func (x *WechatTransferSceneReportInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AlipayPageSignReq) GetPurchaseEnv() string {
-	if x != nil {
-		return x.PurchaseEnv
-	}
-	return ""
+// Deprecated: Use WechatTransferSceneReportInfo.ProtoReflect.Descriptor instead.
+func (*WechatTransferSceneReportInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AlipayPageSignReq) GetAmount() int64 {
+func (x *WechatTransferSceneReportInfo) GetInfoType() string {
 	if x != nil {
-		return x.Amount
+		return x.InfoType
 	}
-	return 0
+	return ""
 }
 
-func (x *AlipayPageSignReq) GetProductIdStr() string {
+func (x *WechatTransferSceneReportInfo) GetInfoContent() string {
 	if x != nil {
-		return x.ProductIdStr
+		return x.InfoContent
 	}
 	return ""
 }
 
-type AlipayTradeReq struct {
+type WechatFundTransferReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OutTradeNo    string `protobuf:"bytes,1,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`       //商户唯一订单号
-	TotalAmount   string `protobuf:"bytes,2,opt,name=TotalAmount,proto3" json:"TotalAmount,omitempty"`     // 订单总金额，单位为元，精确到小数点后两位，STD_RED_PACKET 产品取值范围[0.01,100000000]； TRANS_ACCOUNT_NO_PWD产品取值范围[0.1,100000000]
-	AppPkgName    string `protobuf:"bytes,3,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`       //应用包名
-	SignNotifyURL string `protobuf:"bytes,4,opt,name=SignNotifyURL,proto3" json:"SignNotifyURL,omitempty"` //签约通知回调
-	Subject       string `protobuf:"bytes,5,opt,name=Subject,proto3" json:"Subject,omitempty"`             //订单标题
+	OrderSn            string                           `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`                       //商户唯一订单号
+	AppPkgName         string                           `protobuf:"bytes,2,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`                 //应用包名
+	OpenId             string                           `protobuf:"bytes,3,opt,name=OpenId,proto3" json:"OpenId,omitempty"`                         //收款用户openid
+	TransAmount        int64                            `protobuf:"varint,4,opt,name=TransAmount,proto3" json:"TransAmount,omitempty"`              //转账金额（分）
+	TransferSceneId    string                           `protobuf:"bytes,5,opt,name=TransferSceneId,proto3" json:"TransferSceneId,omitempty"`       //转账场景ID，如1000现金营销
+	TransferRemark     string                           `protobuf:"bytes,6,opt,name=TransferRemark,proto3" json:"TransferRemark,omitempty"`         //转账备注，用户收款时可见
+	UserRecvPerception string                           `protobuf:"bytes,7,opt,name=UserRecvPerception,proto3" json:"UserRecvPerception,omitempty"` //用户收款感知，如：活动奖励、现金奖励
+	ReportInfos        []*WechatTransferSceneReportInfo `protobuf:"bytes,8,rep,name=ReportInfos,proto3" json:"ReportInfos,omitempty"`               //转账场景报备信息
 }
 
-func (x *AlipayTradeReq) Reset() {
-	*x = AlipayTradeReq{}
+func (x *WechatFundTransferReq) Reset() {
+	*x = WechatFundTransferReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[19]
+		mi := &file_payment_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AlipayTradeReq) String() string {
+func (x *WechatFundTransferReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlipayTradeReq) ProtoMessage() {}
+func (*WechatFundTransferReq) ProtoMessage() {}
 
-func (x *AlipayTradeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[19]
+func (x *WechatFundTransferReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1900,128 +2231,95 @@ func (x *AlipayTradeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlipayTradeReq.ProtoReflect.Descriptor instead.
-func (*AlipayTradeReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use WechatFundTransferReq.ProtoReflect.Descriptor instead.
+func (*WechatFundTransferReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AlipayTradeReq) GetOutTradeNo() string {
+func (x *WechatFundTransferReq) GetOrderSn() string {
 	if x != nil {
-		return x.OutTradeNo
+		return x.OrderSn
 	}
 	return ""
 }
 
-func (x *AlipayTradeReq) GetTotalAmount() string {
+func (x *WechatFundTransferReq) GetAppPkgName() string {
 	if x != nil {
-		return x.TotalAmount
+		return x.AppPkgName
 	}
 	return ""
 }
 
-func (x *AlipayTradeReq) GetAppPkgName() string {
+func (x *WechatFundTransferReq) GetOpenId() string {
 	if x != nil {
-		return x.AppPkgName
+		return x.OpenId
 	}
 	return ""
 }
 
-func (x *AlipayTradeReq) GetSignNotifyURL() string {
+func (x *WechatFundTransferReq) GetTransAmount() int64 {
 	if x != nil {
-		return x.SignNotifyURL
+		return x.TransAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *AlipayTradeReq) GetSubject() string {
+func (x *WechatFundTransferReq) GetTransferSceneId() string {
 	if x != nil {
-		return x.Subject
+		return x.TransferSceneId
 	}
 	return ""
 }
 
-type AlipayPageUnSignReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OutTradeNo string `protobuf:"bytes,1,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"` //商户唯一订单号
-	AppPkgName string `protobuf:"bytes,2,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"` //应用包名
-}
-
-func (x *AlipayPageUnSignReq) Reset() {
-	*x = AlipayPageUnSignReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *AlipayPageUnSignReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AlipayPageUnSignReq) ProtoMessage() {}
-
-func (x *AlipayPageUnSignReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *WechatFundTransferReq) GetTransferRemark() string {
+	if x != nil {
+		return x.TransferRemark
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AlipayPageUnSignReq.ProtoReflect.Descriptor instead.
-func (*AlipayPageUnSignReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{20}
+	return ""
 }
 
-func (x *AlipayPageUnSignReq) GetOutTradeNo() string {
+func (x *WechatFundTransferReq) GetUserRecvPerception() string {
 	if x != nil {
-		return x.OutTradeNo
+		return x.UserRecvPerception
 	}
 	return ""
 }
 
-func (x *AlipayPageUnSignReq) GetAppPkgName() string {
+func (x *WechatFundTransferReq) GetReportInfos() []*WechatTransferSceneReportInfo {
 	if x != nil {
-		return x.AppPkgName
+		return x.ReportInfos
 	}
-	return ""
+	return nil
 }
 
-type AlipayPageSignResp struct {
+type AlipayCheckAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	URL                 string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
-	OutTradeNo          string `protobuf:"bytes,2,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`
-	ExternalAgreementNo string `protobuf:"bytes,3,opt,name=ExternalAgreementNo,proto3" json:"ExternalAgreementNo,omitempty"`
+	Name       string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Account    string `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`
+	AppPkgName string `protobuf:"bytes,3,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`
+	UserId     int64  `protobuf:"varint,4,opt,name=UserId,proto3" json:"UserId,omitempty"`
 }
 
-func (x *AlipayPageSignResp) Reset() {
-	*x = AlipayPageSignResp{}
+func (x *AlipayCheckAccountReq) Reset() {
+	*x = AlipayCheckAccountReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[21]
+		mi := &file_payment_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AlipayPageSignResp) String() string {
+func (x *AlipayCheckAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlipayPageSignResp) ProtoMessage() {}
+func (*AlipayCheckAccountReq) ProtoMessage() {}
 
-func (x *AlipayPageSignResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[21]
+func (x *AlipayCheckAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2032,33 +2330,40 @@ func (x *AlipayPageSignResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlipayPageSignResp.ProtoReflect.Descriptor instead.
-func (*AlipayPageSignResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use AlipayCheckAccountReq.ProtoReflect.Descriptor instead.
+func (*AlipayCheckAccountReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *AlipayPageSignResp) GetURL() string {
+func (x *AlipayCheckAccountReq) GetName() string {
 	if x != nil {
-		return x.URL
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AlipayPageSignResp) GetOutTradeNo() string {
+func (x *AlipayCheckAccountReq) GetAccount() string {
 	if x != nil {
-		return x.OutTradeNo
+		return x.Account
 	}
 	return ""
 }
 
-func (x *AlipayPageSignResp) GetExternalAgreementNo() string {
+func (x *AlipayCheckAccountReq) GetAppPkgName() string {
 	if x != nil {
-		return x.ExternalAgreementNo
+		return x.AppPkgName
 	}
 	return ""
 }
 
-type AlipayCommonResp struct {
+func (x *AlipayCheckAccountReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AlipayCheckAccountResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2067,23 +2372,23 @@ type AlipayCommonResp struct {
 	Desc   string `protobuf:"bytes,2,opt,name=Desc,proto3" json:"Desc,omitempty"`
 }
 
-func (x *AlipayCommonResp) Reset() {
-	*x = AlipayCommonResp{}
+func (x *AlipayCheckAccountResp) Reset() {
+	*x = AlipayCheckAccountResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[22]
+		mi := &file_payment_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AlipayCommonResp) String() string {
+func (x *AlipayCheckAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlipayCommonResp) ProtoMessage() {}
+func (*AlipayCheckAccountResp) ProtoMessage() {}
 
-func (x *AlipayCommonResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[22]
+func (x *AlipayCheckAccountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2094,56 +2399,56 @@ func (x *AlipayCommonResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlipayCommonResp.ProtoReflect.Descriptor instead.
-func (*AlipayCommonResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use AlipayCheckAccountResp.ProtoReflect.Descriptor instead.
+func (*AlipayCheckAccountResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AlipayCommonResp) GetStatus() int64 {
+func (x *AlipayCheckAccountResp) GetStatus() int64 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *AlipayCommonResp) GetDesc() string {
+func (x *AlipayCheckAccountResp) GetDesc() string {
 	if x != nil {
 		return x.Desc
 	}
 	return ""
 }
 
-type AlipayRefundReq struct {
+// 抖音支付-退款
+type DyOrderRefundReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppPkgName      string `protobuf:"bytes,1,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`           //应用包名
-	OutTradeNo      string `protobuf:"bytes,2,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`           //商户唯一订单号
-	TradeNo         string `protobuf:"bytes,3,opt,name=TradeNo,proto3" json:"TradeNo,omitempty"`                 //支付宝交易号
-	RefundAmount    string `protobuf:"bytes,4,opt,name=RefundAmount,proto3" json:"RefundAmount,omitempty"`       //退款金额
-	RefundReason    string `protobuf:"bytes,5,opt,name=RefundReason,proto3" json:"RefundReason,omitempty"`       //退款原因
-	RefundNotifyUrl string `protobuf:"bytes,6,opt,name=RefundNotifyUrl,proto3" json:"RefundNotifyUrl,omitempty"` //退款回调
-	Operator        string `protobuf:"bytes,7,opt,name=Operator,proto3" json:"Operator,omitempty"`               //发起者
+	AppPkgName   string `protobuf:"bytes,1,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`      //小程序pkgName
+	OutOrderNo   string `protobuf:"bytes,2,opt,name=OutOrderNo,proto3" json:"OutOrderNo,omitempty"`      //商户分配支付单号，标识进行退款的订单
+	OutRefundNo  string `protobuf:"bytes,3,opt,name=OutRefundNo,proto3" json:"OutRefundNo,omitempty"`    //商户分配退款号，保证在商户中唯一
+	Reason       string `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`              //退款原因
+	RefundAmount int64  `protobuf:"varint,5,opt,name=RefundAmount,proto3" json:"RefundAmount,omitempty"` //退款金额，单位分
+	NotifyUrl    string `protobuf:"bytes,6,opt,name=NotifyUrl,proto3" json:"NotifyUrl,omitempty"`        //商户自定义回调地址，必须以 https 开头，支持 443 端口
 }
 
-func (x *AlipayRefundReq) Reset() {
-	*x = AlipayRefundReq{}
+func (x *DyOrderRefundReq) Reset() {
+	*x = DyOrderRefundReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[23]
+		mi := &file_payment_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AlipayRefundReq) String() string {
+func (x *DyOrderRefundReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlipayRefundReq) ProtoMessage() {}
+func (*DyOrderRefundReq) ProtoMessage() {}
 
-func (x *AlipayRefundReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[23]
+func (x *DyOrderRefundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2154,86 +2459,80 @@ func (x *AlipayRefundReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlipayRefundReq.ProtoReflect.Descriptor instead.
-func (*AlipayRefundReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use DyOrderRefundReq.ProtoReflect.Descriptor instead.
+func (*DyOrderRefundReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AlipayRefundReq) GetAppPkgName() string {
+func (x *DyOrderRefundReq) GetAppPkgName() string {
 	if x != nil {
 		return x.AppPkgName
 	}
 	return ""
 }
 
-func (x *AlipayRefundReq) GetOutTradeNo() string {
-	if x != nil {
-		return x.OutTradeNo
-	}
-	return ""
-}
-
-func (x *AlipayRefundReq) GetTradeNo() string {
+func (x *DyOrderRefundReq) GetOutOrderNo() string {
 	if x != nil {
-		return x.TradeNo
+		return x.OutOrderNo
 	}
 	return ""
 }
 
-func (x *AlipayRefundReq) GetRefundAmount() string {
+func (x *DyOrderRefundReq) GetOutRefundNo() string {
 	if x != nil {
-		return x.RefundAmount
+		return x.OutRefundNo
 	}
 	return ""
 }
 
-func (x *AlipayRefundReq) GetRefundReason() string {
+func (x *DyOrderRefundReq) GetReason() string {
 	if x != nil {
-		return x.RefundReason
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *AlipayRefundReq) GetRefundNotifyUrl() string {
+func (x *DyOrderRefundReq) GetRefundAmount() int64 {
 	if x != nil {
-		return x.RefundNotifyUrl
+		return x.RefundAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *AlipayRefundReq) GetOperator() string {
+func (x *DyOrderRefundReq) GetNotifyUrl() string {
 	if x != nil {
-		return x.Operator
+		return x.NotifyUrl
 	}
 	return ""
 }
 
-type AlipayTradePayReq struct {
+// 抖音支付-退款
+type DyOrderRefundResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OutTradeNo          string `protobuf:"bytes,1,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`
-	ExternalAgreementNo string `protobuf:"bytes,2,opt,name=ExternalAgreementNo,proto3" json:"ExternalAgreementNo,omitempty"`
+	ErrNo   int64  `protobuf:"varint,1,opt,name=ErrNo,proto3" json:"ErrNo,omitempty"`    //详见错误码
+	ErrTips string `protobuf:"bytes,2,opt,name=ErrTips,proto3" json:"ErrTips,omitempty"` //详见错误描述
 }
 
-func (x *AlipayTradePayReq) Reset() {
-	*x = AlipayTradePayReq{}
+func (x *DyOrderRefundResp) Reset() {
+	*x = DyOrderRefundResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[24]
+		mi := &file_payment_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AlipayTradePayReq) String() string {
+func (x *DyOrderRefundResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlipayTradePayReq) ProtoMessage() {}
+func (*DyOrderRefundResp) ProtoMessage() {}
 
-func (x *AlipayTradePayReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[24]
+func (x *DyOrderRefundResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2244,51 +2543,65 @@ func (x *AlipayTradePayReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlipayTradePayReq.ProtoReflect.Descriptor instead.
-func (*AlipayTradePayReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DyOrderRefundResp.ProtoReflect.Descriptor instead.
+func (*DyOrderRefundResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *AlipayTradePayReq) GetOutTradeNo() string {
+func (x *DyOrderRefundResp) GetErrNo() int64 {
 	if x != nil {
-		return x.OutTradeNo
+		return x.ErrNo
 	}
-	return ""
+	return 0
 }
 
-func (x *AlipayTradePayReq) GetExternalAgreementNo() string {
+func (x *DyOrderRefundResp) GetErrTips() string {
 	if x != nil {
-		return x.ExternalAgreementNo
+		return x.ErrTips
 	}
 	return ""
 }
 
-type CreateRefundResp struct {
+type AlipayPageSignReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OutTradeRefundNo string `protobuf:"bytes,1,opt,name=OutTradeRefundNo,proto3" json:"OutTradeRefundNo,omitempty"`
-	Desc             string `protobuf:"bytes,2,opt,name=Desc,proto3" json:"Desc,omitempty"`
+	UserId          int64  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`                  //商户唯一订单号
+	Subject         string `protobuf:"bytes,2,opt,name=Subject,proto3" json:"Subject,omitempty"`                 //订单标题
+	NotifyURL       string `protobuf:"bytes,3,opt,name=NotifyURL,proto3" json:"NotifyURL,omitempty"`             //通知回调
+	AppPkgName      string `protobuf:"bytes,4,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`           //应用包名
+	ProductType     int64  `protobuf:"varint,5,opt,name=ProductType,proto3" json:"ProductType,omitempty"`        //商品类型
+	ProductId       int64  `protobuf:"varint,6,opt,name=ProductId,proto3" json:"ProductId,omitempty"`            //商品id 数据库主键
+	ProductDesc     string `protobuf:"bytes,7,opt,name=ProductDesc,proto3" json:"ProductDesc,omitempty"`         //商品详情
+	BelongSignOrder string `protobuf:"bytes,8,opt,name=BelongSignOrder,proto3" json:"BelongSignOrder,omitempty"` //对应的签约订单单号（订阅续费时需要）
+	Ip              string `protobuf:"bytes,9,opt,name=Ip,proto3" json:"Ip,omitempty"`                           //客户端ip地址
+	RedirectUrl     string `protobuf:"bytes,10,opt,name=RedirectUrl,proto3" json:"RedirectUrl,omitempty"`        // 支付成功后重定向地址
+	DeviceId        string `protobuf:"bytes,11,opt,name=DeviceId,proto3" json:"DeviceId,omitempty"`              // 用户设备号
+	IsHwPayProduct  bool   `protobuf:"varint,12,opt,name=IsHwPayProduct,proto3" json:"IsHwPayProduct,omitempty"` // 是否是华为订阅商品
+	AppId           string `protobuf:"bytes,13,opt,name=AppId,proto3" json:"AppId,omitempty"`                    // app id 跟AppPkgName相对应
+	PurchaseEnv     string `protobuf:"bytes,14,opt,name=PurchaseEnv,proto3" json:"PurchaseEnv,omitempty"`        // 订阅购买环境 prod：正式环境, sandbox：沙盒测试
+	Amount          int64  `protobuf:"varint,15,opt,name=Amount,proto3" json:"Amount,omitempty"`                 //商品金额 单位分
+	ProductIdStr    string `protobuf:"bytes,16,opt,name=ProductIdStr,proto3" json:"ProductIdStr,omitempty"`      //商品id 唯一字符串表示
 }
 
-func (x *CreateRefundResp) Reset() {
-	*x = CreateRefundResp{}
+func (x *AlipayPageSignReq) Reset() {
+	*x = AlipayPageSignReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[25]
+		mi := &file_payment_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRefundResp) String() string {
+func (x *AlipayPageSignReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRefundResp) ProtoMessage() {}
+func (*AlipayPageSignReq) ProtoMessage() {}
 
-func (x *CreateRefundResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[25]
+func (x *AlipayPageSignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2299,124 +2612,152 @@ func (x *CreateRefundResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRefundResp.ProtoReflect.Descriptor instead.
-func (*CreateRefundResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AlipayPageSignReq.ProtoReflect.Descriptor instead.
+func (*AlipayPageSignReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateRefundResp) GetOutTradeRefundNo() string {
+func (x *AlipayPageSignReq) GetUserId() int64 {
 	if x != nil {
-		return x.OutTradeRefundNo
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AlipayPageSignReq) GetSubject() string {
+	if x != nil {
+		return x.Subject
 	}
 	return ""
 }
 
-func (x *CreateRefundResp) GetDesc() string {
+func (x *AlipayPageSignReq) GetNotifyURL() string {
 	if x != nil {
-		return x.Desc
+		return x.NotifyURL
 	}
 	return ""
 }
 
-type AliRefundResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AlipayPageSignReq) GetAppPkgName() string {
+	if x != nil {
+		return x.AppPkgName
+	}
+	return ""
+}
 
-	Status           int64  `protobuf:"varint,1,opt,name=Status,proto3" json:"Status,omitempty"`
-	RefundFee        string `protobuf:"bytes,2,opt,name=RefundFee,proto3" json:"RefundFee,omitempty"`
-	Desc             string `protobuf:"bytes,3,opt,name=Desc,proto3" json:"Desc,omitempty"`
-	OutTradeRefundNo string `protobuf:"bytes,4,opt,name=OutTradeRefundNo,proto3" json:"OutTradeRefundNo,omitempty"`
+func (x *AlipayPageSignReq) GetProductType() int64 {
+	if x != nil {
+		return x.ProductType
+	}
+	return 0
 }
 
-func (x *AliRefundResp) Reset() {
-	*x = AliRefundResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AlipayPageSignReq) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
 	}
+	return 0
 }
 
-func (x *AliRefundResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AlipayPageSignReq) GetProductDesc() string {
+	if x != nil {
+		return x.ProductDesc
+	}
+	return ""
 }
 
-func (*AliRefundResp) ProtoMessage() {}
+func (x *AlipayPageSignReq) GetBelongSignOrder() string {
+	if x != nil {
+		return x.BelongSignOrder
+	}
+	return ""
+}
 
-func (x *AliRefundResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AlipayPageSignReq) GetIp() string {
+	if x != nil {
+		return x.Ip
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AliRefundResp.ProtoReflect.Descriptor instead.
-func (*AliRefundResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{26}
+func (x *AlipayPageSignReq) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
 }
 
-func (x *AliRefundResp) GetStatus() int64 {
+func (x *AlipayPageSignReq) GetDeviceId() string {
 	if x != nil {
-		return x.Status
+		return x.DeviceId
 	}
-	return 0
+	return ""
 }
 
-func (x *AliRefundResp) GetRefundFee() string {
+func (x *AlipayPageSignReq) GetIsHwPayProduct() bool {
 	if x != nil {
-		return x.RefundFee
+		return x.IsHwPayProduct
+	}
+	return false
+}
+
+func (x *AlipayPageSignReq) GetAppId() string {
+	if x != nil {
+		return x.AppId
 	}
 	return ""
 }
 
-func (x *AliRefundResp) GetDesc() string {
+func (x *AlipayPageSignReq) GetPurchaseEnv() string {
 	if x != nil {
-		return x.Desc
+		return x.PurchaseEnv
 	}
 	return ""
 }
 
-func (x *AliRefundResp) GetOutTradeRefundNo() string {
+func (x *AlipayPageSignReq) GetAmount() int64 {
 	if x != nil {
-		return x.OutTradeRefundNo
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AlipayPageSignReq) GetProductIdStr() string {
+	if x != nil {
+		return x.ProductIdStr
 	}
 	return ""
 }
 
-type AlipayAgreementModifyReq struct {
+type AlipayTradeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeductTime       string `protobuf:"bytes,1,opt,name=DeductTime,proto3" json:"DeductTime,omitempty"`
-	AppPkgName       string `protobuf:"bytes,2,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`
-	OutTradeNo       string `protobuf:"bytes,3,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`
-	DeductOutTradeNo string `protobuf:"bytes,4,opt,name=DeductOutTradeNo,proto3" json:"DeductOutTradeNo,omitempty"` // 续费扣款单
+	OutTradeNo    string `protobuf:"bytes,1,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`       //商户唯一订单号
+	TotalAmount   string `protobuf:"bytes,2,opt,name=TotalAmount,proto3" json:"TotalAmount,omitempty"`     // 订单总金额，单位为元，精确到小数点后两位，STD_RED_PACKET 产品取值范围[0.01,100000000]； TRANS_ACCOUNT_NO_PWD产品取值范围[0.1,100000000]
+	AppPkgName    string `protobuf:"bytes,3,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`       //应用包名
+	SignNotifyURL string `protobuf:"bytes,4,opt,name=SignNotifyURL,proto3" json:"SignNotifyURL,omitempty"` //签约通知回调
+	Subject       string `protobuf:"bytes,5,opt,name=Subject,proto3" json:"Subject,omitempty"`             //订单标题
 }
 
-func (x *AlipayAgreementModifyReq) Reset() {
-	*x = AlipayAgreementModifyReq{}
+func (x *AlipayTradeReq) Reset() {
+	*x = AlipayTradeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[27]
+		mi := &file_payment_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AlipayAgreementModifyReq) String() string {
+func (x *AlipayTradeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlipayAgreementModifyReq) ProtoMessage() {}
+func (*AlipayTradeReq) ProtoMessage() {}
 
-func (x *AlipayAgreementModifyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[27]
+func (x *AlipayTradeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,68 +2768,72 @@ func (x *AlipayAgreementModifyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlipayAgreementModifyReq.ProtoReflect.Descriptor instead.
-func (*AlipayAgreementModifyReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use AlipayTradeReq.ProtoReflect.Descriptor instead.
+func (*AlipayTradeReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AlipayAgreementModifyReq) GetDeductTime() string {
+func (x *AlipayTradeReq) GetOutTradeNo() string {
 	if x != nil {
-		return x.DeductTime
+		return x.OutTradeNo
 	}
 	return ""
 }
 
-func (x *AlipayAgreementModifyReq) GetAppPkgName() string {
+func (x *AlipayTradeReq) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *AlipayTradeReq) GetAppPkgName() string {
 	if x != nil {
 		return x.AppPkgName
 	}
 	return ""
 }
 
-func (x *AlipayAgreementModifyReq) GetOutTradeNo() string {
+func (x *AlipayTradeReq) GetSignNotifyURL() string {
 	if x != nil {
-		return x.OutTradeNo
+		return x.SignNotifyURL
 	}
 	return ""
 }
 
-func (x *AlipayAgreementModifyReq) GetDeductOutTradeNo() string {
+func (x *AlipayTradeReq) GetSubject() string {
 	if x != nil {
-		return x.DeductOutTradeNo
+		return x.Subject
 	}
 	return ""
 }
 
-// 微信支付退款
-type WechatRefundOrderReq struct {
+type AlipayPageUnSignReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OutTradeNo   string `protobuf:"bytes,1,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`     //内部订单号
-	TotalFee     int64  `protobuf:"varint,2,opt,name=TotalFee,proto3" json:"TotalFee,omitempty"`        //订单总金额，单位为分
-	RefundFee    int64  `protobuf:"varint,3,opt,name=RefundFee,proto3" json:"RefundFee,omitempty"`      //退款总金额，订单总金额，单位为分
-	RefundReason string `protobuf:"bytes,4,opt,name=RefundReason,proto3" json:"RefundReason,omitempty"` //退款理由
+	OutTradeNo string `protobuf:"bytes,1,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"` //商户唯一订单号
+	AppPkgName string `protobuf:"bytes,2,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"` //应用包名
 }
 
-func (x *WechatRefundOrderReq) Reset() {
-	*x = WechatRefundOrderReq{}
+func (x *AlipayPageUnSignReq) Reset() {
+	*x = AlipayPageUnSignReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[28]
+		mi := &file_payment_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WechatRefundOrderReq) String() string {
+func (x *AlipayPageUnSignReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WechatRefundOrderReq) ProtoMessage() {}
+func (*AlipayPageUnSignReq) ProtoMessage() {}
 
-func (x *WechatRefundOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[28]
+func (x *AlipayPageUnSignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2499,78 +2844,53 @@ func (x *WechatRefundOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WechatRefundOrderReq.ProtoReflect.Descriptor instead.
-func (*WechatRefundOrderReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use AlipayPageUnSignReq.ProtoReflect.Descriptor instead.
+func (*AlipayPageUnSignReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *WechatRefundOrderReq) GetOutTradeNo() string {
+func (x *AlipayPageUnSignReq) GetOutTradeNo() string {
 	if x != nil {
 		return x.OutTradeNo
 	}
 	return ""
 }
 
-func (x *WechatRefundOrderReq) GetTotalFee() int64 {
-	if x != nil {
-		return x.TotalFee
-	}
-	return 0
-}
-
-func (x *WechatRefundOrderReq) GetRefundFee() int64 {
-	if x != nil {
-		return x.RefundFee
-	}
-	return 0
-}
-
-func (x *WechatRefundOrderReq) GetRefundReason() string {
+func (x *AlipayPageUnSignReq) GetAppPkgName() string {
 	if x != nil {
-		return x.RefundReason
+		return x.AppPkgName
 	}
 	return ""
 }
 
-// 抖音通用交易系统 https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/api/industry/general_trade/create_order/requestOrder#33efe69e
-// https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/trade-system/general/order/request-order-data-sign
-// SkuList[]​ SkuList说明见下文​
-type DouyinGeneralTradeReq struct {
+type AlipayPageSignResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SkuId            string                           `protobuf:"bytes,1,opt,name=skuId,proto3" json:"skuId,omitempty"`                                                           // 外部商品id
-	Price            int32                            `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                                          // 价格 分
-	Quantity         int32                            `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                                                    // 购买数量​ 0 < quantity <= 100 ​
-	Title            string                           `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                                           // 商品标题，长度 <= 256字节
-	ImageList        []string                         `protobuf:"bytes,5,rep,name=imageList,proto3" json:"imageList,omitempty"`                                                   // 商品图片链接，长度 <= 512 字节，只取第一项
-	Type             DouyinGeneralTradeReq_SkuType    `protobuf:"varint,6,opt,name=type,proto3,enum=payment.DouyinGeneralTradeReq_SkuType" json:"type,omitempty"`                 // 商品类型
-	EntrySchema      *Schema                          `protobuf:"bytes,8,opt,name=entrySchema,proto3" json:"entrySchema,omitempty"`                                               // 非必填 商品详情页链接 https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/trade-system/general/order/request-order-data-sign
-	OrderEntrySchema *Schema                          `protobuf:"bytes,9,opt,name=orderEntrySchema,proto3" json:"orderEntrySchema,omitempty"`                                     // 订单详情页链接 必填
-	LimitPayWayList  []int32                          `protobuf:"varint,10,rep,packed,name=limitPayWayList,proto3" json:"limitPayWayList,omitempty"`                              // 限制的支付方式 1 微信 2 支付宝 屏蔽的支付方式，当开发者没有进件某个支付渠道，可在下单时屏蔽对应的支付方式。如：[1, 2]表示屏蔽微信和支付宝​ 枚举说明：​1-微信​ 2-支付宝​
-	IosPayType       DouyinGeneralTradeReq_IosPayType `protobuf:"varint,11,opt,name=iosPayType,proto3,enum=payment.DouyinGeneralTradeReq_IosPayType" json:"iosPayType,omitempty"` // ios场景下支付方式
-	TagGroupId       string                           `protobuf:"bytes,12,opt,name=tagGroupId,proto3" json:"tagGroupId,omitempty"`                                                // 交易规则标签组​ https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/trade-system/general/tag/tag-group-query
-	SkuAttr          string                           `protobuf:"bytes,13,opt,name=skuAttr,proto3" json:"skuAttr,omitempty"`                                                      // 商品信息：需要将不同商品类型定义的具体结构，转换成json string​ ​号卡类商品必填，即当前商品类型 type in [101、102、103、104、105、106、107]的商品必填，内部结构请详见下文 ”skuAttr“ 小节说明。​
+	URL                 string             `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	OutTradeNo          string             `protobuf:"bytes,2,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`
+	ExternalAgreementNo string             `protobuf:"bytes,3,opt,name=ExternalAgreementNo,proto3" json:"ExternalAgreementNo,omitempty"`
+	Schedule            *SubscribeSchedule `protobuf:"bytes,4,opt,name=Schedule,proto3" json:"Schedule,omitempty"` // 订阅商品的扣款计划
 }
 
-func (x *DouyinGeneralTradeReq) Reset() {
-	*x = DouyinGeneralTradeReq{}
+func (x *AlipayPageSignResp) Reset() {
+	*x = AlipayPageSignResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[29]
+		mi := &file_payment_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DouyinGeneralTradeReq) String() string {
+func (x *AlipayPageSignResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DouyinGeneralTradeReq) ProtoMessage() {}
+func (*AlipayPageSignResp) ProtoMessage() {}
 
-func (x *DouyinGeneralTradeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[29]
+func (x *AlipayPageSignResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2581,122 +2901,125 @@ func (x *DouyinGeneralTradeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DouyinGeneralTradeReq.ProtoReflect.Descriptor instead.
-func (*DouyinGeneralTradeReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use AlipayPageSignResp.ProtoReflect.Descriptor instead.
+func (*AlipayPageSignResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DouyinGeneralTradeReq) GetSkuId() string {
+func (x *AlipayPageSignResp) GetURL() string {
 	if x != nil {
-		return x.SkuId
+		return x.URL
 	}
 	return ""
 }
 
-func (x *DouyinGeneralTradeReq) GetPrice() int32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *DouyinGeneralTradeReq) GetQuantity() int32 {
+func (x *AlipayPageSignResp) GetOutTradeNo() string {
 	if x != nil {
-		return x.Quantity
+		return x.OutTradeNo
 	}
-	return 0
+	return ""
 }
 
-func (x *DouyinGeneralTradeReq) GetTitle() string {
+func (x *AlipayPageSignResp) GetExternalAgreementNo() string {
 	if x != nil {
-		return x.Title
+		return x.ExternalAgreementNo
 	}
 	return ""
 }
 
-func (x *DouyinGeneralTradeReq) GetImageList() []string {
+func (x *AlipayPageSignResp) GetSchedule() *SubscribeSchedule {
 	if x != nil {
-		return x.ImageList
+		return x.Schedule
 	}
 	return nil
 }
 
-func (x *DouyinGeneralTradeReq) GetType() DouyinGeneralTradeReq_SkuType {
-	if x != nil {
-		return x.Type
-	}
-	return DouyinGeneralTradeReq_Unknown
+type AlipayCommonResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int64  `protobuf:"varint,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	Desc   string `protobuf:"bytes,2,opt,name=Desc,proto3" json:"Desc,omitempty"`
 }
 
-func (x *DouyinGeneralTradeReq) GetEntrySchema() *Schema {
-	if x != nil {
-		return x.EntrySchema
+func (x *AlipayCommonResp) Reset() {
+	*x = AlipayCommonResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *DouyinGeneralTradeReq) GetOrderEntrySchema() *Schema {
-	if x != nil {
-		return x.OrderEntrySchema
-	}
-	return nil
+func (x *AlipayCommonResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DouyinGeneralTradeReq) GetLimitPayWayList() []int32 {
-	if x != nil {
-		return x.LimitPayWayList
+func (*AlipayCommonResp) ProtoMessage() {}
+
+func (x *AlipayCommonResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DouyinGeneralTradeReq) GetIosPayType() DouyinGeneralTradeReq_IosPayType {
-	if x != nil {
-		return x.IosPayType
-	}
-	return DouyinGeneralTradeReq_IosPayTypeUnknown
+// Deprecated: Use AlipayCommonResp.ProtoReflect.Descriptor instead.
+func (*AlipayCommonResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DouyinGeneralTradeReq) GetTagGroupId() string {
+func (x *AlipayCommonResp) GetStatus() int64 {
 	if x != nil {
-		return x.TagGroupId
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *DouyinGeneralTradeReq) GetSkuAttr() string {
+func (x *AlipayCommonResp) GetDesc() string {
 	if x != nil {
-		return x.SkuAttr
+		return x.Desc
 	}
 	return ""
 }
 
-// https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/trade-system/general/order/request-order-data-sign
-type Schema struct {
+type AlipayRefundReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`     // 详情页跳转路径，没有前导的“/”，路径后不可携带query参数
-	Params string `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"` // 详情页路径参数
+	AppPkgName      string `protobuf:"bytes,1,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`           //应用包名
+	OutTradeNo      string `protobuf:"bytes,2,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`           //商户唯一订单号
+	TradeNo         string `protobuf:"bytes,3,opt,name=TradeNo,proto3" json:"TradeNo,omitempty"`                 //支付宝交易号
+	RefundAmount    string `protobuf:"bytes,4,opt,name=RefundAmount,proto3" json:"RefundAmount,omitempty"`       //退款金额
+	RefundReason    string `protobuf:"bytes,5,opt,name=RefundReason,proto3" json:"RefundReason,omitempty"`       //退款原因
+	RefundNotifyUrl string `protobuf:"bytes,6,opt,name=RefundNotifyUrl,proto3" json:"RefundNotifyUrl,omitempty"` //退款回调
+	Operator        string `protobuf:"bytes,7,opt,name=Operator,proto3" json:"Operator,omitempty"`               //发起者
 }
 
-func (x *Schema) Reset() {
-	*x = Schema{}
+func (x *AlipayRefundReq) Reset() {
+	*x = AlipayRefundReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[30]
+		mi := &file_payment_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Schema) String() string {
+func (x *AlipayRefundReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Schema) ProtoMessage() {}
+func (*AlipayRefundReq) ProtoMessage() {}
 
-func (x *Schema) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[30]
+func (x *AlipayRefundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2707,52 +3030,86 @@ func (x *Schema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Schema.ProtoReflect.Descriptor instead.
-func (*Schema) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use AlipayRefundReq.ProtoReflect.Descriptor instead.
+func (*AlipayRefundReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Schema) GetPath() string {
+func (x *AlipayRefundReq) GetAppPkgName() string {
 	if x != nil {
-		return x.Path
+		return x.AppPkgName
 	}
 	return ""
 }
 
-func (x *Schema) GetParams() string {
+func (x *AlipayRefundReq) GetOutTradeNo() string {
 	if x != nil {
-		return x.Params
+		return x.OutTradeNo
 	}
 	return ""
 }
 
-type DouyinGeneralTradeReply struct {
+func (x *AlipayRefundReq) GetTradeNo() string {
+	if x != nil {
+		return x.TradeNo
+	}
+	return ""
+}
+
+func (x *AlipayRefundReq) GetRefundAmount() string {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return ""
+}
+
+func (x *AlipayRefundReq) GetRefundReason() string {
+	if x != nil {
+		return x.RefundReason
+	}
+	return ""
+}
+
+func (x *AlipayRefundReq) GetRefundNotifyUrl() string {
+	if x != nil {
+		return x.RefundNotifyUrl
+	}
+	return ""
+}
+
+func (x *AlipayRefundReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type AlipayTradePayReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data              string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`                           //订单信息
-	ByteAuthorization string `protobuf:"bytes,2,opt,name=byteAuthorization,proto3" json:"byteAuthorization,omitempty"` //签名字符串
-	CustomerImId      string `protobuf:"bytes,3,opt,name=customerImId,proto3" json:"customerImId,omitempty"`           // 客服imId 用于ios支付
+	OutTradeNo          string `protobuf:"bytes,1,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`
+	ExternalAgreementNo string `protobuf:"bytes,2,opt,name=ExternalAgreementNo,proto3" json:"ExternalAgreementNo,omitempty"`
 }
 
-func (x *DouyinGeneralTradeReply) Reset() {
-	*x = DouyinGeneralTradeReply{}
+func (x *AlipayTradePayReq) Reset() {
+	*x = AlipayTradePayReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[31]
+		mi := &file_payment_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DouyinGeneralTradeReply) String() string {
+func (x *AlipayTradePayReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DouyinGeneralTradeReply) ProtoMessage() {}
+func (*AlipayTradePayReq) ProtoMessage() {}
 
-func (x *DouyinGeneralTradeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[31]
+func (x *AlipayTradePayReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2763,66 +3120,51 @@ func (x *DouyinGeneralTradeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DouyinGeneralTradeReply.ProtoReflect.Descriptor instead.
-func (*DouyinGeneralTradeReply) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *DouyinGeneralTradeReply) GetData() string {
-	if x != nil {
-		return x.Data
-	}
-	return ""
+// Deprecated: Use AlipayTradePayReq.ProtoReflect.Descriptor instead.
+func (*AlipayTradePayReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DouyinGeneralTradeReply) GetByteAuthorization() string {
+func (x *AlipayTradePayReq) GetOutTradeNo() string {
 	if x != nil {
-		return x.ByteAuthorization
+		return x.OutTradeNo
 	}
 	return ""
 }
 
-func (x *DouyinGeneralTradeReply) GetCustomerImId() string {
+func (x *AlipayTradePayReq) GetExternalAgreementNo() string {
 	if x != nil {
-		return x.CustomerImId
+		return x.ExternalAgreementNo
 	}
 	return ""
 }
 
-type CreateDouyinRefundReq struct {
+type CreateRefundResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppPkgName       string  `protobuf:"bytes,1,opt,name=appPkgName,proto3" json:"appPkgName,omitempty"`             // 应用包名
-	OutOrderNo       string  `protobuf:"bytes,2,opt,name=outOrderNo,proto3" json:"outOrderNo,omitempty"`             // 商户支付订单号
-	OutRefundNo      string  `protobuf:"bytes,3,opt,name=outRefundNo,proto3" json:"outRefundNo,omitempty"`           // 商户退款订单号
-	RefundAmount     int64   `protobuf:"varint,4,opt,name=refundAmount,proto3" json:"refundAmount,omitempty"`        // 退款金额 分
-	RefundReason     string  `protobuf:"bytes,5,opt,name=refundReason,proto3" json:"refundReason,omitempty"`         // 退款原因
-	RefundNotifyUrl  string  `protobuf:"bytes,6,opt,name=refundNotifyUrl,proto3" json:"refundNotifyUrl,omitempty"`   // 退款回调地址
-	OrderEntrySchema *Schema `protobuf:"bytes,7,opt,name=orderEntrySchema,proto3" json:"orderEntrySchema,omitempty"` // 退款单的跳转的 schema
-	OrderSn          string  `protobuf:"bytes,8,opt,name=orderSn,proto3" json:"orderSn,omitempty"`                   //orderCode订单号
-	RefundAll        bool    `protobuf:"varint,9,opt,name=refundAll,proto3" json:"refundAll,omitempty"`              //是否退款全部金额
-	IsPeriodProduct  bool    `protobuf:"varint,10,opt,name=isPeriodProduct,proto3" json:"isPeriodProduct,omitempty"` //是否是周期代扣商品
+	OutTradeRefundNo string `protobuf:"bytes,1,opt,name=OutTradeRefundNo,proto3" json:"OutTradeRefundNo,omitempty"`
+	Desc             string `protobuf:"bytes,2,opt,name=Desc,proto3" json:"Desc,omitempty"`
 }
 
-func (x *CreateDouyinRefundReq) Reset() {
-	*x = CreateDouyinRefundReq{}
+func (x *CreateRefundResp) Reset() {
+	*x = CreateRefundResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[32]
+		mi := &file_payment_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateDouyinRefundReq) String() string {
+func (x *CreateRefundResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDouyinRefundReq) ProtoMessage() {}
+func (*CreateRefundResp) ProtoMessage() {}
 
-func (x *CreateDouyinRefundReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[32]
+func (x *CreateRefundResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2833,107 +3175,53 @@ func (x *CreateDouyinRefundReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDouyinRefundReq.ProtoReflect.Descriptor instead.
-func (*CreateDouyinRefundReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CreateRefundResp.ProtoReflect.Descriptor instead.
+func (*CreateRefundResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CreateDouyinRefundReq) GetAppPkgName() string {
+func (x *CreateRefundResp) GetOutTradeRefundNo() string {
 	if x != nil {
-		return x.AppPkgName
+		return x.OutTradeRefundNo
 	}
 	return ""
 }
 
-func (x *CreateDouyinRefundReq) GetOutOrderNo() string {
+func (x *CreateRefundResp) GetDesc() string {
 	if x != nil {
-		return x.OutOrderNo
+		return x.Desc
 	}
 	return ""
 }
 
-func (x *CreateDouyinRefundReq) GetOutRefundNo() string {
-	if x != nil {
-		return x.OutRefundNo
-	}
-	return ""
-}
-
-func (x *CreateDouyinRefundReq) GetRefundAmount() int64 {
-	if x != nil {
-		return x.RefundAmount
-	}
-	return 0
-}
-
-func (x *CreateDouyinRefundReq) GetRefundReason() string {
-	if x != nil {
-		return x.RefundReason
-	}
-	return ""
-}
-
-func (x *CreateDouyinRefundReq) GetRefundNotifyUrl() string {
-	if x != nil {
-		return x.RefundNotifyUrl
-	}
-	return ""
-}
-
-func (x *CreateDouyinRefundReq) GetOrderEntrySchema() *Schema {
-	if x != nil {
-		return x.OrderEntrySchema
-	}
-	return nil
-}
-
-func (x *CreateDouyinRefundReq) GetOrderSn() string {
-	if x != nil {
-		return x.OrderSn
-	}
-	return ""
-}
-
-func (x *CreateDouyinRefundReq) GetRefundAll() bool {
-	if x != nil {
-		return x.RefundAll
-	}
-	return false
-}
-
-func (x *CreateDouyinRefundReq) GetIsPeriodProduct() bool {
-	if x != nil {
-		return x.IsPeriodProduct
-	}
-	return false
-}
-
-type CreateDouyinRefundResp struct {
+type AliRefundResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RefundId            string `protobuf:"bytes,1,opt,name=refundId,proto3" json:"refundId,omitempty"`                        // 抖音侧退款id
-	RefundAuditDeadline int64  `protobuf:"varint,2,opt,name=refundAuditDeadline,proto3" json:"refundAuditDeadline,omitempty"` // 退款截止期限
+	Status           int64  `protobuf:"varint,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	RefundFee        string `protobuf:"bytes,2,opt,name=RefundFee,proto3" json:"RefundFee,omitempty"`
+	Desc             string `protobuf:"bytes,3,opt,name=Desc,proto3" json:"Desc,omitempty"`
+	OutTradeRefundNo string `protobuf:"bytes,4,opt,name=OutTradeRefundNo,proto3" json:"OutTradeRefundNo,omitempty"`
 }
 
-func (x *CreateDouyinRefundResp) Reset() {
-	*x = CreateDouyinRefundResp{}
+func (x *AliRefundResp) Reset() {
+	*x = AliRefundResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[33]
+		mi := &file_payment_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateDouyinRefundResp) String() string {
+func (x *AliRefundResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDouyinRefundResp) ProtoMessage() {}
+func (*AliRefundResp) ProtoMessage() {}
 
-func (x *CreateDouyinRefundResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[33]
+func (x *AliRefundResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2944,52 +3232,67 @@ func (x *CreateDouyinRefundResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDouyinRefundResp.ProtoReflect.Descriptor instead.
-func (*CreateDouyinRefundResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use AliRefundResp.ProtoReflect.Descriptor instead.
+func (*AliRefundResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CreateDouyinRefundResp) GetRefundId() string {
+func (x *AliRefundResp) GetStatus() int64 {
 	if x != nil {
-		return x.RefundId
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AliRefundResp) GetRefundFee() string {
+	if x != nil {
+		return x.RefundFee
 	}
 	return ""
 }
 
-func (x *CreateDouyinRefundResp) GetRefundAuditDeadline() int64 {
+func (x *AliRefundResp) GetDesc() string {
 	if x != nil {
-		return x.RefundAuditDeadline
+		return x.Desc
 	}
-	return 0
+	return ""
 }
 
-type BindHuaweiPayDataReq struct {
+func (x *AliRefundResp) GetOutTradeRefundNo() string {
+	if x != nil {
+		return x.OutTradeRefundNo
+	}
+	return ""
+}
+
+type AlipayAgreementModifyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId     int64  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`        // 用户id
-	OutOrderNo string `protobuf:"bytes,2,opt,name=OutOrderNo,proto3" json:"OutOrderNo,omitempty"` // 订单号
-	HwpayToken string `protobuf:"bytes,3,opt,name=HwpayToken,proto3" json:"HwpayToken,omitempty"` // 华为购买token
+	DeductTime       string `protobuf:"bytes,1,opt,name=DeductTime,proto3" json:"DeductTime,omitempty"`
+	AppPkgName       string `protobuf:"bytes,2,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`
+	OutTradeNo       string `protobuf:"bytes,3,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`
+	DeductOutTradeNo string `protobuf:"bytes,4,opt,name=DeductOutTradeNo,proto3" json:"DeductOutTradeNo,omitempty"` // 续费扣款单
 }
 
-func (x *BindHuaweiPayDataReq) Reset() {
-	*x = BindHuaweiPayDataReq{}
+func (x *AlipayAgreementModifyReq) Reset() {
+	*x = AlipayAgreementModifyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[34]
+		mi := &file_payment_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BindHuaweiPayDataReq) String() string {
+func (x *AlipayAgreementModifyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BindHuaweiPayDataReq) ProtoMessage() {}
+func (*AlipayAgreementModifyReq) ProtoMessage() {}
 
-func (x *BindHuaweiPayDataReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[34]
+func (x *AlipayAgreementModifyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3000,59 +3303,68 @@ func (x *BindHuaweiPayDataReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BindHuaweiPayDataReq.ProtoReflect.Descriptor instead.
-func (*BindHuaweiPayDataReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use AlipayAgreementModifyReq.ProtoReflect.Descriptor instead.
+func (*AlipayAgreementModifyReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *BindHuaweiPayDataReq) GetUserId() int64 {
+func (x *AlipayAgreementModifyReq) GetDeductTime() string {
 	if x != nil {
-		return x.UserId
+		return x.DeductTime
 	}
-	return 0
+	return ""
 }
 
-func (x *BindHuaweiPayDataReq) GetOutOrderNo() string {
+func (x *AlipayAgreementModifyReq) GetAppPkgName() string {
 	if x != nil {
-		return x.OutOrderNo
+		return x.AppPkgName
 	}
 	return ""
 }
 
-func (x *BindHuaweiPayDataReq) GetHwpayToken() string {
+func (x *AlipayAgreementModifyReq) GetOutTradeNo() string {
 	if x != nil {
-		return x.HwpayToken
+		return x.OutTradeNo
 	}
 	return ""
 }
 
-type UnsubscribeHuaweiReq struct {
+func (x *AlipayAgreementModifyReq) GetDeductOutTradeNo() string {
+	if x != nil {
+		return x.DeductOutTradeNo
+	}
+	return ""
+}
+
+// 微信支付退款
+type WechatRefundOrderReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pkg            string `protobuf:"bytes,1,opt,name=Pkg,proto3" json:"Pkg,omitempty"`                       // 客户端包名
-	SubscriptionId string `protobuf:"bytes,2,opt,name=SubscriptionId,proto3" json:"SubscriptionId,omitempty"` // 华为订阅id
-	PurchaseToken  string `protobuf:"bytes,3,opt,name=PurchaseToken,proto3" json:"PurchaseToken,omitempty"`   // 华为购买token
+	OutTradeNo   string `protobuf:"bytes,1,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`     //内部订单号
+	TotalFee     int64  `protobuf:"varint,2,opt,name=TotalFee,proto3" json:"TotalFee,omitempty"`        //订单总金额，单位为分
+	RefundFee    int64  `protobuf:"varint,3,opt,name=RefundFee,proto3" json:"RefundFee,omitempty"`      //退款总金额，订单总金额，单位为分
+	RefundReason string `protobuf:"bytes,4,opt,name=RefundReason,proto3" json:"RefundReason,omitempty"` //退款理由
 }
 
-func (x *UnsubscribeHuaweiReq) Reset() {
-	*x = UnsubscribeHuaweiReq{}
+func (x *WechatRefundOrderReq) Reset() {
+	*x = WechatRefundOrderReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[35]
+		mi := &file_payment_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubscribeHuaweiReq) String() string {
+func (x *WechatRefundOrderReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubscribeHuaweiReq) ProtoMessage() {}
+func (*WechatRefundOrderReq) ProtoMessage() {}
 
-func (x *UnsubscribeHuaweiReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[35]
+func (x *WechatRefundOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3063,58 +3375,78 @@ func (x *UnsubscribeHuaweiReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubscribeHuaweiReq.ProtoReflect.Descriptor instead.
-func (*UnsubscribeHuaweiReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use WechatRefundOrderReq.ProtoReflect.Descriptor instead.
+func (*WechatRefundOrderReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *UnsubscribeHuaweiReq) GetPkg() string {
+func (x *WechatRefundOrderReq) GetOutTradeNo() string {
 	if x != nil {
-		return x.Pkg
+		return x.OutTradeNo
 	}
 	return ""
 }
 
-func (x *UnsubscribeHuaweiReq) GetSubscriptionId() string {
+func (x *WechatRefundOrderReq) GetTotalFee() int64 {
 	if x != nil {
-		return x.SubscriptionId
+		return x.TotalFee
 	}
-	return ""
+	return 0
 }
 
-func (x *UnsubscribeHuaweiReq) GetPurchaseToken() string {
+func (x *WechatRefundOrderReq) GetRefundFee() int64 {
 	if x != nil {
-		return x.PurchaseToken
+		return x.RefundFee
+	}
+	return 0
+}
+
+func (x *WechatRefundOrderReq) GetRefundReason() string {
+	if x != nil {
+		return x.RefundReason
 	}
 	return ""
 }
 
-type BindHuaweiPayDataResp struct {
+// 抖音通用交易系统 https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/api/industry/general_trade/create_order/requestOrder#33efe69e
+// https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/trade-system/general/order/request-order-data-sign
+// SkuList[]​ SkuList说明见下文​
+type DouyinGeneralTradeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int32  `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"` // 返回状态
-	Msg  string `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`    // 返回消息
+	SkuId            string                           `protobuf:"bytes,1,opt,name=skuId,proto3" json:"skuId,omitempty"`                                                           // 外部商品id
+	Price            int32                            `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                                          // 价格 分
+	Quantity         int32                            `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                                                    // 购买数量​ 0 < quantity <= 100 ​
+	Title            string                           `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                                           // 商品标题，长度 <= 256字节
+	ImageList        []string                         `protobuf:"bytes,5,rep,name=imageList,proto3" json:"imageList,omitempty"`                                                   // 商品图片链接，长度 <= 512 字节，只取第一项
+	Type             DouyinGeneralTradeReq_SkuType    `protobuf:"varint,6,opt,name=type,proto3,enum=payment.DouyinGeneralTradeReq_SkuType" json:"type,omitempty"`                 // 商品类型
+	EntrySchema      *Schema                          `protobuf:"bytes,8,opt,name=entrySchema,proto3" json:"entrySchema,omitempty"`                                               // 非必填 商品详情页链接 https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/trade-system/general/order/request-order-data-sign
+	OrderEntrySchema *Schema                          `protobuf:"bytes,9,opt,name=orderEntrySchema,proto3" json:"orderEntrySchema,omitempty"`                                     // 订单详情页链接 必填
+	LimitPayWayList  []int32                          `protobuf:"varint,10,rep,packed,name=limitPayWayList,proto3" json:"limitPayWayList,omitempty"`                              // 限制的支付方式 1 微信 2 支付宝 屏蔽的支付方式，当开发者没有进件某个支付渠道，可在下单时屏蔽对应的支付方式。如：[1, 2]表示屏蔽微信和支付宝​ 枚举说明：​1-微信​ 2-支付宝​
+	IosPayType       DouyinGeneralTradeReq_IosPayType `protobuf:"varint,11,opt,name=iosPayType,proto3,enum=payment.DouyinGeneralTradeReq_IosPayType" json:"iosPayType,omitempty"` // ios场景下支付方式
+	TagGroupId       string                           `protobuf:"bytes,12,opt,name=tagGroupId,proto3" json:"tagGroupId,omitempty"`                                                // 交易规则标签组​ https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/trade-system/general/tag/tag-group-query
+	SkuAttr          string                           `protobuf:"bytes,13,opt,name=skuAttr,proto3" json:"skuAttr,omitempty"`                                                      // 商品信息：需要将不同商品类型定义的具体结构，转换成json string​ ​号卡类商品必填，即当前商品类型 type in [101、102、103、104、105、106、107]的商品必填，内部结构请详见下文 ”skuAttr“ 小节说明。​
 }
 
-func (x *BindHuaweiPayDataResp) Reset() {
-	*x = BindHuaweiPayDataResp{}
+func (x *DouyinGeneralTradeReq) Reset() {
+	*x = DouyinGeneralTradeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[36]
+		mi := &file_payment_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BindHuaweiPayDataResp) String() string {
+func (x *DouyinGeneralTradeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BindHuaweiPayDataResp) ProtoMessage() {}
+func (*DouyinGeneralTradeReq) ProtoMessage() {}
 
-func (x *BindHuaweiPayDataResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[36]
+func (x *DouyinGeneralTradeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3125,51 +3457,122 @@ func (x *BindHuaweiPayDataResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BindHuaweiPayDataResp.ProtoReflect.Descriptor instead.
-func (*BindHuaweiPayDataResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use DouyinGeneralTradeReq.ProtoReflect.Descriptor instead.
+func (*DouyinGeneralTradeReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *BindHuaweiPayDataResp) GetCode() int32 {
+func (x *DouyinGeneralTradeReq) GetSkuId() string {
 	if x != nil {
-		return x.Code
+		return x.SkuId
 	}
-	return 0
+	return ""
 }
 
-func (x *BindHuaweiPayDataResp) GetMsg() string {
+func (x *DouyinGeneralTradeReq) GetPrice() int32 {
 	if x != nil {
-		return x.Msg
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-type UnsubscribeHuaweiResp struct {
-	state         protoimpl.MessageState
+func (x *DouyinGeneralTradeReq) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *DouyinGeneralTradeReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DouyinGeneralTradeReq) GetImageList() []string {
+	if x != nil {
+		return x.ImageList
+	}
+	return nil
+}
+
+func (x *DouyinGeneralTradeReq) GetType() DouyinGeneralTradeReq_SkuType {
+	if x != nil {
+		return x.Type
+	}
+	return DouyinGeneralTradeReq_Unknown
+}
+
+func (x *DouyinGeneralTradeReq) GetEntrySchema() *Schema {
+	if x != nil {
+		return x.EntrySchema
+	}
+	return nil
+}
+
+func (x *DouyinGeneralTradeReq) GetOrderEntrySchema() *Schema {
+	if x != nil {
+		return x.OrderEntrySchema
+	}
+	return nil
+}
+
+func (x *DouyinGeneralTradeReq) GetLimitPayWayList() []int32 {
+	if x != nil {
+		return x.LimitPayWayList
+	}
+	return nil
+}
+
+func (x *DouyinGeneralTradeReq) GetIosPayType() DouyinGeneralTradeReq_IosPayType {
+	if x != nil {
+		return x.IosPayType
+	}
+	return DouyinGeneralTradeReq_IosPayTypeUnknown
+}
+
+func (x *DouyinGeneralTradeReq) GetTagGroupId() string {
+	if x != nil {
+		return x.TagGroupId
+	}
+	return ""
+}
+
+func (x *DouyinGeneralTradeReq) GetSkuAttr() string {
+	if x != nil {
+		return x.SkuAttr
+	}
+	return ""
+}
+
+// https://developer.open-douyin.com/docs/resource/zh-CN/mini-app/develop/server/trade-system/general/order/request-order-data-sign
+type Schema struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int32  `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"` // 返回状态
-	Msg  string `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`    // 返回消息
+	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`     // 详情页跳转路径，没有前导的“/”，路径后不可携带query参数
+	Params string `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"` // 详情页路径参数
 }
 
-func (x *UnsubscribeHuaweiResp) Reset() {
-	*x = UnsubscribeHuaweiResp{}
+func (x *Schema) Reset() {
+	*x = Schema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[37]
+		mi := &file_payment_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubscribeHuaweiResp) String() string {
+func (x *Schema) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubscribeHuaweiResp) ProtoMessage() {}
+func (*Schema) ProtoMessage() {}
 
-func (x *UnsubscribeHuaweiResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[37]
+func (x *Schema) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3180,53 +3583,52 @@ func (x *UnsubscribeHuaweiResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubscribeHuaweiResp.ProtoReflect.Descriptor instead.
-func (*UnsubscribeHuaweiResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use Schema.ProtoReflect.Descriptor instead.
+func (*Schema) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *UnsubscribeHuaweiResp) GetCode() int32 {
+func (x *Schema) GetPath() string {
 	if x != nil {
-		return x.Code
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-func (x *UnsubscribeHuaweiResp) GetMsg() string {
+func (x *Schema) GetParams() string {
 	if x != nil {
-		return x.Msg
+		return x.Params
 	}
 	return ""
 }
 
-type DouyinPeriodOrderReq struct {
+type DouyinGeneralTradeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action            DouyinPeriodOrderReqAction `protobuf:"varint,1,opt,name=Action,proto3,enum=payment.DouyinPeriodOrderReqAction" json:"Action,omitempty"` // 动作
-	Pkg               string                     `protobuf:"bytes,2,opt,name=Pkg,proto3" json:"Pkg,omitempty"`                                                // 客户端包名
-	UserId            int64                      `protobuf:"varint,3,opt,name=UserId,proto3" json:"UserId,omitempty"`                                         // 用户id
-	PmDyPeriodOrderId int64                      `protobuf:"varint,4,opt,name=PmDyPeriodOrderId,proto3" json:"PmDyPeriodOrderId,omitempty"`                   // pm_dy_period_order id
+	Data              string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`                           //订单信息
+	ByteAuthorization string `protobuf:"bytes,2,opt,name=byteAuthorization,proto3" json:"byteAuthorization,omitempty"` //签名字符串
+	CustomerImId      string `protobuf:"bytes,3,opt,name=customerImId,proto3" json:"customerImId,omitempty"`           // 客服imId 用于ios支付
 }
 
-func (x *DouyinPeriodOrderReq) Reset() {
-	*x = DouyinPeriodOrderReq{}
+func (x *DouyinGeneralTradeReply) Reset() {
+	*x = DouyinGeneralTradeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[38]
+		mi := &file_payment_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DouyinPeriodOrderReq) String() string {
+func (x *DouyinGeneralTradeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DouyinPeriodOrderReq) ProtoMessage() {}
+func (*DouyinGeneralTradeReply) ProtoMessage() {}
 
-func (x *DouyinPeriodOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[38]
+func (x *DouyinGeneralTradeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3237,72 +3639,66 @@ func (x *DouyinPeriodOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DouyinPeriodOrderReq.ProtoReflect.Descriptor instead.
-func (*DouyinPeriodOrderReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *DouyinPeriodOrderReq) GetAction() DouyinPeriodOrderReqAction {
-	if x != nil {
-		return x.Action
-	}
-	return DouyinPeriodOrderReqAction_DyPeriodActionQuery
+// Deprecated: Use DouyinGeneralTradeReply.ProtoReflect.Descriptor instead.
+func (*DouyinGeneralTradeReply) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DouyinPeriodOrderReq) GetPkg() string {
+func (x *DouyinGeneralTradeReply) GetData() string {
 	if x != nil {
-		return x.Pkg
+		return x.Data
 	}
 	return ""
 }
 
-func (x *DouyinPeriodOrderReq) GetUserId() int64 {
+func (x *DouyinGeneralTradeReply) GetByteAuthorization() string {
 	if x != nil {
-		return x.UserId
+		return x.ByteAuthorization
 	}
-	return 0
+	return ""
 }
 
-func (x *DouyinPeriodOrderReq) GetPmDyPeriodOrderId() int64 {
+func (x *DouyinGeneralTradeReply) GetCustomerImId() string {
 	if x != nil {
-		return x.PmDyPeriodOrderId
+		return x.CustomerImId
 	}
-	return 0
+	return ""
 }
 
-// 抖音已签约周期扣款信息
-type DySignedOrderInfo struct {
+type CreateDouyinRefundReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderSn           string `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`                     // 内部 订单唯一标识 (开发者侧代扣单的单号)
-	AppPkg            string `protobuf:"bytes,2,opt,name=AppPkg,proto3" json:"AppPkg,omitempty"`                       // 来源包名
-	UserId            int64  `protobuf:"varint,3,opt,name=UserId,proto3" json:"UserId,omitempty"`                      // 用户id
-	NextDecuctionTime string `protobuf:"bytes,4,opt,name=NextDecuctionTime,proto3" json:"NextDecuctionTime,omitempty"` // 下次扣款时间
-	DySignNo          string `protobuf:"bytes,5,opt,name=DySignNo,proto3" json:"DySignNo,omitempty"`                   // 抖音平台返回的签约单号
-	NotifyUrl         string `protobuf:"bytes,6,opt,name=NotifyUrl,proto3" json:"NotifyUrl,omitempty"`                 // 通知地址
-	MerchantUid       string `protobuf:"bytes,7,opt,name=MerchantUid,proto3" json:"MerchantUid,omitempty"`             // 收款商户号
-	PkId              int64  `protobuf:"varint,8,opt,name=PkId,proto3" json:"PkId,omitempty"`                          // 主键ID
+	AppPkgName       string  `protobuf:"bytes,1,opt,name=appPkgName,proto3" json:"appPkgName,omitempty"`             // 应用包名
+	OutOrderNo       string  `protobuf:"bytes,2,opt,name=outOrderNo,proto3" json:"outOrderNo,omitempty"`             // 商户支付订单号
+	OutRefundNo      string  `protobuf:"bytes,3,opt,name=outRefundNo,proto3" json:"outRefundNo,omitempty"`           // 商户退款订单号
+	RefundAmount     int64   `protobuf:"varint,4,opt,name=refundAmount,proto3" json:"refundAmount,omitempty"`        // 退款金额 分
+	RefundReason     string  `protobuf:"bytes,5,opt,name=refundReason,proto3" json:"refundReason,omitempty"`         // 退款原因
+	RefundNotifyUrl  string  `protobuf:"bytes,6,opt,name=refundNotifyUrl,proto3" json:"refundNotifyUrl,omitempty"`   // 退款回调地址
+	OrderEntrySchema *Schema `protobuf:"bytes,7,opt,name=orderEntrySchema,proto3" json:"orderEntrySchema,omitempty"` // 退款单的跳转的 schema
+	OrderSn          string  `protobuf:"bytes,8,opt,name=orderSn,proto3" json:"orderSn,omitempty"`                   //orderCode订单号
+	RefundAll        bool    `protobuf:"varint,9,opt,name=refundAll,proto3" json:"refundAll,omitempty"`              //是否退款全部金额
+	IsPeriodProduct  bool    `protobuf:"varint,10,opt,name=isPeriodProduct,proto3" json:"isPeriodProduct,omitempty"` //是否是周期代扣商品
 }
 
-func (x *DySignedOrderInfo) Reset() {
-	*x = DySignedOrderInfo{}
+func (x *CreateDouyinRefundReq) Reset() {
+	*x = CreateDouyinRefundReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[39]
+		mi := &file_payment_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DySignedOrderInfo) String() string {
+func (x *CreateDouyinRefundReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DySignedOrderInfo) ProtoMessage() {}
+func (*CreateDouyinRefundReq) ProtoMessage() {}
 
-func (x *DySignedOrderInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[39]
+func (x *CreateDouyinRefundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3313,99 +3709,107 @@ func (x *DySignedOrderInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DySignedOrderInfo.ProtoReflect.Descriptor instead.
-func (*DySignedOrderInfo) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use CreateDouyinRefundReq.ProtoReflect.Descriptor instead.
+func (*CreateDouyinRefundReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DySignedOrderInfo) GetOrderSn() string {
+func (x *CreateDouyinRefundReq) GetAppPkgName() string {
 	if x != nil {
-		return x.OrderSn
+		return x.AppPkgName
 	}
 	return ""
 }
 
-func (x *DySignedOrderInfo) GetAppPkg() string {
+func (x *CreateDouyinRefundReq) GetOutOrderNo() string {
 	if x != nil {
-		return x.AppPkg
+		return x.OutOrderNo
 	}
 	return ""
 }
 
-func (x *DySignedOrderInfo) GetUserId() int64 {
+func (x *CreateDouyinRefundReq) GetOutRefundNo() string {
 	if x != nil {
-		return x.UserId
+		return x.OutRefundNo
+	}
+	return ""
+}
+
+func (x *CreateDouyinRefundReq) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
 	}
 	return 0
 }
 
-func (x *DySignedOrderInfo) GetNextDecuctionTime() string {
+func (x *CreateDouyinRefundReq) GetRefundReason() string {
 	if x != nil {
-		return x.NextDecuctionTime
+		return x.RefundReason
 	}
 	return ""
 }
 
-func (x *DySignedOrderInfo) GetDySignNo() string {
+func (x *CreateDouyinRefundReq) GetRefundNotifyUrl() string {
 	if x != nil {
-		return x.DySignNo
+		return x.RefundNotifyUrl
 	}
 	return ""
 }
 
-func (x *DySignedOrderInfo) GetNotifyUrl() string {
+func (x *CreateDouyinRefundReq) GetOrderEntrySchema() *Schema {
 	if x != nil {
-		return x.NotifyUrl
+		return x.OrderEntrySchema
 	}
-	return ""
+	return nil
 }
 
-func (x *DySignedOrderInfo) GetMerchantUid() string {
+func (x *CreateDouyinRefundReq) GetOrderSn() string {
 	if x != nil {
-		return x.MerchantUid
+		return x.OrderSn
 	}
 	return ""
 }
 
-func (x *DySignedOrderInfo) GetPkId() int64 {
+func (x *CreateDouyinRefundReq) GetRefundAll() bool {
 	if x != nil {
-		return x.PkId
+		return x.RefundAll
 	}
-	return 0
+	return false
 }
 
-type DouyinPeriodOrderResp struct {
+func (x *CreateDouyinRefundReq) GetIsPeriodProduct() bool {
+	if x != nil {
+		return x.IsPeriodProduct
+	}
+	return false
+}
+
+type CreateDouyinRefundResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId            int64                `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`                      // 用户id
-	IsSign            int32                `protobuf:"varint,2,opt,name=IsSign,proto3" json:"IsSign,omitempty"`                      // 是否签约, 0未签约, 1已签约, 2已解约
-	IsUnsignSuccess   bool                 `protobuf:"varint,3,opt,name=IsUnsignSuccess,proto3" json:"IsUnsignSuccess,omitempty"`    // 是否解约成功
-	DeductionAmount   int64                `protobuf:"varint,4,opt,name=DeductionAmount,proto3" json:"DeductionAmount,omitempty"`    // 扣款金额 单位分
-	ExpireDate        string               `protobuf:"bytes,5,opt,name=ExpireDate,proto3" json:"ExpireDate,omitempty"`               // 已签约到期时间 年-月-日 时:分:秒
-	NextDecuctionTime string               `protobuf:"bytes,6,opt,name=NextDecuctionTime,proto3" json:"NextDecuctionTime,omitempty"` // 下次扣款时间 年-月-日 时:分:秒
-	Msg               string               `protobuf:"bytes,7,opt,name=Msg,proto3" json:"Msg,omitempty"`                             // 描述信息
-	SignedList        []*DySignedOrderInfo `protobuf:"bytes,8,rep,name=SignedList,proto3" json:"SignedList,omitempty"`               // 抖音已签约周期扣款列表信息
+	RefundId            string `protobuf:"bytes,1,opt,name=refundId,proto3" json:"refundId,omitempty"`                        // 抖音侧退款id
+	RefundAuditDeadline int64  `protobuf:"varint,2,opt,name=refundAuditDeadline,proto3" json:"refundAuditDeadline,omitempty"` // 退款截止期限
 }
 
-func (x *DouyinPeriodOrderResp) Reset() {
-	*x = DouyinPeriodOrderResp{}
+func (x *CreateDouyinRefundResp) Reset() {
+	*x = CreateDouyinRefundResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[40]
+		mi := &file_payment_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DouyinPeriodOrderResp) String() string {
+func (x *CreateDouyinRefundResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DouyinPeriodOrderResp) ProtoMessage() {}
+func (*CreateDouyinRefundResp) ProtoMessage() {}
 
-func (x *DouyinPeriodOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[40]
+func (x *CreateDouyinRefundResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3416,100 +3820,115 @@ func (x *DouyinPeriodOrderResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DouyinPeriodOrderResp.ProtoReflect.Descriptor instead.
-func (*DouyinPeriodOrderResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use CreateDouyinRefundResp.ProtoReflect.Descriptor instead.
+func (*CreateDouyinRefundResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DouyinPeriodOrderResp) GetUserId() int64 {
+func (x *CreateDouyinRefundResp) GetRefundId() string {
 	if x != nil {
-		return x.UserId
+		return x.RefundId
 	}
-	return 0
+	return ""
 }
 
-func (x *DouyinPeriodOrderResp) GetIsSign() int32 {
+func (x *CreateDouyinRefundResp) GetRefundAuditDeadline() int64 {
 	if x != nil {
-		return x.IsSign
+		return x.RefundAuditDeadline
 	}
 	return 0
 }
 
-func (x *DouyinPeriodOrderResp) GetIsUnsignSuccess() bool {
-	if x != nil {
-		return x.IsUnsignSuccess
-	}
-	return false
+type BindHuaweiPayDataReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`        // 用户id
+	OutOrderNo string `protobuf:"bytes,2,opt,name=OutOrderNo,proto3" json:"OutOrderNo,omitempty"` // 订单号
+	HwpayToken string `protobuf:"bytes,3,opt,name=HwpayToken,proto3" json:"HwpayToken,omitempty"` // 华为购买token
 }
 
-func (x *DouyinPeriodOrderResp) GetDeductionAmount() int64 {
-	if x != nil {
-		return x.DeductionAmount
+func (x *BindHuaweiPayDataReq) Reset() {
+	*x = BindHuaweiPayDataReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *DouyinPeriodOrderResp) GetExpireDate() string {
-	if x != nil {
-		return x.ExpireDate
+func (x *BindHuaweiPayDataReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindHuaweiPayDataReq) ProtoMessage() {}
+
+func (x *BindHuaweiPayDataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DouyinPeriodOrderResp) GetNextDecuctionTime() string {
+// Deprecated: Use BindHuaweiPayDataReq.ProtoReflect.Descriptor instead.
+func (*BindHuaweiPayDataReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BindHuaweiPayDataReq) GetUserId() int64 {
 	if x != nil {
-		return x.NextDecuctionTime
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *DouyinPeriodOrderResp) GetMsg() string {
+func (x *BindHuaweiPayDataReq) GetOutOrderNo() string {
 	if x != nil {
-		return x.Msg
+		return x.OutOrderNo
 	}
 	return ""
 }
 
-func (x *DouyinPeriodOrderResp) GetSignedList() []*DySignedOrderInfo {
+func (x *BindHuaweiPayDataReq) GetHwpayToken() string {
 	if x != nil {
-		return x.SignedList
+		return x.HwpayToken
 	}
-	return nil
+	return ""
 }
 
-// 微信退款申请
-type WechatMiniRefundReq struct {
+type UnsubscribeHuaweiReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppPkgName      string `protobuf:"bytes,1,opt,name=appPkgName,proto3" json:"appPkgName,omitempty"`           // 应用包名
-	OutOrderNo      string `protobuf:"bytes,2,opt,name=outOrderNo,proto3" json:"outOrderNo,omitempty"`           // 商户支付订单号
-	OutRefundNo     string `protobuf:"bytes,3,opt,name=outRefundNo,proto3" json:"outRefundNo,omitempty"`         // 商户退款订单号
-	RefundAmount    int64  `protobuf:"varint,4,opt,name=refundAmount,proto3" json:"refundAmount,omitempty"`      // 退款金额 分
-	RefundReason    string `protobuf:"bytes,5,opt,name=refundReason,proto3" json:"refundReason,omitempty"`       // 退款原因
-	RefundNotifyUrl string `protobuf:"bytes,6,opt,name=refundNotifyUrl,proto3" json:"refundNotifyUrl,omitempty"` // 退款回调地址
-	TotalAmount     int64  `protobuf:"varint,7,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`        // 所在订单的总金额 分
-	OrderSn         string `protobuf:"bytes,8,opt,name=orderSn,proto3" json:"orderSn,omitempty"`                 // orderCode订单号，所在订单唯一标识
+	Pkg            string `protobuf:"bytes,1,opt,name=Pkg,proto3" json:"Pkg,omitempty"`                       // 客户端包名
+	SubscriptionId string `protobuf:"bytes,2,opt,name=SubscriptionId,proto3" json:"SubscriptionId,omitempty"` // 华为订阅id
+	PurchaseToken  string `protobuf:"bytes,3,opt,name=PurchaseToken,proto3" json:"PurchaseToken,omitempty"`   // 华为购买token
 }
 
-func (x *WechatMiniRefundReq) Reset() {
-	*x = WechatMiniRefundReq{}
+func (x *UnsubscribeHuaweiReq) Reset() {
+	*x = UnsubscribeHuaweiReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[41]
+		mi := &file_payment_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WechatMiniRefundReq) String() string {
+func (x *UnsubscribeHuaweiReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WechatMiniRefundReq) ProtoMessage() {}
+func (*UnsubscribeHuaweiReq) ProtoMessage() {}
 
-func (x *WechatMiniRefundReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[41]
+func (x *UnsubscribeHuaweiReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3520,93 +3939,113 @@ func (x *WechatMiniRefundReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WechatMiniRefundReq.ProtoReflect.Descriptor instead.
-func (*WechatMiniRefundReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use UnsubscribeHuaweiReq.ProtoReflect.Descriptor instead.
+func (*UnsubscribeHuaweiReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *WechatMiniRefundReq) GetAppPkgName() string {
+func (x *UnsubscribeHuaweiReq) GetPkg() string {
 	if x != nil {
-		return x.AppPkgName
+		return x.Pkg
 	}
 	return ""
 }
 
-func (x *WechatMiniRefundReq) GetOutOrderNo() string {
+func (x *UnsubscribeHuaweiReq) GetSubscriptionId() string {
 	if x != nil {
-		return x.OutOrderNo
+		return x.SubscriptionId
 	}
 	return ""
 }
 
-func (x *WechatMiniRefundReq) GetOutRefundNo() string {
+func (x *UnsubscribeHuaweiReq) GetPurchaseToken() string {
 	if x != nil {
-		return x.OutRefundNo
+		return x.PurchaseToken
 	}
 	return ""
 }
 
-func (x *WechatMiniRefundReq) GetRefundAmount() int64 {
-	if x != nil {
-		return x.RefundAmount
-	}
-	return 0
+type BindHuaweiPayDataResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"` // 返回状态
+	Msg  string `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`    // 返回消息
 }
 
-func (x *WechatMiniRefundReq) GetRefundReason() string {
-	if x != nil {
-		return x.RefundReason
+func (x *BindHuaweiPayDataResp) Reset() {
+	*x = BindHuaweiPayDataResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *WechatMiniRefundReq) GetRefundNotifyUrl() string {
-	if x != nil {
-		return x.RefundNotifyUrl
+func (x *BindHuaweiPayDataResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindHuaweiPayDataResp) ProtoMessage() {}
+
+func (x *BindHuaweiPayDataResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *WechatMiniRefundReq) GetTotalAmount() int64 {
+// Deprecated: Use BindHuaweiPayDataResp.ProtoReflect.Descriptor instead.
+func (*BindHuaweiPayDataResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BindHuaweiPayDataResp) GetCode() int32 {
 	if x != nil {
-		return x.TotalAmount
+		return x.Code
 	}
 	return 0
 }
 
-func (x *WechatMiniRefundReq) GetOrderSn() string {
+func (x *BindHuaweiPayDataResp) GetMsg() string {
 	if x != nil {
-		return x.OrderSn
+		return x.Msg
 	}
 	return ""
 }
 
-type WechatMiniRefundResp struct {
+type UnsubscribeHuaweiResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// refundId【微信支付退款单号】申请退款受理成功时，该笔退款单在微信支付侧生成的唯一标识。
-	RefundId string `protobuf:"bytes,1,opt,name=refundId,proto3" json:"refundId,omitempty"`
+	Code int32  `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"` // 返回状态
+	Msg  string `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`    // 返回消息
 }
 
-func (x *WechatMiniRefundResp) Reset() {
-	*x = WechatMiniRefundResp{}
+func (x *UnsubscribeHuaweiResp) Reset() {
+	*x = UnsubscribeHuaweiResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[42]
+		mi := &file_payment_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WechatMiniRefundResp) String() string {
+func (x *UnsubscribeHuaweiResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WechatMiniRefundResp) ProtoMessage() {}
+func (*UnsubscribeHuaweiResp) ProtoMessage() {}
 
-func (x *WechatMiniRefundResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[42]
+func (x *UnsubscribeHuaweiResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3617,45 +4056,53 @@ func (x *WechatMiniRefundResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WechatMiniRefundResp.ProtoReflect.Descriptor instead.
-func (*WechatMiniRefundResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use UnsubscribeHuaweiResp.ProtoReflect.Descriptor instead.
+func (*UnsubscribeHuaweiResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *WechatMiniRefundResp) GetRefundId() string {
+func (x *UnsubscribeHuaweiResp) GetCode() int32 {
 	if x != nil {
-		return x.RefundId
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UnsubscribeHuaweiResp) GetMsg() string {
+	if x != nil {
+		return x.Msg
 	}
 	return ""
 }
 
-// 微信退款单详情
-type WechatMiniRefundQueryReq struct {
+type DelayHuaweiSubscriptionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppPkgName  string `protobuf:"bytes,1,opt,name=appPkgName,proto3" json:"appPkgName,omitempty"`   // 应用包名
-	OutRefundNo string `protobuf:"bytes,2,opt,name=outRefundNo,proto3" json:"outRefundNo,omitempty"` // 商户退款订单号
+	Pkg            string `protobuf:"bytes,1,opt,name=Pkg,proto3" json:"Pkg,omitempty"`                       // 客户端包名
+	SubscriptionId string `protobuf:"bytes,2,opt,name=SubscriptionId,proto3" json:"SubscriptionId,omitempty"` // 华为订阅id
+	PurchaseToken  string `protobuf:"bytes,3,opt,name=PurchaseToken,proto3" json:"PurchaseToken,omitempty"`   // 华为购买token
+	DelayDays      int64  `protobuf:"varint,4,opt,name=DelayDays,proto3" json:"DelayDays,omitempty"`          // 延期天数
 }
 
-func (x *WechatMiniRefundQueryReq) Reset() {
-	*x = WechatMiniRefundQueryReq{}
+func (x *DelayHuaweiSubscriptionReq) Reset() {
+	*x = DelayHuaweiSubscriptionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[43]
+		mi := &file_payment_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WechatMiniRefundQueryReq) String() string {
+func (x *DelayHuaweiSubscriptionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WechatMiniRefundQueryReq) ProtoMessage() {}
+func (*DelayHuaweiSubscriptionReq) ProtoMessage() {}
 
-func (x *WechatMiniRefundQueryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[43]
+func (x *DelayHuaweiSubscriptionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3666,61 +4113,66 @@ func (x *WechatMiniRefundQueryReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WechatMiniRefundQueryReq.ProtoReflect.Descriptor instead.
-func (*WechatMiniRefundQueryReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use DelayHuaweiSubscriptionReq.ProtoReflect.Descriptor instead.
+func (*DelayHuaweiSubscriptionReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *WechatMiniRefundQueryReq) GetAppPkgName() string {
+func (x *DelayHuaweiSubscriptionReq) GetPkg() string {
 	if x != nil {
-		return x.AppPkgName
+		return x.Pkg
 	}
 	return ""
 }
 
-func (x *WechatMiniRefundQueryReq) GetOutRefundNo() string {
+func (x *DelayHuaweiSubscriptionReq) GetSubscriptionId() string {
 	if x != nil {
-		return x.OutRefundNo
+		return x.SubscriptionId
 	}
 	return ""
 }
 
-type WechatMiniRefundQueryResp struct {
+func (x *DelayHuaweiSubscriptionReq) GetPurchaseToken() string {
+	if x != nil {
+		return x.PurchaseToken
+	}
+	return ""
+}
+
+func (x *DelayHuaweiSubscriptionReq) GetDelayDays() int64 {
+	if x != nil {
+		return x.DelayDays
+	}
+	return 0
+}
+
+type DelayHuaweiSubscriptionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// refundId【微信支付退款单号】申请退款受理成功时，该笔退款单在微信支付侧生成的唯一标识。
-	RefundId    string `protobuf:"bytes,1,opt,name=refundId,proto3" json:"refundId,omitempty"`
-	OutRefundNo string `protobuf:"bytes,2,opt,name=outRefundNo,proto3" json:"outRefundNo,omitempty"` // 商户退款订单号
-	// successTime
-	// 退款成功的时间，该字段在退款状态status为SUCCESS（退款成功）时返回。示例：2015-05-20T13:29:35+08:00
-	SuccessTime string `protobuf:"bytes,3,opt,name=successTime,proto3" json:"successTime,omitempty"`
-	// createTime 提交退款申请成功，微信受理退款申请单的时间。格式如 successTime
-	CreateTime string `protobuf:"bytes,4,opt,name=createTime,proto3" json:"createTime,omitempty"`
-	// status 【退款状态】退款单的退款处理状态,SUCCESS: 退款成功,CLOSED:
-	//
-	//	退款关闭,PROCESSING: 退款处理中,ABNORMAL: 退款异常，
-	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Code              int32  `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`                           // 返回状态
+	Msg               string `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`                              // 返回消息
+	NewExpirationTime int64  `protobuf:"varint,3,opt,name=NewExpirationTime,proto3" json:"NewExpirationTime,omitempty"` // 延期后的过期时间，UTC时间戳，以毫秒为单位
 }
 
-func (x *WechatMiniRefundQueryResp) Reset() {
-	*x = WechatMiniRefundQueryResp{}
+func (x *DelayHuaweiSubscriptionResp) Reset() {
+	*x = DelayHuaweiSubscriptionResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[44]
+		mi := &file_payment_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WechatMiniRefundQueryResp) String() string {
+func (x *DelayHuaweiSubscriptionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WechatMiniRefundQueryResp) ProtoMessage() {}
+func (*DelayHuaweiSubscriptionResp) ProtoMessage() {}
 
-func (x *WechatMiniRefundQueryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[44]
+func (x *DelayHuaweiSubscriptionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3731,81 +4183,67 @@ func (x *WechatMiniRefundQueryResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WechatMiniRefundQueryResp.ProtoReflect.Descriptor instead.
-func (*WechatMiniRefundQueryResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *WechatMiniRefundQueryResp) GetRefundId() string {
-	if x != nil {
-		return x.RefundId
-	}
-	return ""
-}
-
-func (x *WechatMiniRefundQueryResp) GetOutRefundNo() string {
-	if x != nil {
-		return x.OutRefundNo
-	}
-	return ""
+// Deprecated: Use DelayHuaweiSubscriptionResp.ProtoReflect.Descriptor instead.
+func (*DelayHuaweiSubscriptionResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *WechatMiniRefundQueryResp) GetSuccessTime() string {
+func (x *DelayHuaweiSubscriptionResp) GetCode() int32 {
 	if x != nil {
-		return x.SuccessTime
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *WechatMiniRefundQueryResp) GetCreateTime() string {
+func (x *DelayHuaweiSubscriptionResp) GetMsg() string {
 	if x != nil {
-		return x.CreateTime
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *WechatMiniRefundQueryResp) GetStatus() string {
+func (x *DelayHuaweiSubscriptionResp) GetNewExpirationTime() int64 {
 	if x != nil {
-		return x.Status
+		return x.NewExpirationTime
 	}
-	return ""
+	return 0
 }
 
-// 微信退款申请-虚拟支付退款
-type WechatMiniXPayRefundReq struct {
+type GetHuaweiSubscriptionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppPkgName      string `protobuf:"bytes,1,opt,name=appPkgName,proto3" json:"appPkgName,omitempty"`           // 应用包名
-	OutOrderNo      string `protobuf:"bytes,2,opt,name=outOrderNo,proto3" json:"outOrderNo,omitempty"`           // 商户支付订单号
-	OutRefundNo     string `protobuf:"bytes,3,opt,name=outRefundNo,proto3" json:"outRefundNo,omitempty"`         // 商户退款订单号
-	RefundAmount    int64  `protobuf:"varint,4,opt,name=refundAmount,proto3" json:"refundAmount,omitempty"`      // 退款金额 分
-	RefundReason    string `protobuf:"bytes,5,opt,name=refundReason,proto3" json:"refundReason,omitempty"`       // 退款原因
-	RefundNotifyUrl string `protobuf:"bytes,6,opt,name=refundNotifyUrl,proto3" json:"refundNotifyUrl,omitempty"` // 退款回调地址
-	TotalAmount     int64  `protobuf:"varint,7,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`        // 所在订单的总金额 分
-	OrderSn         string `protobuf:"bytes,8,opt,name=orderSn,proto3" json:"orderSn,omitempty"`                 // orderCode订单号，所在订单唯一标识
-	OpenId          string `protobuf:"bytes,9,opt,name=OpenId,proto3" json:"OpenId,omitempty"`                   // 下单时的微信用户openid
-	SessionKey      string `protobuf:"bytes,10,opt,name=SessionKey,proto3" json:"SessionKey,omitempty"`          // 微信平台返回的session_key
-}
-
-func (x *WechatMiniXPayRefundReq) Reset() {
-	*x = WechatMiniXPayRefundReq{}
+	Code              int32  `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`                           // 返回状态
+	Msg               string `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`                              // 返回消息
+	SubIsvalid        bool   `protobuf:"varint,3,opt,name=SubIsvalid,proto3" json:"SubIsvalid,omitempty"`               // 订阅是否有效(已收费且未过期，也没有发生退款)
+	AutoRenewing      bool   `protobuf:"varint,4,opt,name=AutoRenewing,proto3" json:"AutoRenewing,omitempty"`           // 是否会自动续订
+	RenewStatus       int32  `protobuf:"varint,5,opt,name=RenewStatus,proto3" json:"RenewStatus,omitempty"`             // 续期状态 1：当前周期到期时自动续期 0：用户停止了续期
+	PurchaseState     int32  `protobuf:"varint,6,opt,name=PurchaseState,proto3" json:"PurchaseState,omitempty"`         // 订单交易状态 -1：初始化 0：已购买 1：已取消 2：已退款 3：待处理
+	ProductId         string `protobuf:"bytes,7,opt,name=ProductId,proto3" json:"ProductId,omitempty"`                  // 商品id
+	ExpirationDate    int64  `protobuf:"varint,8,opt,name=ExpirationDate,proto3" json:"ExpirationDate,omitempty"`       // 过期时间，UTC时间戳，以毫秒为单位
+	CancellationTime  int64  `protobuf:"varint,9,opt,name=CancellationTime,proto3" json:"CancellationTime,omitempty"`   // 取消订阅时间，UTC时间戳，以毫秒为单位
+	OrderId           string `protobuf:"bytes,10,opt,name=OrderId,proto3" json:"OrderId,omitempty"`                     // 华为订单id
+	InappPurchaseData string `protobuf:"bytes,11,opt,name=InappPurchaseData,proto3" json:"InappPurchaseData,omitempty"` // 华为返回的原始购买详情json
+}
+
+func (x *GetHuaweiSubscriptionResp) Reset() {
+	*x = GetHuaweiSubscriptionResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[45]
+		mi := &file_payment_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WechatMiniXPayRefundReq) String() string {
+func (x *GetHuaweiSubscriptionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WechatMiniXPayRefundReq) ProtoMessage() {}
+func (*GetHuaweiSubscriptionResp) ProtoMessage() {}
 
-func (x *WechatMiniXPayRefundReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[45]
+func (x *GetHuaweiSubscriptionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3816,106 +4254,116 @@ func (x *WechatMiniXPayRefundReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WechatMiniXPayRefundReq.ProtoReflect.Descriptor instead.
-func (*WechatMiniXPayRefundReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetHuaweiSubscriptionResp.ProtoReflect.Descriptor instead.
+func (*GetHuaweiSubscriptionResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *WechatMiniXPayRefundReq) GetAppPkgName() string {
+func (x *GetHuaweiSubscriptionResp) GetCode() int32 {
 	if x != nil {
-		return x.AppPkgName
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *WechatMiniXPayRefundReq) GetOutOrderNo() string {
+func (x *GetHuaweiSubscriptionResp) GetMsg() string {
 	if x != nil {
-		return x.OutOrderNo
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *WechatMiniXPayRefundReq) GetOutRefundNo() string {
+func (x *GetHuaweiSubscriptionResp) GetSubIsvalid() bool {
 	if x != nil {
-		return x.OutRefundNo
+		return x.SubIsvalid
 	}
-	return ""
+	return false
 }
 
-func (x *WechatMiniXPayRefundReq) GetRefundAmount() int64 {
+func (x *GetHuaweiSubscriptionResp) GetAutoRenewing() bool {
 	if x != nil {
-		return x.RefundAmount
+		return x.AutoRenewing
+	}
+	return false
+}
+
+func (x *GetHuaweiSubscriptionResp) GetRenewStatus() int32 {
+	if x != nil {
+		return x.RenewStatus
 	}
 	return 0
 }
 
-func (x *WechatMiniXPayRefundReq) GetRefundReason() string {
+func (x *GetHuaweiSubscriptionResp) GetPurchaseState() int32 {
 	if x != nil {
-		return x.RefundReason
+		return x.PurchaseState
 	}
-	return ""
+	return 0
 }
 
-func (x *WechatMiniXPayRefundReq) GetRefundNotifyUrl() string {
+func (x *GetHuaweiSubscriptionResp) GetProductId() string {
 	if x != nil {
-		return x.RefundNotifyUrl
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *WechatMiniXPayRefundReq) GetTotalAmount() int64 {
+func (x *GetHuaweiSubscriptionResp) GetExpirationDate() int64 {
 	if x != nil {
-		return x.TotalAmount
+		return x.ExpirationDate
 	}
 	return 0
 }
 
-func (x *WechatMiniXPayRefundReq) GetOrderSn() string {
+func (x *GetHuaweiSubscriptionResp) GetCancellationTime() int64 {
 	if x != nil {
-		return x.OrderSn
+		return x.CancellationTime
 	}
-	return ""
+	return 0
 }
 
-func (x *WechatMiniXPayRefundReq) GetOpenId() string {
+func (x *GetHuaweiSubscriptionResp) GetOrderId() string {
 	if x != nil {
-		return x.OpenId
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *WechatMiniXPayRefundReq) GetSessionKey() string {
+func (x *GetHuaweiSubscriptionResp) GetInappPurchaseData() string {
 	if x != nil {
-		return x.SessionKey
+		return x.InappPurchaseData
 	}
 	return ""
 }
 
-type WechatMiniXPayRefundResp struct {
+type DouyinPeriodOrderReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RefundId string `protobuf:"bytes,1,opt,name=refundId,proto3" json:"refundId,omitempty"` // 退款单的微信侧单号
+	Action            DouyinPeriodOrderReqAction `protobuf:"varint,1,opt,name=Action,proto3,enum=payment.DouyinPeriodOrderReqAction" json:"Action,omitempty"` // 动作
+	Pkg               string                     `protobuf:"bytes,2,opt,name=Pkg,proto3" json:"Pkg,omitempty"`                                                // 客户端包名
+	UserId            int64                      `protobuf:"varint,3,opt,name=UserId,proto3" json:"UserId,omitempty"`                                         // 用户id
+	PmDyPeriodOrderId int64                      `protobuf:"varint,4,opt,name=PmDyPeriodOrderId,proto3" json:"PmDyPeriodOrderId,omitempty"`                   // pm_dy_period_order id
 }
 
-func (x *WechatMiniXPayRefundResp) Reset() {
-	*x = WechatMiniXPayRefundResp{}
+func (x *DouyinPeriodOrderReq) Reset() {
+	*x = DouyinPeriodOrderReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[46]
+		mi := &file_payment_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WechatMiniXPayRefundResp) String() string {
+func (x *DouyinPeriodOrderReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WechatMiniXPayRefundResp) ProtoMessage() {}
+func (*DouyinPeriodOrderReq) ProtoMessage() {}
 
-func (x *WechatMiniXPayRefundResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[46]
+func (x *DouyinPeriodOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3926,48 +4374,72 @@ func (x *WechatMiniXPayRefundResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WechatMiniXPayRefundResp.ProtoReflect.Descriptor instead.
-func (*WechatMiniXPayRefundResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use DouyinPeriodOrderReq.ProtoReflect.Descriptor instead.
+func (*DouyinPeriodOrderReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *WechatMiniXPayRefundResp) GetRefundId() string {
+func (x *DouyinPeriodOrderReq) GetAction() DouyinPeriodOrderReqAction {
 	if x != nil {
-		return x.RefundId
+		return x.Action
+	}
+	return DouyinPeriodOrderReqAction_DyPeriodActionQuery
+}
+
+func (x *DouyinPeriodOrderReq) GetPkg() string {
+	if x != nil {
+		return x.Pkg
 	}
 	return ""
 }
 
-// 微信-虚拟支付-查询创建的订单（现金单，非代币单）/退款单
-type WechatMiniXPayQueryOrderReq struct {
+func (x *DouyinPeriodOrderReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DouyinPeriodOrderReq) GetPmDyPeriodOrderId() int64 {
+	if x != nil {
+		return x.PmDyPeriodOrderId
+	}
+	return 0
+}
+
+// 抖音已签约周期扣款信息
+type DySignedOrderInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppPkgName string `protobuf:"bytes,1,opt,name=appPkgName,proto3" json:"appPkgName,omitempty"` // 应用包名
-	OutOrderNo string `protobuf:"bytes,2,opt,name=outOrderNo,proto3" json:"outOrderNo,omitempty"` // 商户支付订单号
-	WxOrderId  string `protobuf:"bytes,3,opt,name=wxOrderId,proto3" json:"wxOrderId,omitempty"`   // 微信内部单号(与order_id二选一)
-	OpenId     string `protobuf:"bytes,4,opt,name=OpenId,proto3" json:"OpenId,omitempty"`         // 下单时的微信用户openid
-	SessionKey string `protobuf:"bytes,5,opt,name=SessionKey,proto3" json:"SessionKey,omitempty"` // 微信平台返回的session_key
+	OrderSn           string `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"`                     // 内部 订单唯一标识 (开发者侧代扣单的单号)
+	AppPkg            string `protobuf:"bytes,2,opt,name=AppPkg,proto3" json:"AppPkg,omitempty"`                       // 来源包名
+	UserId            int64  `protobuf:"varint,3,opt,name=UserId,proto3" json:"UserId,omitempty"`                      // 用户id
+	NextDecuctionTime string `protobuf:"bytes,4,opt,name=NextDecuctionTime,proto3" json:"NextDecuctionTime,omitempty"` // 下次扣款时间
+	DySignNo          string `protobuf:"bytes,5,opt,name=DySignNo,proto3" json:"DySignNo,omitempty"`                   // 抖音平台返回的签约单号
+	NotifyUrl         string `protobuf:"bytes,6,opt,name=NotifyUrl,proto3" json:"NotifyUrl,omitempty"`                 // 通知地址
+	MerchantUid       string `protobuf:"bytes,7,opt,name=MerchantUid,proto3" json:"MerchantUid,omitempty"`             // 收款商户号
+	PkId              int64  `protobuf:"varint,8,opt,name=PkId,proto3" json:"PkId,omitempty"`                          // 主键ID
 }
 
-func (x *WechatMiniXPayQueryOrderReq) Reset() {
-	*x = WechatMiniXPayQueryOrderReq{}
+func (x *DySignedOrderInfo) Reset() {
+	*x = DySignedOrderInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[47]
+		mi := &file_payment_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WechatMiniXPayQueryOrderReq) String() string {
+func (x *DySignedOrderInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WechatMiniXPayQueryOrderReq) ProtoMessage() {}
+func (*DySignedOrderInfo) ProtoMessage() {}
 
-func (x *WechatMiniXPayQueryOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[47]
+func (x *DySignedOrderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3978,91 +4450,99 @@ func (x *WechatMiniXPayQueryOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WechatMiniXPayQueryOrderReq.ProtoReflect.Descriptor instead.
-func (*WechatMiniXPayQueryOrderReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use DySignedOrderInfo.ProtoReflect.Descriptor instead.
+func (*DySignedOrderInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *WechatMiniXPayQueryOrderReq) GetAppPkgName() string {
+func (x *DySignedOrderInfo) GetOrderSn() string {
 	if x != nil {
-		return x.AppPkgName
+		return x.OrderSn
 	}
 	return ""
 }
 
-func (x *WechatMiniXPayQueryOrderReq) GetOutOrderNo() string {
+func (x *DySignedOrderInfo) GetAppPkg() string {
 	if x != nil {
-		return x.OutOrderNo
+		return x.AppPkg
 	}
 	return ""
 }
 
-func (x *WechatMiniXPayQueryOrderReq) GetWxOrderId() string {
+func (x *DySignedOrderInfo) GetUserId() int64 {
 	if x != nil {
-		return x.WxOrderId
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *WechatMiniXPayQueryOrderReq) GetOpenId() string {
+func (x *DySignedOrderInfo) GetNextDecuctionTime() string {
 	if x != nil {
-		return x.OpenId
+		return x.NextDecuctionTime
 	}
 	return ""
 }
 
-func (x *WechatMiniXPayQueryOrderReq) GetSessionKey() string {
+func (x *DySignedOrderInfo) GetDySignNo() string {
 	if x != nil {
-		return x.SessionKey
+		return x.DySignNo
 	}
 	return ""
 }
 
-type WechatMiniXPayQueryOrderResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *DySignedOrderInfo) GetNotifyUrl() string {
+	if x != nil {
+		return x.NotifyUrl
+	}
+	return ""
+}
 
-	OutOrderNo string `protobuf:"bytes,1,opt,name=outOrderNo,proto3" json:"outOrderNo,omitempty"`  // 商户支付订单号
-	WxOrderId  string `protobuf:"bytes,2,opt,name=wxOrderId,proto3" json:"wxOrderId,omitempty"`    // 微信内部单号(与order_id二选一)
-	CreateTime int64  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime,omitempty"` // 微信内部单号(与order_id二选一)
-	UpdateTime int64  `protobuf:"varint,4,opt,name=updateTime,proto3" json:"updateTime,omitempty"` // 微信内部单号(与order_id二选一)
-	// status
-	//
-	//	当前状态 0-订单初始化（未创建成功，不可用于支付）1-订单创建成功
-	//	2-订单已经支付，待发货 3-订单发货中 4-订单已发货 5-订单已经退款
-	//	6-订单已经关闭（不可再使用） 7-订单退款失败 8-用户退款完成
-	//	9-回收广告金完成 10-分账回退完成
-	Status    int64 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
-	BizType   int64 `protobuf:"varint,6,opt,name=bizType,proto3" json:"bizType,omitempty"`
-	OrderFee  int64 `protobuf:"varint,7,opt,name=orderFee,proto3" json:"orderFee,omitempty"`    // 订单金额，单位分
-	CouponFee int64 `protobuf:"varint,8,opt,name=couponFee,proto3" json:"couponFee,omitempty"`  // 订单优惠金额，单位分(暂无此字段)
-	PaidFee   int64 `protobuf:"varint,9,opt,name=paidFee,proto3" json:"paidFee,omitempty"`      // 用户支付金额
-	OrderType int64 `protobuf:"varint,10,opt,name=orderType,proto3" json:"orderType,omitempty"` // 订单类型0-支付单 1-退款单
-	RefundFee int64 `protobuf:"varint,11,opt,name=refundFee,proto3" json:"refundFee,omitempty"` // 当类型为退款单时表示退款金额，单位分
-	PaidTime  int64 `protobuf:"varint,12,opt,name=paidTime,proto3" json:"paidTime,omitempty"`   // 支付/退款时间，unix秒级时间戳
-	LeftFee   int64 `protobuf:"varint,13,opt,name=leftFee,proto3" json:"leftFee,omitempty"`     // 支付单类型时表示此单经过退款还剩余的金额，单位分
-	// wxPayOrderId 微信支付交易单号，为用户微信支付详情页面上的交易单号
-	WxPayOrderId string `protobuf:"bytes,14,opt,name=wxPayOrderId,proto3" json:"wxPayOrderId,omitempty"`
+func (x *DySignedOrderInfo) GetMerchantUid() string {
+	if x != nil {
+		return x.MerchantUid
+	}
+	return ""
 }
 
-func (x *WechatMiniXPayQueryOrderResp) Reset() {
-	*x = WechatMiniXPayQueryOrderResp{}
+func (x *DySignedOrderInfo) GetPkId() int64 {
+	if x != nil {
+		return x.PkId
+	}
+	return 0
+}
+
+type DouyinPeriodOrderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId            int64                `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`                      // 用户id
+	IsSign            int32                `protobuf:"varint,2,opt,name=IsSign,proto3" json:"IsSign,omitempty"`                      // 是否签约, 0未签约, 1已签约, 2已解约
+	IsUnsignSuccess   bool                 `protobuf:"varint,3,opt,name=IsUnsignSuccess,proto3" json:"IsUnsignSuccess,omitempty"`    // 是否解约成功
+	DeductionAmount   int64                `protobuf:"varint,4,opt,name=DeductionAmount,proto3" json:"DeductionAmount,omitempty"`    // 扣款金额 单位分
+	ExpireDate        string               `protobuf:"bytes,5,opt,name=ExpireDate,proto3" json:"ExpireDate,omitempty"`               // 已签约到期时间 年-月-日 时:分:秒
+	NextDecuctionTime string               `protobuf:"bytes,6,opt,name=NextDecuctionTime,proto3" json:"NextDecuctionTime,omitempty"` // 下次扣款时间 年-月-日 时:分:秒
+	Msg               string               `protobuf:"bytes,7,opt,name=Msg,proto3" json:"Msg,omitempty"`                             // 描述信息
+	SignedList        []*DySignedOrderInfo `protobuf:"bytes,8,rep,name=SignedList,proto3" json:"SignedList,omitempty"`               // 抖音已签约周期扣款列表信息
+}
+
+func (x *DouyinPeriodOrderResp) Reset() {
+	*x = DouyinPeriodOrderResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[48]
+		mi := &file_payment_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WechatMiniXPayQueryOrderResp) String() string {
+func (x *DouyinPeriodOrderResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WechatMiniXPayQueryOrderResp) ProtoMessage() {}
+func (*DouyinPeriodOrderResp) ProtoMessage() {}
 
-func (x *WechatMiniXPayQueryOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[48]
+func (x *DouyinPeriodOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4073,134 +4553,197 @@ func (x *WechatMiniXPayQueryOrderResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WechatMiniXPayQueryOrderResp.ProtoReflect.Descriptor instead.
-func (*WechatMiniXPayQueryOrderResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use DouyinPeriodOrderResp.ProtoReflect.Descriptor instead.
+func (*DouyinPeriodOrderResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetOutOrderNo() string {
+func (x *DouyinPeriodOrderResp) GetUserId() int64 {
 	if x != nil {
-		return x.OutOrderNo
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetWxOrderId() string {
+func (x *DouyinPeriodOrderResp) GetIsSign() int32 {
 	if x != nil {
-		return x.WxOrderId
+		return x.IsSign
 	}
-	return ""
+	return 0
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetCreateTime() int64 {
+func (x *DouyinPeriodOrderResp) GetIsUnsignSuccess() bool {
 	if x != nil {
-		return x.CreateTime
+		return x.IsUnsignSuccess
 	}
-	return 0
+	return false
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetUpdateTime() int64 {
+func (x *DouyinPeriodOrderResp) GetDeductionAmount() int64 {
 	if x != nil {
-		return x.UpdateTime
+		return x.DeductionAmount
 	}
 	return 0
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetStatus() int64 {
+func (x *DouyinPeriodOrderResp) GetExpireDate() string {
 	if x != nil {
-		return x.Status
+		return x.ExpireDate
 	}
-	return 0
+	return ""
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetBizType() int64 {
+func (x *DouyinPeriodOrderResp) GetNextDecuctionTime() string {
 	if x != nil {
-		return x.BizType
+		return x.NextDecuctionTime
 	}
-	return 0
+	return ""
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetOrderFee() int64 {
+func (x *DouyinPeriodOrderResp) GetMsg() string {
 	if x != nil {
-		return x.OrderFee
+		return x.Msg
 	}
-	return 0
+	return ""
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetCouponFee() int64 {
+func (x *DouyinPeriodOrderResp) GetSignedList() []*DySignedOrderInfo {
 	if x != nil {
-		return x.CouponFee
+		return x.SignedList
 	}
-	return 0
+	return nil
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetPaidFee() int64 {
+// 微信退款申请
+type WechatMiniRefundReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppPkgName      string `protobuf:"bytes,1,opt,name=appPkgName,proto3" json:"appPkgName,omitempty"`           // 应用包名
+	OutOrderNo      string `protobuf:"bytes,2,opt,name=outOrderNo,proto3" json:"outOrderNo,omitempty"`           // 商户支付订单号
+	OutRefundNo     string `protobuf:"bytes,3,opt,name=outRefundNo,proto3" json:"outRefundNo,omitempty"`         // 商户退款订单号
+	RefundAmount    int64  `protobuf:"varint,4,opt,name=refundAmount,proto3" json:"refundAmount,omitempty"`      // 退款金额 分
+	RefundReason    string `protobuf:"bytes,5,opt,name=refundReason,proto3" json:"refundReason,omitempty"`       // 退款原因
+	RefundNotifyUrl string `protobuf:"bytes,6,opt,name=refundNotifyUrl,proto3" json:"refundNotifyUrl,omitempty"` // 退款回调地址
+	TotalAmount     int64  `protobuf:"varint,7,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`        // 所在订单的总金额 分
+	OrderSn         string `protobuf:"bytes,8,opt,name=orderSn,proto3" json:"orderSn,omitempty"`                 // orderCode订单号，所在订单唯一标识
+}
+
+func (x *WechatMiniRefundReq) Reset() {
+	*x = WechatMiniRefundReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WechatMiniRefundReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WechatMiniRefundReq) ProtoMessage() {}
+
+func (x *WechatMiniRefundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WechatMiniRefundReq.ProtoReflect.Descriptor instead.
+func (*WechatMiniRefundReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *WechatMiniRefundReq) GetAppPkgName() string {
 	if x != nil {
-		return x.PaidFee
+		return x.AppPkgName
 	}
-	return 0
+	return ""
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetOrderType() int64 {
+func (x *WechatMiniRefundReq) GetOutOrderNo() string {
 	if x != nil {
-		return x.OrderType
+		return x.OutOrderNo
 	}
-	return 0
+	return ""
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetRefundFee() int64 {
+func (x *WechatMiniRefundReq) GetOutRefundNo() string {
 	if x != nil {
-		return x.RefundFee
+		return x.OutRefundNo
 	}
-	return 0
+	return ""
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetPaidTime() int64 {
+func (x *WechatMiniRefundReq) GetRefundAmount() int64 {
 	if x != nil {
-		return x.PaidTime
+		return x.RefundAmount
 	}
 	return 0
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetLeftFee() int64 {
+func (x *WechatMiniRefundReq) GetRefundReason() string {
 	if x != nil {
-		return x.LeftFee
+		return x.RefundReason
+	}
+	return ""
+}
+
+func (x *WechatMiniRefundReq) GetRefundNotifyUrl() string {
+	if x != nil {
+		return x.RefundNotifyUrl
+	}
+	return ""
+}
+
+func (x *WechatMiniRefundReq) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
 	}
 	return 0
 }
 
-func (x *WechatMiniXPayQueryOrderResp) GetWxPayOrderId() string {
+func (x *WechatMiniRefundReq) GetOrderSn() string {
 	if x != nil {
-		return x.WxPayOrderId
+		return x.OrderSn
 	}
 	return ""
 }
 
-type AutoPkgAddReq struct {
+type WechatMiniRefundResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SqlList []string `protobuf:"bytes,1,rep,name=sqlList,proto3" json:"sqlList,omitempty"` //执行sql
+	// refundId【微信支付退款单号】申请退款受理成功时，该笔退款单在微信支付侧生成的唯一标识。
+	RefundId string `protobuf:"bytes,1,opt,name=refundId,proto3" json:"refundId,omitempty"`
 }
 
-func (x *AutoPkgAddReq) Reset() {
-	*x = AutoPkgAddReq{}
+func (x *WechatMiniRefundResp) Reset() {
+	*x = WechatMiniRefundResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[49]
+		mi := &file_payment_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AutoPkgAddReq) String() string {
+func (x *WechatMiniRefundResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AutoPkgAddReq) ProtoMessage() {}
+func (*WechatMiniRefundResp) ProtoMessage() {}
 
-func (x *AutoPkgAddReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[49]
+func (x *WechatMiniRefundResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4211,45 +4754,45 @@ func (x *AutoPkgAddReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutoPkgAddReq.ProtoReflect.Descriptor instead.
-func (*AutoPkgAddReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use WechatMiniRefundResp.ProtoReflect.Descriptor instead.
+func (*WechatMiniRefundResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *AutoPkgAddReq) GetSqlList() []string {
+func (x *WechatMiniRefundResp) GetRefundId() string {
 	if x != nil {
-		return x.SqlList
+		return x.RefundId
 	}
-	return nil
+	return ""
 }
 
-type AutoPkgAddResp struct {
+// 微信退款单详情
+type WechatMiniRefundQueryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppIds  []string `protobuf:"bytes,1,rep,name=appIds,proto3" json:"appIds,omitempty"`   //失败的app_id
-	FailMsg string   `protobuf:"bytes,2,opt,name=failMsg,proto3" json:"failMsg,omitempty"` //失败原因
-	Status  int64    `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`  //0成功，1失败
+	AppPkgName  string `protobuf:"bytes,1,opt,name=appPkgName,proto3" json:"appPkgName,omitempty"`   // 应用包名
+	OutRefundNo string `protobuf:"bytes,2,opt,name=outRefundNo,proto3" json:"outRefundNo,omitempty"` // 商户退款订单号
 }
 
-func (x *AutoPkgAddResp) Reset() {
-	*x = AutoPkgAddResp{}
+func (x *WechatMiniRefundQueryReq) Reset() {
+	*x = WechatMiniRefundQueryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[50]
+		mi := &file_payment_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AutoPkgAddResp) String() string {
+func (x *WechatMiniRefundQueryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AutoPkgAddResp) ProtoMessage() {}
+func (*WechatMiniRefundQueryReq) ProtoMessage() {}
 
-func (x *AutoPkgAddResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[50]
+func (x *WechatMiniRefundQueryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4260,58 +4803,60 @@ func (x *AutoPkgAddResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutoPkgAddResp.ProtoReflect.Descriptor instead.
-func (*AutoPkgAddResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *AutoPkgAddResp) GetAppIds() []string {
-	if x != nil {
-		return x.AppIds
-	}
-	return nil
+// Deprecated: Use WechatMiniRefundQueryReq.ProtoReflect.Descriptor instead.
+func (*WechatMiniRefundQueryReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *AutoPkgAddResp) GetFailMsg() string {
+func (x *WechatMiniRefundQueryReq) GetAppPkgName() string {
 	if x != nil {
-		return x.FailMsg
+		return x.AppPkgName
 	}
 	return ""
 }
 
-func (x *AutoPkgAddResp) GetStatus() int64 {
+func (x *WechatMiniRefundQueryReq) GetOutRefundNo() string {
 	if x != nil {
-		return x.Status
+		return x.OutRefundNo
 	}
-	return 0
+	return ""
 }
 
-type DyPeriodOrderReq struct {
+type WechatMiniRefundQueryResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderSn string `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"` // 内部 订单唯一标识 (开发者侧代扣单的单号)
-	AppPkg  string `protobuf:"bytes,2,opt,name=AppPkg,proto3" json:"AppPkg,omitempty"`   // 来源包名
+	// refundId【微信支付退款单号】申请退款受理成功时，该笔退款单在微信支付侧生成的唯一标识。
+	RefundId    string `protobuf:"bytes,1,opt,name=refundId,proto3" json:"refundId,omitempty"`
+	OutRefundNo string `protobuf:"bytes,2,opt,name=outRefundNo,proto3" json:"outRefundNo,omitempty"` // 商户退款订单号
+	// successTime
+	// 退款成功的时间，该字段在退款状态status为SUCCESS（退款成功）时返回。示例：2015-05-20T13:29:35+08:00
+	SuccessTime string `protobuf:"bytes,3,opt,name=successTime,proto3" json:"successTime,omitempty"`
+	// createTime 提交退款申请成功，微信受理退款申请单的时间。格式如 successTime
+	CreateTime string `protobuf:"bytes,4,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	// status 【退款状态】退款单的退款处理状态,SUCCESS: 退款成功,CLOSED:
+	//  退款关闭,PROCESSING: 退款处理中,ABNORMAL: 退款异常，
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *DyPeriodOrderReq) Reset() {
-	*x = DyPeriodOrderReq{}
+func (x *WechatMiniRefundQueryResp) Reset() {
+	*x = WechatMiniRefundQueryResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[51]
+		mi := &file_payment_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DyPeriodOrderReq) String() string {
+func (x *WechatMiniRefundQueryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DyPeriodOrderReq) ProtoMessage() {}
+func (*WechatMiniRefundQueryResp) ProtoMessage() {}
 
-func (x *DyPeriodOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[51]
+func (x *WechatMiniRefundQueryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4322,52 +4867,81 @@ func (x *DyPeriodOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DyPeriodOrderReq.ProtoReflect.Descriptor instead.
-func (*DyPeriodOrderReq) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use WechatMiniRefundQueryResp.ProtoReflect.Descriptor instead.
+func (*WechatMiniRefundQueryResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *DyPeriodOrderReq) GetOrderSn() string {
+func (x *WechatMiniRefundQueryResp) GetRefundId() string {
 	if x != nil {
-		return x.OrderSn
+		return x.RefundId
 	}
 	return ""
 }
 
-func (x *DyPeriodOrderReq) GetAppPkg() string {
+func (x *WechatMiniRefundQueryResp) GetOutRefundNo() string {
 	if x != nil {
-		return x.AppPkg
+		return x.OutRefundNo
 	}
 	return ""
 }
 
-type DyPeriodOrderResp struct {
+func (x *WechatMiniRefundQueryResp) GetSuccessTime() string {
+	if x != nil {
+		return x.SuccessTime
+	}
+	return ""
+}
+
+func (x *WechatMiniRefundQueryResp) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+func (x *WechatMiniRefundQueryResp) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 微信退款申请-虚拟支付退款
+type WechatMiniXPayRefundReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SignNo     string `protobuf:"bytes,1,opt,name=signNo,proto3" json:"signNo,omitempty"`          // 抖音签约单号
-	PayStatus  int64  `protobuf:"varint,2,opt,name=payStatus,proto3" json:"payStatus,omitempty"`   //支付状态 0未支付  1已支付
-	SignStatus int64  `protobuf:"varint,3,opt,name=signStatus,proto3" json:"signStatus,omitempty"` //签约状态, 0 待签约 , 1已签约 , 2取消签约 , 3 签约到期
+	AppPkgName      string `protobuf:"bytes,1,opt,name=appPkgName,proto3" json:"appPkgName,omitempty"`           // 应用包名
+	OutOrderNo      string `protobuf:"bytes,2,opt,name=outOrderNo,proto3" json:"outOrderNo,omitempty"`           // 商户支付订单号
+	OutRefundNo     string `protobuf:"bytes,3,opt,name=outRefundNo,proto3" json:"outRefundNo,omitempty"`         // 商户退款订单号
+	RefundAmount    int64  `protobuf:"varint,4,opt,name=refundAmount,proto3" json:"refundAmount,omitempty"`      // 退款金额 分
+	RefundReason    string `protobuf:"bytes,5,opt,name=refundReason,proto3" json:"refundReason,omitempty"`       // 退款原因
+	RefundNotifyUrl string `protobuf:"bytes,6,opt,name=refundNotifyUrl,proto3" json:"refundNotifyUrl,omitempty"` // 退款回调地址
+	TotalAmount     int64  `protobuf:"varint,7,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`        // 所在订单的总金额 分
+	OrderSn         string `protobuf:"bytes,8,opt,name=orderSn,proto3" json:"orderSn,omitempty"`                 // orderCode订单号，所在订单唯一标识
+	OpenId          string `protobuf:"bytes,9,opt,name=OpenId,proto3" json:"OpenId,omitempty"`                   // 下单时的微信用户openid
+	SessionKey      string `protobuf:"bytes,10,opt,name=SessionKey,proto3" json:"SessionKey,omitempty"`          // 微信平台返回的session_key
 }
 
-func (x *DyPeriodOrderResp) Reset() {
-	*x = DyPeriodOrderResp{}
+func (x *WechatMiniXPayRefundReq) Reset() {
+	*x = WechatMiniXPayRefundReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_proto_msgTypes[52]
+		mi := &file_payment_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DyPeriodOrderResp) String() string {
+func (x *WechatMiniXPayRefundReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DyPeriodOrderResp) ProtoMessage() {}
+func (*WechatMiniXPayRefundReq) ProtoMessage() {}
 
-func (x *DyPeriodOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[52]
+func (x *WechatMiniXPayRefundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4378,969 +4952,3951 @@ func (x *DyPeriodOrderResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DyPeriodOrderResp.ProtoReflect.Descriptor instead.
-func (*DyPeriodOrderResp) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use WechatMiniXPayRefundReq.ProtoReflect.Descriptor instead.
+func (*WechatMiniXPayRefundReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *DyPeriodOrderResp) GetSignNo() string {
+func (x *WechatMiniXPayRefundReq) GetAppPkgName() string {
 	if x != nil {
-		return x.SignNo
+		return x.AppPkgName
 	}
 	return ""
 }
 
-func (x *DyPeriodOrderResp) GetPayStatus() int64 {
+func (x *WechatMiniXPayRefundReq) GetOutOrderNo() string {
 	if x != nil {
-		return x.PayStatus
+		return x.OutOrderNo
 	}
-	return 0
+	return ""
 }
 
-func (x *DyPeriodOrderResp) GetSignStatus() int64 {
+func (x *WechatMiniXPayRefundReq) GetOutRefundNo() string {
 	if x != nil {
-		return x.SignStatus
+		return x.OutRefundNo
 	}
-	return 0
+	return ""
 }
 
-var File_payment_proto protoreflect.FileDescriptor
-
-var file_payment_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xda, 0x05, 0x0a, 0x0b, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50,
-	0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70,
-	0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x53, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x53, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08,
-	0x57, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x57, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x54, 0x0a, 0x15, 0x64, 0x6f, 0x75, 0x79,
-	0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54,
-	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x52, 0x15, 0x64, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2d,
-	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x11, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x28, 0x0a,
-	0x0f, 0x49, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x49, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x6e, 0x65, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x49, 0x6e,
-	0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x6e,
-	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x12,
-	0x32, 0x0a, 0x14, 0x46, 0x69, 0x72, 0x73, 0x74, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x46,
-	0x69, 0x72, 0x73, 0x74, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x55,
-	0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61,
-	0x6e, 0x74, 0x55, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x49, 0x73, 0x42, 0x61, 0x73, 0x65, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18,
-	0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x49, 0x73, 0x42, 0x61, 0x73, 0x65, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a,
-	0x12, 0x45, 0x78, 0x69, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x4e, 0x6f, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x16, 0x0a,
-	0x06, 0x4e, 0x74, 0x68, 0x4e, 0x75, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4e,
-	0x74, 0x68, 0x4e, 0x75, 0x6d, 0x22, 0xf5, 0x03, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50,
-	0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e,
-	0x12, 0x2a, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x10, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x57, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x57, 0x61, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x57, 0x78,
-	0x55, 0x6e, 0x69, 0x41, 0x70, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x41, 0x70, 0x70, 0x50,
-	0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x08, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x41, 0x70,
-	0x70, 0x12, 0x35, 0x0a, 0x08, 0x54, 0x69, 0x6b, 0x54, 0x6f, 0x6b, 0x45, 0x63, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x69,
-	0x6b, 0x74, 0x6f, 0x6b, 0x45, 0x63, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x08,
-	0x54, 0x69, 0x6b, 0x54, 0x6f, 0x6b, 0x45, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x69, 0x70,
-	0x61, 0x79, 0x57, 0x65, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x69,
-	0x70, 0x61, 0x79, 0x57, 0x65, 0x62, 0x12, 0x35, 0x0a, 0x08, 0x57, 0x78, 0x4e, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x57, 0x78, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x79, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x52, 0x08, 0x57, 0x78, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x32, 0x0a,
-	0x08, 0x4b, 0x73, 0x55, 0x6e, 0x69, 0x41, 0x70, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4b, 0x73, 0x55, 0x6e, 0x69, 0x41,
-	0x70, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x08, 0x4b, 0x73, 0x55, 0x6e, 0x69, 0x41, 0x70,
-	0x70, 0x12, 0x38, 0x0a, 0x09, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57,
-	0x78, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x52, 0x09, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x12, 0x44,
-	0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64,
-	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54,
-	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x12, 0x44, 0x6f, 0x75, 0x79, 0x69,
-	0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x22, 0xd4, 0x01,
-	0x0a, 0x10, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x41, 0x70, 0x70, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a,
-	0x0a, 0x08, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x50, 0x61, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x6e, 0x22, 0x4c, 0x0a, 0x10, 0x57, 0x78, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x64, 0x65,
-	0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x64, 0x65, 0x55,
-	0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x61, 0x73, 0x65, 0x36, 0x34,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x61, 0x73, 0x65,
-	0x36, 0x34, 0x22, 0xcd, 0x01, 0x0a, 0x11, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x70,
-	0x61, 0x79, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72, 0x65, 0x70,
-	0x61, 0x79, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x77, 0x65, 0x62, 0x55, 0x72, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x77, 0x65, 0x62, 0x55, 0x72, 0x6c, 0x12, 0x1e,
-	0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x16,
-	0x0a, 0x06, 0x57, 0x61, 0x70, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x57, 0x61, 0x70, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x57, 0x61, 0x70, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x57, 0x61, 0x70, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x30, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x67, 0x72, 0x65,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x4e, 0x6f, 0x22, 0x45, 0x0a, 0x0d, 0x57, 0x78, 0x48, 0x35, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70,
-	0x6c, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x48, 0x35, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x48, 0x35, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f,
-	0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x22, 0x4c, 0x0a, 0x10, 0x54, 0x69, 0x6b,
-	0x74, 0x6f, 0x6b, 0x45, 0x63, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x51, 0x0a, 0x0d, 0x4b, 0x73, 0x55, 0x6e, 0x69,
-	0x41, 0x70, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x4e, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a, 0x10, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18,
-	0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x2a, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x50, 0x61, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x76, 0x0a,
-	0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12,
-	0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x2a, 0x0a, 0x07, 0x50, 0x61, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x50, 0x61,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b,
-	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x53, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x50,
-	0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x50, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x68, 0x69,
-	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x54, 0x68, 0x69, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x22,
-	0xcb, 0x02, 0x0a, 0x1d, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x55, 0x6e, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a,
-	0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x30, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79,
-	0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70, 0x61, 0x79, 0x65, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x69, 0x7a, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x42, 0x69, 0x7a, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x26, 0x0a,
-	0x0e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1e, 0x0a,
-	0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5f, 0x0a,
-	0x09, 0x50, 0x61, 0x79, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x7d,
-	0x0a, 0x15, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b,
-	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x44, 0x0a,
-	0x16, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x44, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44,
-	0x65, 0x73, 0x63, 0x22, 0xce, 0x01, 0x0a, 0x10, 0x44, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50,
-	0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70,
-	0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x52,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f,
-	0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x55, 0x72, 0x6c, 0x22, 0x43, 0x0a, 0x11, 0x44, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72,
-	0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x45, 0x72, 0x72, 0x4e, 0x6f, 0x12,
-	0x18, 0x0a, 0x07, 0x45, 0x72, 0x72, 0x54, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x45, 0x72, 0x72, 0x54, 0x69, 0x70, 0x73, 0x22, 0xf9, 0x03, 0x0a, 0x11, 0x41, 0x6c,
-	0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x12,
-	0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x4c, 0x12,
-	0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x73, 0x63, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x73,
-	0x63, 0x12, 0x28, 0x0a, 0x0f, 0x42, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x42, 0x65, 0x6c, 0x6f,
-	0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x52,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a,
-	0x08, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x73, 0x48,
-	0x77, 0x50, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x49, 0x73, 0x48, 0x77, 0x50, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x75, 0x72, 0x63, 0x68,
-	0x61, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x75,
-	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x53, 0x74,
-	0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x49, 0x64, 0x53, 0x74, 0x72, 0x22, 0xb2, 0x01, 0x0a, 0x0e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x54,
-	0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75,
-	0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70,
-	0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x69,
-	0x67, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x4c,
-	0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x55, 0x0a, 0x13, 0x41, 0x6c,
-	0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x55, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65,
-	0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e,
-	0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x78, 0x0a, 0x12, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f,
-	0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x22, 0x3e, 0x0a, 0x10, 0x41,
-	0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x65, 0x73, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x65, 0x73, 0x63, 0x22, 0xf9, 0x01, 0x0a, 0x0f,
-	0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12,
-	0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12,
-	0x18, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66,
-	0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x65, 0x66, 0x75,
-	0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x65, 0x0a, 0x11, 0x41, 0x6c, 0x69, 0x70, 0x61,
-	0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
-	0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x30, 0x0a, 0x13,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x22, 0x52,
-	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x4f, 0x75,
-	0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x12, 0x12,
-	0x0a, 0x04, 0x44, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x65,
-	0x73, 0x63, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x41, 0x6c, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x65,
-	0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x65, 0x73, 0x63, 0x12, 0x2a,
-	0x0a, 0x10, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x4e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x22, 0xa6, 0x01, 0x0a, 0x18, 0x41,
-	0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65, 0x64, 0x75, 0x63,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x65, 0x64,
-	0x75, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b,
-	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70,
-	0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72,
-	0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x65, 0x64, 0x75, 0x63,
-	0x74, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64,
-	0x65, 0x4e, 0x6f, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x52, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
-	0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x75,
-	0x6e, 0x64, 0x46, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x52, 0x65, 0x66,
-	0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbb, 0x05, 0x0a, 0x15, 0x44,
-	0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6b, 0x75, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6b, 0x75, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x53,
-	0x6b, 0x75, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x0b,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
-	0x3b, 0x0a, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x10, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x28, 0x0a, 0x0f,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x79, 0x57, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18,
-	0x0a, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x79, 0x57,
-	0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x69, 0x6f, 0x73, 0x50, 0x61, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x49, 0x6f, 0x73, 0x50, 0x61,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x69, 0x6f, 0x73, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x75, 0x41, 0x74, 0x74, 0x72, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x6b, 0x75, 0x41, 0x74, 0x74, 0x72, 0x22, 0x7d, 0x0a, 0x07, 0x53,
-	0x6b, 0x75, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x10, 0x91, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x52, 0x65, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x65, 0x56, 0x69, 0x70, 0x10, 0x92, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x52,
-	0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x10, 0x93, 0x03, 0x12, 0x14,
-	0x0a, 0x0f, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x10, 0x94, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x75,
-	0x6c, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x10, 0x95, 0x03, 0x22, 0x4c, 0x0a, 0x0a, 0x49, 0x6f,
-	0x73, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x6f, 0x73, 0x50,
-	0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
-	0x10, 0x0a, 0x0c, 0x49, 0x6f, 0x73, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6d, 0x10,
-	0x01, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x6f, 0x73, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x44,
-	0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64, 0x10, 0x02, 0x22, 0x34, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x7f,
-	0x0a, 0x17, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54,
-	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a,
-	0x11, 0x62, 0x79, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x79, 0x74, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x6d, 0x49, 0x64, 0x22,
-	0x8a, 0x03, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e,
-	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70,
-	0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
-	0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x75, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f,
-	0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74,
-	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x72,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x3b, 0x0a,
-	0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6c,
-	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41,
-	0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x50,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x66, 0x0a, 0x16,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x75,
-	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69,
-	0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x13, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x44, 0x65, 0x61, 0x64,
-	0x6c, 0x69, 0x6e, 0x65, 0x22, 0x6e, 0x0a, 0x14, 0x42, 0x69, 0x6e, 0x64, 0x48, 0x75, 0x61, 0x77,
-	0x65, 0x69, 0x50, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x48, 0x77, 0x70, 0x61, 0x79, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x48, 0x77, 0x70, 0x61, 0x79, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x76, 0x0a, 0x14, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
-	0x50, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x50, 0x6b, 0x67, 0x12, 0x26,
-	0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61,
-	0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50,
-	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3d, 0x0a, 0x15,
-	0x42, 0x69, 0x6e, 0x64, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x50, 0x61, 0x79, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x3d, 0x0a, 0x15, 0x55,
-	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0xab, 0x01, 0x0a, 0x14, 0x44,
-	0x6f, 0x75, 0x79, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x12, 0x3b, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x6f,
-	0x75, 0x79, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x10, 0x0a, 0x03, 0x50, 0x6b, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x50,
-	0x6b, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x50, 0x6d,
-	0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x50, 0x6d, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xfb, 0x01, 0x0a, 0x11, 0x44, 0x79, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18,
-	0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x50,
-	0x6b, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67,
-	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x4e, 0x65, 0x78, 0x74,
-	0x44, 0x65, 0x63, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x4e, 0x65, 0x78, 0x74, 0x44, 0x65, 0x63, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x79, 0x53, 0x69, 0x67, 0x6e,
-	0x4e, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x44, 0x79, 0x53, 0x69, 0x67, 0x6e,
-	0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c,
-	0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x55, 0x69, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x55,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6b, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x50, 0x6b, 0x49, 0x64, 0x22, 0xb7, 0x02, 0x0a, 0x15, 0x44, 0x6f, 0x75, 0x79, 0x69,
-	0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x53, 0x69,
-	0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x49, 0x73, 0x53, 0x69, 0x67, 0x6e,
-	0x12, 0x28, 0x0a, 0x0f, 0x49, 0x73, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x49, 0x73, 0x55, 0x6e, 0x73,
-	0x69, 0x67, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x44, 0x65,
-	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0f, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61,
-	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x4e, 0x65, 0x78, 0x74, 0x44, 0x65, 0x63, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x4e, 0x65, 0x78, 0x74, 0x44, 0x65, 0x63, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x4d, 0x73, 0x67, 0x12, 0x3a, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x44, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x22, 0xa5, 0x02, 0x0a, 0x13, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x50,
-	0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70,
-	0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x52,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
-	0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22,
-	0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x66,
-	0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x22, 0x32, 0x0a, 0x14, 0x57, 0x65, 0x63, 0x68,
-	0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x18,
-	0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x50,
-	0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70,
-	0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x52,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
-	0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x22, 0xb3, 0x01, 0x0a, 0x19, 0x57,
-	0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75,
-	0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75,
-	0x6e, 0x64, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e,
-	0x64, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x52, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0xe1, 0x02, 0x0a, 0x17, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58,
-	0x50, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
-	0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x20, 0x0a, 0x0b,
-	0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x12, 0x22,
-	0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c,
-	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b,
-	0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x4b, 0x65, 0x79, 0x22, 0x36, 0x0a, 0x18, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69,
-	0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x22, 0xb3, 0x01, 0x0a,
-	0x1b, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
-	0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
-	0x77, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x77, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b,
-	0x65, 0x79, 0x22, 0xb8, 0x03, 0x0a, 0x1c, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e,
-	0x69, 0x58, 0x50, 0x61, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x7a,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x7a, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x65, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x61, 0x69, 0x64, 0x46, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x70, 0x61, 0x69, 0x64, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x46,
-	0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x46, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6c, 0x65, 0x66, 0x74, 0x46, 0x65, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x6c, 0x65, 0x66, 0x74, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x78, 0x50,
-	0x61, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x77, 0x78, 0x50, 0x61, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x29, 0x0a,
-	0x0d, 0x41, 0x75, 0x74, 0x6f, 0x50, 0x6b, 0x67, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x71, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x71, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x6f,
-	0x50, 0x6b, 0x67, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70,
-	0x70, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x70, 0x49,
-	0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x44, 0x0a, 0x10, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x53, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x22, 0x69, 0x0a, 0x11, 0x44, 0x79,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x69, 0x67, 0x6e, 0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x22, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x59,
-	0x44, 0x49, 0x41, 0x4d, 0x4f, 0x4e, 0x44, 0x10, 0x01, 0x2a, 0x8f, 0x01, 0x0a, 0x07, 0x50, 0x61,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x57,
-	0x61, 0x70, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x41, 0x70, 0x70,
-	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x69, 0x6b, 0x74, 0x6f, 0x6b, 0x45, 0x63, 0x10, 0x02,
-	0x12, 0x0d, 0x0a, 0x09, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x57, 0x65, 0x62, 0x10, 0x03, 0x12,
-	0x09, 0x0a, 0x05, 0x57, 0x78, 0x57, 0x65, 0x62, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4b, 0x73,
-	0x55, 0x6e, 0x69, 0x41, 0x70, 0x70, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x78, 0x55, 0x6e,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x78, 0x56, 0x33, 0x48,
-	0x35, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x10, 0x08, 0x2a, 0x8f, 0x01, 0x0a, 0x1a,
-	0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x79,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0x01, 0x12, 0x1c, 0x0a,
-	0x18, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47,
-	0x65, 0x74, 0x50, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x44,
-	0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x03, 0x32, 0xfb, 0x11,
-	0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x50, 0x61, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x54,
-	0x0a, 0x1a, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x55, 0x6e, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e,
-	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x55, 0x6e, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x12, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a,
-	0x0d, 0x44, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x19,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x44, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x75, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
-	0x6f, 0x75, 0x79, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x75, 0x79,
-	0x69, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x75, 0x79,
-	0x69, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x43, 0x0a, 0x0b,
-	0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x54, 0x72, 0x61, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41,
-	0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x4f, 0x0a, 0x14, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x50,
-	0x61, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x67, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x46, 0x0a, 0x0b, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x48, 0x35, 0x50, 0x61,
-	0x79, 0x12, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70,
-	0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x21, 0x41, 0x6c,
-	0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x50, 0x61, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69,
-	0x67, 0x6e, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79,
-	0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x10, 0x41, 0x6c, 0x69, 0x70,
-	0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x55, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1c, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67,
-	0x65, 0x55, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x12, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x18, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75,
-	0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x40, 0x0a, 0x0c, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x12, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61,
-	0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x47, 0x0a, 0x0e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x54, 0x72, 0x61, 0x64,
-	0x65, 0x50, 0x61, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41,
-	0x6c, 0x69, 0x70, 0x61, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71,
-	0x1a, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61,
-	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x15, 0x41,
-	0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x79, 0x12, 0x21, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41,
-	0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x4c, 0x0a, 0x12, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x55, 0x6e, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67,
-	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57,
-	0x78, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x4d, 0x0a, 0x11, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x46, 0x0a, 0x10, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x50, 0x61, 0x79, 0x48, 0x35, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c,
-	0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x78, 0x48, 0x35, 0x50, 0x61,
-	0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x52, 0x0a, 0x11, 0x42, 0x69, 0x6e, 0x64, 0x48,
-	0x75, 0x61, 0x77, 0x65, 0x69, 0x50, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x48, 0x75, 0x61, 0x77, 0x65,
-	0x69, 0x50, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69,
-	0x50, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x11, 0x55,
-	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69,
-	0x12, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x52, 0x65, 0x71, 0x1a,
-	0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x58, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75,
-	0x61, 0x77, 0x65, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48,
-	0x75, 0x61, 0x77, 0x65, 0x69, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x11, 0x44, 0x6f, 0x75,
-	0x79, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1d,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x50,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a,
-	0x10, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e,
-	0x64, 0x12, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68,
-	0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a,
-	0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74,
-	0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e,
-	0x0a, 0x15, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75,
-	0x6e, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75,
-	0x6e, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b,
-	0x0a, 0x14, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58, 0x50, 0x61, 0x79,
-	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x52,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58, 0x50, 0x61,
-	0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x18, 0x57,
-	0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58, 0x50, 0x61, 0x79,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69,
-	0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x6f, 0x50, 0x6b, 0x67, 0x41,
-	0x64, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74,
-	0x6f, 0x50, 0x6b, 0x67, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x50, 0x6b, 0x67, 0x41, 0x64, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x0d, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44,
-	0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
-	0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x42, 0x06, 0x5a, 0x04, 0x2e,
-	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *WechatMiniXPayRefundReq) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
 }
 
-var (
-	file_payment_proto_rawDescOnce sync.Once
-	file_payment_proto_rawDescData = file_payment_proto_rawDesc
-)
-
-func file_payment_proto_rawDescGZIP() []byte {
-	file_payment_proto_rawDescOnce.Do(func() {
-		file_payment_proto_rawDescData = protoimpl.X.CompressGZIP(file_payment_proto_rawDescData)
-	})
-	return file_payment_proto_rawDescData
+func (x *WechatMiniXPayRefundReq) GetRefundReason() string {
+	if x != nil {
+		return x.RefundReason
+	}
+	return ""
 }
 
-var file_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
-var file_payment_proto_goTypes = []interface{}{
-	(Currency)(0),                         // 0: payment.Currency
-	(PayType)(0),                          // 1: payment.PayType
-	(DouyinPeriodOrderReqAction)(0),       // 2: payment.DouyinPeriodOrderReqAction
-	(DouyinGeneralTradeReq_SkuType)(0),    // 3: payment.DouyinGeneralTradeReq.SkuType
-	(DouyinGeneralTradeReq_IosPayType)(0), // 4: payment.DouyinGeneralTradeReq.IosPayType
-	(*OrderPayReq)(nil),                   // 5: payment.OrderPayReq
-	(*OrderPayResp)(nil),                  // 6: payment.OrderPayResp
-	(*WxUniAppPayReply)(nil),              // 7: payment.WxUniAppPayReply
-	(*WxNativePayReply)(nil),              // 8: payment.WxNativePayReply
-	(*WxUnifiedPayReply)(nil),             // 9: payment.WxUnifiedPayReply
-	(*WxH5PayReplay)(nil),                 // 10: payment.WxH5PayReplay
-	(*TiktokEcPayReply)(nil),              // 11: payment.TiktokEcPayReply
-	(*KsUniAppReply)(nil),                 // 12: payment.KsUniAppReply
-	(*ClosePayOrderReq)(nil),              // 13: payment.ClosePayOrderReq
-	(*Empty)(nil),                         // 14: payment.Empty
-	(*OrderStatusReq)(nil),                // 15: payment.OrderStatusReq
-	(*OrderStatusResp)(nil),               // 16: payment.OrderStatusResp
-	(*AlipayFundTransUniTransferReq)(nil), // 17: payment.AlipayFundTransUniTransferReq
-	(*PayeeInfo)(nil),                     // 18: payment.PayeeInfo
-	(*AlipayCheckAccountReq)(nil),         // 19: payment.AlipayCheckAccountReq
-	(*AlipayCheckAccountResp)(nil),        // 20: payment.AlipayCheckAccountResp
-	(*DyOrderRefundReq)(nil),              // 21: payment.DyOrderRefundReq
-	(*DyOrderRefundResp)(nil),             // 22: payment.DyOrderRefundResp
-	(*AlipayPageSignReq)(nil),             // 23: payment.AlipayPageSignReq
-	(*AlipayTradeReq)(nil),                // 24: payment.AlipayTradeReq
-	(*AlipayPageUnSignReq)(nil),           // 25: payment.AlipayPageUnSignReq
-	(*AlipayPageSignResp)(nil),            // 26: payment.AlipayPageSignResp
-	(*AlipayCommonResp)(nil),              // 27: payment.AlipayCommonResp
-	(*AlipayRefundReq)(nil),               // 28: payment.AlipayRefundReq
-	(*AlipayTradePayReq)(nil),             // 29: payment.AlipayTradePayReq
-	(*CreateRefundResp)(nil),              // 30: payment.CreateRefundResp
-	(*AliRefundResp)(nil),                 // 31: payment.AliRefundResp
-	(*AlipayAgreementModifyReq)(nil),      // 32: payment.AlipayAgreementModifyReq
-	(*WechatRefundOrderReq)(nil),          // 33: payment.WechatRefundOrderReq
-	(*DouyinGeneralTradeReq)(nil),         // 34: payment.DouyinGeneralTradeReq
-	(*Schema)(nil),                        // 35: payment.Schema
-	(*DouyinGeneralTradeReply)(nil),       // 36: payment.DouyinGeneralTradeReply
-	(*CreateDouyinRefundReq)(nil),         // 37: payment.CreateDouyinRefundReq
-	(*CreateDouyinRefundResp)(nil),        // 38: payment.CreateDouyinRefundResp
-	(*BindHuaweiPayDataReq)(nil),          // 39: payment.BindHuaweiPayDataReq
-	(*UnsubscribeHuaweiReq)(nil),          // 40: payment.UnsubscribeHuaweiReq
-	(*BindHuaweiPayDataResp)(nil),         // 41: payment.BindHuaweiPayDataResp
-	(*UnsubscribeHuaweiResp)(nil),         // 42: payment.UnsubscribeHuaweiResp
-	(*DouyinPeriodOrderReq)(nil),          // 43: payment.DouyinPeriodOrderReq
-	(*DySignedOrderInfo)(nil),             // 44: payment.DySignedOrderInfo
-	(*DouyinPeriodOrderResp)(nil),         // 45: payment.DouyinPeriodOrderResp
-	(*WechatMiniRefundReq)(nil),           // 46: payment.WechatMiniRefundReq
-	(*WechatMiniRefundResp)(nil),          // 47: payment.WechatMiniRefundResp
-	(*WechatMiniRefundQueryReq)(nil),      // 48: payment.WechatMiniRefundQueryReq
-	(*WechatMiniRefundQueryResp)(nil),     // 49: payment.WechatMiniRefundQueryResp
-	(*WechatMiniXPayRefundReq)(nil),       // 50: payment.WechatMiniXPayRefundReq
-	(*WechatMiniXPayRefundResp)(nil),      // 51: payment.WechatMiniXPayRefundResp
-	(*WechatMiniXPayQueryOrderReq)(nil),   // 52: payment.WechatMiniXPayQueryOrderReq
-	(*WechatMiniXPayQueryOrderResp)(nil),  // 53: payment.WechatMiniXPayQueryOrderResp
-	(*AutoPkgAddReq)(nil),                 // 54: payment.AutoPkgAddReq
-	(*AutoPkgAddResp)(nil),                // 55: payment.AutoPkgAddResp
-	(*DyPeriodOrderReq)(nil),              // 56: payment.DyPeriodOrderReq
-	(*DyPeriodOrderResp)(nil),             // 57: payment.DyPeriodOrderResp
-}
-var file_payment_proto_depIdxs = []int32{
-	1,  // 0: payment.OrderPayReq.PayType:type_name -> payment.PayType
-	34, // 1: payment.OrderPayReq.douyinGeneralTradeReq:type_name -> payment.DouyinGeneralTradeReq
-	0,  // 2: payment.OrderPayReq.currency:type_name -> payment.Currency
-	1,  // 3: payment.OrderPayResp.PayType:type_name -> payment.PayType
-	7,  // 4: payment.OrderPayResp.WxUniApp:type_name -> payment.WxUniAppPayReply
-	11, // 5: payment.OrderPayResp.TikTokEc:type_name -> payment.TiktokEcPayReply
-	8,  // 6: payment.OrderPayResp.WxNative:type_name -> payment.WxNativePayReply
-	12, // 7: payment.OrderPayResp.KsUniApp:type_name -> payment.KsUniAppReply
-	9,  // 8: payment.OrderPayResp.WxUnified:type_name -> payment.WxUnifiedPayReply
-	36, // 9: payment.OrderPayResp.DouyinGeneralTrade:type_name -> payment.DouyinGeneralTradeReply
-	1,  // 10: payment.ClosePayOrderReq.PayType:type_name -> payment.PayType
-	1,  // 11: payment.OrderStatusReq.PayType:type_name -> payment.PayType
-	18, // 12: payment.AlipayFundTransUniTransferReq.payeeInfo:type_name -> payment.PayeeInfo
-	3,  // 13: payment.DouyinGeneralTradeReq.type:type_name -> payment.DouyinGeneralTradeReq.SkuType
-	35, // 14: payment.DouyinGeneralTradeReq.entrySchema:type_name -> payment.Schema
-	35, // 15: payment.DouyinGeneralTradeReq.orderEntrySchema:type_name -> payment.Schema
-	4,  // 16: payment.DouyinGeneralTradeReq.iosPayType:type_name -> payment.DouyinGeneralTradeReq.IosPayType
-	35, // 17: payment.CreateDouyinRefundReq.orderEntrySchema:type_name -> payment.Schema
-	2,  // 18: payment.DouyinPeriodOrderReq.Action:type_name -> payment.DouyinPeriodOrderReqAction
-	44, // 19: payment.DouyinPeriodOrderResp.SignedList:type_name -> payment.DySignedOrderInfo
-	5,  // 20: payment.Payment.OrderPay:input_type -> payment.OrderPayReq
-	13, // 21: payment.Payment.ClosePayOrder:input_type -> payment.ClosePayOrderReq
-	17, // 22: payment.Payment.AlipayFundTransUniTransfer:input_type -> payment.AlipayFundTransUniTransferReq
-	15, // 23: payment.Payment.OrderStatus:input_type -> payment.OrderStatusReq
-	19, // 24: payment.Payment.AlipayCheckAccount:input_type -> payment.AlipayCheckAccountReq
-	21, // 25: payment.Payment.DyOrderRefund:input_type -> payment.DyOrderRefundReq
-	37, // 26: payment.Payment.CreateDouyinRefund:input_type -> payment.CreateDouyinRefundReq
-	24, // 27: payment.Payment.AlipayTrade:input_type -> payment.AlipayTradeReq
-	23, // 28: payment.Payment.AlipayPagePayAndSign:input_type -> payment.AlipayPageSignReq
-	23, // 29: payment.Payment.AlipayH5Pay:input_type -> payment.AlipayPageSignReq
-	23, // 30: payment.Payment.AlipayPagePayAndSignChoiceAccount:input_type -> payment.AlipayPageSignReq
-	25, // 31: payment.Payment.AlipayPageUnSign:input_type -> payment.AlipayPageUnSignReq
-	28, // 32: payment.Payment.AlipayCreateRefund:input_type -> payment.AlipayRefundReq
-	28, // 33: payment.Payment.AlipayRefund:input_type -> payment.AlipayRefundReq
-	29, // 34: payment.Payment.AlipayTradePay:input_type -> payment.AlipayTradePayReq
-	32, // 35: payment.Payment.AlipayAgreementModify:input_type -> payment.AlipayAgreementModifyReq
-	23, // 36: payment.Payment.WechatUnifiedOrder:input_type -> payment.AlipayPageSignReq
-	33, // 37: payment.Payment.WechatRefundOrder:input_type -> payment.WechatRefundOrderReq
-	23, // 38: payment.Payment.WechatPayH5Order:input_type -> payment.AlipayPageSignReq
-	39, // 39: payment.Payment.BindHuaweiPayData:input_type -> payment.BindHuaweiPayDataReq
-	40, // 40: payment.Payment.UnsubscribeHuawei:input_type -> payment.UnsubscribeHuaweiReq
-	40, // 41: payment.Payment.UnsubscribeHuaweiRefund:input_type -> payment.UnsubscribeHuaweiReq
-	43, // 42: payment.Payment.DouyinPeriodOrder:input_type -> payment.DouyinPeriodOrderReq
-	46, // 43: payment.Payment.WechatMiniRefund:input_type -> payment.WechatMiniRefundReq
-	48, // 44: payment.Payment.WechatMiniRefundQuery:input_type -> payment.WechatMiniRefundQueryReq
-	50, // 45: payment.Payment.WechatMiniXPayRefund:input_type -> payment.WechatMiniXPayRefundReq
-	52, // 46: payment.Payment.WechatMiniXPayQueryOrder:input_type -> payment.WechatMiniXPayQueryOrderReq
-	54, // 47: payment.Payment.AutoPkgAdd:input_type -> payment.AutoPkgAddReq
-	56, // 48: payment.Payment.DyPeriodOrder:input_type -> payment.DyPeriodOrderReq
-	6,  // 49: payment.Payment.OrderPay:output_type -> payment.OrderPayResp
-	14, // 50: payment.Payment.ClosePayOrder:output_type -> payment.Empty
-	14, // 51: payment.Payment.AlipayFundTransUniTransfer:output_type -> payment.Empty
-	16, // 52: payment.Payment.OrderStatus:output_type -> payment.OrderStatusResp
-	20, // 53: payment.Payment.AlipayCheckAccount:output_type -> payment.AlipayCheckAccountResp
-	22, // 54: payment.Payment.DyOrderRefund:output_type -> payment.DyOrderRefundResp
-	38, // 55: payment.Payment.CreateDouyinRefund:output_type -> payment.CreateDouyinRefundResp
-	26, // 56: payment.Payment.AlipayTrade:output_type -> payment.AlipayPageSignResp
-	26, // 57: payment.Payment.AlipayPagePayAndSign:output_type -> payment.AlipayPageSignResp
-	26, // 58: payment.Payment.AlipayH5Pay:output_type -> payment.AlipayPageSignResp
-	26, // 59: payment.Payment.AlipayPagePayAndSignChoiceAccount:output_type -> payment.AlipayPageSignResp
-	27, // 60: payment.Payment.AlipayPageUnSign:output_type -> payment.AlipayCommonResp
-	30, // 61: payment.Payment.AlipayCreateRefund:output_type -> payment.CreateRefundResp
-	31, // 62: payment.Payment.AlipayRefund:output_type -> payment.AliRefundResp
-	27, // 63: payment.Payment.AlipayTradePay:output_type -> payment.AlipayCommonResp
-	27, // 64: payment.Payment.AlipayAgreementModify:output_type -> payment.AlipayCommonResp
-	9,  // 65: payment.Payment.WechatUnifiedOrder:output_type -> payment.WxUnifiedPayReply
-	30, // 66: payment.Payment.WechatRefundOrder:output_type -> payment.CreateRefundResp
-	10, // 67: payment.Payment.WechatPayH5Order:output_type -> payment.WxH5PayReplay
-	41, // 68: payment.Payment.BindHuaweiPayData:output_type -> payment.BindHuaweiPayDataResp
-	42, // 69: payment.Payment.UnsubscribeHuawei:output_type -> payment.UnsubscribeHuaweiResp
-	42, // 70: payment.Payment.UnsubscribeHuaweiRefund:output_type -> payment.UnsubscribeHuaweiResp
-	45, // 71: payment.Payment.DouyinPeriodOrder:output_type -> payment.DouyinPeriodOrderResp
-	47, // 72: payment.Payment.WechatMiniRefund:output_type -> payment.WechatMiniRefundResp
-	49, // 73: payment.Payment.WechatMiniRefundQuery:output_type -> payment.WechatMiniRefundQueryResp
-	51, // 74: payment.Payment.WechatMiniXPayRefund:output_type -> payment.WechatMiniXPayRefundResp
-	53, // 75: payment.Payment.WechatMiniXPayQueryOrder:output_type -> payment.WechatMiniXPayQueryOrderResp
-	55, // 76: payment.Payment.AutoPkgAdd:output_type -> payment.AutoPkgAddResp
-	57, // 77: payment.Payment.DyPeriodOrder:output_type -> payment.DyPeriodOrderResp
-	49, // [49:78] is the sub-list for method output_type
-	20, // [20:49] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+func (x *WechatMiniXPayRefundReq) GetRefundNotifyUrl() string {
+	if x != nil {
+		return x.RefundNotifyUrl
+	}
+	return ""
 }
 
-func init() { file_payment_proto_init() }
-func file_payment_proto_init() {
-	if File_payment_proto != nil {
-		return
+func (x *WechatMiniXPayRefundReq) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
 	}
-	if !protoimpl.UnsafeEnabled {
+	return 0
+}
+
+func (x *WechatMiniXPayRefundReq) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *WechatMiniXPayRefundReq) GetOpenId() string {
+	if x != nil {
+		return x.OpenId
+	}
+	return ""
+}
+
+func (x *WechatMiniXPayRefundReq) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+type WechatMiniXPayRefundResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundId string `protobuf:"bytes,1,opt,name=refundId,proto3" json:"refundId,omitempty"` // 退款单的微信侧单号
+}
+
+func (x *WechatMiniXPayRefundResp) Reset() {
+	*x = WechatMiniXPayRefundResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WechatMiniXPayRefundResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WechatMiniXPayRefundResp) ProtoMessage() {}
+
+func (x *WechatMiniXPayRefundResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WechatMiniXPayRefundResp.ProtoReflect.Descriptor instead.
+func (*WechatMiniXPayRefundResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *WechatMiniXPayRefundResp) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+// 微信-虚拟支付-查询创建的订单（现金单，非代币单）/退款单
+type WechatMiniXPayQueryOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppPkgName string `protobuf:"bytes,1,opt,name=appPkgName,proto3" json:"appPkgName,omitempty"` // 应用包名
+	OutOrderNo string `protobuf:"bytes,2,opt,name=outOrderNo,proto3" json:"outOrderNo,omitempty"` // 商户支付订单号
+	WxOrderId  string `protobuf:"bytes,3,opt,name=wxOrderId,proto3" json:"wxOrderId,omitempty"`   // 微信内部单号(与order_id二选一)
+	OpenId     string `protobuf:"bytes,4,opt,name=OpenId,proto3" json:"OpenId,omitempty"`         // 下单时的微信用户openid
+	SessionKey string `protobuf:"bytes,5,opt,name=SessionKey,proto3" json:"SessionKey,omitempty"` // 微信平台返回的session_key
+}
+
+func (x *WechatMiniXPayQueryOrderReq) Reset() {
+	*x = WechatMiniXPayQueryOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WechatMiniXPayQueryOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WechatMiniXPayQueryOrderReq) ProtoMessage() {}
+
+func (x *WechatMiniXPayQueryOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WechatMiniXPayQueryOrderReq.ProtoReflect.Descriptor instead.
+func (*WechatMiniXPayQueryOrderReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WechatMiniXPayQueryOrderReq) GetAppPkgName() string {
+	if x != nil {
+		return x.AppPkgName
+	}
+	return ""
+}
+
+func (x *WechatMiniXPayQueryOrderReq) GetOutOrderNo() string {
+	if x != nil {
+		return x.OutOrderNo
+	}
+	return ""
+}
+
+func (x *WechatMiniXPayQueryOrderReq) GetWxOrderId() string {
+	if x != nil {
+		return x.WxOrderId
+	}
+	return ""
+}
+
+func (x *WechatMiniXPayQueryOrderReq) GetOpenId() string {
+	if x != nil {
+		return x.OpenId
+	}
+	return ""
+}
+
+func (x *WechatMiniXPayQueryOrderReq) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+type WechatMiniXPayQueryOrderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OutOrderNo string `protobuf:"bytes,1,opt,name=outOrderNo,proto3" json:"outOrderNo,omitempty"`  // 商户支付订单号
+	WxOrderId  string `protobuf:"bytes,2,opt,name=wxOrderId,proto3" json:"wxOrderId,omitempty"`    // 微信内部单号(与order_id二选一)
+	CreateTime int64  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime,omitempty"` // 微信内部单号(与order_id二选一)
+	UpdateTime int64  `protobuf:"varint,4,opt,name=updateTime,proto3" json:"updateTime,omitempty"` // 微信内部单号(与order_id二选一)
+	// status
+	//  当前状态 0-订单初始化（未创建成功，不可用于支付）1-订单创建成功
+	//  2-订单已经支付，待发货 3-订单发货中 4-订单已发货 5-订单已经退款
+	//  6-订单已经关闭（不可再使用） 7-订单退款失败 8-用户退款完成
+	//  9-回收广告金完成 10-分账回退完成
+	Status    int64 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	BizType   int64 `protobuf:"varint,6,opt,name=bizType,proto3" json:"bizType,omitempty"`
+	OrderFee  int64 `protobuf:"varint,7,opt,name=orderFee,proto3" json:"orderFee,omitempty"`    // 订单金额，单位分
+	CouponFee int64 `protobuf:"varint,8,opt,name=couponFee,proto3" json:"couponFee,omitempty"`  // 订单优惠金额，单位分(暂无此字段)
+	PaidFee   int64 `protobuf:"varint,9,opt,name=paidFee,proto3" json:"paidFee,omitempty"`      // 用户支付金额
+	OrderType int64 `protobuf:"varint,10,opt,name=orderType,proto3" json:"orderType,omitempty"` // 订单类型0-支付单 1-退款单
+	RefundFee int64 `protobuf:"varint,11,opt,name=refundFee,proto3" json:"refundFee,omitempty"` // 当类型为退款单时表示退款金额，单位分
+	PaidTime  int64 `protobuf:"varint,12,opt,name=paidTime,proto3" json:"paidTime,omitempty"`   // 支付/退款时间，unix秒级时间戳
+	LeftFee   int64 `protobuf:"varint,13,opt,name=leftFee,proto3" json:"leftFee,omitempty"`     // 支付单类型时表示此单经过退款还剩余的金额，单位分
+	// wxPayOrderId 微信支付交易单号，为用户微信支付详情页面上的交易单号
+	WxPayOrderId string `protobuf:"bytes,14,opt,name=wxPayOrderId,proto3" json:"wxPayOrderId,omitempty"`
+}
+
+func (x *WechatMiniXPayQueryOrderResp) Reset() {
+	*x = WechatMiniXPayQueryOrderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WechatMiniXPayQueryOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WechatMiniXPayQueryOrderResp) ProtoMessage() {}
+
+func (x *WechatMiniXPayQueryOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WechatMiniXPayQueryOrderResp.ProtoReflect.Descriptor instead.
+func (*WechatMiniXPayQueryOrderResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetOutOrderNo() string {
+	if x != nil {
+		return x.OutOrderNo
+	}
+	return ""
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetWxOrderId() string {
+	if x != nil {
+		return x.WxOrderId
+	}
+	return ""
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetBizType() int64 {
+	if x != nil {
+		return x.BizType
+	}
+	return 0
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetOrderFee() int64 {
+	if x != nil {
+		return x.OrderFee
+	}
+	return 0
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetCouponFee() int64 {
+	if x != nil {
+		return x.CouponFee
+	}
+	return 0
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetPaidFee() int64 {
+	if x != nil {
+		return x.PaidFee
+	}
+	return 0
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetOrderType() int64 {
+	if x != nil {
+		return x.OrderType
+	}
+	return 0
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetRefundFee() int64 {
+	if x != nil {
+		return x.RefundFee
+	}
+	return 0
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetPaidTime() int64 {
+	if x != nil {
+		return x.PaidTime
+	}
+	return 0
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetLeftFee() int64 {
+	if x != nil {
+		return x.LeftFee
+	}
+	return 0
+}
+
+func (x *WechatMiniXPayQueryOrderResp) GetWxPayOrderId() string {
+	if x != nil {
+		return x.WxPayOrderId
+	}
+	return ""
+}
+
+type DyPeriodOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderSn string `protobuf:"bytes,1,opt,name=OrderSn,proto3" json:"OrderSn,omitempty"` // 内部 订单唯一标识 (开发者侧代扣单的单号)
+	AppPkg  string `protobuf:"bytes,2,opt,name=AppPkg,proto3" json:"AppPkg,omitempty"`   // 来源包名
+}
+
+func (x *DyPeriodOrderReq) Reset() {
+	*x = DyPeriodOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DyPeriodOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DyPeriodOrderReq) ProtoMessage() {}
+
+func (x *DyPeriodOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DyPeriodOrderReq.ProtoReflect.Descriptor instead.
+func (*DyPeriodOrderReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DyPeriodOrderReq) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *DyPeriodOrderReq) GetAppPkg() string {
+	if x != nil {
+		return x.AppPkg
+	}
+	return ""
+}
+
+type DyPeriodOrderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignNo     string `protobuf:"bytes,1,opt,name=signNo,proto3" json:"signNo,omitempty"`          // 抖音签约单号
+	PayStatus  int64  `protobuf:"varint,2,opt,name=payStatus,proto3" json:"payStatus,omitempty"`   //支付状态 0未支付  1已支付
+	SignStatus int64  `protobuf:"varint,3,opt,name=signStatus,proto3" json:"signStatus,omitempty"` //签约状态, 0 待签约 , 1已签约 , 2取消签约 , 3 签约到期
+}
+
+func (x *DyPeriodOrderResp) Reset() {
+	*x = DyPeriodOrderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DyPeriodOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DyPeriodOrderResp) ProtoMessage() {}
+
+func (x *DyPeriodOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DyPeriodOrderResp.ProtoReflect.Descriptor instead.
+func (*DyPeriodOrderResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DyPeriodOrderResp) GetSignNo() string {
+	if x != nil {
+		return x.SignNo
+	}
+	return ""
+}
+
+func (x *DyPeriodOrderResp) GetPayStatus() int64 {
+	if x != nil {
+		return x.PayStatus
+	}
+	return 0
+}
+
+func (x *DyPeriodOrderResp) GetSignStatus() int64 {
+	if x != nil {
+		return x.SignStatus
+	}
+	return 0
+}
+
+// 用户订阅，汇总支付宝周期扣款协议、抖音周期代扣签约和华为订阅
+type UserSubscriptionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel        string `protobuf:"bytes,1,opt,name=Channel,proto3" json:"Channel,omitempty"`                // 订阅渠道 alipay|douyin|huawei
+	SubscriptionNo string `protobuf:"bytes,2,opt,name=SubscriptionNo,proto3" json:"SubscriptionNo,omitempty"`  // 订阅标识：支付宝为内部协议号，抖音为内部签约单号，华为为订阅id
+	AppPkg         string `protobuf:"bytes,3,opt,name=AppPkg,proto3" json:"AppPkg,omitempty"`                  // 包名
+	UserId         int64  `protobuf:"varint,4,opt,name=UserId,proto3" json:"UserId,omitempty"`                 // 用户id
+	OutTradeNo     string `protobuf:"bytes,5,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`          // 签约(首期)订单号
+	Status         int32  `protobuf:"varint,6,opt,name=Status,proto3" json:"Status,omitempty"`                 // 订阅状态 1生效中 2已解约或已取消自动续费 3已失效(续费失败、到期或已退款)
+	Amount         int64  `protobuf:"varint,7,opt,name=Amount,proto3" json:"Amount,omitempty"`                 // 每期扣款金额，单位分
+	PeriodUnit     string `protobuf:"bytes,8,opt,name=PeriodUnit,proto3" json:"PeriodUnit,omitempty"`          // 扣款周期单位 day|week|month|year，华为为空
+	PeriodCount    int32  `protobuf:"varint,9,opt,name=PeriodCount,proto3" json:"PeriodCount,omitempty"`       // 每期包含的周期单位数
+	SignTime       string `protobuf:"bytes,10,opt,name=SignTime,proto3" json:"SignTime,omitempty"`             // 签约(首次购买)时间
+	NextChargeTime string `protobuf:"bytes,11,opt,name=NextChargeTime,proto3" json:"NextChargeTime,omitempty"` // 下次扣款时间，非生效中的为空
+}
+
+func (x *UserSubscriptionInfo) Reset() {
+	*x = UserSubscriptionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSubscriptionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSubscriptionInfo) ProtoMessage() {}
+
+func (x *UserSubscriptionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSubscriptionInfo.ProtoReflect.Descriptor instead.
+func (*UserSubscriptionInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UserSubscriptionInfo) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *UserSubscriptionInfo) GetSubscriptionNo() string {
+	if x != nil {
+		return x.SubscriptionNo
+	}
+	return ""
+}
+
+func (x *UserSubscriptionInfo) GetAppPkg() string {
+	if x != nil {
+		return x.AppPkg
+	}
+	return ""
+}
+
+func (x *UserSubscriptionInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserSubscriptionInfo) GetOutTradeNo() string {
+	if x != nil {
+		return x.OutTradeNo
+	}
+	return ""
+}
+
+func (x *UserSubscriptionInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UserSubscriptionInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UserSubscriptionInfo) GetPeriodUnit() string {
+	if x != nil {
+		return x.PeriodUnit
+	}
+	return ""
+}
+
+func (x *UserSubscriptionInfo) GetPeriodCount() int32 {
+	if x != nil {
+		return x.PeriodCount
+	}
+	return 0
+}
+
+func (x *UserSubscriptionInfo) GetSignTime() string {
+	if x != nil {
+		return x.SignTime
+	}
+	return ""
+}
+
+func (x *UserSubscriptionInfo) GetNextChargeTime() string {
+	if x != nil {
+		return x.NextChargeTime
+	}
+	return ""
+}
+
+type GetUserSubscriptionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg        string `protobuf:"bytes,1,opt,name=Pkg,proto3" json:"Pkg,omitempty"`                // 客户端包名
+	UserId     int64  `protobuf:"varint,2,opt,name=UserId,proto3" json:"UserId,omitempty"`         // 用户id
+	OnlyActive bool   `protobuf:"varint,3,opt,name=OnlyActive,proto3" json:"OnlyActive,omitempty"` // 只返回生效中的订阅
+}
+
+func (x *GetUserSubscriptionsReq) Reset() {
+	*x = GetUserSubscriptionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserSubscriptionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSubscriptionsReq) ProtoMessage() {}
+
+func (x *GetUserSubscriptionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSubscriptionsReq.ProtoReflect.Descriptor instead.
+func (*GetUserSubscriptionsReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetUserSubscriptionsReq) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *GetUserSubscriptionsReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserSubscriptionsReq) GetOnlyActive() bool {
+	if x != nil {
+		return x.OnlyActive
+	}
+	return false
+}
+
+type GetUserSubscriptionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*UserSubscriptionInfo `protobuf:"bytes,1,rep,name=List,proto3" json:"List,omitempty"` // 按签约时间倒序
+}
+
+func (x *GetUserSubscriptionsResp) Reset() {
+	*x = GetUserSubscriptionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserSubscriptionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSubscriptionsResp) ProtoMessage() {}
+
+func (x *GetUserSubscriptionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSubscriptionsResp.ProtoReflect.Descriptor instead.
+func (*GetUserSubscriptionsResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetUserSubscriptionsResp) GetList() []*UserSubscriptionInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type CancelSubscriptionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg            string `protobuf:"bytes,1,opt,name=Pkg,proto3" json:"Pkg,omitempty"`                       // 客户端包名
+	UserId         int64  `protobuf:"varint,2,opt,name=UserId,proto3" json:"UserId,omitempty"`                // 用户id
+	Channel        string `protobuf:"bytes,3,opt,name=Channel,proto3" json:"Channel,omitempty"`               // 订阅渠道
+	SubscriptionNo string `protobuf:"bytes,4,opt,name=SubscriptionNo,proto3" json:"SubscriptionNo,omitempty"` // 订阅标识，GetUserSubscriptions返回的SubscriptionNo
+}
+
+func (x *CancelSubscriptionReq) Reset() {
+	*x = CancelSubscriptionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSubscriptionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionReq) ProtoMessage() {}
+
+func (x *CancelSubscriptionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionReq.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CancelSubscriptionReq) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *CancelSubscriptionReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CancelSubscriptionReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CancelSubscriptionReq) GetSubscriptionNo() string {
+	if x != nil {
+		return x.SubscriptionNo
+	}
+	return ""
+}
+
+type CancelSubscriptionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"` // 0成功 1失败
+	Msg  string `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`    // 返回消息
+}
+
+func (x *CancelSubscriptionResp) Reset() {
+	*x = CancelSubscriptionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSubscriptionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionResp) ProtoMessage() {}
+
+func (x *CancelSubscriptionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionResp.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CancelSubscriptionResp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CancelSubscriptionResp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 订阅的一次扣款记录
+type SubscriptionChargeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel        string `protobuf:"bytes,1,opt,name=Channel,proto3" json:"Channel,omitempty"`               // 订阅渠道
+	SubscriptionNo string `protobuf:"bytes,2,opt,name=SubscriptionNo,proto3" json:"SubscriptionNo,omitempty"` // 所属订阅标识
+	OutTradeNo     string `protobuf:"bytes,3,opt,name=OutTradeNo,proto3" json:"OutTradeNo,omitempty"`         // 内部订单号
+	Amount         int64  `protobuf:"varint,4,opt,name=Amount,proto3" json:"Amount,omitempty"`                // 金额，单位分
+	Status         int32  `protobuf:"varint,5,opt,name=Status,proto3" json:"Status,omitempty"`                // 订单状态 -1关闭 0未支付 1已支付 2支付失败 3已退款
+	ChargeTime     string `protobuf:"bytes,6,opt,name=ChargeTime,proto3" json:"ChargeTime,omitempty"`         // 扣款时间，未扣款的为创建时间
+}
+
+func (x *SubscriptionChargeInfo) Reset() {
+	*x = SubscriptionChargeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionChargeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionChargeInfo) ProtoMessage() {}
+
+func (x *SubscriptionChargeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionChargeInfo.ProtoReflect.Descriptor instead.
+func (*SubscriptionChargeInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SubscriptionChargeInfo) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SubscriptionChargeInfo) GetSubscriptionNo() string {
+	if x != nil {
+		return x.SubscriptionNo
+	}
+	return ""
+}
+
+func (x *SubscriptionChargeInfo) GetOutTradeNo() string {
+	if x != nil {
+		return x.OutTradeNo
+	}
+	return ""
+}
+
+func (x *SubscriptionChargeInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SubscriptionChargeInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SubscriptionChargeInfo) GetChargeTime() string {
+	if x != nil {
+		return x.ChargeTime
+	}
+	return ""
+}
+
+type GetSubscriptionHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg            string `protobuf:"bytes,1,opt,name=Pkg,proto3" json:"Pkg,omitempty"`                       // 客户端包名
+	UserId         int64  `protobuf:"varint,2,opt,name=UserId,proto3" json:"UserId,omitempty"`                // 用户id
+	Channel        string `protobuf:"bytes,3,opt,name=Channel,proto3" json:"Channel,omitempty"`               // 订阅渠道，为空时查全部渠道
+	SubscriptionNo string `protobuf:"bytes,4,opt,name=SubscriptionNo,proto3" json:"SubscriptionNo,omitempty"` // 订阅标识，为空时查全部订阅
+}
+
+func (x *GetSubscriptionHistoryReq) Reset() {
+	*x = GetSubscriptionHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionHistoryReq) ProtoMessage() {}
+
+func (x *GetSubscriptionHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionHistoryReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetSubscriptionHistoryReq) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *GetSubscriptionHistoryReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSubscriptionHistoryReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *GetSubscriptionHistoryReq) GetSubscriptionNo() string {
+	if x != nil {
+		return x.SubscriptionNo
+	}
+	return ""
+}
+
+type GetSubscriptionHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*SubscriptionChargeInfo `protobuf:"bytes,1,rep,name=List,proto3" json:"List,omitempty"` // 按扣款时间倒序，华为续费不单独落订单
+}
+
+func (x *GetSubscriptionHistoryResp) Reset() {
+	*x = GetSubscriptionHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionHistoryResp) ProtoMessage() {}
+
+func (x *GetSubscriptionHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionHistoryResp.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionHistoryResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetSubscriptionHistoryResp) GetList() []*SubscriptionChargeInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 应用配置 pm_app_config，密钥类字段列表中返回脱敏值，保存时为空的字段不修改
+type AppConfigInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`                        // 配置id，新建时为0
+	AppPkgName     string `protobuf:"bytes,2,opt,name=AppPkgName,proto3" json:"AppPkgName,omitempty"`         // 应用包名
+	AlipayAppId    string `protobuf:"bytes,3,opt,name=AlipayAppId,proto3" json:"AlipayAppId,omitempty"`       // 支付宝appid，需已在pm_pay_config_alipay中启用
+	WechatPayAppId string `protobuf:"bytes,4,opt,name=WechatPayAppId,proto3" json:"WechatPayAppId,omitempty"` // 微信支付appid，需已在pm_pay_config_wechat中启用
+	TiktokPayAppId string `protobuf:"bytes,5,opt,name=TiktokPayAppId,proto3" json:"TiktokPayAppId,omitempty"` // 抖音支付appid，需已在pm_pay_config_tiktok中启用
+	KsPayAppId     string `protobuf:"bytes,6,opt,name=KsPayAppId,proto3" json:"KsPayAppId,omitempty"`         // 快手支付appid，需已在pm_pay_config_ks中启用
+	Status         int32  `protobuf:"varint,7,opt,name=Status,proto3" json:"Status,omitempty"`                // 0停用 1启用，保存时不修改
+	UpdatedAt      string `protobuf:"bytes,8,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`           // 更新时间
+}
+
+func (x *AppConfigInfo) Reset() {
+	*x = AppConfigInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppConfigInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppConfigInfo) ProtoMessage() {}
+
+func (x *AppConfigInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppConfigInfo.ProtoReflect.Descriptor instead.
+func (*AppConfigInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AppConfigInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AppConfigInfo) GetAppPkgName() string {
+	if x != nil {
+		return x.AppPkgName
+	}
+	return ""
+}
+
+func (x *AppConfigInfo) GetAlipayAppId() string {
+	if x != nil {
+		return x.AlipayAppId
+	}
+	return ""
+}
+
+func (x *AppConfigInfo) GetWechatPayAppId() string {
+	if x != nil {
+		return x.WechatPayAppId
+	}
+	return ""
+}
+
+func (x *AppConfigInfo) GetTiktokPayAppId() string {
+	if x != nil {
+		return x.TiktokPayAppId
+	}
+	return ""
+}
+
+func (x *AppConfigInfo) GetKsPayAppId() string {
+	if x != nil {
+		return x.KsPayAppId
+	}
+	return ""
+}
+
+func (x *AppConfigInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AppConfigInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 微信支付商户配置 pm_pay_config_wechat
+type WechatPayConfigInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	AppId          string `protobuf:"bytes,2,opt,name=AppId,proto3" json:"AppId,omitempty"`                   // 应用id
+	MchId          string `protobuf:"bytes,3,opt,name=MchId,proto3" json:"MchId,omitempty"`                   // 商户号
+	ApiKey         string `protobuf:"bytes,4,opt,name=ApiKey,proto3" json:"ApiKey,omitempty"`                 // apiV3密钥
+	ApiKeyV2       string `protobuf:"bytes,5,opt,name=ApiKeyV2,proto3" json:"ApiKeyV2,omitempty"`             // apiV2密钥
+	NotifyUrl      string `protobuf:"bytes,6,opt,name=NotifyUrl,proto3" json:"NotifyUrl,omitempty"`           // 回调地址
+	PrivateKeyPath string `protobuf:"bytes,7,opt,name=PrivateKeyPath,proto3" json:"PrivateKeyPath,omitempty"` // 商户私钥文件路径
+	PublicKeyId    string `protobuf:"bytes,8,opt,name=PublicKeyId,proto3" json:"PublicKeyId,omitempty"`       // 微信支付公钥id
+	PublicKeyPath  string `protobuf:"bytes,9,opt,name=PublicKeyPath,proto3" json:"PublicKeyPath,omitempty"`   // 微信支付公钥文件路径
+	SerialNumber   string `protobuf:"bytes,10,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`    // 商户证书序列号
+	Remark         string `protobuf:"bytes,11,opt,name=Remark,proto3" json:"Remark,omitempty"`                // 备注
+	XPayAppKey     string `protobuf:"bytes,12,opt,name=XPayAppKey,proto3" json:"XPayAppKey,omitempty"`        // 虚拟支付现网AppKey
+	XPayOfferId    string `protobuf:"bytes,13,opt,name=XPayOfferId,proto3" json:"XPayOfferId,omitempty"`      // 虚拟支付OfferID
+	XPayMsgToken   string `protobuf:"bytes,14,opt,name=XPayMsgToken,proto3" json:"XPayMsgToken,omitempty"`    // 小程序消息推送Token
+	PlatformNumer  string `protobuf:"bytes,15,opt,name=PlatformNumer,proto3" json:"PlatformNumer,omitempty"`  // 微信支付平台证书编号
+	WapUrl         string `protobuf:"bytes,16,opt,name=WapUrl,proto3" json:"WapUrl,omitempty"`                // 支付H5域名
+	WapName        string `protobuf:"bytes,17,opt,name=WapName,proto3" json:"WapName,omitempty"`              // 支付名称
+	Status         int32  `protobuf:"varint,18,opt,name=Status,proto3" json:"Status,omitempty"`               // 0停用 1启用
+}
+
+func (x *WechatPayConfigInfo) Reset() {
+	*x = WechatPayConfigInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WechatPayConfigInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WechatPayConfigInfo) ProtoMessage() {}
+
+func (x *WechatPayConfigInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WechatPayConfigInfo.ProtoReflect.Descriptor instead.
+func (*WechatPayConfigInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *WechatPayConfigInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WechatPayConfigInfo) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetMchId() string {
+	if x != nil {
+		return x.MchId
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetApiKeyV2() string {
+	if x != nil {
+		return x.ApiKeyV2
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetNotifyUrl() string {
+	if x != nil {
+		return x.NotifyUrl
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetPrivateKeyPath() string {
+	if x != nil {
+		return x.PrivateKeyPath
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetPublicKeyId() string {
+	if x != nil {
+		return x.PublicKeyId
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetPublicKeyPath() string {
+	if x != nil {
+		return x.PublicKeyPath
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetXPayAppKey() string {
+	if x != nil {
+		return x.XPayAppKey
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetXPayOfferId() string {
+	if x != nil {
+		return x.XPayOfferId
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetXPayMsgToken() string {
+	if x != nil {
+		return x.XPayMsgToken
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetPlatformNumer() string {
+	if x != nil {
+		return x.PlatformNumer
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetWapUrl() string {
+	if x != nil {
+		return x.WapUrl
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetWapName() string {
+	if x != nil {
+		return x.WapName
+	}
+	return ""
+}
+
+func (x *WechatPayConfigInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 支付宝商户配置 pm_pay_config_alipay
+type AlipayPayConfigInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int64  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	AppId            string `protobuf:"bytes,2,opt,name=AppId,proto3" json:"AppId,omitempty"`                       // 应用id
+	PrivateKey       string `protobuf:"bytes,3,opt,name=PrivateKey,proto3" json:"PrivateKey,omitempty"`             // 应用私钥
+	AppCertPublicKey string `protobuf:"bytes,4,opt,name=AppCertPublicKey,proto3" json:"AppCertPublicKey,omitempty"` // 应用公钥证书
+	PublicKey        string `protobuf:"bytes,5,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`               // 支付宝公钥证书
+	PayRootCert      string `protobuf:"bytes,6,opt,name=PayRootCert,proto3" json:"PayRootCert,omitempty"`           // 支付宝根证书
+	IsProduction     int32  `protobuf:"varint,7,opt,name=IsProduction,proto3" json:"IsProduction,omitempty"`        // 是否生产 0否 1是
+	Remark           string `protobuf:"bytes,8,opt,name=Remark,proto3" json:"Remark,omitempty"`                     // 备注
+	NotifyUrl        string `protobuf:"bytes,9,opt,name=NotifyUrl,proto3" json:"NotifyUrl,omitempty"`               // 回调地址
+	MerchantNo       string `protobuf:"bytes,10,opt,name=MerchantNo,proto3" json:"MerchantNo,omitempty"`            // 商户号
+	MerchantName     string `protobuf:"bytes,11,opt,name=MerchantName,proto3" json:"MerchantName,omitempty"`        // 商户名称
+	Status           int32  `protobuf:"varint,12,opt,name=Status,proto3" json:"Status,omitempty"`                   // 0停用 1启用
+}
+
+func (x *AlipayPayConfigInfo) Reset() {
+	*x = AlipayPayConfigInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlipayPayConfigInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlipayPayConfigInfo) ProtoMessage() {}
+
+func (x *AlipayPayConfigInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlipayPayConfigInfo.ProtoReflect.Descriptor instead.
+func (*AlipayPayConfigInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AlipayPayConfigInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlipayPayConfigInfo) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AlipayPayConfigInfo) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *AlipayPayConfigInfo) GetAppCertPublicKey() string {
+	if x != nil {
+		return x.AppCertPublicKey
+	}
+	return ""
+}
+
+func (x *AlipayPayConfigInfo) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *AlipayPayConfigInfo) GetPayRootCert() string {
+	if x != nil {
+		return x.PayRootCert
+	}
+	return ""
+}
+
+func (x *AlipayPayConfigInfo) GetIsProduction() int32 {
+	if x != nil {
+		return x.IsProduction
+	}
+	return 0
+}
+
+func (x *AlipayPayConfigInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *AlipayPayConfigInfo) GetNotifyUrl() string {
+	if x != nil {
+		return x.NotifyUrl
+	}
+	return ""
+}
+
+func (x *AlipayPayConfigInfo) GetMerchantNo() string {
+	if x != nil {
+		return x.MerchantNo
+	}
+	return ""
+}
+
+func (x *AlipayPayConfigInfo) GetMerchantName() string {
+	if x != nil {
+		return x.MerchantName
+	}
+	return ""
+}
+
+func (x *AlipayPayConfigInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 抖音支付商户配置 pm_pay_config_tiktok
+type TiktokPayConfigInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 int64  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	AppId              string `protobuf:"bytes,2,opt,name=AppId,proto3" json:"AppId,omitempty"`                            // 应用id
+	Salt               string `protobuf:"bytes,3,opt,name=Salt,proto3" json:"Salt,omitempty"`                              // 担保支付加密参数
+	NotifyUrl          string `protobuf:"bytes,4,opt,name=NotifyUrl,proto3" json:"NotifyUrl,omitempty"`                    // 回调地址
+	Token              string `protobuf:"bytes,5,opt,name=Token,proto3" json:"Token,omitempty"`                            // 担保支付token
+	Remark             string `protobuf:"bytes,6,opt,name=Remark,proto3" json:"Remark,omitempty"`                          // 备注
+	PrivateKey         string `protobuf:"bytes,7,opt,name=PrivateKey,proto3" json:"PrivateKey,omitempty"`                  // 应用私钥
+	KeyVersion         string `protobuf:"bytes,8,opt,name=KeyVersion,proto3" json:"KeyVersion,omitempty"`                  // 私钥版本号
+	PlatformPublicKey  string `protobuf:"bytes,9,opt,name=PlatformPublicKey,proto3" json:"PlatformPublicKey,omitempty"`    // 平台公钥
+	CustomerImId       string `protobuf:"bytes,10,opt,name=CustomerImId,proto3" json:"CustomerImId,omitempty"`             // 抖音客服id
+	MerchantUid        string `protobuf:"bytes,11,opt,name=MerchantUid,proto3" json:"MerchantUid,omitempty"`               // 收款商户号
+	SignPayMerchantUid string `protobuf:"bytes,12,opt,name=SignPayMerchantUid,proto3" json:"SignPayMerchantUid,omitempty"` // 代扣收款商户号
+	Status             int32  `protobuf:"varint,13,opt,name=Status,proto3" json:"Status,omitempty"`                        // 0停用 1启用
+}
+
+func (x *TiktokPayConfigInfo) Reset() {
+	*x = TiktokPayConfigInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TiktokPayConfigInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TiktokPayConfigInfo) ProtoMessage() {}
+
+func (x *TiktokPayConfigInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TiktokPayConfigInfo.ProtoReflect.Descriptor instead.
+func (*TiktokPayConfigInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TiktokPayConfigInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TiktokPayConfigInfo) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *TiktokPayConfigInfo) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+func (x *TiktokPayConfigInfo) GetNotifyUrl() string {
+	if x != nil {
+		return x.NotifyUrl
+	}
+	return ""
+}
+
+func (x *TiktokPayConfigInfo) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TiktokPayConfigInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *TiktokPayConfigInfo) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *TiktokPayConfigInfo) GetKeyVersion() string {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return ""
+}
+
+func (x *TiktokPayConfigInfo) GetPlatformPublicKey() string {
+	if x != nil {
+		return x.PlatformPublicKey
+	}
+	return ""
+}
+
+func (x *TiktokPayConfigInfo) GetCustomerImId() string {
+	if x != nil {
+		return x.CustomerImId
+	}
+	return ""
+}
+
+func (x *TiktokPayConfigInfo) GetMerchantUid() string {
+	if x != nil {
+		return x.MerchantUid
+	}
+	return ""
+}
+
+func (x *TiktokPayConfigInfo) GetSignPayMerchantUid() string {
+	if x != nil {
+		return x.SignPayMerchantUid
+	}
+	return ""
+}
+
+func (x *TiktokPayConfigInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 快手支付商户配置 pm_pay_config_ks
+type KsPayConfigInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	AppId     string `protobuf:"bytes,2,opt,name=AppId,proto3" json:"AppId,omitempty"`         // 应用id
+	AppSecret string `protobuf:"bytes,3,opt,name=AppSecret,proto3" json:"AppSecret,omitempty"` // 应用secret
+	NotifyUrl string `protobuf:"bytes,4,opt,name=NotifyUrl,proto3" json:"NotifyUrl,omitempty"` // 回调地址
+	Remark    string `protobuf:"bytes,5,opt,name=Remark,proto3" json:"Remark,omitempty"`       // 备注
+	Status    int32  `protobuf:"varint,6,opt,name=Status,proto3" json:"Status,omitempty"`      // 0停用 1启用
+}
+
+func (x *KsPayConfigInfo) Reset() {
+	*x = KsPayConfigInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KsPayConfigInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KsPayConfigInfo) ProtoMessage() {}
+
+func (x *KsPayConfigInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KsPayConfigInfo.ProtoReflect.Descriptor instead.
+func (*KsPayConfigInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *KsPayConfigInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KsPayConfigInfo) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *KsPayConfigInfo) GetAppSecret() string {
+	if x != nil {
+		return x.AppSecret
+	}
+	return ""
+}
+
+func (x *KsPayConfigInfo) GetNotifyUrl() string {
+	if x != nil {
+		return x.NotifyUrl
+	}
+	return ""
+}
+
+func (x *KsPayConfigInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *KsPayConfigInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 包名可用的支付宝账号 app_alipay_app
+type AppAlipayAppInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	AppId      string `protobuf:"bytes,2,opt,name=AppId,proto3" json:"AppId,omitempty"`            // 支付宝appid，需已在pm_pay_config_alipay中启用
+	AppPkg     string `protobuf:"bytes,3,opt,name=AppPkg,proto3" json:"AppPkg,omitempty"`          // 应用包名
+	RelateType int32  `protobuf:"varint,4,opt,name=RelateType,proto3" json:"RelateType,omitempty"` // 关联类型 1备用 2兜底
+	SortNo     int32  `protobuf:"varint,5,opt,name=SortNo,proto3" json:"SortNo,omitempty"`         // 同类型内按升序选用
+	Status     int32  `protobuf:"varint,6,opt,name=Status,proto3" json:"Status,omitempty"`         // 0停用 1启用
+}
+
+func (x *AppAlipayAppInfo) Reset() {
+	*x = AppAlipayAppInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppAlipayAppInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppAlipayAppInfo) ProtoMessage() {}
+
+func (x *AppAlipayAppInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppAlipayAppInfo.ProtoReflect.Descriptor instead.
+func (*AppAlipayAppInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AppAlipayAppInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AppAlipayAppInfo) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AppAlipayAppInfo) GetAppPkg() string {
+	if x != nil {
+		return x.AppPkg
+	}
+	return ""
+}
+
+func (x *AppAlipayAppInfo) GetRelateType() int32 {
+	if x != nil {
+		return x.RelateType
+	}
+	return 0
+}
+
+func (x *AppAlipayAppInfo) GetSortNo() int32 {
+	if x != nil {
+		return x.SortNo
+	}
+	return 0
+}
+
+func (x *AppAlipayAppInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 华为应用配置 huawei_app
+type HuaweiAppInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                int64  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	AppId             string `protobuf:"bytes,2,opt,name=AppId,proto3" json:"AppId,omitempty"`                         // 华为appid
+	AppPkg            string `protobuf:"bytes,3,opt,name=AppPkg,proto3" json:"AppPkg,omitempty"`                       // 应用包名
+	AppSecret         string `protobuf:"bytes,4,opt,name=AppSecret,proto3" json:"AppSecret,omitempty"`                 // 验证公钥
+	ClientId          string `protobuf:"bytes,5,opt,name=ClientId,proto3" json:"ClientId,omitempty"`                   // Client ID
+	ClientSecret      string `protobuf:"bytes,6,opt,name=ClientSecret,proto3" json:"ClientSecret,omitempty"`           // Client Secret
+	Sha256Fingerprint string `protobuf:"bytes,7,opt,name=Sha256Fingerprint,proto3" json:"Sha256Fingerprint,omitempty"` // SHA256证书指纹
+	IapPublicKey      string `protobuf:"bytes,8,opt,name=IapPublicKey,proto3" json:"IapPublicKey,omitempty"`           // 应用内支付公钥
+	Status            int32  `protobuf:"varint,9,opt,name=Status,proto3" json:"Status,omitempty"`                      // 0停用 1启用
+}
+
+func (x *HuaweiAppInfo) Reset() {
+	*x = HuaweiAppInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HuaweiAppInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HuaweiAppInfo) ProtoMessage() {}
+
+func (x *HuaweiAppInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HuaweiAppInfo.ProtoReflect.Descriptor instead.
+func (*HuaweiAppInfo) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *HuaweiAppInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HuaweiAppInfo) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *HuaweiAppInfo) GetAppPkg() string {
+	if x != nil {
+		return x.AppPkg
+	}
+	return ""
+}
+
+func (x *HuaweiAppInfo) GetAppSecret() string {
+	if x != nil {
+		return x.AppSecret
+	}
+	return ""
+}
+
+func (x *HuaweiAppInfo) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *HuaweiAppInfo) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *HuaweiAppInfo) GetSha256Fingerprint() string {
+	if x != nil {
+		return x.Sha256Fingerprint
+	}
+	return ""
+}
+
+func (x *HuaweiAppInfo) GetIapPublicKey() string {
+	if x != nil {
+		return x.IapPublicKey
+	}
+	return ""
+}
+
+func (x *HuaweiAppInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type SaveConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Table        string               `protobuf:"bytes,1,opt,name=Table,proto3" json:"Table,omitempty"`         // 配置表 pm_app_config|pm_pay_config_wechat|pm_pay_config_alipay|pm_pay_config_tiktok|pm_pay_config_ks|app_alipay_app|huawei_app
+	Operator     string               `protobuf:"bytes,2,opt,name=Operator,proto3" json:"Operator,omitempty"`   // 已废弃，操作人取认证通过的管理员
+	AppConfig    *AppConfigInfo       `protobuf:"bytes,3,opt,name=AppConfig,proto3" json:"AppConfig,omitempty"` // 按Table传对应的配置，Id为0时新建
+	Wechat       *WechatPayConfigInfo `protobuf:"bytes,4,opt,name=Wechat,proto3" json:"Wechat,omitempty"`
+	Alipay       *AlipayPayConfigInfo `protobuf:"bytes,5,opt,name=Alipay,proto3" json:"Alipay,omitempty"`
+	Tiktok       *TiktokPayConfigInfo `protobuf:"bytes,6,opt,name=Tiktok,proto3" json:"Tiktok,omitempty"`
+	Ks           *KsPayConfigInfo     `protobuf:"bytes,7,opt,name=Ks,proto3" json:"Ks,omitempty"`
+	AppAlipayApp *AppAlipayAppInfo    `protobuf:"bytes,8,opt,name=AppAlipayApp,proto3" json:"AppAlipayApp,omitempty"`
+	HuaweiApp    *HuaweiAppInfo       `protobuf:"bytes,9,opt,name=HuaweiApp,proto3" json:"HuaweiApp,omitempty"`
+}
+
+func (x *SaveConfigReq) Reset() {
+	*x = SaveConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConfigReq) ProtoMessage() {}
+
+func (x *SaveConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConfigReq.ProtoReflect.Descriptor instead.
+func (*SaveConfigReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SaveConfigReq) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *SaveConfigReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *SaveConfigReq) GetAppConfig() *AppConfigInfo {
+	if x != nil {
+		return x.AppConfig
+	}
+	return nil
+}
+
+func (x *SaveConfigReq) GetWechat() *WechatPayConfigInfo {
+	if x != nil {
+		return x.Wechat
+	}
+	return nil
+}
+
+func (x *SaveConfigReq) GetAlipay() *AlipayPayConfigInfo {
+	if x != nil {
+		return x.Alipay
+	}
+	return nil
+}
+
+func (x *SaveConfigReq) GetTiktok() *TiktokPayConfigInfo {
+	if x != nil {
+		return x.Tiktok
+	}
+	return nil
+}
+
+func (x *SaveConfigReq) GetKs() *KsPayConfigInfo {
+	if x != nil {
+		return x.Ks
+	}
+	return nil
+}
+
+func (x *SaveConfigReq) GetAppAlipayApp() *AppAlipayAppInfo {
+	if x != nil {
+		return x.AppAlipayApp
+	}
+	return nil
+}
+
+func (x *SaveConfigReq) GetHuaweiApp() *HuaweiAppInfo {
+	if x != nil {
+		return x.HuaweiApp
+	}
+	return nil
+}
+
+type SaveConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"` // 0成功 1失败
+	Msg  string `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`    // 失败原因
+	Id   int64  `protobuf:"varint,3,opt,name=Id,proto3" json:"Id,omitempty"`     // 配置id
+}
+
+func (x *SaveConfigResp) Reset() {
+	*x = SaveConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveConfigResp) ProtoMessage() {}
+
+func (x *SaveConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveConfigResp.ProtoReflect.Descriptor instead.
+func (*SaveConfigResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SaveConfigResp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SaveConfigResp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SaveConfigResp) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Table    string `protobuf:"bytes,1,opt,name=Table,proto3" json:"Table,omitempty"`        // 配置表
+	AppPkg   string `protobuf:"bytes,2,opt,name=AppPkg,proto3" json:"AppPkg,omitempty"`      // 按包名筛选，商户配置表不支持
+	AppId    string `protobuf:"bytes,3,opt,name=AppId,proto3" json:"AppId,omitempty"`        // 按appid筛选，pm_app_config不支持
+	Page     int32  `protobuf:"varint,4,opt,name=Page,proto3" json:"Page,omitempty"`         // 页码，从1开始
+	PageSize int32  `protobuf:"varint,5,opt,name=PageSize,proto3" json:"PageSize,omitempty"` // 每页条数，默认20，最大100
+}
+
+func (x *ListConfigReq) Reset() {
+	*x = ListConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigReq) ProtoMessage() {}
+
+func (x *ListConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigReq.ProtoReflect.Descriptor instead.
+func (*ListConfigReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListConfigReq) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ListConfigReq) GetAppPkg() string {
+	if x != nil {
+		return x.AppPkg
+	}
+	return ""
+}
+
+func (x *ListConfigReq) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ListConfigReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListConfigReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total         int64                  `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`          // 总数
+	AppConfigs    []*AppConfigInfo       `protobuf:"bytes,2,rep,name=AppConfigs,proto3" json:"AppConfigs,omitempty"` // 按Table返回对应的列表，密钥类字段脱敏
+	Wechat        []*WechatPayConfigInfo `protobuf:"bytes,3,rep,name=Wechat,proto3" json:"Wechat,omitempty"`
+	Alipay        []*AlipayPayConfigInfo `protobuf:"bytes,4,rep,name=Alipay,proto3" json:"Alipay,omitempty"`
+	Tiktok        []*TiktokPayConfigInfo `protobuf:"bytes,5,rep,name=Tiktok,proto3" json:"Tiktok,omitempty"`
+	Ks            []*KsPayConfigInfo     `protobuf:"bytes,6,rep,name=Ks,proto3" json:"Ks,omitempty"`
+	AppAlipayApps []*AppAlipayAppInfo    `protobuf:"bytes,7,rep,name=AppAlipayApps,proto3" json:"AppAlipayApps,omitempty"`
+	HuaweiApps    []*HuaweiAppInfo       `protobuf:"bytes,8,rep,name=HuaweiApps,proto3" json:"HuaweiApps,omitempty"`
+}
+
+func (x *ListConfigResp) Reset() {
+	*x = ListConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigResp) ProtoMessage() {}
+
+func (x *ListConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigResp.ProtoReflect.Descriptor instead.
+func (*ListConfigResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListConfigResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListConfigResp) GetAppConfigs() []*AppConfigInfo {
+	if x != nil {
+		return x.AppConfigs
+	}
+	return nil
+}
+
+func (x *ListConfigResp) GetWechat() []*WechatPayConfigInfo {
+	if x != nil {
+		return x.Wechat
+	}
+	return nil
+}
+
+func (x *ListConfigResp) GetAlipay() []*AlipayPayConfigInfo {
+	if x != nil {
+		return x.Alipay
+	}
+	return nil
+}
+
+func (x *ListConfigResp) GetTiktok() []*TiktokPayConfigInfo {
+	if x != nil {
+		return x.Tiktok
+	}
+	return nil
+}
+
+func (x *ListConfigResp) GetKs() []*KsPayConfigInfo {
+	if x != nil {
+		return x.Ks
+	}
+	return nil
+}
+
+func (x *ListConfigResp) GetAppAlipayApps() []*AppAlipayAppInfo {
+	if x != nil {
+		return x.AppAlipayApps
+	}
+	return nil
+}
+
+func (x *ListConfigResp) GetHuaweiApps() []*HuaweiAppInfo {
+	if x != nil {
+		return x.HuaweiApps
+	}
+	return nil
+}
+
+type SetConfigStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Table    string `protobuf:"bytes,1,opt,name=Table,proto3" json:"Table,omitempty"`       // 配置表
+	Id       int64  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`            // 配置id
+	Status   int32  `protobuf:"varint,3,opt,name=Status,proto3" json:"Status,omitempty"`    // 0停用 1启用
+	Operator string `protobuf:"bytes,4,opt,name=Operator,proto3" json:"Operator,omitempty"` // 已废弃，操作人取认证通过的管理员
+	Remark   string `protobuf:"bytes,5,opt,name=Remark,proto3" json:"Remark,omitempty"`     // 备注
+}
+
+func (x *SetConfigStatusReq) Reset() {
+	*x = SetConfigStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConfigStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigStatusReq) ProtoMessage() {}
+
+func (x *SetConfigStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigStatusReq.ProtoReflect.Descriptor instead.
+func (*SetConfigStatusReq) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SetConfigStatusReq) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *SetConfigStatusReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetConfigStatusReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SetConfigStatusReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *SetConfigStatusReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type SetConfigStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32  `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"` // 0成功 1失败
+	Msg  string `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`    // 失败原因
+}
+
+func (x *SetConfigStatusResp) Reset() {
+	*x = SetConfigStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConfigStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigStatusResp) ProtoMessage() {}
+
+func (x *SetConfigStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigStatusResp.ProtoReflect.Descriptor instead.
+func (*SetConfigStatusResp) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetConfigStatusResp) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetConfigStatusResp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+var File_payment_proto protoreflect.FileDescriptor
+
+var file_payment_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xec, 0x06, 0x0a, 0x0b, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50,
+	0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70,
+	0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08,
+	0x57, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x57, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x54, 0x0a, 0x15, 0x64, 0x6f, 0x75, 0x79,
+	0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x52, 0x15, 0x64, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2d,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x28, 0x0a,
+	0x0f, 0x49, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x49, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x6e, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x49, 0x6e,
+	0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x6e,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x12,
+	0x32, 0x0a, 0x14, 0x46, 0x69, 0x72, 0x73, 0x74, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x46,
+	0x69, 0x72, 0x73, 0x74, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x55,
+	0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61,
+	0x6e, 0x74, 0x55, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x49, 0x73, 0x42, 0x61, 0x73, 0x65, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x49, 0x73, 0x42, 0x61, 0x73, 0x65, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a,
+	0x12, 0x45, 0x78, 0x69, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4e, 0x6f, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x4e, 0x74, 0x68, 0x4e, 0x75, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4e,
+	0x74, 0x68, 0x4e, 0x75, 0x6d, 0x12, 0x30, 0x0a, 0x09, 0x77, 0x78, 0x58, 0x50, 0x61, 0x79, 0x52,
+	0x65, 0x71, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x57, 0x78, 0x58, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x52, 0x09, 0x77, 0x78,
+	0x58, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x72, 0x69,
+	0x61, 0x6c, 0x44, 0x61, 0x79, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x54, 0x72,
+	0x69, 0x61, 0x6c, 0x44, 0x61, 0x79, 0x73, 0x22, 0xdb, 0x04, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x6e, 0x12, 0x2a, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x57, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x57, 0x61, 0x70, 0x12, 0x35, 0x0a, 0x08,
+	0x57, 0x78, 0x55, 0x6e, 0x69, 0x41, 0x70, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x41, 0x70,
+	0x70, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x08, 0x57, 0x78, 0x55, 0x6e, 0x69,
+	0x41, 0x70, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x54, 0x69, 0x6b, 0x54, 0x6f, 0x6b, 0x45, 0x63, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x54, 0x69, 0x6b, 0x74, 0x6f, 0x6b, 0x45, 0x63, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x52, 0x08, 0x54, 0x69, 0x6b, 0x54, 0x6f, 0x6b, 0x45, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c,
+	0x69, 0x70, 0x61, 0x79, 0x57, 0x65, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41,
+	0x6c, 0x69, 0x70, 0x61, 0x79, 0x57, 0x65, 0x62, 0x12, 0x35, 0x0a, 0x08, 0x57, 0x78, 0x4e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x78, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x08, 0x57, 0x78, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12,
+	0x32, 0x0a, 0x08, 0x4b, 0x73, 0x55, 0x6e, 0x69, 0x41, 0x70, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4b, 0x73, 0x55, 0x6e,
+	0x69, 0x41, 0x70, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x08, 0x4b, 0x73, 0x55, 0x6e, 0x69,
+	0x41, 0x70, 0x70, 0x12, 0x38, 0x0a, 0x09, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x52, 0x09, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x50, 0x0a,
+	0x12, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x12, 0x44, 0x6f, 0x75,
+	0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x2c, 0x0a, 0x06, 0x57, 0x78, 0x58, 0x50, 0x61, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x78, 0x58, 0x50, 0x61, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x06, 0x57, 0x78, 0x58, 0x50, 0x61, 0x79, 0x12, 0x36, 0x0a,
+	0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x46,
+	0x69, 0x72, 0x73, 0x74, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x46, 0x69, 0x72, 0x73, 0x74, 0x44, 0x65, 0x64, 0x75, 0x63,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x69, 0x72, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x46, 0x69, 0x72, 0x73, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x44, 0x61, 0x79, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x44, 0x61, 0x79,
+	0x73, 0x22, 0xd4, 0x01, 0x0a, 0x10, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x41, 0x70, 0x70, 0x50, 0x61,
+	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x69, 0x67, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x61, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x22, 0x4c, 0x0a, 0x10, 0x57, 0x78, 0x4e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43,
+	0x6f, 0x64, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x61,
+	0x73, 0x65, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x64, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0xcd, 0x01, 0x0a, 0x11, 0x57, 0x78, 0x55, 0x6e, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x77, 0x65, 0x62,
+	0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x77, 0x65, 0x62, 0x55,
+	0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x4e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x57, 0x61, 0x70, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x57, 0x61, 0x70, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x57, 0x61,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x57, 0x61, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x22, 0x45, 0x0a, 0x0d, 0x57, 0x78, 0x48, 0x35, 0x50, 0x61,
+	0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x48, 0x35, 0x55, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x48, 0x35, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x22, 0x4c, 0x0a,
+	0x10, 0x54, 0x69, 0x6b, 0x74, 0x6f, 0x6b, 0x45, 0x63, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x51, 0x0a, 0x0d, 0x4b,
+	0x73, 0x55, 0x6e, 0x69, 0x41, 0x70, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x97,
+	0x01, 0x0a, 0x09, 0x57, 0x78, 0x58, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x4d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x42, 0x75, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x42, 0x75, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x57, 0x78, 0x58,
+	0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x53, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x61, 0x79, 0x53,
+	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x61, 0x79, 0x53, 0x69, 0x67,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x22, 0x78, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x50, 0x61, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x2a, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x76, 0x0a, 0x0e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x2a, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x50, 0x61, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x79,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x61,
+	0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x68, 0x69, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x54, 0x68, 0x69, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0xcb, 0x02,
+	0x0a, 0x1d, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x55, 0x6e, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x30, 0x0a,
+	0x09, 0x70, 0x61, 0x79, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x65, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70, 0x61, 0x79, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x42, 0x69, 0x7a, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x42, 0x69, 0x7a, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x42,
+	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x41,
+	0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x09, 0x50,
+	0x61, 0x79, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xaf, 0x02, 0x0a,
+	0x13, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x6c, 0x69, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x6c, 0x69, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x46, 0x75, 0x6e,
+	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x50, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x46, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x46, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18,
+	0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x42, 0x69, 0x6c, 0x6c, 0x4e, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x69, 0x6c, 0x6c, 0x4e, 0x6f, 0x22, 0x53,
+	0x0a, 0x17, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x18, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75,
+	0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x09,
+	0x70, 0x61, 0x79, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x65, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70, 0x61, 0x79, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0xaa, 0x02, 0x0a, 0x17, 0x41, 0x6c, 0x69, 0x70, 0x61,
+	0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x12, 0x1e, 0x0a, 0x0a,
+	0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x42, 0x69, 0x7a, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x42, 0x69, 0x7a, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x42, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x37, 0x0a, 0x05, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x18, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75,
+	0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61,
+	0x69, 0x6c, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x46, 0x61, 0x69,
+	0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x30, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69,
+	0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5d, 0x0a, 0x1d, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x66, 0x6f, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x49, 0x6e, 0x66, 0x6f, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xd7, 0x02, 0x0a, 0x15, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74,
+	0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70,
+	0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41,
+	0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53,
+	0x63, 0x65, 0x6e, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x2e, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63,
+	0x76, 0x50, 0x65, 0x72, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x76, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22,
+	0x7d, 0x0a, 0x15, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50,
+	0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x44,
+	0x0a, 0x16, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x44, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x44, 0x65, 0x73, 0x63, 0x22, 0xce, 0x01, 0x0a, 0x10, 0x44, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70,
+	0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41,
+	0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f,
+	0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x75, 0x74,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x4f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x55, 0x72, 0x6c, 0x22, 0x43, 0x0a, 0x11, 0x44, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x45, 0x72, 0x72, 0x4e, 0x6f,
+	0x12, 0x18, 0x0a, 0x07, 0x45, 0x72, 0x72, 0x54, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x45, 0x72, 0x72, 0x54, 0x69, 0x70, 0x73, 0x22, 0xf9, 0x03, 0x0a, 0x11, 0x41,
+	0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x4c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x4c,
+	0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x73, 0x63, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65,
+	0x73, 0x63, 0x12, 0x28, 0x0a, 0x0f, 0x42, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x42, 0x65, 0x6c,
+	0x6f, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x70, 0x12, 0x20, 0x0a, 0x0b,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x73,
+	0x48, 0x77, 0x50, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x49, 0x73, 0x48, 0x77, 0x50, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x75, 0x72, 0x63,
+	0x68, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x53,
+	0x74, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x64, 0x53, 0x74, 0x72, 0x22, 0xb2, 0x01, 0x0a, 0x0e, 0x41, 0x6c, 0x69, 0x70, 0x61,
+	0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f,
+	0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x41,
+	0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x53,
+	0x69, 0x67, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x52,
+	0x4c, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x55, 0x0a, 0x13, 0x41,
+	0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x55, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x4e, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x1e, 0x0a, 0x0a, 0x4f,
+	0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x30, 0x0a, 0x13, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x12, 0x36, 0x0a,
+	0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x3e, 0x0a, 0x10, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x44, 0x65, 0x73, 0x63, 0x22, 0xf9, 0x01, 0x0a, 0x0f, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70,
+	0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41,
+	0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f,
+	0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x4e, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x65, 0x0a, 0x11, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x22, 0x52, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x10,
+	0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x65, 0x73, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x65, 0x73, 0x63, 0x22, 0x85, 0x01, 0x0a,
+	0x0d, 0x41, 0x6c, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x46, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x46, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x44, 0x65, 0x73, 0x63, 0x12, 0x2a, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x4e, 0x6f, 0x22, 0xa6, 0x01, 0x0a, 0x18, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x41,
+	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e,
+	0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x44, 0x65, 0x64,
+	0x75, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x22, 0x94, 0x01,
+	0x0a, 0x14, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46,
+	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46,
+	0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbb, 0x05, 0x0a, 0x15, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x6b, 0x75, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x6b, 0x75, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x53, 0x6b, 0x75, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x0b, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x3b, 0x0a, 0x10, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x50,
+	0x61, 0x79, 0x57, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x0f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x79, 0x57, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x49, 0x0a, 0x0a, 0x69, 0x6f, 0x73, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44,
+	0x6f, 0x75, 0x79, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x2e, 0x49, 0x6f, 0x73, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x69, 0x6f, 0x73, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x61, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x61, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x6b, 0x75, 0x41, 0x74, 0x74, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6b,
+	0x75, 0x41, 0x74, 0x74, 0x72, 0x22, 0x7d, 0x0a, 0x07, 0x53, 0x6b, 0x75, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x14, 0x0a,
+	0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x10, 0x91, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x56,
+	0x69, 0x70, 0x10, 0x92, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x43, 0x6f, 0x69, 0x6e, 0x10, 0x93, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x55, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x10, 0x94, 0x03, 0x12, 0x14,
+	0x0a, 0x0f, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x75, 0x6c, 0x6c, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x10, 0x95, 0x03, 0x22, 0x4c, 0x0a, 0x0a, 0x49, 0x6f, 0x73, 0x50, 0x61, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x6f, 0x73, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x6f, 0x73,
+	0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6d, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x49,
+	0x6f, 0x73, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x44, 0x69, 0x61, 0x6d, 0x6f, 0x6e, 0x64,
+	0x10, 0x02, 0x22, 0x34, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x7f, 0x0a, 0x17, 0x44, 0x6f, 0x75, 0x79,
+	0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x11, 0x62, 0x79, 0x74, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x62, 0x79, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x6d, 0x49, 0x64, 0x22, 0x8a, 0x03, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4e, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x3b, 0x0a, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x0f,
+	0x69, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x66, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x6e,
+	0x0a, 0x14, 0x42, 0x69, 0x6e, 0x64, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x50, 0x61, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1e,
+	0x0a, 0x0a, 0x48, 0x77, 0x70, 0x61, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x48, 0x77, 0x70, 0x61, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x76,
+	0x0a, 0x14, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61,
+	0x77, 0x65, 0x69, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x6b, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x50, 0x6b, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x0d, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3d, 0x0a, 0x15, 0x42, 0x69, 0x6e, 0x64, 0x48, 0x75,
+	0x61, 0x77, 0x65, 0x69, 0x50, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x3d, 0x0a, 0x15, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x4d, 0x73, 0x67, 0x22, 0x9a, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x48, 0x75,
+	0x61, 0x77, 0x65, 0x69, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x50, 0x6b, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x44, 0x61, 0x79, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x44, 0x61, 0x79,
+	0x73, 0x22, 0x71, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x0a, 0x11, 0x4e, 0x65, 0x77, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x4e, 0x65, 0x77, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x87, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x48, 0x75, 0x61, 0x77,
+	0x65, 0x69, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x49,
+	0x73, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x53, 0x75,
+	0x62, 0x49, 0x73, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x6f,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2c, 0x0a, 0x11, 0x49, 0x6e, 0x61, 0x70, 0x70, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x49, 0x6e, 0x61,
+	0x70, 0x70, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0xab,
+	0x01, 0x0a, 0x14, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x3b, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x6b, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x50, 0x6b, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x11, 0x50, 0x6d, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x50, 0x6d, 0x44, 0x79, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xfb, 0x01, 0x0a,
+	0x11, 0x44, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x70,
+	0x70, 0x50, 0x6b, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11,
+	0x4e, 0x65, 0x78, 0x74, 0x44, 0x65, 0x63, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x4e, 0x65, 0x78, 0x74, 0x44, 0x65, 0x63,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x79,
+	0x53, 0x69, 0x67, 0x6e, 0x4e, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x44, 0x79,
+	0x53, 0x69, 0x67, 0x6e, 0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74,
+	0x55, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d, 0x65, 0x72, 0x63, 0x68,
+	0x61, 0x6e, 0x74, 0x55, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6b, 0x49, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x6b, 0x49, 0x64, 0x22, 0xb7, 0x02, 0x0a, 0x15, 0x44,
+	0x6f, 0x75, 0x79, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x49, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x49, 0x73,
+	0x53, 0x69, 0x67, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x49, 0x73, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x49,
+	0x73, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x4e, 0x65, 0x78, 0x74,
+	0x44, 0x65, 0x63, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x4e, 0x65, 0x78, 0x74, 0x44, 0x65, 0x63, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x3a, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0xa5, 0x02, 0x0a, 0x13, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d,
+	0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x20, 0x0a, 0x0b,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x12, 0x22,
+	0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c,
+	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x22, 0x32, 0x0a, 0x14,
+	0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64,
+	0x22, 0x5c, 0x0a, 0x18, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x22, 0xb3,
+	0x01, 0x0a, 0x19, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xe1, 0x02, 0x0a, 0x17, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d,
+	0x69, 0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x4e, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x36, 0x0a, 0x18, 0x57, 0x65, 0x63, 0x68,
+	0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64,
+	0x22, 0xb3, 0x01, 0x0a, 0x1b, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58,
+	0x50, 0x61, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f,
+	0x12, 0x1c, 0x0a, 0x09, 0x77, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0xb8, 0x03, 0x0a, 0x1c, 0x57, 0x65, 0x63, 0x68, 0x61,
+	0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x78, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x78, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x69, 0x7a, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x62, 0x69, 0x7a, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x46, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x46, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x46, 0x65, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x64, 0x46, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x70, 0x61, 0x69, 0x64, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x69, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x66, 0x74, 0x46, 0x65, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x65, 0x66, 0x74, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x77, 0x78, 0x50, 0x61, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x78, 0x50, 0x61, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x44, 0x0a, 0x10, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x22, 0x69, 0x0a, 0x11, 0x44, 0x79, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x4e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x4e, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xde, 0x02, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41,
+	0x70, 0x70, 0x50, 0x6b, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x4e,
+	0x65, 0x78, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x50, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x50, 0x6b, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x6e, 0x6c, 0x79,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x4f, 0x6e,
+	0x6c, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x4d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x50, 0x6b, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x22, 0x3e, 0x0a,
+	0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0xca, 0x01,
+	0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75,
+	0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x6b, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x50, 0x6b, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x22, 0x51, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x33, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x87, 0x02, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70,
+	0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41,
+	0x70, 0x70, 0x50, 0x6b, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x6c, 0x69,
+	0x70, 0x61, 0x79, 0x41, 0x70, 0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x57,
+	0x65, 0x63, 0x68, 0x61, 0x74, 0x50, 0x61, 0x79, 0x41, 0x70, 0x70, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x50, 0x61, 0x79, 0x41, 0x70,
+	0x70, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x69, 0x6b, 0x74, 0x6f, 0x6b, 0x50, 0x61, 0x79,
+	0x41, 0x70, 0x70, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54, 0x69, 0x6b,
+	0x74, 0x6f, 0x6b, 0x50, 0x61, 0x79, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4b,
+	0x73, 0x50, 0x61, 0x79, 0x41, 0x70, 0x70, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x4b, 0x73, 0x50, 0x61, 0x79, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xa5, 0x04, 0x0a, 0x13, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x50, 0x61, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x70, 0x70,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x4d, 0x63, 0x68, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x4d, 0x63, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x56, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x56, 0x32, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x20, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x52,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x58, 0x50, 0x61, 0x79, 0x41, 0x70, 0x70, 0x4b, 0x65,
+	0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x58, 0x50, 0x61, 0x79, 0x41, 0x70, 0x70,
+	0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x58, 0x50, 0x61, 0x79, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x58, 0x50, 0x61, 0x79, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x58, 0x50, 0x61, 0x79, 0x4d, 0x73, 0x67,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x58, 0x50, 0x61,
+	0x79, 0x4d, 0x73, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x57, 0x61, 0x70, 0x55, 0x72, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x57, 0x61, 0x70, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x57, 0x61, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x57, 0x61, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xfd, 0x02, 0x0a, 0x13, 0x41, 0x6c,
+	0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x43, 0x65,
+	0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x41, 0x70, 0x70, 0x43, 0x65, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x61, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x43,
+	0x65, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x49, 0x73, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12,
+	0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x4e, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x4e, 0x6f, 0x12, 0x22, 0x0a,
+	0x0c, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x97, 0x03, 0x0a, 0x13, 0x54, 0x69,
+	0x6b, 0x74, 0x6f, 0x6b, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4b, 0x65, 0x79,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x6d, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x65, 0x72,
+	0x63, 0x68, 0x61, 0x6e, 0x74, 0x55, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x55, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x53,
+	0x69, 0x67, 0x6e, 0x50, 0x61, 0x79, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x55, 0x69,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x61, 0x79,
+	0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x55, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x0f, 0x4b, 0x73, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x41, 0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x41, 0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x41, 0x70,
+	0x70, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41,
+	0x70, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x12, 0x1e, 0x0a, 0x0a,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x53, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x6f,
+	0x72, 0x74, 0x4e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x95, 0x02, 0x0a,
+	0x0d, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41,
+	0x70, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x12, 0x1c, 0x0a, 0x09,
+	0x41, 0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x41, 0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x53, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x46, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x61, 0x70, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x49, 0x61, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xb8, 0x03, 0x0a, 0x0d, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34,
+	0x0a, 0x06, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x50,
+	0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x57, 0x65,
+	0x63, 0x68, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x54, 0x69,
+	0x6b, 0x74, 0x6f, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x69, 0x6b, 0x74, 0x6f, 0x6b, 0x50, 0x61, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x54, 0x69, 0x6b, 0x74, 0x6f, 0x6b,
+	0x12, 0x28, 0x0a, 0x02, 0x4b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4b, 0x73, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x02, 0x4b, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x41, 0x70,
+	0x70, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x70, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x41, 0x6c,
+	0x69, 0x70, 0x61, 0x79, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x41, 0x70, 0x70,
+	0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x70, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x48, 0x75, 0x61,
+	0x77, 0x65, 0x69, 0x41, 0x70, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x41, 0x70, 0x70,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x41, 0x70, 0x70, 0x22,
+	0x46, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x41, 0x70, 0x70, 0x50, 0x6b, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x70, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x50, 0x61, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xa3, 0x03,
+	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x34,
+	0x0a, 0x06, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x50,
+	0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x57, 0x65,
+	0x63, 0x68, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x54, 0x69,
+	0x6b, 0x74, 0x6f, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x69, 0x6b, 0x74, 0x6f, 0x6b, 0x50, 0x61, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x54, 0x69, 0x6b, 0x74, 0x6f, 0x6b,
+	0x12, 0x28, 0x0a, 0x02, 0x4b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4b, 0x73, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x02, 0x4b, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x41, 0x70,
+	0x70, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x70, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x41,
+	0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x41, 0x70,
+	0x70, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x70, 0x70, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x48,
+	0x75, 0x61, 0x77, 0x65, 0x69, 0x41, 0x70, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69,
+	0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x41,
+	0x70, 0x70, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x3b, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x2a, 0x22, 0x0a, 0x08, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x44, 0x59, 0x44, 0x49, 0x41, 0x4d, 0x4f, 0x4e, 0x44, 0x10, 0x01, 0x2a, 0x9b, 0x01,
+	0x0a, 0x07, 0x50, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x6c, 0x69,
+	0x70, 0x61, 0x79, 0x57, 0x61, 0x70, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x78, 0x55, 0x6e,
+	0x69, 0x41, 0x70, 0x70, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x69, 0x6b, 0x74, 0x6f, 0x6b,
+	0x45, 0x63, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x57, 0x65,
+	0x62, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x78, 0x57, 0x65, 0x62, 0x10, 0x04, 0x12, 0x0c,
+	0x0a, 0x08, 0x4b, 0x73, 0x55, 0x6e, 0x69, 0x41, 0x70, 0x70, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09,
+	0x57, 0x78, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x57,
+	0x78, 0x56, 0x33, 0x48, 0x35, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x6f, 0x75, 0x79, 0x69,
+	0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x10, 0x08, 0x12,
+	0x0a, 0x0a, 0x06, 0x57, 0x78, 0x58, 0x50, 0x61, 0x79, 0x10, 0x09, 0x2a, 0x8f, 0x01, 0x0a, 0x1a,
+	0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x79,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0x01, 0x12, 0x1c, 0x0a,
+	0x18, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x44,
+	0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x03, 0x32, 0xb5, 0x1a,
+	0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x50, 0x61, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x62,
+	0x0a, 0x1a, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x55, 0x6e, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e,
+	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x55, 0x6e, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x56, 0x0a, 0x14, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e,
+	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x14, 0x41, 0x6c,
+	0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69,
+	0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x12, 0x57, 0x65, 0x63, 0x68, 0x61,
+	0x74, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1e, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x46, 0x75,
+	0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x46, 0x75,
+	0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0b, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a,
+	0x12, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c,
+	0x69, 0x70, 0x61, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c,
+	0x69, 0x70, 0x61, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x0d, 0x44, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x44, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x1a, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x79, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x43, 0x0a, 0x0b, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69,
+	0x70, 0x61, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x14, 0x41, 0x6c, 0x69, 0x70,
+	0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x50, 0x61, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e,
+	0x12, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61,
+	0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x0b, 0x41, 0x6c, 0x69,
+	0x70, 0x61, 0x79, 0x48, 0x35, 0x50, 0x61, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5c, 0x0a, 0x21, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x50,
+	0x61, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69,
+	0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x4b, 0x0a, 0x10, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x55, 0x6e, 0x53,
+	0x69, 0x67, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c,
+	0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x55, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70,
+	0x61, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x12,
+	0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x12, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69,
+	0x70, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0c, 0x41, 0x6c, 0x69, 0x70, 0x61,
+	0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0e, 0x41, 0x6c, 0x69,
+	0x70, 0x61, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x55, 0x0a, 0x15, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x21, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x19,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x12, 0x57, 0x65, 0x63,
+	0x68, 0x61, 0x74, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79,
+	0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x78, 0x55, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50,
+	0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4d, 0x0a, 0x11, 0x57, 0x65, 0x63, 0x68, 0x61,
+	0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x10, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74,
+	0x50, 0x61, 0x79, 0x48, 0x35, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x70, 0x61, 0x79, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x57, 0x78, 0x48, 0x35, 0x50, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x52,
+	0x0a, 0x11, 0x42, 0x69, 0x6e, 0x64, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x50, 0x61, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x69,
+	0x6e, 0x64, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x50, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x69, 0x6e,
+	0x64, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x50, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x52, 0x0a, 0x11, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61,
+	0x77, 0x65, 0x69, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77,
+	0x65, 0x69, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x52, 0x65, 0x71,
+	0x1a, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x64, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x48, 0x75, 0x61, 0x77, 0x65,
+	0x69, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x24, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x1b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x46, 0x65, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65,
+	0x69, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x1c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69,
+	0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x5a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x75, 0x61, 0x77, 0x65, 0x69, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x52, 0x0a, 0x11, 0x44, 0x6f, 0x75, 0x79, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44,
+	0x6f, 0x75, 0x79, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x6f,
+	0x75, 0x79, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x10, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e,
+	0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x15, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69,
+	0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x21, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69,
+	0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x22, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61,
+	0x74, 0x4d, 0x69, 0x6e, 0x69, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x14, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69,
+	0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e,
+	0x69, 0x58, 0x50, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x21,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d,
+	0x69, 0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x67, 0x0a, 0x18, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58,
+	0x50, 0x61, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x69,
+	0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x65,
+	0x63, 0x68, 0x61, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x58, 0x50, 0x61, 0x79, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x0d, 0x44, 0x79,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x44, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x55, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x22, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x0a, 0x53, 0x61, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a,
+	0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x17,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_payment_proto_rawDescOnce sync.Once
+	file_payment_proto_rawDescData = file_payment_proto_rawDesc
+)
+
+func file_payment_proto_rawDescGZIP() []byte {
+	file_payment_proto_rawDescOnce.Do(func() {
+		file_payment_proto_rawDescData = protoimpl.X.CompressGZIP(file_payment_proto_rawDescData)
+	})
+	return file_payment_proto_rawDescData
+}
+
+var file_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
+var file_payment_proto_goTypes = []interface{}{
+	(Currency)(0),                         // 0: payment.Currency
+	(PayType)(0),                          // 1: payment.PayType
+	(DouyinPeriodOrderReqAction)(0),       // 2: payment.DouyinPeriodOrderReqAction
+	(DouyinGeneralTradeReq_SkuType)(0),    // 3: payment.DouyinGeneralTradeReq.SkuType
+	(DouyinGeneralTradeReq_IosPayType)(0), // 4: payment.DouyinGeneralTradeReq.IosPayType
+	(*OrderPayReq)(nil),                   // 5: payment.OrderPayReq
+	(*OrderPayResp)(nil),                  // 6: payment.OrderPayResp
+	(*SubscribeSchedule)(nil),             // 7: payment.SubscribeSchedule
+	(*WxUniAppPayReply)(nil),              // 8: payment.WxUniAppPayReply
+	(*WxNativePayReply)(nil),              // 9: payment.WxNativePayReply
+	(*WxUnifiedPayReply)(nil),             // 10: payment.WxUnifiedPayReply
+	(*WxH5PayReplay)(nil),                 // 11: payment.WxH5PayReplay
+	(*TiktokEcPayReply)(nil),              // 12: payment.TiktokEcPayReply
+	(*KsUniAppReply)(nil),                 // 13: payment.KsUniAppReply
+	(*WxXPayReq)(nil),                     // 14: payment.WxXPayReq
+	(*WxXPayReply)(nil),                   // 15: payment.WxXPayReply
+	(*ClosePayOrderReq)(nil),              // 16: payment.ClosePayOrderReq
+	(*Empty)(nil),                         // 17: payment.Empty
+	(*OrderStatusReq)(nil),                // 18: payment.OrderStatusReq
+	(*OrderStatusResp)(nil),               // 19: payment.OrderStatusResp
+	(*AlipayFundTransUniTransferReq)(nil), // 20: payment.AlipayFundTransUniTransferReq
+	(*PayeeInfo)(nil),                     // 21: payment.PayeeInfo
+	(*AlipayFundTransResp)(nil),           // 22: payment.AlipayFundTransResp
+	(*AlipayFundTransQueryReq)(nil),       // 23: payment.AlipayFundTransQueryReq
+	(*AlipayFundTransBatchItem)(nil),      // 24: payment.AlipayFundTransBatchItem
+	(*AlipayFundTransBatchReq)(nil),       // 25: payment.AlipayFundTransBatchReq
+	(*AlipayFundTransBatchResp)(nil),      // 26: payment.AlipayFundTransBatchResp
+	(*WechatTransferSceneReportInfo)(nil), // 27: payment.WechatTransferSceneReportInfo
+	(*WechatFundTransferReq)(nil),         // 28: payment.WechatFundTransferReq
+	(*AlipayCheckAccountReq)(nil),         // 29: payment.AlipayCheckAccountReq
+	(*AlipayCheckAccountResp)(nil),        // 30: payment.AlipayCheckAccountResp
+	(*DyOrderRefundReq)(nil),              // 31: payment.DyOrderRefundReq
+	(*DyOrderRefundResp)(nil),             // 32: payment.DyOrderRefundResp
+	(*AlipayPageSignReq)(nil),             // 33: payment.AlipayPageSignReq
+	(*AlipayTradeReq)(nil),                // 34: payment.AlipayTradeReq
+	(*AlipayPageUnSignReq)(nil),           // 35: payment.AlipayPageUnSignReq
+	(*AlipayPageSignResp)(nil),            // 36: payment.AlipayPageSignResp
+	(*AlipayCommonResp)(nil),              // 37: payment.AlipayCommonResp
+	(*AlipayRefundReq)(nil),               // 38: payment.AlipayRefundReq
+	(*AlipayTradePayReq)(nil),             // 39: payment.AlipayTradePayReq
+	(*CreateRefundResp)(nil),              // 40: payment.CreateRefundResp
+	(*AliRefundResp)(nil),                 // 41: payment.AliRefundResp
+	(*AlipayAgreementModifyReq)(nil),      // 42: payment.AlipayAgreementModifyReq
+	(*WechatRefundOrderReq)(nil),          // 43: payment.WechatRefundOrderReq
+	(*DouyinGeneralTradeReq)(nil),         // 44: payment.DouyinGeneralTradeReq
+	(*Schema)(nil),                        // 45: payment.Schema
+	(*DouyinGeneralTradeReply)(nil),       // 46: payment.DouyinGeneralTradeReply
+	(*CreateDouyinRefundReq)(nil),         // 47: payment.CreateDouyinRefundReq
+	(*CreateDouyinRefundResp)(nil),        // 48: payment.CreateDouyinRefundResp
+	(*BindHuaweiPayDataReq)(nil),          // 49: payment.BindHuaweiPayDataReq
+	(*UnsubscribeHuaweiReq)(nil),          // 50: payment.UnsubscribeHuaweiReq
+	(*BindHuaweiPayDataResp)(nil),         // 51: payment.BindHuaweiPayDataResp
+	(*UnsubscribeHuaweiResp)(nil),         // 52: payment.UnsubscribeHuaweiResp
+	(*DelayHuaweiSubscriptionReq)(nil),    // 53: payment.DelayHuaweiSubscriptionReq
+	(*DelayHuaweiSubscriptionResp)(nil),   // 54: payment.DelayHuaweiSubscriptionResp
+	(*GetHuaweiSubscriptionResp)(nil),     // 55: payment.GetHuaweiSubscriptionResp
+	(*DouyinPeriodOrderReq)(nil),          // 56: payment.DouyinPeriodOrderReq
+	(*DySignedOrderInfo)(nil),             // 57: payment.DySignedOrderInfo
+	(*DouyinPeriodOrderResp)(nil),         // 58: payment.DouyinPeriodOrderResp
+	(*WechatMiniRefundReq)(nil),           // 59: payment.WechatMiniRefundReq
+	(*WechatMiniRefundResp)(nil),          // 60: payment.WechatMiniRefundResp
+	(*WechatMiniRefundQueryReq)(nil),      // 61: payment.WechatMiniRefundQueryReq
+	(*WechatMiniRefundQueryResp)(nil),     // 62: payment.WechatMiniRefundQueryResp
+	(*WechatMiniXPayRefundReq)(nil),       // 63: payment.WechatMiniXPayRefundReq
+	(*WechatMiniXPayRefundResp)(nil),      // 64: payment.WechatMiniXPayRefundResp
+	(*WechatMiniXPayQueryOrderReq)(nil),   // 65: payment.WechatMiniXPayQueryOrderReq
+	(*WechatMiniXPayQueryOrderResp)(nil),  // 66: payment.WechatMiniXPayQueryOrderResp
+	(*DyPeriodOrderReq)(nil),              // 67: payment.DyPeriodOrderReq
+	(*DyPeriodOrderResp)(nil),             // 68: payment.DyPeriodOrderResp
+	(*UserSubscriptionInfo)(nil),          // 69: payment.UserSubscriptionInfo
+	(*GetUserSubscriptionsReq)(nil),       // 70: payment.GetUserSubscriptionsReq
+	(*GetUserSubscriptionsResp)(nil),      // 71: payment.GetUserSubscriptionsResp
+	(*CancelSubscriptionReq)(nil),         // 72: payment.CancelSubscriptionReq
+	(*CancelSubscriptionResp)(nil),        // 73: payment.CancelSubscriptionResp
+	(*SubscriptionChargeInfo)(nil),        // 74: payment.SubscriptionChargeInfo
+	(*GetSubscriptionHistoryReq)(nil),     // 75: payment.GetSubscriptionHistoryReq
+	(*GetSubscriptionHistoryResp)(nil),    // 76: payment.GetSubscriptionHistoryResp
+	(*AppConfigInfo)(nil),                 // 77: payment.AppConfigInfo
+	(*WechatPayConfigInfo)(nil),           // 78: payment.WechatPayConfigInfo
+	(*AlipayPayConfigInfo)(nil),           // 79: payment.AlipayPayConfigInfo
+	(*TiktokPayConfigInfo)(nil),           // 80: payment.TiktokPayConfigInfo
+	(*KsPayConfigInfo)(nil),               // 81: payment.KsPayConfigInfo
+	(*AppAlipayAppInfo)(nil),              // 82: payment.AppAlipayAppInfo
+	(*HuaweiAppInfo)(nil),                 // 83: payment.HuaweiAppInfo
+	(*SaveConfigReq)(nil),                 // 84: payment.SaveConfigReq
+	(*SaveConfigResp)(nil),                // 85: payment.SaveConfigResp
+	(*ListConfigReq)(nil),                 // 86: payment.ListConfigReq
+	(*ListConfigResp)(nil),                // 87: payment.ListConfigResp
+	(*SetConfigStatusReq)(nil),            // 88: payment.SetConfigStatusReq
+	(*SetConfigStatusResp)(nil),           // 89: payment.SetConfigStatusResp
+}
+var file_payment_proto_depIdxs = []int32{
+	1,  // 0: payment.OrderPayReq.PayType:type_name -> payment.PayType
+	44, // 1: payment.OrderPayReq.douyinGeneralTradeReq:type_name -> payment.DouyinGeneralTradeReq
+	0,  // 2: payment.OrderPayReq.currency:type_name -> payment.Currency
+	14, // 3: payment.OrderPayReq.wxXPayReq:type_name -> payment.WxXPayReq
+	1,  // 4: payment.OrderPayResp.PayType:type_name -> payment.PayType
+	8,  // 5: payment.OrderPayResp.WxUniApp:type_name -> payment.WxUniAppPayReply
+	12, // 6: payment.OrderPayResp.TikTokEc:type_name -> payment.TiktokEcPayReply
+	9,  // 7: payment.OrderPayResp.WxNative:type_name -> payment.WxNativePayReply
+	13, // 8: payment.OrderPayResp.KsUniApp:type_name -> payment.KsUniAppReply
+	10, // 9: payment.OrderPayResp.WxUnified:type_name -> payment.WxUnifiedPayReply
+	46, // 10: payment.OrderPayResp.DouyinGeneralTrade:type_name -> payment.DouyinGeneralTradeReply
+	15, // 11: payment.OrderPayResp.WxXPay:type_name -> payment.WxXPayReply
+	7,  // 12: payment.OrderPayResp.Schedule:type_name -> payment.SubscribeSchedule
+	1,  // 13: payment.ClosePayOrderReq.PayType:type_name -> payment.PayType
+	1,  // 14: payment.OrderStatusReq.PayType:type_name -> payment.PayType
+	21, // 15: payment.AlipayFundTransUniTransferReq.payeeInfo:type_name -> payment.PayeeInfo
+	21, // 16: payment.AlipayFundTransBatchItem.payeeInfo:type_name -> payment.PayeeInfo
+	24, // 17: payment.AlipayFundTransBatchReq.Items:type_name -> payment.AlipayFundTransBatchItem
+	22, // 18: payment.AlipayFundTransBatchResp.List:type_name -> payment.AlipayFundTransResp
+	27, // 19: payment.WechatFundTransferReq.ReportInfos:type_name -> payment.WechatTransferSceneReportInfo
+	7,  // 20: payment.AlipayPageSignResp.Schedule:type_name -> payment.SubscribeSchedule
+	3,  // 21: payment.DouyinGeneralTradeReq.type:type_name -> payment.DouyinGeneralTradeReq.SkuType
+	45, // 22: payment.DouyinGeneralTradeReq.entrySchema:type_name -> payment.Schema
+	45, // 23: payment.DouyinGeneralTradeReq.orderEntrySchema:type_name -> payment.Schema
+	4,  // 24: payment.DouyinGeneralTradeReq.iosPayType:type_name -> payment.DouyinGeneralTradeReq.IosPayType
+	45, // 25: payment.CreateDouyinRefundReq.orderEntrySchema:type_name -> payment.Schema
+	2,  // 26: payment.DouyinPeriodOrderReq.Action:type_name -> payment.DouyinPeriodOrderReqAction
+	57, // 27: payment.DouyinPeriodOrderResp.SignedList:type_name -> payment.DySignedOrderInfo
+	69, // 28: payment.GetUserSubscriptionsResp.List:type_name -> payment.UserSubscriptionInfo
+	74, // 29: payment.GetSubscriptionHistoryResp.List:type_name -> payment.SubscriptionChargeInfo
+	77, // 30: payment.SaveConfigReq.AppConfig:type_name -> payment.AppConfigInfo
+	78, // 31: payment.SaveConfigReq.Wechat:type_name -> payment.WechatPayConfigInfo
+	79, // 32: payment.SaveConfigReq.Alipay:type_name -> payment.AlipayPayConfigInfo
+	80, // 33: payment.SaveConfigReq.Tiktok:type_name -> payment.TiktokPayConfigInfo
+	81, // 34: payment.SaveConfigReq.Ks:type_name -> payment.KsPayConfigInfo
+	82, // 35: payment.SaveConfigReq.AppAlipayApp:type_name -> payment.AppAlipayAppInfo
+	83, // 36: payment.SaveConfigReq.HuaweiApp:type_name -> payment.HuaweiAppInfo
+	77, // 37: payment.ListConfigResp.AppConfigs:type_name -> payment.AppConfigInfo
+	78, // 38: payment.ListConfigResp.Wechat:type_name -> payment.WechatPayConfigInfo
+	79, // 39: payment.ListConfigResp.Alipay:type_name -> payment.AlipayPayConfigInfo
+	80, // 40: payment.ListConfigResp.Tiktok:type_name -> payment.TiktokPayConfigInfo
+	81, // 41: payment.ListConfigResp.Ks:type_name -> payment.KsPayConfigInfo
+	82, // 42: payment.ListConfigResp.AppAlipayApps:type_name -> payment.AppAlipayAppInfo
+	83, // 43: payment.ListConfigResp.HuaweiApps:type_name -> payment.HuaweiAppInfo
+	5,  // 44: payment.Payment.OrderPay:input_type -> payment.OrderPayReq
+	16, // 45: payment.Payment.ClosePayOrder:input_type -> payment.ClosePayOrderReq
+	20, // 46: payment.Payment.AlipayFundTransUniTransfer:input_type -> payment.AlipayFundTransUniTransferReq
+	23, // 47: payment.Payment.AlipayFundTransQuery:input_type -> payment.AlipayFundTransQueryReq
+	25, // 48: payment.Payment.AlipayFundTransBatch:input_type -> payment.AlipayFundTransBatchReq
+	28, // 49: payment.Payment.WechatFundTransfer:input_type -> payment.WechatFundTransferReq
+	18, // 50: payment.Payment.OrderStatus:input_type -> payment.OrderStatusReq
+	29, // 51: payment.Payment.AlipayCheckAccount:input_type -> payment.AlipayCheckAccountReq
+	31, // 52: payment.Payment.DyOrderRefund:input_type -> payment.DyOrderRefundReq
+	47, // 53: payment.Payment.CreateDouyinRefund:input_type -> payment.CreateDouyinRefundReq
+	34, // 54: payment.Payment.AlipayTrade:input_type -> payment.AlipayTradeReq
+	33, // 55: payment.Payment.AlipayPagePayAndSign:input_type -> payment.AlipayPageSignReq
+	33, // 56: payment.Payment.AlipayH5Pay:input_type -> payment.AlipayPageSignReq
+	33, // 57: payment.Payment.AlipayPagePayAndSignChoiceAccount:input_type -> payment.AlipayPageSignReq
+	35, // 58: payment.Payment.AlipayPageUnSign:input_type -> payment.AlipayPageUnSignReq
+	38, // 59: payment.Payment.AlipayCreateRefund:input_type -> payment.AlipayRefundReq
+	38, // 60: payment.Payment.AlipayRefund:input_type -> payment.AlipayRefundReq
+	39, // 61: payment.Payment.AlipayTradePay:input_type -> payment.AlipayTradePayReq
+	42, // 62: payment.Payment.AlipayAgreementModify:input_type -> payment.AlipayAgreementModifyReq
+	33, // 63: payment.Payment.WechatUnifiedOrder:input_type -> payment.AlipayPageSignReq
+	43, // 64: payment.Payment.WechatRefundOrder:input_type -> payment.WechatRefundOrderReq
+	33, // 65: payment.Payment.WechatPayH5Order:input_type -> payment.AlipayPageSignReq
+	49, // 66: payment.Payment.BindHuaweiPayData:input_type -> payment.BindHuaweiPayDataReq
+	50, // 67: payment.Payment.UnsubscribeHuawei:input_type -> payment.UnsubscribeHuaweiReq
+	50, // 68: payment.Payment.UnsubscribeHuaweiRefund:input_type -> payment.UnsubscribeHuaweiReq
+	53, // 69: payment.Payment.DelayHuaweiSubscription:input_type -> payment.DelayHuaweiSubscriptionReq
+	50, // 70: payment.Payment.ReturnFeeHuaweiSubscription:input_type -> payment.UnsubscribeHuaweiReq
+	50, // 71: payment.Payment.WithdrawalHuaweiSubscription:input_type -> payment.UnsubscribeHuaweiReq
+	50, // 72: payment.Payment.GetHuaweiSubscription:input_type -> payment.UnsubscribeHuaweiReq
+	56, // 73: payment.Payment.DouyinPeriodOrder:input_type -> payment.DouyinPeriodOrderReq
+	59, // 74: payment.Payment.WechatMiniRefund:input_type -> payment.WechatMiniRefundReq
+	61, // 75: payment.Payment.WechatMiniRefundQuery:input_type -> payment.WechatMiniRefundQueryReq
+	63, // 76: payment.Payment.WechatMiniXPayRefund:input_type -> payment.WechatMiniXPayRefundReq
+	65, // 77: payment.Payment.WechatMiniXPayQueryOrder:input_type -> payment.WechatMiniXPayQueryOrderReq
+	67, // 78: payment.Payment.DyPeriodOrder:input_type -> payment.DyPeriodOrderReq
+	70, // 79: payment.Payment.GetUserSubscriptions:input_type -> payment.GetUserSubscriptionsReq
+	72, // 80: payment.Payment.CancelSubscription:input_type -> payment.CancelSubscriptionReq
+	75, // 81: payment.Payment.GetSubscriptionHistory:input_type -> payment.GetSubscriptionHistoryReq
+	84, // 82: payment.Payment.SaveConfig:input_type -> payment.SaveConfigReq
+	86, // 83: payment.Payment.ListConfig:input_type -> payment.ListConfigReq
+	88, // 84: payment.Payment.SetConfigStatus:input_type -> payment.SetConfigStatusReq
+	6,  // 85: payment.Payment.OrderPay:output_type -> payment.OrderPayResp
+	17, // 86: payment.Payment.ClosePayOrder:output_type -> payment.Empty
+	22, // 87: payment.Payment.AlipayFundTransUniTransfer:output_type -> payment.AlipayFundTransResp
+	22, // 88: payment.Payment.AlipayFundTransQuery:output_type -> payment.AlipayFundTransResp
+	26, // 89: payment.Payment.AlipayFundTransBatch:output_type -> payment.AlipayFundTransBatchResp
+	22, // 90: payment.Payment.WechatFundTransfer:output_type -> payment.AlipayFundTransResp
+	19, // 91: payment.Payment.OrderStatus:output_type -> payment.OrderStatusResp
+	30, // 92: payment.Payment.AlipayCheckAccount:output_type -> payment.AlipayCheckAccountResp
+	32, // 93: payment.Payment.DyOrderRefund:output_type -> payment.DyOrderRefundResp
+	48, // 94: payment.Payment.CreateDouyinRefund:output_type -> payment.CreateDouyinRefundResp
+	36, // 95: payment.Payment.AlipayTrade:output_type -> payment.AlipayPageSignResp
+	36, // 96: payment.Payment.AlipayPagePayAndSign:output_type -> payment.AlipayPageSignResp
+	36, // 97: payment.Payment.AlipayH5Pay:output_type -> payment.AlipayPageSignResp
+	36, // 98: payment.Payment.AlipayPagePayAndSignChoiceAccount:output_type -> payment.AlipayPageSignResp
+	37, // 99: payment.Payment.AlipayPageUnSign:output_type -> payment.AlipayCommonResp
+	40, // 100: payment.Payment.AlipayCreateRefund:output_type -> payment.CreateRefundResp
+	41, // 101: payment.Payment.AlipayRefund:output_type -> payment.AliRefundResp
+	37, // 102: payment.Payment.AlipayTradePay:output_type -> payment.AlipayCommonResp
+	37, // 103: payment.Payment.AlipayAgreementModify:output_type -> payment.AlipayCommonResp
+	10, // 104: payment.Payment.WechatUnifiedOrder:output_type -> payment.WxUnifiedPayReply
+	40, // 105: payment.Payment.WechatRefundOrder:output_type -> payment.CreateRefundResp
+	11, // 106: payment.Payment.WechatPayH5Order:output_type -> payment.WxH5PayReplay
+	51, // 107: payment.Payment.BindHuaweiPayData:output_type -> payment.BindHuaweiPayDataResp
+	52, // 108: payment.Payment.UnsubscribeHuawei:output_type -> payment.UnsubscribeHuaweiResp
+	52, // 109: payment.Payment.UnsubscribeHuaweiRefund:output_type -> payment.UnsubscribeHuaweiResp
+	54, // 110: payment.Payment.DelayHuaweiSubscription:output_type -> payment.DelayHuaweiSubscriptionResp
+	52, // 111: payment.Payment.ReturnFeeHuaweiSubscription:output_type -> payment.UnsubscribeHuaweiResp
+	52, // 112: payment.Payment.WithdrawalHuaweiSubscription:output_type -> payment.UnsubscribeHuaweiResp
+	55, // 113: payment.Payment.GetHuaweiSubscription:output_type -> payment.GetHuaweiSubscriptionResp
+	58, // 114: payment.Payment.DouyinPeriodOrder:output_type -> payment.DouyinPeriodOrderResp
+	60, // 115: payment.Payment.WechatMiniRefund:output_type -> payment.WechatMiniRefundResp
+	62, // 116: payment.Payment.WechatMiniRefundQuery:output_type -> payment.WechatMiniRefundQueryResp
+	64, // 117: payment.Payment.WechatMiniXPayRefund:output_type -> payment.WechatMiniXPayRefundResp
+	66, // 118: payment.Payment.WechatMiniXPayQueryOrder:output_type -> payment.WechatMiniXPayQueryOrderResp
+	68, // 119: payment.Payment.DyPeriodOrder:output_type -> payment.DyPeriodOrderResp
+	71, // 120: payment.Payment.GetUserSubscriptions:output_type -> payment.GetUserSubscriptionsResp
+	73, // 121: payment.Payment.CancelSubscription:output_type -> payment.CancelSubscriptionResp
+	76, // 122: payment.Payment.GetSubscriptionHistory:output_type -> payment.GetSubscriptionHistoryResp
+	85, // 123: payment.Payment.SaveConfig:output_type -> payment.SaveConfigResp
+	87, // 124: payment.Payment.ListConfig:output_type -> payment.ListConfigResp
+	89, // 125: payment.Payment.SetConfigStatus:output_type -> payment.SetConfigStatusResp
+	85, // [85:126] is the sub-list for method output_type
+	44, // [44:85] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
+}
+
+func init() { file_payment_proto_init() }
+func file_payment_proto_init() {
+	if File_payment_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
 		file_payment_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrderPayReq); i {
 			case 0:
@@ -5353,8 +8909,296 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderPayResp); i {
+		file_payment_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderPayResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WxUniAppPayReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WxNativePayReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WxUnifiedPayReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WxH5PayReplay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TiktokEcPayReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KsUniAppReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WxXPayReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WxXPayReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePayOrderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayFundTransUniTransferReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayeeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayFundTransResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayFundTransQueryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayFundTransBatchItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayFundTransBatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayFundTransBatchResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatTransferSceneReportInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatFundTransferReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayCheckAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayCheckAccountResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5365,8 +9209,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WxUniAppPayReply); i {
+		file_payment_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DyOrderRefundReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5377,8 +9221,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WxNativePayReply); i {
+		file_payment_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DyOrderRefundResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5389,8 +9233,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WxUnifiedPayReply); i {
+		file_payment_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayPageSignReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5401,8 +9245,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WxH5PayReplay); i {
+		file_payment_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayTradeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5413,8 +9257,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TiktokEcPayReply); i {
+		file_payment_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayPageUnSignReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5425,8 +9269,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KsUniAppReply); i {
+		file_payment_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayPageSignResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5437,8 +9281,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClosePayOrderReq); i {
+		file_payment_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayCommonResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5449,8 +9293,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+		file_payment_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayRefundReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5461,8 +9305,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderStatusReq); i {
+		file_payment_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayTradePayReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5473,8 +9317,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderStatusResp); i {
+		file_payment_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRefundResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5485,8 +9329,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlipayFundTransUniTransferReq); i {
+		file_payment_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AliRefundResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5497,8 +9341,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PayeeInfo); i {
+		file_payment_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayAgreementModifyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5509,8 +9353,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlipayCheckAccountReq); i {
+		file_payment_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatRefundOrderReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5521,8 +9365,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlipayCheckAccountResp); i {
+		file_payment_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DouyinGeneralTradeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5533,8 +9377,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DyOrderRefundReq); i {
+		file_payment_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Schema); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5545,8 +9389,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DyOrderRefundResp); i {
+		file_payment_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DouyinGeneralTradeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5557,8 +9401,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlipayPageSignReq); i {
+		file_payment_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDouyinRefundReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5569,8 +9413,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlipayTradeReq); i {
+		file_payment_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDouyinRefundResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5581,8 +9425,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlipayPageUnSignReq); i {
+		file_payment_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindHuaweiPayDataReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5593,8 +9437,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlipayPageSignResp); i {
+		file_payment_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeHuaweiReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5605,8 +9449,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlipayCommonResp); i {
+		file_payment_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindHuaweiPayDataResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5617,8 +9461,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlipayRefundReq); i {
+		file_payment_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeHuaweiResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5629,8 +9473,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlipayTradePayReq); i {
+		file_payment_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelayHuaweiSubscriptionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5641,8 +9485,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRefundResp); i {
+		file_payment_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelayHuaweiSubscriptionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5653,8 +9497,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AliRefundResp); i {
+		file_payment_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHuaweiSubscriptionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5665,8 +9509,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlipayAgreementModifyReq); i {
+		file_payment_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DouyinPeriodOrderReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5677,8 +9521,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WechatRefundOrderReq); i {
+		file_payment_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DySignedOrderInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5689,8 +9533,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DouyinGeneralTradeReq); i {
+		file_payment_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DouyinPeriodOrderResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5701,8 +9545,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Schema); i {
+		file_payment_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatMiniRefundReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5713,8 +9557,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DouyinGeneralTradeReply); i {
+		file_payment_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatMiniRefundResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5725,8 +9569,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDouyinRefundReq); i {
+		file_payment_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatMiniRefundQueryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5737,8 +9581,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDouyinRefundResp); i {
+		file_payment_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatMiniRefundQueryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5749,8 +9593,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BindHuaweiPayDataReq); i {
+		file_payment_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatMiniXPayRefundReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5761,8 +9605,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeHuaweiReq); i {
+		file_payment_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatMiniXPayRefundResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5773,8 +9617,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BindHuaweiPayDataResp); i {
+		file_payment_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatMiniXPayQueryOrderReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5785,8 +9629,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeHuaweiResp); i {
+		file_payment_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatMiniXPayQueryOrderResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5797,8 +9641,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DouyinPeriodOrderReq); i {
+		file_payment_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DyPeriodOrderReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5809,8 +9653,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DySignedOrderInfo); i {
+		file_payment_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DyPeriodOrderResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5821,8 +9665,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DouyinPeriodOrderResp); i {
+		file_payment_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSubscriptionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5833,8 +9677,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WechatMiniRefundReq); i {
+		file_payment_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserSubscriptionsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5845,8 +9689,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WechatMiniRefundResp); i {
+		file_payment_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserSubscriptionsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5857,8 +9701,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WechatMiniRefundQueryReq); i {
+		file_payment_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSubscriptionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5869,8 +9713,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WechatMiniRefundQueryResp); i {
+		file_payment_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSubscriptionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5881,8 +9725,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WechatMiniXPayRefundReq); i {
+		file_payment_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionChargeInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5893,8 +9737,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WechatMiniXPayRefundResp); i {
+		file_payment_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionHistoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5905,8 +9749,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WechatMiniXPayQueryOrderReq); i {
+		file_payment_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionHistoryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5917,8 +9761,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WechatMiniXPayQueryOrderResp); i {
+		file_payment_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppConfigInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5929,8 +9773,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AutoPkgAddReq); i {
+		file_payment_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WechatPayConfigInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5941,8 +9785,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AutoPkgAddResp); i {
+		file_payment_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlipayPayConfigInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5953,8 +9797,8 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DyPeriodOrderReq); i {
+		file_payment_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TiktokPayConfigInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5965,8 +9809,104 @@ func file_payment_proto_init() {
 				return nil
 			}
 		}
-		file_payment_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DyPeriodOrderResp); i {
+		file_payment_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KsPayConfigInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppAlipayAppInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HuaweiAppInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConfigStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConfigStatusResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5984,7 +9924,7 @@ func file_payment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_payment_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   53,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,9 @@ const (
 	Payment_OrderPay_FullMethodName                          = "/payment.Payment/OrderPay"
 	Payment_ClosePayOrder_FullMethodName                     = "/payment.Payment/ClosePayOrder"
 	Payment_AlipayFundTransUniTransfer_FullMethodName        = "/payment.Payment/AlipayFundTransUniTransfer"
+	Payment_AlipayFundTransQuery_FullMethodName              = "/payment.Payment/AlipayFundTransQuery"
+	Payment_AlipayFundTransBatch_FullMethodName              = "/payment.Payment/AlipayFundTransBatch"
+	Payment_WechatFundTransfer_FullMethodName                = "/payment.Payment/WechatFundTransfer"
 	Payment_OrderStatus_FullMethodName                       = "/payment.Payment/OrderStatus"
 	Payment_AlipayCheckAccount_FullMethodName                = "/payment.Payment/AlipayCheckAccount"
 	Payment_DyOrderRefund_FullMethodName                     = "/payment.Payment/DyOrderRefund"
@@ -41,13 +44,22 @@ const (
 	Payment_BindHuaweiPayData_FullMethodName                 = "/payment.Payment/BindHuaweiPayData"
 	Payment_UnsubscribeHuawei_FullMethodName                 = "/payment.Payment/UnsubscribeHuawei"
 	Payment_UnsubscribeHuaweiRefund_FullMethodName           = "/payment.Payment/UnsubscribeHuaweiRefund"
+	Payment_DelayHuaweiSubscription_FullMethodName           = "/payment.Payment/DelayHuaweiSubscription"
+	Payment_ReturnFeeHuaweiSubscription_FullMethodName       = "/payment.Payment/ReturnFeeHuaweiSubscription"
+	Payment_WithdrawalHuaweiSubscription_FullMethodName      = "/payment.Payment/WithdrawalHuaweiSubscription"
+	Payment_GetHuaweiSubscription_FullMethodName             = "/payment.Payment/GetHuaweiSubscription"
 	Payment_DouyinPeriodOrder_FullMethodName                 = "/payment.Payment/DouyinPeriodOrder"
 	Payment_WechatMiniRefund_FullMethodName                  = "/payment.Payment/WechatMiniRefund"
 	Payment_WechatMiniRefundQuery_FullMethodName             = "/payment.Payment/WechatMiniRefundQuery"
 	Payment_WechatMiniXPayRefund_FullMethodName              = "/payment.Payment/WechatMiniXPayRefund"
 	Payment_WechatMiniXPayQueryOrder_FullMethodName          = "/payment.Payment/WechatMiniXPayQueryOrder"
-	Payment_AutoPkgAdd_FullMethodName                        = "/payment.Payment/AutoPkgAdd"
 	Payment_DyPeriodOrder_FullMethodName                     = "/payment.Payment/DyPeriodOrder"
+	Payment_GetUserSubscriptions_FullMethodName              = "/payment.Payment/GetUserSubscriptions"
+	Payment_CancelSubscription_FullMethodName                = "/payment.Payment/CancelSubscription"
+	Payment_GetSubscriptionHistory_FullMethodName            = "/payment.Payment/GetSubscriptionHistory"
+	Payment_SaveConfig_FullMethodName                        = "/payment.Payment/SaveConfig"
+	Payment_ListConfig_FullMethodName                        = "/payment.Payment/ListConfig"
+	Payment_SetConfigStatus_FullMethodName                   = "/payment.Payment/SetConfigStatus"
 )
 
 // PaymentClient is the client API for Payment service.
@@ -59,7 +71,13 @@ type PaymentClient interface {
 	// 关闭订单
 	ClosePayOrder(ctx context.Context, in *ClosePayOrderReq, opts ...grpc.CallOption) (*Empty, error)
 	// 支付宝转出
-	AlipayFundTransUniTransfer(ctx context.Context, in *AlipayFundTransUniTransferReq, opts ...grpc.CallOption) (*Empty, error)
+	AlipayFundTransUniTransfer(ctx context.Context, in *AlipayFundTransUniTransferReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error)
+	// 支付宝转出结果查询
+	AlipayFundTransQuery(ctx context.Context, in *AlipayFundTransQueryReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error)
+	// 支付宝批量转出
+	AlipayFundTransBatch(ctx context.Context, in *AlipayFundTransBatchReq, opts ...grpc.CallOption) (*AlipayFundTransBatchResp, error)
+	// 微信商家转账到零钱，转账结果查询使用AlipayFundTransQuery
+	WechatFundTransfer(ctx context.Context, in *WechatFundTransferReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error)
 	// 查询订单
 	OrderStatus(ctx context.Context, in *OrderStatusReq, opts ...grpc.CallOption) (*OrderStatusResp, error)
 	// 支付宝转出账号校验
@@ -98,6 +116,14 @@ type PaymentClient interface {
 	UnsubscribeHuawei(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error)
 	// 用户主动解除华为订阅后的退款操作
 	UnsubscribeHuaweiRefund(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error)
+	// 华为订阅延期，用于客服补偿
+	DelayHuaweiSubscription(ctx context.Context, in *DelayHuaweiSubscriptionReq, opts ...grpc.CallOption) (*DelayHuaweiSubscriptionResp, error)
+	// 华为订阅返还最近一期费用，不取消订阅
+	ReturnFeeHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error)
+	// 华为订阅撤销，退还最近一期费用并立即取消订阅
+	WithdrawalHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error)
+	// 查询华为订阅实时状态
+	GetHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*GetHuaweiSubscriptionResp, error)
 	// 抖音周期代扣相关查询和修改(包括解约)
 	DouyinPeriodOrder(ctx context.Context, in *DouyinPeriodOrderReq, opts ...grpc.CallOption) (*DouyinPeriodOrderResp, error)
 	// 小程序-微信的退款申请
@@ -108,10 +134,20 @@ type PaymentClient interface {
 	WechatMiniXPayRefund(ctx context.Context, in *WechatMiniXPayRefundReq, opts ...grpc.CallOption) (*WechatMiniXPayRefundResp, error)
 	// 微信虚拟支付-退款/订单详情
 	WechatMiniXPayQueryOrder(ctx context.Context, in *WechatMiniXPayQueryOrderReq, opts ...grpc.CallOption) (*WechatMiniXPayQueryOrderResp, error)
-	// AutoPkgAdd 自动创建应用
-	AutoPkgAdd(ctx context.Context, in *AutoPkgAddReq, opts ...grpc.CallOption) (*AutoPkgAddResp, error)
 	// DyPeriodOrder 查询抖音周期代扣订单
 	DyPeriodOrder(ctx context.Context, in *DyPeriodOrderReq, opts ...grpc.CallOption) (*DyPeriodOrderResp, error)
+	// GetUserSubscriptions 查询用户在支付宝、抖音、华为的订阅
+	GetUserSubscriptions(ctx context.Context, in *GetUserSubscriptionsReq, opts ...grpc.CallOption) (*GetUserSubscriptionsResp, error)
+	// CancelSubscription 取消订阅，按渠道解约或取消自动续费
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionReq, opts ...grpc.CallOption) (*CancelSubscriptionResp, error)
+	// GetSubscriptionHistory 查询用户订阅的扣款记录
+	GetSubscriptionHistory(ctx context.Context, in *GetSubscriptionHistoryReq, opts ...grpc.CallOption) (*GetSubscriptionHistoryResp, error)
+	// SaveConfig 新建或更新应用、商户配置
+	SaveConfig(ctx context.Context, in *SaveConfigReq, opts ...grpc.CallOption) (*SaveConfigResp, error)
+	// ListConfig 查询应用、商户配置
+	ListConfig(ctx context.Context, in *ListConfigReq, opts ...grpc.CallOption) (*ListConfigResp, error)
+	// SetConfigStatus 停用或启用应用、商户配置
+	SetConfigStatus(ctx context.Context, in *SetConfigStatusReq, opts ...grpc.CallOption) (*SetConfigStatusResp, error)
 }
 
 type paymentClient struct {
@@ -140,8 +176,8 @@ func (c *paymentClient) ClosePayOrder(ctx context.Context, in *ClosePayOrderReq,
 	return out, nil
 }
 
-func (c *paymentClient) AlipayFundTransUniTransfer(ctx context.Context, in *AlipayFundTransUniTransferReq, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
+func (c *paymentClient) AlipayFundTransUniTransfer(ctx context.Context, in *AlipayFundTransUniTransferReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error) {
+	out := new(AlipayFundTransResp)
 	err := c.cc.Invoke(ctx, Payment_AlipayFundTransUniTransfer_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -149,6 +185,33 @@ func (c *paymentClient) AlipayFundTransUniTransfer(ctx context.Context, in *Alip
 	return out, nil
 }
 
+func (c *paymentClient) AlipayFundTransQuery(ctx context.Context, in *AlipayFundTransQueryReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error) {
+	out := new(AlipayFundTransResp)
+	err := c.cc.Invoke(ctx, Payment_AlipayFundTransQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) AlipayFundTransBatch(ctx context.Context, in *AlipayFundTransBatchReq, opts ...grpc.CallOption) (*AlipayFundTransBatchResp, error) {
+	out := new(AlipayFundTransBatchResp)
+	err := c.cc.Invoke(ctx, Payment_AlipayFundTransBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) WechatFundTransfer(ctx context.Context, in *WechatFundTransferReq, opts ...grpc.CallOption) (*AlipayFundTransResp, error) {
+	out := new(AlipayFundTransResp)
+	err := c.cc.Invoke(ctx, Payment_WechatFundTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentClient) OrderStatus(ctx context.Context, in *OrderStatusReq, opts ...grpc.CallOption) (*OrderStatusResp, error) {
 	out := new(OrderStatusResp)
 	err := c.cc.Invoke(ctx, Payment_OrderStatus_FullMethodName, in, out, opts...)
@@ -320,6 +383,42 @@ func (c *paymentClient) UnsubscribeHuaweiRefund(ctx context.Context, in *Unsubsc
 	return out, nil
 }
 
+func (c *paymentClient) DelayHuaweiSubscription(ctx context.Context, in *DelayHuaweiSubscriptionReq, opts ...grpc.CallOption) (*DelayHuaweiSubscriptionResp, error) {
+	out := new(DelayHuaweiSubscriptionResp)
+	err := c.cc.Invoke(ctx, Payment_DelayHuaweiSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) ReturnFeeHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error) {
+	out := new(UnsubscribeHuaweiResp)
+	err := c.cc.Invoke(ctx, Payment_ReturnFeeHuaweiSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) WithdrawalHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*UnsubscribeHuaweiResp, error) {
+	out := new(UnsubscribeHuaweiResp)
+	err := c.cc.Invoke(ctx, Payment_WithdrawalHuaweiSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) GetHuaweiSubscription(ctx context.Context, in *UnsubscribeHuaweiReq, opts ...grpc.CallOption) (*GetHuaweiSubscriptionResp, error) {
+	out := new(GetHuaweiSubscriptionResp)
+	err := c.cc.Invoke(ctx, Payment_GetHuaweiSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentClient) DouyinPeriodOrder(ctx context.Context, in *DouyinPeriodOrderReq, opts ...grpc.CallOption) (*DouyinPeriodOrderResp, error) {
 	out := new(DouyinPeriodOrderResp)
 	err := c.cc.Invoke(ctx, Payment_DouyinPeriodOrder_FullMethodName, in, out, opts...)
@@ -365,18 +464,63 @@ func (c *paymentClient) WechatMiniXPayQueryOrder(ctx context.Context, in *Wechat
 	return out, nil
 }
 
-func (c *paymentClient) AutoPkgAdd(ctx context.Context, in *AutoPkgAddReq, opts ...grpc.CallOption) (*AutoPkgAddResp, error) {
-	out := new(AutoPkgAddResp)
-	err := c.cc.Invoke(ctx, Payment_AutoPkgAdd_FullMethodName, in, out, opts...)
+func (c *paymentClient) DyPeriodOrder(ctx context.Context, in *DyPeriodOrderReq, opts ...grpc.CallOption) (*DyPeriodOrderResp, error) {
+	out := new(DyPeriodOrderResp)
+	err := c.cc.Invoke(ctx, Payment_DyPeriodOrder_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *paymentClient) DyPeriodOrder(ctx context.Context, in *DyPeriodOrderReq, opts ...grpc.CallOption) (*DyPeriodOrderResp, error) {
-	out := new(DyPeriodOrderResp)
-	err := c.cc.Invoke(ctx, Payment_DyPeriodOrder_FullMethodName, in, out, opts...)
+func (c *paymentClient) GetUserSubscriptions(ctx context.Context, in *GetUserSubscriptionsReq, opts ...grpc.CallOption) (*GetUserSubscriptionsResp, error) {
+	out := new(GetUserSubscriptionsResp)
+	err := c.cc.Invoke(ctx, Payment_GetUserSubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionReq, opts ...grpc.CallOption) (*CancelSubscriptionResp, error) {
+	out := new(CancelSubscriptionResp)
+	err := c.cc.Invoke(ctx, Payment_CancelSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) GetSubscriptionHistory(ctx context.Context, in *GetSubscriptionHistoryReq, opts ...grpc.CallOption) (*GetSubscriptionHistoryResp, error) {
+	out := new(GetSubscriptionHistoryResp)
+	err := c.cc.Invoke(ctx, Payment_GetSubscriptionHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) SaveConfig(ctx context.Context, in *SaveConfigReq, opts ...grpc.CallOption) (*SaveConfigResp, error) {
+	out := new(SaveConfigResp)
+	err := c.cc.Invoke(ctx, Payment_SaveConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) ListConfig(ctx context.Context, in *ListConfigReq, opts ...grpc.CallOption) (*ListConfigResp, error) {
+	out := new(ListConfigResp)
+	err := c.cc.Invoke(ctx, Payment_ListConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentClient) SetConfigStatus(ctx context.Context, in *SetConfigStatusReq, opts ...grpc.CallOption) (*SetConfigStatusResp, error) {
+	out := new(SetConfigStatusResp)
+	err := c.cc.Invoke(ctx, Payment_SetConfigStatus_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -392,7 +536,13 @@ type PaymentServer interface {
 	// 关闭订单
 	ClosePayOrder(context.Context, *ClosePayOrderReq) (*Empty, error)
 	// 支付宝转出
-	AlipayFundTransUniTransfer(context.Context, *AlipayFundTransUniTransferReq) (*Empty, error)
+	AlipayFundTransUniTransfer(context.Context, *AlipayFundTransUniTransferReq) (*AlipayFundTransResp, error)
+	// 支付宝转出结果查询
+	AlipayFundTransQuery(context.Context, *AlipayFundTransQueryReq) (*AlipayFundTransResp, error)
+	// 支付宝批量转出
+	AlipayFundTransBatch(context.Context, *AlipayFundTransBatchReq) (*AlipayFundTransBatchResp, error)
+	// 微信商家转账到零钱，转账结果查询使用AlipayFundTransQuery
+	WechatFundTransfer(context.Context, *WechatFundTransferReq) (*AlipayFundTransResp, error)
 	// 查询订单
 	OrderStatus(context.Context, *OrderStatusReq) (*OrderStatusResp, error)
 	// 支付宝转出账号校验
@@ -431,6 +581,14 @@ type PaymentServer interface {
 	UnsubscribeHuawei(context.Context, *UnsubscribeHuaweiReq) (*UnsubscribeHuaweiResp, error)
 	// 用户主动解除华为订阅后的退款操作
 	UnsubscribeHuaweiRefund(context.Context, *UnsubscribeHuaweiReq) (*UnsubscribeHuaweiResp, error)
+	// 华为订阅延期，用于客服补偿
+	DelayHuaweiSubscription(context.Context, *DelayHuaweiSubscriptionReq) (*DelayHuaweiSubscriptionResp, error)
+	// 华为订阅返还最近一期费用，不取消订阅
+	ReturnFeeHuaweiSubscription(context.Context, *UnsubscribeHuaweiReq) (*UnsubscribeHuaweiResp, error)
+	// 华为订阅撤销，退还最近一期费用并立即取消订阅
+	WithdrawalHuaweiSubscription(context.Context, *UnsubscribeHuaweiReq) (*UnsubscribeHuaweiResp, error)
+	// 查询华为订阅实时状态
+	GetHuaweiSubscription(context.Context, *UnsubscribeHuaweiReq) (*GetHuaweiSubscriptionResp, error)
 	// 抖音周期代扣相关查询和修改(包括解约)
 	DouyinPeriodOrder(context.Context, *DouyinPeriodOrderReq) (*DouyinPeriodOrderResp, error)
 	// 小程序-微信的退款申请
@@ -441,10 +599,20 @@ type PaymentServer interface {
 	WechatMiniXPayRefund(context.Context, *WechatMiniXPayRefundReq) (*WechatMiniXPayRefundResp, error)
 	// 微信虚拟支付-退款/订单详情
 	WechatMiniXPayQueryOrder(context.Context, *WechatMiniXPayQueryOrderReq) (*WechatMiniXPayQueryOrderResp, error)
-	// AutoPkgAdd 自动创建应用
-	AutoPkgAdd(context.Context, *AutoPkgAddReq) (*AutoPkgAddResp, error)
 	// DyPeriodOrder 查询抖音周期代扣订单
 	DyPeriodOrder(context.Context, *DyPeriodOrderReq) (*DyPeriodOrderResp, error)
+	// GetUserSubscriptions 查询用户在支付宝、抖音、华为的订阅
+	GetUserSubscriptions(context.Context, *GetUserSubscriptionsReq) (*GetUserSubscriptionsResp, error)
+	// CancelSubscription 取消订阅，按渠道解约或取消自动续费
+	CancelSubscription(context.Context, *CancelSubscriptionReq) (*CancelSubscriptionResp, error)
+	// GetSubscriptionHistory 查询用户订阅的扣款记录
+	GetSubscriptionHistory(context.Context, *GetSubscriptionHistoryReq) (*GetSubscriptionHistoryResp, error)
+	// SaveConfig 新建或更新应用、商户配置
+	SaveConfig(context.Context, *SaveConfigReq) (*SaveConfigResp, error)
+	// ListConfig 查询应用、商户配置
+	ListConfig(context.Context, *ListConfigReq) (*ListConfigResp, error)
+	// SetConfigStatus 停用或启用应用、商户配置
+	SetConfigStatus(context.Context, *SetConfigStatusReq) (*SetConfigStatusResp, error)
 	mustEmbedUnimplementedPaymentServer()
 }
 
@@ -458,9 +626,18 @@ func (UnimplementedPaymentServer) OrderPay(context.Context, *OrderPayReq) (*Orde
 func (UnimplementedPaymentServer) ClosePayOrder(context.Context, *ClosePayOrderReq) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClosePayOrder not implemented")
 }
-func (UnimplementedPaymentServer) AlipayFundTransUniTransfer(context.Context, *AlipayFundTransUniTransferReq) (*Empty, error) {
+func (UnimplementedPaymentServer) AlipayFundTransUniTransfer(context.Context, *AlipayFundTransUniTransferReq) (*AlipayFundTransResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlipayFundTransUniTransfer not implemented")
 }
+func (UnimplementedPaymentServer) AlipayFundTransQuery(context.Context, *AlipayFundTransQueryReq) (*AlipayFundTransResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlipayFundTransQuery not implemented")
+}
+func (UnimplementedPaymentServer) AlipayFundTransBatch(context.Context, *AlipayFundTransBatchReq) (*AlipayFundTransBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlipayFundTransBatch not implemented")
+}
+func (UnimplementedPaymentServer) WechatFundTransfer(context.Context, *WechatFundTransferReq) (*AlipayFundTransResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WechatFundTransfer not implemented")
+}
 func (UnimplementedPaymentServer) OrderStatus(context.Context, *OrderStatusReq) (*OrderStatusResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OrderStatus not implemented")
 }
@@ -518,6 +695,18 @@ func (UnimplementedPaymentServer) UnsubscribeHuawei(context.Context, *Unsubscrib
 func (UnimplementedPaymentServer) UnsubscribeHuaweiRefund(context.Context, *UnsubscribeHuaweiReq) (*UnsubscribeHuaweiResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeHuaweiRefund not implemented")
 }
+func (UnimplementedPaymentServer) DelayHuaweiSubscription(context.Context, *DelayHuaweiSubscriptionReq) (*DelayHuaweiSubscriptionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayHuaweiSubscription not implemented")
+}
+func (UnimplementedPaymentServer) ReturnFeeHuaweiSubscription(context.Context, *UnsubscribeHuaweiReq) (*UnsubscribeHuaweiResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnFeeHuaweiSubscription not implemented")
+}
+func (UnimplementedPaymentServer) WithdrawalHuaweiSubscription(context.Context, *UnsubscribeHuaweiReq) (*UnsubscribeHuaweiResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalHuaweiSubscription not implemented")
+}
+func (UnimplementedPaymentServer) GetHuaweiSubscription(context.Context, *UnsubscribeHuaweiReq) (*GetHuaweiSubscriptionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHuaweiSubscription not implemented")
+}
 func (UnimplementedPaymentServer) DouyinPeriodOrder(context.Context, *DouyinPeriodOrderReq) (*DouyinPeriodOrderResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DouyinPeriodOrder not implemented")
 }
@@ -533,12 +722,27 @@ func (UnimplementedPaymentServer) WechatMiniXPayRefund(context.Context, *WechatM
 func (UnimplementedPaymentServer) WechatMiniXPayQueryOrder(context.Context, *WechatMiniXPayQueryOrderReq) (*WechatMiniXPayQueryOrderResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WechatMiniXPayQueryOrder not implemented")
 }
-func (UnimplementedPaymentServer) AutoPkgAdd(context.Context, *AutoPkgAddReq) (*AutoPkgAddResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AutoPkgAdd not implemented")
-}
 func (UnimplementedPaymentServer) DyPeriodOrder(context.Context, *DyPeriodOrderReq) (*DyPeriodOrderResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DyPeriodOrder not implemented")
 }
+func (UnimplementedPaymentServer) GetUserSubscriptions(context.Context, *GetUserSubscriptionsReq) (*GetUserSubscriptionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSubscriptions not implemented")
+}
+func (UnimplementedPaymentServer) CancelSubscription(context.Context, *CancelSubscriptionReq) (*CancelSubscriptionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (UnimplementedPaymentServer) GetSubscriptionHistory(context.Context, *GetSubscriptionHistoryReq) (*GetSubscriptionHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptionHistory not implemented")
+}
+func (UnimplementedPaymentServer) SaveConfig(context.Context, *SaveConfigReq) (*SaveConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveConfig not implemented")
+}
+func (UnimplementedPaymentServer) ListConfig(context.Context, *ListConfigReq) (*ListConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfig not implemented")
+}
+func (UnimplementedPaymentServer) SetConfigStatus(context.Context, *SetConfigStatusReq) (*SetConfigStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConfigStatus not implemented")
+}
 func (UnimplementedPaymentServer) mustEmbedUnimplementedPaymentServer() {}
 
 // UnsafePaymentServer may be embedded to opt out of forward compatibility for this service.
@@ -606,6 +810,60 @@ func _Payment_AlipayFundTransUniTransfer_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payment_AlipayFundTransQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlipayFundTransQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).AlipayFundTransQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_AlipayFundTransQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).AlipayFundTransQuery(ctx, req.(*AlipayFundTransQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_AlipayFundTransBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlipayFundTransBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).AlipayFundTransBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_AlipayFundTransBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).AlipayFundTransBatch(ctx, req.(*AlipayFundTransBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_WechatFundTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WechatFundTransferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).WechatFundTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_WechatFundTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).WechatFundTransfer(ctx, req.(*WechatFundTransferReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Payment_OrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OrderStatusReq)
 	if err := dec(in); err != nil {
@@ -948,6 +1206,78 @@ func _Payment_UnsubscribeHuaweiRefund_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payment_DelayHuaweiSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelayHuaweiSubscriptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).DelayHuaweiSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_DelayHuaweiSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).DelayHuaweiSubscription(ctx, req.(*DelayHuaweiSubscriptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_ReturnFeeHuaweiSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeHuaweiReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).ReturnFeeHuaweiSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_ReturnFeeHuaweiSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).ReturnFeeHuaweiSubscription(ctx, req.(*UnsubscribeHuaweiReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_WithdrawalHuaweiSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeHuaweiReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).WithdrawalHuaweiSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_WithdrawalHuaweiSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).WithdrawalHuaweiSubscription(ctx, req.(*UnsubscribeHuaweiReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_GetHuaweiSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeHuaweiReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GetHuaweiSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GetHuaweiSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GetHuaweiSubscription(ctx, req.(*UnsubscribeHuaweiReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Payment_DouyinPeriodOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DouyinPeriodOrderReq)
 	if err := dec(in); err != nil {
@@ -1038,38 +1368,128 @@ func _Payment_WechatMiniXPayQueryOrder_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_AutoPkgAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AutoPkgAddReq)
+func _Payment_DyPeriodOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DyPeriodOrderReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServer).AutoPkgAdd(ctx, in)
+		return srv.(PaymentServer).DyPeriodOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Payment_AutoPkgAdd_FullMethodName,
+		FullMethod: Payment_DyPeriodOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServer).AutoPkgAdd(ctx, req.(*AutoPkgAddReq))
+		return srv.(PaymentServer).DyPeriodOrder(ctx, req.(*DyPeriodOrderReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_DyPeriodOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DyPeriodOrderReq)
+func _Payment_GetUserSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSubscriptionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServer).DyPeriodOrder(ctx, in)
+		return srv.(PaymentServer).GetUserSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Payment_DyPeriodOrder_FullMethodName,
+		FullMethod: Payment_GetUserSubscriptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServer).DyPeriodOrder(ctx, req.(*DyPeriodOrderReq))
+		return srv.(PaymentServer).GetUserSubscriptions(ctx, req.(*GetUserSubscriptionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSubscriptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).CancelSubscription(ctx, req.(*CancelSubscriptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_GetSubscriptionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).GetSubscriptionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_GetSubscriptionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).GetSubscriptionHistory(ctx, req.(*GetSubscriptionHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_SaveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).SaveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_SaveConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).SaveConfig(ctx, req.(*SaveConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_ListConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).ListConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_ListConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).ListConfig(ctx, req.(*ListConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payment_SetConfigStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConfigStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServer).SetConfigStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payment_SetConfigStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServer).SetConfigStatus(ctx, req.(*SetConfigStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1093,6 +1513,18 @@ var Payment_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AlipayFundTransUniTransfer",
 			Handler:    _Payment_AlipayFundTransUniTransfer_Handler,
 		},
+		{
+			MethodName: "AlipayFundTransQuery",
+			Handler:    _Payment_AlipayFundTransQuery_Handler,
+		},
+		{
+			MethodName: "AlipayFundTransBatch",
+			Handler:    _Payment_AlipayFundTransBatch_Handler,
+		},
+		{
+			MethodName: "WechatFundTransfer",
+			Handler:    _Payment_WechatFundTransfer_Handler,
+		},
 		{
 			MethodName: "OrderStatus",
 			Handler:    _Payment_OrderStatus_Handler,
@@ -1169,6 +1601,22 @@ var Payment_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnsubscribeHuaweiRefund",
 			Handler:    _Payment_UnsubscribeHuaweiRefund_Handler,
 		},
+		{
+			MethodName: "DelayHuaweiSubscription",
+			Handler:    _Payment_DelayHuaweiSubscription_Handler,
+		},
+		{
+			MethodName: "ReturnFeeHuaweiSubscription",
+			Handler:    _Payment_ReturnFeeHuaweiSubscription_Handler,
+		},
+		{
+			MethodName: "WithdrawalHuaweiSubscription",
+			Handler:    _Payment_WithdrawalHuaweiSubscription_Handler,
+		},
+		{
+			MethodName: "GetHuaweiSubscription",
+			Handler:    _Payment_GetHuaweiSubscription_Handler,
+		},
 		{
 			MethodName: "DouyinPeriodOrder",
 			Handler:    _Payment_DouyinPeriodOrder_Handler,
@@ -1189,14 +1637,34 @@ var Payment_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WechatMiniXPayQueryOrder",
 			Handler:    _Payment_WechatMiniXPayQueryOrder_Handler,
 		},
-		{
-			MethodName: "AutoPkgAdd",
-			Handler:    _Payment_AutoPkgAdd_Handler,
-		},
 		{
 			MethodName: "DyPeriodOrder",
 			Handler:    _Payment_DyPeriodOrder_Handler,
 		},
+		{
+			MethodName: "GetUserSubscriptions",
+			Handler:    _Payment_GetUserSubscriptions_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _Payment_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "GetSubscriptionHistory",
+			Handler:    _Payment_GetSubscriptionHistory_Handler,
+		},
+		{
+			MethodName: "SaveConfig",
+			Handler:    _Payment_SaveConfig_Handler,
+		},
+		{
+			MethodName: "ListConfig",
+			Handler:    _Payment_ListConfig_Handler,
+		},
+		{
+			MethodName: "SetConfigStatus",
+			Handler:    _Payment_SetConfigStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment.proto",